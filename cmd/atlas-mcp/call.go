@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/codeownersnet/atlas/internal/config"
+	"github.com/codeownersnet/atlas/pkg/atlasmcp"
+	"github.com/spf13/cobra"
+)
+
+// newCallCmd creates the call subcommand
+func newCallCmd() *cobra.Command {
+	var (
+		argsJSON   string
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "call <tool>",
+		Short: "Invoke a single tool and print its result",
+		Long: `Build clients from the configuration, execute one tool handler, and print
+the result. Useful for scripting and for debugging handlers without an MCP client.
+
+Arguments are passed as a JSON object. Use --args @file.json to read them from
+a file or --args - to read them from stdin.`,
+		Example: `  atlas-mcp call jira_get_issue --args '{"issue_key": "PROJ-123"}'
+  atlas-mcp call confluence_search --args @query.json --json`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCall(cmd.Context(), cmd.InOrStdin(), cmd.OutOrStdout(), args[0], argsJSON, jsonOutput)
+		},
+	}
+
+	cmd.Flags().StringVarP(&argsJSON, "args", "a", "{}", "tool arguments as a JSON object, @file, or - for stdin")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "print the full tool result as JSON")
+
+	return cmd
+}
+
+func runCall(ctx context.Context, in io.Reader, out io.Writer, toolName, argsJSON string, jsonOutput bool) error {
+	arguments, err := parseCallArgs(in, argsJSON)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	logger := atlasmcp.NewLogger(cfg.Logging)
+	srv, err := atlasmcp.New(ctx, cfg, atlasmcp.WithLogger(&logger))
+	if err != nil {
+		return err
+	}
+
+	result, err := srv.CallTool(ctx, toolName, arguments)
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", toolName, err)
+	}
+
+	if jsonOutput {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal result: %w", err)
+		}
+		fmt.Fprintln(out, string(data))
+	} else {
+		for _, content := range result.Content {
+			fmt.Fprintln(out, content.Text)
+		}
+	}
+
+	if result.IsError {
+		return fmt.Errorf("%s returned an error result", toolName)
+	}
+
+	return nil
+}
+
+// parseCallArgs parses tool arguments from inline JSON, @file, or - (stdin)
+func parseCallArgs(in io.Reader, value string) (map[string]interface{}, error) {
+	var data []byte
+
+	switch {
+	case value == "-":
+		b, err := io.ReadAll(in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read arguments from stdin: %w", err)
+		}
+		data = b
+	case strings.HasPrefix(value, "@"):
+		b, err := os.ReadFile(strings.TrimPrefix(value, "@"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read arguments file: %w", err)
+		}
+		data = b
+	default:
+		data = []byte(value)
+	}
+
+	arguments := make(map[string]interface{})
+	if strings.TrimSpace(string(data)) == "" {
+		return arguments, nil
+	}
+
+	if err := json.Unmarshal(data, &arguments); err != nil {
+		return nil, fmt.Errorf("arguments must be a JSON object: %w", err)
+	}
+
+	return arguments, nil
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/codeownersnet/atlas/internal/config"
+	"github.com/codeownersnet/atlas/pkg/atlasmcp"
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+const doctorCheckTimeout = 15 * time.Second
+
+// doctorJiraPermissions are the Jira permissions used by the Jira tools
+var doctorJiraPermissions = []string{
+	"BROWSE_PROJECTS",
+	"CREATE_ISSUES",
+	"EDIT_ISSUES",
+	"TRANSITION_ISSUES",
+	"ADD_COMMENTS",
+	"WORK_ON_ISSUES",
+	"LINK_ISSUES",
+	"DELETE_ISSUES",
+}
+
+// newDoctorCmd creates the doctor subcommand
+func newDoctorCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Check configuration, authentication, and available tools",
+		Long: `Validate the configuration, authenticate against each configured product,
+and report the deployment type, permissions, and the tools that would be registered.
+
+Useful for debugging a first-time setup before connecting an MCP client.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDoctor(cmd.OutOrStdout(), configFile)
+		},
+	}
+}
+
+// doctorReport collects check results and prints them as they happen
+type doctorReport struct {
+	out      io.Writer
+	failures int
+}
+
+func (r *doctorReport) section(title string) {
+	fmt.Fprintf(r.out, "\n%s\n", title)
+}
+
+func (r *doctorReport) ok(format string, args ...interface{}) {
+	fmt.Fprintf(r.out, "  [OK]   %s\n", fmt.Sprintf(format, args...))
+}
+
+func (r *doctorReport) warn(format string, args ...interface{}) {
+	fmt.Fprintf(r.out, "  [WARN] %s\n", fmt.Sprintf(format, args...))
+}
+
+func (r *doctorReport) fail(format string, args ...interface{}) {
+	r.failures++
+	fmt.Fprintf(r.out, "  [FAIL] %s\n", fmt.Sprintf(format, args...))
+}
+
+func (r *doctorReport) info(format string, args ...interface{}) {
+	fmt.Fprintf(r.out, "         %s\n", fmt.Sprintf(format, args...))
+}
+
+func runDoctor(out io.Writer, configFile string) error {
+	report := &doctorReport{out: out}
+
+	fmt.Fprintf(out, "atlas-mcp %s doctor\n", version)
+
+	// Configuration
+	report.section("Configuration")
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		report.fail("%v", err)
+		return fmt.Errorf("configuration is invalid")
+	}
+	if configFile != "" {
+		report.ok("loaded %s", configFile)
+	} else {
+		report.ok("loaded from environment")
+	}
+	report.info("read-only mode: %t", cfg.Security.ReadOnlyMode)
+	if len(cfg.Security.EnabledTools) > 0 {
+		report.info("enabled tools: %s", strings.Join(cfg.Security.EnabledTools, ", "))
+	}
+	if len(cfg.Security.ConfirmTools) > 0 {
+		report.info("tools requiring confirmation: %s", strings.Join(cfg.Security.ConfirmTools, ", "))
+	}
+	if cfg.Security.RawRequestTools {
+		report.info("raw request tools: enabled")
+	}
+	if cfg.Server.DataDir != "" {
+		report.info("data directory: %s", cfg.Server.DataDir)
+	}
+	if cfg.Webhook.Enabled {
+		report.info("webhook receiver: %s", cfg.Webhook.Addr)
+	}
+
+	logger := atlasmcp.NewLogger(cfg.Logging)
+	ctx := context.Background()
+	httpCfg := cfg.HTTP
+
+	// Jira
+	if cfg.IsJiraConfigured() {
+		doctorCheckJira(ctx, report, "Jira", cfg.Jira, httpCfg, &logger)
+		for _, name := range sortedKeys(cfg.JiraInstances) {
+			doctorCheckJira(ctx, report, fmt.Sprintf("Jira (instance %q)", name), cfg.JiraInstances[name], httpCfg, &logger)
+		}
+	} else {
+		report.section("Jira")
+		report.info("not configured (set JIRA_URL to enable)")
+	}
+
+	// Confluence
+	if cfg.IsConfluenceConfigured() {
+		doctorCheckConfluence(ctx, report, "Confluence", cfg.Confluence, httpCfg, &logger)
+		for _, name := range sortedKeys(cfg.ConfluenceInstances) {
+			doctorCheckConfluence(ctx, report, fmt.Sprintf("Confluence (instance %q)", name), cfg.ConfluenceInstances[name], httpCfg, &logger)
+		}
+	} else {
+		report.section("Confluence")
+		report.info("not configured (set CONFLUENCE_URL to enable)")
+	}
+
+	// Opsgenie
+	report.section("Opsgenie")
+	if cfg.IsOpsgenieConfigured() {
+		client, err := atlasmcp.NewOpsgenieClient(cfg.Opsgenie, httpCfg, &logger)
+		if err != nil {
+			report.fail("client: %v", err)
+		} else {
+			checkCtx, cancel := context.WithTimeout(ctx, doctorCheckTimeout)
+			account, err := client.GetAccount(checkCtx)
+			cancel()
+			if err != nil {
+				report.fail("authentication: %v", err)
+			} else {
+				report.ok("authenticated to account %q", account.Name)
+				if account.Plan != nil && account.Plan.Name != "" {
+					report.info("plan: %s", account.Plan.Name)
+				}
+			}
+		}
+	} else {
+		report.info("not configured (set OPSGENIE_API_KEY to enable)")
+	}
+
+	// Tools
+	report.section("Tools")
+	srv, err := atlasmcp.New(ctx, cfg, atlasmcp.WithLogger(&logger))
+	if err != nil {
+		report.fail("%v", err)
+	} else {
+		tools := srv.ListTools()
+		names := make([]string, 0, len(tools))
+		for _, tool := range tools {
+			names = append(names, tool.Name)
+		}
+		sort.Strings(names)
+
+		report.ok("%d tools would be registered", len(names))
+		for _, name := range names {
+			report.info("%s", name)
+		}
+	}
+
+	fmt.Fprintln(out)
+	if report.failures > 0 {
+		return fmt.Errorf("doctor found %d problem(s)", report.failures)
+	}
+	fmt.Fprintln(out, "No problems found.")
+	return nil
+}
+
+// doctorCheckJira checks authentication and permissions for a Jira instance
+func doctorCheckJira(ctx context.Context, report *doctorReport, title string, cfg *config.JiraConfig, httpCfg *config.HTTPConfig, logger *zerolog.Logger) {
+	report.section(fmt.Sprintf("%s (%s)", title, cfg.URL))
+
+	client, err := atlasmcp.NewJiraClient(cfg, httpCfg, logger)
+	if err != nil {
+		report.fail("client: %v", err)
+		return
+	}
+	report.ok("deployment: %s, auth method: %s", client.GetDeploymentType(), cfg.AuthMethod)
+
+	ctx, cancel := context.WithTimeout(ctx, doctorCheckTimeout)
+	defer cancel()
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		report.fail("authentication: %v", err)
+		return
+	}
+	report.ok("authenticated as %s", describeUser(user.DisplayName, user.EmailAddress))
+
+	permissions, err := client.GetMyPermissions(ctx, &jira.MyPermissionsOptions{
+		Permissions: doctorJiraPermissions,
+	})
+	if err != nil {
+		report.warn("permissions: %v", err)
+		return
+	}
+
+	var granted, missing []string
+	for _, key := range doctorJiraPermissions {
+		if permissions[key].HavePermission {
+			granted = append(granted, key)
+		} else {
+			missing = append(missing, key)
+		}
+	}
+	if len(granted) > 0 {
+		report.ok("permissions: %s", strings.Join(granted, ", "))
+	}
+	if len(missing) > 0 {
+		report.warn("missing permissions: %s", strings.Join(missing, ", "))
+	}
+	if len(cfg.ProjectsFilter) > 0 {
+		report.info("projects filter: %s", strings.Join(cfg.ProjectsFilter, ", "))
+	}
+}
+
+// doctorCheckConfluence checks authentication for a Confluence instance
+func doctorCheckConfluence(ctx context.Context, report *doctorReport, title string, cfg *config.ConfluenceConfig, httpCfg *config.HTTPConfig, logger *zerolog.Logger) {
+	report.section(fmt.Sprintf("%s (%s)", title, cfg.URL))
+
+	client, err := atlasmcp.NewConfluenceClient(cfg, httpCfg, logger)
+	if err != nil {
+		report.fail("client: %v", err)
+		return
+	}
+	report.ok("deployment: %s, auth method: %s", client.GetDeploymentType(), cfg.AuthMethod)
+
+	ctx, cancel := context.WithTimeout(ctx, doctorCheckTimeout)
+	defer cancel()
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		report.fail("authentication: %v", err)
+		return
+	}
+	if user.Type == "anonymous" {
+		report.fail("authentication: credentials were not accepted (anonymous user)")
+		return
+	}
+	report.ok("authenticated as %s", describeUser(user.DisplayName, user.Email))
+
+	if len(cfg.SpacesFilter) > 0 {
+		report.info("spaces filter: %s", strings.Join(cfg.SpacesFilter, ", "))
+	}
+}
+
+// describeUser formats a display name with an optional email address
+func describeUser(displayName, email string) string {
+	if email == "" {
+		return displayName
+	}
+	return fmt.Sprintf("%s <%s>", displayName, email)
+}
+
+// sortedKeys returns the keys of a map in sorted order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/codeownersnet/atlas/internal/config"
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/pkg/atlasmcp"
+	"github.com/spf13/cobra"
+)
+
+// newToolsCmd creates the tools subcommand
+func newToolsCmd() *cobra.Command {
+	var (
+		format     string
+		configured bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "tools",
+		Short: "List available tools with descriptions and input schemas",
+		Long: `Print every tool with its description and JSON input schema without starting
+a transport. Useful for generating MCP client configuration and documentation.
+
+By default every built-in tool is listed: the Jira, Confluence, Opsgenie, and
+cross-product tools, and the raw request, diagnostic, and event tools. Use --configured
+to list only the tools the server would expose with the current configuration
+(configured products, ENABLED_TOOLS, and READ_ONLY_MODE).`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tools, err := listTools(configured, configFile)
+			if err != nil {
+				return err
+			}
+			return printTools(cmd.OutOrStdout(), tools, format)
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "text", "output format: text, json, or markdown")
+	cmd.Flags().BoolVar(&configured, "configured", false, "only list tools enabled by the current configuration")
+
+	return cmd
+}
+
+// listTools returns the tools sorted by name
+func listTools(configured bool, configFile string) ([]mcp.Tool, error) {
+	if !configured {
+		return atlasmcp.AllTools()
+	}
+
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+	logger := atlasmcp.NewLogger(cfg.Logging)
+	srv, err := atlasmcp.New(context.Background(), cfg, atlasmcp.WithLogger(&logger))
+	if err != nil {
+		return nil, err
+	}
+	return srv.ListTools(), nil
+}
+
+// printTools writes the tools in the requested format
+func printTools(out io.Writer, tools []mcp.Tool, format string) error {
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(tools, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal tools: %w", err)
+		}
+		fmt.Fprintln(out, string(data))
+
+	case "markdown", "md":
+		fmt.Fprintln(out, "| Tool | Description | Parameters |")
+		fmt.Fprintln(out, "|------|-------------|------------|")
+		for _, tool := range tools {
+			fmt.Fprintf(out, "| `%s` | %s | %s |\n",
+				tool.Name,
+				escapeMarkdownCell(tool.Description),
+				escapeMarkdownCell(formatParameters(tool.InputSchema)),
+			)
+		}
+
+	case "text", "":
+		for i, tool := range tools {
+			if i > 0 {
+				fmt.Fprintln(out)
+			}
+			schema, err := json.MarshalIndent(tool.InputSchema, "  ", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal schema for %s: %w", tool.Name, err)
+			}
+			fmt.Fprintf(out, "%s\n  %s\n  %s\n", tool.Name, tool.Description, schema)
+		}
+		fmt.Fprintf(out, "\n%d tools\n", len(tools))
+
+	default:
+		return fmt.Errorf("unsupported format %q (use text, json, or markdown)", format)
+	}
+
+	return nil
+}
+
+// formatParameters lists schema properties, marking required ones with an asterisk
+func formatParameters(schema mcp.InputSchema) string {
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make([]string, 0, len(names))
+	for _, name := range names {
+		param := fmt.Sprintf("`%s` (%s)", name, schema.Properties[name].Type)
+		if required[name] {
+			param += "*"
+		}
+		params = append(params, param)
+	}
+
+	return strings.Join(params, ", ")
+}
+
+// escapeMarkdownCell makes text safe for a single markdown table cell
+func escapeMarkdownCell(text string) string {
+	text = strings.ReplaceAll(text, "|", "\\|")
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCaptureSize is the number of exchanges a capture keeps in memory
+	// when no size is given
+	DefaultCaptureSize = 100
+
+	// maxCaptureBody caps the bytes of a request or response body kept per
+	// exchange
+	maxCaptureBody = 64 << 10
+)
+
+// redactedHeaders are headers whose values are never captured
+var redactedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// Exchange is a captured HTTP request and its response
+type Exchange struct {
+	ID              uint64            `json:"id"`
+	Time            time.Time         `json:"time"`
+	DurationMs      int64             `json:"duration_ms"`
+	Method          string            `json:"method"`
+	URL             string            `json:"url"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	RequestBody     string            `json:"request_body,omitempty"`
+	StatusCode      int               `json:"status_code,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    string            `json:"response_body,omitempty"`
+	Truncated       bool              `json:"truncated,omitempty"` // A body exceeded the capture limit
+	Error           string            `json:"error,omitempty"`
+}
+
+// Capture records sanitized HTTP exchanges for debugging API
+// incompatibilities. It keeps the most recent exchanges in memory and, when a
+// directory is set, also writes each exchange to a JSON file there. Secret
+// header values are redacted.
+type Capture struct {
+	mu      sync.Mutex
+	entries []Exchange // Ring buffer
+	next    int
+	full    bool
+	seq     uint64
+	dir     string
+}
+
+// NewCapture creates a capture keeping the last size exchanges in memory
+// (DefaultCaptureSize if size is zero or less). If dir is not empty, it is
+// created and every exchange is also written to it.
+func NewCapture(size int, dir string) (*Capture, error) {
+	if size <= 0 {
+		size = DefaultCaptureSize
+	}
+
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create capture directory: %w", err)
+		}
+	}
+
+	return &Capture{
+		entries: make([]Exchange, size),
+		dir:     dir,
+	}, nil
+}
+
+// Exchanges returns the captured exchanges in memory, oldest first
+func (c *Capture) Exchanges() []Exchange {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.full {
+		return append([]Exchange(nil), c.entries[:c.next]...)
+	}
+	return append(append([]Exchange(nil), c.entries[c.next:]...), c.entries[:c.next]...)
+}
+
+// record stores a finished exchange
+func (c *Capture) record(ex *Exchange) error {
+	c.mu.Lock()
+	c.seq++
+	ex.ID = c.seq
+	c.entries[c.next] = *ex
+	c.next = (c.next + 1) % len(c.entries)
+	if c.next == 0 {
+		c.full = true
+	}
+	c.mu.Unlock()
+
+	if c.dir == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(ex, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal captured exchange: %w", err)
+	}
+	name := fmt.Sprintf("%s-%06d.json", ex.Time.UTC().Format("20060102T150405"), ex.ID)
+	if err := os.WriteFile(filepath.Join(c.dir, name), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write captured exchange: %w", err)
+	}
+	return nil
+}
+
+// newExchange starts capturing a request
+func newExchange(req *http.Request, customHeaders map[string]string) *Exchange {
+	ex := &Exchange{
+		Time:           time.Now(),
+		Method:         req.Method,
+		URL:            maskURL(req.URL.String()),
+		RequestHeaders: sanitizeHeaders(req.Header, customHeaders),
+	}
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, truncated := readCaptureBody(body)
+			body.Close()
+			ex.RequestBody = data
+			ex.Truncated = truncated
+		}
+	}
+
+	return ex
+}
+
+// sanitizeHeaders flattens headers, redacting credentials, values of
+// secret-looking headers, and custom header values
+func sanitizeHeaders(header http.Header, customHeaders map[string]string) map[string]string {
+	custom := make(map[string]bool, len(customHeaders))
+	for name := range customHeaders {
+		custom[http.CanonicalHeaderKey(name)] = true
+	}
+
+	sanitized := make(map[string]string, len(header))
+	for name, values := range header {
+		if redactedHeaders[name] || custom[name] || isSecretHeader(name) {
+			sanitized[name] = "***"
+			continue
+		}
+		sanitized[name] = strings.Join(values, ", ")
+	}
+	return sanitized
+}
+
+// isSecretHeader reports whether a header name suggests it holds a secret
+func isSecretHeader(name string) bool {
+	lower := strings.ToLower(name)
+	for _, word := range []string{"token", "secret", "key", "auth", "session", "password"} {
+		if strings.Contains(lower, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// readCaptureBody reads a body up to the capture limit
+func readCaptureBody(r io.Reader) (string, bool) {
+	data, _ := io.ReadAll(io.LimitReader(r, maxCaptureBody+1))
+	if len(data) > maxCaptureBody {
+		return string(data[:maxCaptureBody]), true
+	}
+	return string(data), false
+}
+
+// captureBody copies a response body into the exchange as it is read and
+// records the exchange when the body is closed
+type captureBody struct {
+	body    io.ReadCloser
+	capture *Capture
+	ex      *Exchange
+	buf     strings.Builder
+	once    sync.Once
+	onError func(error)
+}
+
+func (b *captureBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+	remaining := maxCaptureBody - b.buf.Len()
+	if n > remaining {
+		b.ex.Truncated = true
+	}
+	if remaining > 0 {
+		b.buf.Write(p[:min(n, remaining)])
+	}
+	if err != nil && err != io.EOF {
+		b.ex.Error = err.Error()
+	}
+	return n, err
+}
+
+func (b *captureBody) Close() error {
+	err := b.body.Close()
+	b.once.Do(func() {
+		b.ex.ResponseBody = b.buf.String()
+		b.ex.DurationMs = time.Since(b.ex.Time).Milliseconds()
+		if recordErr := b.capture.record(b.ex); recordErr != nil {
+			b.onError(recordErr)
+		}
+	})
+	return err
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/codeownersnet/atlas/internal/auth"
+)
+
+func TestClientCapture(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"key": "PROJ-1"}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	capture, err := NewCapture(2, dir)
+	if err != nil {
+		t.Fatalf("NewCapture() error = %v", err)
+	}
+
+	auth, _ := auth.NewBasicAuth("user@example.com", "token123")
+	client, err := NewClient(&Config{
+		BaseURL:       server.URL,
+		Auth:          auth,
+		CustomHeaders: map[string]string{"X-Tenant": "acme"},
+		Capture:       capture,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Post(context.Background(), "/rest/api/2/issue", []byte(`{"fields": {}}`))
+		if err != nil {
+			t.Fatalf("Post() error = %v", err)
+		}
+		io.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+
+	// The ring buffer keeps the last two exchanges
+	exchanges := capture.Exchanges()
+	if len(exchanges) != 2 || exchanges[0].ID != 2 || exchanges[1].ID != 3 {
+		t.Fatalf("Exchanges() = %d exchanges, want IDs 2 and 3", len(exchanges))
+	}
+
+	ex := exchanges[1]
+	if ex.Method != http.MethodPost || !strings.HasSuffix(ex.URL, "/rest/api/2/issue") {
+		t.Errorf("request = %s %s", ex.Method, ex.URL)
+	}
+	if ex.RequestBody != `{"fields": {}}` || ex.ResponseBody != `{"key": "PROJ-1"}` || ex.StatusCode != http.StatusCreated {
+		t.Errorf("exchange = %+v", ex)
+	}
+	for _, header := range []string{"Authorization", "X-Tenant"} {
+		if ex.RequestHeaders[header] != "***" {
+			t.Errorf("request header %s = %q, want redacted", header, ex.RequestHeaders[header])
+		}
+	}
+	if ex.ResponseHeaders["Set-Cookie"] != "***" || ex.ResponseHeaders["X-Request-Id"] != "req-1" {
+		t.Errorf("response headers = %v", ex.ResponseHeaders)
+	}
+
+	// Every exchange is also written to the directory
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 3 {
+		t.Fatalf("capture directory has %d files, want 3", len(files))
+	}
+	data, _ := os.ReadFile(files[0])
+	var written Exchange
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("captured file is not JSON: %v", err)
+	}
+	if strings.Contains(string(data), "token123") || strings.Contains(string(data), "dXNlckBleGFtcGxlLmNvbTp0b2tlbjEyMw") {
+		t.Error("captured file contains credentials")
+	}
+}
+
+func TestClientCaptureFailedRequest(t *testing.T) {
+	capture, _ := NewCapture(0, "")
+
+	auth, _ := auth.NewBasicAuth("user@example.com", "token123")
+	client, err := NewClient(&Config{
+		BaseURL:    "http://127.0.0.1:1",
+		Auth:       auth,
+		MaxRetries: 1,
+		RetryDelay: 1,
+		Capture:    capture,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.Get(context.Background(), "/rest/api/2/myself"); err == nil {
+		t.Fatal("Expected connection error")
+	}
+
+	exchanges := capture.Exchanges()
+	if len(exchanges) != 2 {
+		t.Fatalf("Exchanges() = %d exchanges, want one per attempt", len(exchanges))
+	}
+	if exchanges[0].Error == "" || exchanges[0].StatusCode != 0 {
+		t.Errorf("exchange = %+v, want connection error", exchanges[0])
+	}
+}
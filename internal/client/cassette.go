@@ -0,0 +1,355 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CassetteMode is whether a cassette records live interactions or replays
+// recorded ones
+type CassetteMode string
+
+const (
+	CassetteRecord CassetteMode = "record" // Send requests and record them
+	CassetteReplay CassetteMode = "replay" // Answer requests from the recording
+)
+
+// cassetteOrigin replaces the scheme and host of the recorded site in
+// recorded bodies
+const cassetteOrigin = "https://atlassian.example"
+
+// redactedFields are JSON fields whose string values are never recorded
+var redactedFields = map[string]bool{
+	"emailaddress": true,
+	"email":        true,
+	"password":     true,
+	"token":        true,
+	"accesstoken":  true,
+	"apitoken":     true,
+	"apikey":       true,
+	"secret":       true,
+}
+
+// redactedParams are query parameters whose values are never recorded
+var redactedParams = map[string]bool{
+	"username":  true,
+	"accountid": true,
+	"userkey":   true,
+	"email":     true,
+}
+
+// emailPattern matches email addresses in request paths and queries
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+// userClausePattern matches JQL and CQL clauses comparing a user field with
+// a value: a quoted string, a word, or a list
+var userClausePattern = regexp.MustCompile(`(?i)\b((?:assignee|reporter|creator|watcher|voter|contributor|mention|user(?:\.fullname|\.accountid)?)(?:\s*(?:!=|!~|=|~)\s*|\s+(?:not\s+)?in\s+|\s+was\s+(?:not\s+)?(?:in\s+)?))("[^"]*"|'[^']*'|\((?:[^()]|\(\))*\)|[^\s()]+(?:\(\))?)`)
+
+// userFunctionPattern matches the JQL and CQL values that name no user, such
+// as currentUser() or EMPTY
+var userFunctionPattern = regexp.MustCompile(`(?i)^(?:\w+\(\)|empty|null)$`)
+
+// Interaction is a recorded request and its response. Requests are matched
+// by method and path; the query string is compared parameter by parameter,
+// in any order.
+type Interaction struct {
+	Method       string `json:"method"`
+	Path         string `json:"path"`
+	RequestBody  string `json:"request_body,omitempty"`
+	StatusCode   int    `json:"status_code"`
+	ContentType  string `json:"content_type,omitempty"`
+	ResponseBody string `json:"response_body,omitempty"`
+}
+
+// cassetteFile is the JSON file a cassette is stored in
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Cassette records API interactions to a JSON file, or replays them from
+// one instead of sending requests, for deterministic tests and offline use.
+// Recorded interactions are sanitized: credentials are never recorded,
+// email addresses and secret-looking JSON fields are redacted, and the
+// site's URL is replaced with https://atlassian.example. Request paths and
+// queries lose email addresses, user parameters, and the users named in
+// JQL and CQL.
+//
+// A replayed request gets the first unused interaction with its method and
+// path, or the last one once all are used, so repeated requests keep
+// working. Request bodies are recorded for reference but not matched.
+type Cassette struct {
+	mu           sync.Mutex
+	mode         CassetteMode
+	path         string
+	interactions []Interaction
+	used         []bool
+}
+
+// NewCassette opens the cassette file at path. In replay mode the file is
+// read; in record mode it is created, replacing any earlier recording, and
+// rewritten after every interaction.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{mode: mode, path: path}
+
+	switch mode {
+	case CassetteReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		var file cassetteFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+		c.interactions = file.Interactions
+		c.used = make([]bool, len(file.Interactions))
+	case CassetteRecord:
+		c.interactions = []Interaction{}
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return nil, fmt.Errorf("failed to create cassette directory: %w", err)
+		}
+		if err := c.save(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %q: use %q or %q", mode, CassetteRecord, CassetteReplay)
+	}
+
+	return c, nil
+}
+
+// Mode returns whether the cassette records or replays
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+// Interactions returns the recorded interactions, in order
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// CassetteMissError is returned when a replayed request has no recorded
+// interaction
+type CassetteMissError struct {
+	Method string
+	Path   string
+}
+
+func (e *CassetteMissError) Error() string {
+	return fmt.Sprintf("cassette has no recorded interaction for %s %s", e.Method, e.Path)
+}
+
+// roundTrip answers a request from the cassette, or sends it with
+// httpClient and records it
+func (c *Cassette) roundTrip(httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	if c.mode == CassetteReplay {
+		return c.replay(req)
+	}
+
+	var requestBody []byte
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			requestBody, _ = io.ReadAll(body)
+			body.Close()
+		}
+	}
+
+	// Without an explicit Accept-Encoding the transport decompresses the
+	// response, so the cassette holds plain bodies
+	req.Header.Del("Accept-Encoding")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	origin := req.URL.Scheme + "://" + req.URL.Host
+	err = c.record(Interaction{
+		Method:       req.Method,
+		Path:         sanitizePath(req.URL.RequestURI()),
+		RequestBody:  sanitizeBody(requestBody, origin),
+		StatusCode:   resp.StatusCode,
+		ContentType:  resp.Header.Get("Content-Type"),
+		ResponseBody: sanitizeBody(responseBody, origin),
+	})
+	return resp, err
+}
+
+// replay builds the response of the interaction matching a request
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	path := sanitizePath(req.URL.RequestURI())
+
+	c.mu.Lock()
+	match := -1
+	for i, in := range c.interactions {
+		if in.Method != req.Method || sanitizePath(in.Path) != path {
+			continue
+		}
+		match = i
+		if !c.used[i] {
+			break
+		}
+	}
+	if match >= 0 {
+		c.used[match] = true
+	}
+	c.mu.Unlock()
+
+	if match < 0 {
+		return nil, &CassetteMissError{Method: req.Method, Path: path}
+	}
+
+	in := c.interactions[match]
+	header := make(http.Header)
+	if in.ContentType != "" {
+		header.Set("Content-Type", in.ContentType)
+	}
+	header.Set("Content-Length", strconv.Itoa(len(in.ResponseBody)))
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.StatusCode, http.StatusText(in.StatusCode)),
+		StatusCode:    in.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(in.ResponseBody)),
+		ContentLength: int64(len(in.ResponseBody)),
+		Request:       req,
+	}, nil
+}
+
+// record appends an interaction and rewrites the cassette file
+func (c *Cassette) record(in Interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, in)
+	return c.save()
+}
+
+// save writes the cassette file; the caller holds the lock or owns c
+func (c *Cassette) save() error {
+	data, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette: %w", err)
+	}
+	if err := os.WriteFile(c.path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// sanitizeBody redacts secrets and email addresses in a JSON body and
+// replaces the site's origin. Bodies that are not JSON only get the origin
+// replaced.
+func sanitizeBody(data []byte, origin string) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	// Numbers are kept as written, so large IDs keep their precision
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err == nil {
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(redactFields(decoded)); err == nil {
+			data = bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+		}
+	}
+	return strings.ReplaceAll(string(data), origin, cassetteOrigin)
+}
+
+// sanitizePath redacts users in a request path and its query, and sorts the
+// query parameters, so a request matches its recording whatever the order of
+// its parameters
+func sanitizePath(requestURI string) string {
+	path, rawQuery, _ := strings.Cut(requestURI, "?")
+	path = emailPattern.ReplaceAllString(path, "***")
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil || rawQuery == "" {
+		return path
+	}
+	for key, values := range query {
+		for i, value := range values {
+			if redactedParams[strings.ToLower(key)] {
+				values[i] = "***"
+				continue
+			}
+			values[i] = emailPattern.ReplaceAllString(redactUserClauses(value), "***")
+		}
+	}
+	return path + "?" + query.Encode()
+}
+
+// redactUserClauses replaces the users named in the user clauses of a JQL or
+// CQL query, keeping functions such as currentUser()
+func redactUserClauses(query string) string {
+	return userClausePattern.ReplaceAllStringFunc(query, func(clause string) string {
+		match := userClausePattern.FindStringSubmatch(clause)
+		field, value := match[1], match[2]
+		if !strings.HasPrefix(value, "(") {
+			return field + redactUser(value)
+		}
+		items := strings.Split(value[1:len(value)-1], ",")
+		for i, item := range items {
+			items[i] = redactUser(strings.TrimSpace(item))
+		}
+		return field + "(" + strings.Join(items, ", ") + ")"
+	})
+}
+
+// redactUser redacts a user value of a JQL or CQL clause
+func redactUser(value string) string {
+	if userFunctionPattern.MatchString(value) {
+		return value
+	}
+	return `"***"`
+}
+
+// redactFields replaces the string values of redacted fields in a decoded
+// JSON value
+func redactFields(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if _, isString := field.(string); isString && redactedFields[strings.ToLower(key)] {
+				value[key] = "***"
+				continue
+			}
+			value[key] = redactFields(field)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactFields(item)
+		}
+	}
+	return v
+}
+
+// isCassetteMiss reports whether err is a replayed request without a
+// recorded interaction
+func isCassetteMiss(err error) bool {
+	var miss *CassetteMissError
+	return errors.As(err, &miss)
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/codeownersnet/atlas/internal/auth"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			w.Write([]byte(`{"self": "` + server.URL + `/rest/api/2/myself", "id": 10000000000000001,
+				"emailAddress": "jdoe@example.com", "displayName": "Jane Doe"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errorMessages": ["Page ` + r.URL.Query().Get("startAt") + `"]}`))
+		}
+	}))
+
+	path := filepath.Join(t.TempDir(), "cassettes", "session.json")
+	recorder, err := NewCassette(path, CassetteRecord)
+	if err != nil {
+		t.Fatalf("NewCassette() error = %v", err)
+	}
+	provider, _ := auth.NewBasicAuth("user@example.com", "token123")
+	live, err := NewClient(&Config{BaseURL: server.URL, Auth: provider, Cassette: recorder})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	get := func(client *Client, path string) (int, string, error) {
+		resp, err := client.Get(context.Background(), path)
+		if err != nil {
+			return 0, "", err
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(body), nil
+	}
+
+	// The live response is returned unchanged
+	status, body, err := get(live, "/rest/api/2/myself")
+	if err != nil || status != http.StatusOK || !strings.Contains(body, "jdoe@example.com") {
+		t.Fatalf("Get() = %d %s, %v", status, body, err)
+	}
+	if _, _, err := get(live, "/rest/api/2/search?startAt=50"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if _, _, err := get(live, "/rest/api/2/search?jql=reporter%3D%22jdoe%40example.com%22&maxResults=10"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	server.Close()
+
+	// The recording is sanitized
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read cassette: %v", err)
+	}
+	recorded := string(data)
+	for _, secret := range []string{"jdoe@example.com", server.URL, "token123", "Authorization"} {
+		if strings.Contains(recorded, secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, recorded)
+		}
+	}
+	if !strings.Contains(recorded, "10000000000000001") || !strings.Contains(recorded, cassetteOrigin+"/rest/api/2/myself") {
+		t.Errorf("cassette should keep IDs and links:\n%s", recorded)
+	}
+
+	// Replaying answers from the recording without a server
+	player, err := NewCassette(path, CassetteReplay)
+	if err != nil {
+		t.Fatalf("NewCassette() error = %v", err)
+	}
+	offline, err := NewClient(&Config{BaseURL: "https://jira.invalid", Auth: provider, Cassette: player})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		status, body, err := get(offline, "/rest/api/2/myself")
+		if err != nil || status != http.StatusOK || !strings.Contains(body, "Jane Doe") {
+			t.Errorf("replayed Get() = %d %s, %v", status, body, err)
+		}
+	}
+	if status, body, err := get(offline, "/rest/api/2/search?startAt=50"); err != nil || status != http.StatusNotFound || !strings.Contains(body, "Page 50") {
+		t.Errorf("replayed Get() = %d %s, %v, want the recorded 404", status, body, err)
+	}
+	if status, _, err := get(offline, "/rest/api/2/search?maxResults=10&jql=reporter%3D%22jdoe%40example.com%22"); err != nil || status != http.StatusOK {
+		t.Errorf("replayed Get() with reordered parameters = %d, %v", status, err)
+	}
+	if _, _, err := get(offline, "/rest/api/2/search?startAt=100"); !isCassetteMiss(err) {
+		t.Errorf("Get() of an unrecorded request error = %v, want a cassette miss", err)
+	}
+}
+
+func TestSanitizePath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/rest/api/2/myself", "/rest/api/2/myself"},
+		{"/rest/api/2/search?startAt=50&maxResults=10", "/rest/api/2/search?maxResults=10&startAt=50"},
+		{"/v2/users/jdoe@example.com", "/v2/users/***"},
+		{"/rest/api/2/user?username=jdoe", "/rest/api/2/user?username=%2A%2A%2A"},
+		{
+			"/rest/api/2/search?jql=" + url.QueryEscape("assignee = jdoe AND project = PROJ"),
+			"/rest/api/2/search?jql=" + url.QueryEscape(`assignee = "***" AND project = PROJ`),
+		},
+		{
+			"/rest/api/2/search?jql=" + url.QueryEscape("reporter in (jdoe, currentUser()) OR assignee = currentUser()"),
+			"/rest/api/2/search?jql=" + url.QueryEscape(`reporter in ("***", currentUser()) OR assignee = currentUser()`),
+		},
+		{
+			"/rest/api/content/search?cql=" + url.QueryEscape(`creator = "Jane Doe" and text ~ "mail jdoe@example.com"`),
+			"/rest/api/content/search?cql=" + url.QueryEscape(`creator = "***" and text ~ "mail ***"`),
+		},
+	}
+
+	for _, tt := range tests {
+		if got := sanitizePath(tt.path); got != tt.want {
+			t.Errorf("sanitizePath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestNewCassetteErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewCassette(filepath.Join(dir, "missing.json"), CassetteReplay); err == nil {
+		t.Error("NewCassette() should fail to replay a missing file")
+	}
+	if _, err := NewCassette(filepath.Join(dir, "session.json"), "rewind"); err == nil {
+		t.Error("NewCassette() should reject unknown modes")
+	}
+}
@@ -2,17 +2,26 @@ package client
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/codeownersnet/atlas/internal/auth"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/proxy"
 )
 
@@ -21,8 +30,15 @@ const (
 	defaultMaxRetries    = 3
 	defaultRetryDelay    = 1 * time.Second
 	defaultMaxRetryDelay = 10 * time.Second
+
+	defaultMaxIdleConns        = 100
+	defaultIdleConnTimeout     = 90 * time.Second
+	defaultTLSHandshakeTimeout = 10 * time.Second
 )
 
+// tracer creates a child span for every outgoing HTTP request attempt
+var tracer = otel.Tracer("github.com/codeownersnet/atlas/internal/client")
+
 // Client is an HTTP client with retry logic, authentication, and logging
 type Client struct {
 	httpClient    *http.Client
@@ -32,6 +48,9 @@ type Client struct {
 	logger        *zerolog.Logger
 	maxRetries    int
 	retryDelay    time.Duration
+	maxBodySize   int64
+	capture       *Capture
+	cassette      *Cassette
 }
 
 // Config holds the configuration for creating a new client
@@ -41,13 +60,37 @@ type Config struct {
 	CustomHeaders map[string]string
 	Logger        *zerolog.Logger
 	Timeout       time.Duration
-	MaxRetries    int
+	MaxRetries    int // Zero uses the default; negative disables retries
 	RetryDelay    time.Duration
 	SSLVerify     bool
 	HTTPProxy     string
 	HTTPSProxy    string
 	SOCKSProxy    string
 	NoProxy       string
+
+	// TLS files: a PEM CA bundle trusted in addition to the system roots,
+	// and a PEM client certificate and key for mutual TLS
+	CACertFile     string
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// MaxResponseSize is the maximum size in bytes of a (decompressed)
+	// response body. Zero means no limit.
+	MaxResponseSize int64
+
+	// Connection pooling. Zero values use the defaults; MaxConnsPerHost
+	// defaults to no limit.
+	MaxIdleConns        int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+	TLSHandshakeTimeout time.Duration
+
+	// Capture, if set, records every request and response for debugging
+	Capture *Capture
+
+	// Cassette, if set, records every interaction to a fixture file or
+	// replays recorded interactions instead of sending requests
+	Cassette *Cassette
 }
 
 // NewClient creates a new HTTP client with the given configuration
@@ -69,6 +112,8 @@ func NewClient(cfg *Config) (*Client, error) {
 	maxRetries := cfg.MaxRetries
 	if maxRetries == 0 {
 		maxRetries = defaultMaxRetries
+	} else if maxRetries < 0 {
+		maxRetries = 0
 	}
 
 	retryDelay := cfg.RetryDelay
@@ -95,15 +140,44 @@ func NewClient(cfg *Config) (*Client, error) {
 		logger:        cfg.Logger,
 		maxRetries:    maxRetries,
 		retryDelay:    retryDelay,
+		maxBodySize:   cfg.MaxResponseSize,
+		capture:       cfg.Capture,
+		cassette:      cfg.Cassette,
 	}, nil
 }
 
-// createTransport creates an HTTP transport with proxy and SSL configuration
+// createTransport creates an HTTP transport with proxy, SSL, and connection
+// pooling configuration
 func createTransport(cfg *Config) (http.RoundTripper, error) {
+	maxIdleConns := cfg.MaxIdleConns
+	if maxIdleConns == 0 {
+		maxIdleConns = defaultMaxIdleConns
+	}
+
+	idleConnTimeout := cfg.IdleConnTimeout
+	if idleConnTimeout == 0 {
+		idleConnTimeout = defaultIdleConnTimeout
+	}
+
+	tlsHandshakeTimeout := cfg.TLSHandshakeTimeout
+	if tlsHandshakeTimeout == 0 {
+		tlsHandshakeTimeout = defaultTLSHandshakeTimeout
+	}
+
+	tlsConfig, err := createTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: !cfg.SSLVerify,
-		},
+		TLSClientConfig: tlsConfig,
+		// Each client talks to a single host, so all idle connections may
+		// belong to it
+		MaxIdleConns:        maxIdleConns,
+		MaxIdleConnsPerHost: maxIdleConns,
+		MaxConnsPerHost:     cfg.MaxConnsPerHost,
+		IdleConnTimeout:     idleConnTimeout,
+		TLSHandshakeTimeout: tlsHandshakeTimeout,
 	}
 
 	// Configure proxy
@@ -114,6 +188,44 @@ func createTransport(cfg *Config) (http.RoundTripper, error) {
 	return transport, nil
 }
 
+// createTLSConfig creates the TLS configuration with the custom CA bundle and
+// client certificate, if any
+func createTLSConfig(cfg *Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: !cfg.SSLVerify,
+	}
+
+	if cfg.CACertFile != "" {
+		pem, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate file: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificates found in CA certificate file %s", cfg.CACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertFile != "" || cfg.ClientKeyFile != "" {
+		if cfg.ClientCertFile == "" || cfg.ClientKeyFile == "" {
+			return nil, fmt.Errorf("client certificate and key files must be set together")
+		}
+
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
 // configureProxy configures proxy settings for the transport
 func configureProxy(transport *http.Transport, cfg *Config) error {
 	// SOCKS proxy takes precedence
@@ -231,7 +343,12 @@ func (c *Client) Do(ctx context.Context, method, path string, body io.Reader) (*
 			}
 		}
 
-		resp, err := c.doRequest(ctx, method, path, body)
+		resp, err := c.doRequest(ctx, method, path, body, attempt)
+		var tooLarge *ResponseTooLargeError
+		if errors.As(err, &tooLarge) || isCassetteMiss(err) {
+			// The same response would be returned again
+			return nil, err
+		}
 		if err != nil {
 			lastErr = err
 			c.logDebug("request failed", map[string]interface{}{
@@ -263,15 +380,27 @@ func (c *Client) Do(ctx context.Context, method, path string, body io.Reader) (*
 }
 
 // doRequest performs a single HTTP request
-func (c *Client) doRequest(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+func (c *Client) doRequest(ctx context.Context, method, path string, body io.Reader, attempt int) (*http.Response, error) {
 	// Build full URL
 	fullURL := c.baseURL + path
 
+	ctx, span := tracer.Start(ctx, "HTTP "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			attribute.String("url.full", maskURL(fullURL)),
+			attribute.Int("http.request.resend_count", attempt),
+		),
+	)
+	defer span.End()
+
 	// Create request
 	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
 	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	span.SetAttributes(attribute.String("server.address", req.URL.Hostname()))
 
 	// Apply authentication
 	if err := c.auth.Apply(req); err != nil {
@@ -282,27 +411,158 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body io.Rea
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Accept-Charset", "utf-8")
+	req.Header.Set("Accept-Encoding", "gzip")
 
 	// Apply custom headers
 	for key, value := range c.customHeaders {
 		req.Header.Set(key, value)
 	}
 
+	// Propagate trace context to the remote service
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
 	// Log request (with sensitive data masked)
 	c.logRequest(req)
 
+	var ex *Exchange
+	if c.capture != nil {
+		ex = newExchange(req, c.customHeaders)
+	}
+
 	// Perform request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.recordFailedExchange(ex, err)
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+
 	// Log response
 	c.logResponse(resp)
 
+	if ex != nil {
+		ex.StatusCode = resp.StatusCode
+		ex.ResponseHeaders = sanitizeHeaders(resp.Header, nil)
+	}
+
+	if err := c.prepareBody(resp); err != nil {
+		resp.Body.Close()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.recordFailedExchange(ex, err)
+		return nil, err
+	}
+
+	if ex != nil {
+		resp.Body = &captureBody{
+			body:    resp.Body,
+			capture: c.capture,
+			ex:      ex,
+			onError: func(err error) {
+				c.logDebug("failed to capture exchange", map[string]interface{}{"error": err.Error()})
+			},
+		}
+	}
+
 	return resp, nil
 }
 
+// send sends a request, or answers it from the cassette if one is set
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if c.cassette != nil {
+		return c.cassette.roundTrip(c.httpClient, req)
+	}
+	return c.httpClient.Do(req)
+}
+
+// recordFailedExchange records a captured exchange that failed before its
+// response body could be read
+func (c *Client) recordFailedExchange(ex *Exchange, err error) {
+	if ex == nil {
+		return
+	}
+	ex.Error = err.Error()
+	ex.DurationMs = time.Since(ex.Time).Milliseconds()
+	if recordErr := c.capture.record(ex); recordErr != nil {
+		c.logDebug("failed to capture exchange", map[string]interface{}{"error": recordErr.Error()})
+	}
+}
+
+// prepareBody decompresses a gzip-encoded response body and enforces the
+// maximum response size
+func (c *Client) prepareBody(resp *http.Response) error {
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(resp.Body)
+		switch {
+		case errors.Is(err, io.EOF):
+			// Empty body
+		case err != nil:
+			return fmt.Errorf("failed to decompress response: %w", err)
+		default:
+			resp.Body = &gzipBody{Reader: gz, body: resp.Body}
+		}
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		resp.Uncompressed = true
+	}
+
+	if c.maxBodySize <= 0 {
+		return nil
+	}
+	if resp.ContentLength > c.maxBodySize {
+		return &ResponseTooLargeError{Limit: c.maxBodySize, Size: resp.ContentLength}
+	}
+	resp.Body = &limitedBody{body: resp.Body, remaining: c.maxBodySize, limit: c.maxBodySize}
+
+	return nil
+}
+
+// gzipBody decompresses a response body and closes the underlying body
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
+
+// limitedBody fails reads once more than limit bytes have been read
+type limitedBody struct {
+	body      io.ReadCloser
+	remaining int64
+	limit     int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, &ResponseTooLargeError{Limit: b.limit}
+	}
+
+	// Read one byte past the limit to detect oversized bodies
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.body.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n + int(b.remaining), &ResponseTooLargeError{Limit: b.limit}
+	}
+	return n, err
+}
+
+func (b *limitedBody) Close() error {
+	return b.body.Close()
+}
+
 // shouldRetry determines if a request should be retried based on status code
 func (c *Client) shouldRetry(statusCode int) bool {
 	// Retry on server errors and rate limiting
@@ -411,6 +671,20 @@ func (e *HTTPError) Error() string {
 	return fmt.Sprintf("HTTP %d: %s - %s", e.StatusCode, e.Status, e.Body)
 }
 
+// ResponseTooLargeError is returned when a response body exceeds the maximum
+// response size
+type ResponseTooLargeError struct {
+	Limit int64 // Maximum size in bytes
+	Size  int64 // Declared size in bytes, or zero when unknown
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	if e.Size > 0 {
+		return fmt.Sprintf("response of %d bytes exceeds the maximum response size of %d bytes; request fewer results or fields, or raise the limit", e.Size, e.Limit)
+	}
+	return fmt.Sprintf("response exceeds the maximum response size of %d bytes; request fewer results or fields, or raise the limit", e.Limit)
+}
+
 // NewHTTPError creates a new HTTP error from a response
 func NewHTTPError(resp *http.Response) error {
 	body, _ := io.ReadAll(resp.Body)
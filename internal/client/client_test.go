@@ -1,16 +1,33 @@
 package client
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/codeownersnet/atlas/internal/auth"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
 )
 
 func TestNewClient(t *testing.T) {
@@ -85,6 +102,169 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestCreateTransportPooling(t *testing.T) {
+	tests := []struct {
+		name                string
+		config              *Config
+		wantMaxIdleConns    int
+		wantMaxConnsPerHost int
+		wantIdleTimeout     time.Duration
+		wantTLSTimeout      time.Duration
+	}{
+		{
+			name:             "defaults",
+			config:           &Config{},
+			wantMaxIdleConns: defaultMaxIdleConns,
+			wantIdleTimeout:  defaultIdleConnTimeout,
+			wantTLSTimeout:   defaultTLSHandshakeTimeout,
+		},
+		{
+			name: "configured",
+			config: &Config{
+				MaxIdleConns:        20,
+				MaxConnsPerHost:     50,
+				IdleConnTimeout:     30 * time.Second,
+				TLSHandshakeTimeout: 5 * time.Second,
+			},
+			wantMaxIdleConns:    20,
+			wantMaxConnsPerHost: 50,
+			wantIdleTimeout:     30 * time.Second,
+			wantTLSTimeout:      5 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := createTransport(tt.config)
+			if err != nil {
+				t.Fatalf("createTransport() error = %v", err)
+			}
+			transport := rt.(*http.Transport)
+
+			if transport.MaxIdleConns != tt.wantMaxIdleConns || transport.MaxIdleConnsPerHost != tt.wantMaxIdleConns {
+				t.Errorf("MaxIdleConns/MaxIdleConnsPerHost = %d/%d, want %d", transport.MaxIdleConns, transport.MaxIdleConnsPerHost, tt.wantMaxIdleConns)
+			}
+			if transport.MaxConnsPerHost != tt.wantMaxConnsPerHost {
+				t.Errorf("MaxConnsPerHost = %d, want %d", transport.MaxConnsPerHost, tt.wantMaxConnsPerHost)
+			}
+			if transport.IdleConnTimeout != tt.wantIdleTimeout {
+				t.Errorf("IdleConnTimeout = %v, want %v", transport.IdleConnTimeout, tt.wantIdleTimeout)
+			}
+			if transport.TLSHandshakeTimeout != tt.wantTLSTimeout {
+				t.Errorf("TLSHandshakeTimeout = %v, want %v", transport.TLSHandshakeTimeout, tt.wantTLSTimeout)
+			}
+		})
+	}
+}
+
+func TestClientTLSFiles(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "atlas-client" {
+			t.Errorf("Expected client certificate atlas-client")
+		}
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	writePEM(t, caFile, "CERTIFICATE", server.Certificate().Raw)
+	certFile, keyFile := writeClientCert(t, dir)
+
+	auth, _ := auth.NewBasicAuth("user@example.com", "token123")
+	client, err := NewClient(&Config{
+		BaseURL:        server.URL,
+		Auth:           auth,
+		SSLVerify:      true,
+		MaxRetries:     1,
+		RetryDelay:     time.Millisecond,
+		CACertFile:     caFile,
+		ClientCertFile: certFile,
+		ClientKeyFile:  keyFile,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	resp, err := client.Get(context.Background(), "/test")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+
+	// Without the CA bundle the server certificate is not trusted
+	client, err = NewClient(&Config{
+		BaseURL:        server.URL,
+		Auth:           auth,
+		SSLVerify:      true,
+		MaxRetries:     1,
+		RetryDelay:     time.Millisecond,
+		ClientCertFile: certFile,
+		ClientKeyFile:  keyFile,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if _, err := client.Get(context.Background(), "/test"); err == nil {
+		t.Error("Expected certificate verification error without CA bundle")
+	}
+
+	// Invalid TLS file settings fail client creation
+	invalid := []*Config{
+		{CACertFile: filepath.Join(dir, "missing.pem")},
+		{CACertFile: keyFile},
+		{ClientCertFile: certFile},
+	}
+	for _, cfg := range invalid {
+		cfg.BaseURL = server.URL
+		cfg.Auth = auth
+		if _, err := NewClient(cfg); err == nil {
+			t.Errorf("NewClient(%+v) expected error", cfg)
+		}
+	}
+}
+
+// writeClientCert writes a self-signed client certificate and key to dir
+func writeClientCert(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "atlas-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+
+	certFile = filepath.Join(dir, "client.pem")
+	keyFile = filepath.Join(dir, "client-key.pem")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "PRIVATE KEY", keyDER)
+	return certFile, keyFile
+}
+
+// writePEM writes a single PEM block to a file
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+}
+
 func TestClientGet(t *testing.T) {
 	// Create a test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -213,6 +393,39 @@ func TestClientRetry(t *testing.T) {
 	}
 }
 
+func TestClientNoRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	auth, _ := auth.NewBasicAuth("user@example.com", "token123")
+	logger := zerolog.Nop()
+
+	client, err := NewClient(&Config{
+		BaseURL:    server.URL,
+		Auth:       auth,
+		Logger:     &logger,
+		MaxRetries: -1,
+		RetryDelay: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	resp, err := client.Post(context.Background(), "/hook", []byte(`{}`))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if attempts != 1 || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected 1 attempt with status 503, got %d attempts with status %d", attempts, resp.StatusCode)
+	}
+}
+
 func TestClientCustomHeaders(t *testing.T) {
 	// Create a test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -372,6 +585,117 @@ func TestMaskURL(t *testing.T) {
 	}
 }
 
+func TestClientGzip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("Expected Accept-Encoding gzip, got %q", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(`{"status": "compressed"}`))
+		gz.Close()
+	}))
+	defer server.Close()
+
+	auth, _ := auth.NewBasicAuth("user@example.com", "token123")
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: auth})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	resp, err := client.Get(context.Background(), "/test")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(body) != `{"status": "compressed"}` {
+		t.Errorf("Expected decompressed body, got %q", string(body))
+	}
+	if resp.Header.Get("Content-Encoding") != "" {
+		t.Errorf("Expected Content-Encoding to be removed, got %q", resp.Header.Get("Content-Encoding"))
+	}
+}
+
+func TestClientMaxResponseSize(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/declared":
+			w.Write([]byte(strings.Repeat("a", 200)))
+		case "/streamed":
+			// Flushing before the end forces a chunked body without Content-Length
+			w.Write([]byte(strings.Repeat("a", 60)))
+			w.(http.Flusher).Flush()
+			w.Write([]byte(strings.Repeat("a", 60)))
+		case "/gzip":
+			w.Header().Set("Content-Encoding", "gzip")
+			gz := gzip.NewWriter(w)
+			gz.Write([]byte(strings.Repeat("a", 200)))
+			gz.Close()
+		default:
+			w.Write([]byte(strings.Repeat("a", 100)))
+		}
+	}))
+	defer server.Close()
+
+	auth, _ := auth.NewBasicAuth("user@example.com", "token123")
+	client, err := NewClient(&Config{
+		BaseURL:         server.URL,
+		Auth:            auth,
+		MaxRetries:      2,
+		RetryDelay:      time.Millisecond,
+		MaxResponseSize: 100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	ctx := context.Background()
+
+	// A body at the limit is read in full
+	resp, err := client.Get(ctx, "/exact")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || len(body) != 100 {
+		t.Errorf("ReadAll() = %d bytes, %v; want 100 bytes", len(body), err)
+	}
+
+	// A declared Content-Length over the limit fails without retrying
+	requests = 0
+	_, err = client.Get(ctx, "/declared")
+	var tooLarge *ResponseTooLargeError
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("Get() error = %v, want ResponseTooLargeError", err)
+	}
+	if tooLarge.Size != 200 || tooLarge.Limit != 100 {
+		t.Errorf("ResponseTooLargeError = %+v, want size 200 and limit 100", tooLarge)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+
+	// Bodies without a declared size fail while being read
+	for _, path := range []string{"/streamed", "/gzip"} {
+		resp, err := client.Get(ctx, path)
+		if err != nil {
+			t.Fatalf("Get(%s) error = %v", path, err)
+		}
+		_, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !errors.As(err, &tooLarge) {
+			t.Errorf("ReadAll(%s) error = %v, want ResponseTooLargeError", path, err)
+		}
+	}
+}
+
 func TestHTTPError(t *testing.T) {
 	resp := &http.Response{
 		StatusCode: 404,
@@ -397,3 +721,62 @@ func TestHTTPError(t *testing.T) {
 		t.Errorf("HTTPError.Error() should contain error body")
 	}
 }
+
+func TestClientTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(tracenoop.NewTracerProvider())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	auth, _ := auth.NewBasicAuth("user@example.com", "token123")
+	logger := zerolog.Nop()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    auth,
+		Logger:  &logger,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Start a parent span as the MCP server does for a tool call
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "tools/call test")
+	resp, err := client.Get(ctx, "/missing")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+
+	httpSpan := spans[0]
+	if httpSpan.Name() != "HTTP GET" {
+		t.Errorf("Expected span name 'HTTP GET', got %s", httpSpan.Name())
+	}
+	if httpSpan.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("Expected HTTP span to be a child of the tool call span")
+	}
+	if httpSpan.Status().Code != codes.Error {
+		t.Errorf("Expected error status for 404 response, got %v", httpSpan.Status().Code)
+	}
+
+	var statusCode int64
+	for _, attr := range httpSpan.Attributes() {
+		if attr.Key == "http.response.status_code" {
+			statusCode = attr.Value.AsInt64()
+		}
+	}
+	if statusCode != http.StatusNotFound {
+		t.Errorf("Expected status code attribute 404, got %d", statusCode)
+	}
+}
@@ -1,10 +1,14 @@
 package config
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/viper"
@@ -17,12 +21,24 @@ type Config struct {
 	Opsgenie   *OpsgenieConfig
 	Server     *ServerConfig
 	Security   *SecurityConfig
+	Output     *OutputConfig
 	Logging    *LoggingConfig
 	Proxy      *ProxyConfig
+	HTTP       *HTTPConfig
+	Tracing    *TracingConfig
+	Webhook    *WebhookConfig
+
+	// Additional named instances (e.g. sandbox, eu) keyed by lowercase name
+	JiraInstances       map[string]*JiraConfig
+	ConfluenceInstances map[string]*ConfluenceConfig
 }
 
+// DefaultInstanceName is the name under which the primary instance is addressable
+const DefaultInstanceName = "default"
+
 // JiraConfig holds Jira-specific configuration
 type JiraConfig struct {
+	Name             string // Instance name (empty for the primary instance)
 	URL              string
 	Username         string
 	APIToken         string
@@ -36,11 +52,32 @@ type JiraConfig struct {
 	HTTPSProxy       string
 	SOCKSProxy       string
 	NoProxy          string
+	CACert           string // PEM CA bundle file for TLS verification
+	ClientCert       string // PEM client certificate file for mTLS
+	ClientKey        string // PEM client key file for mTLS
 	AuthMethod       AuthMethod
+
+	// Jira Automation incoming webhooks by lowercase rule name, and the
+	// secrets of the webhooks that require one
+	AutomationWebhooks      map[string]string
+	AutomationWebhookTokens map[string]string
+
+	// Seconds the field list and per-project create metadata are cached
+	// (0 disables caching)
+	MetadataCacheTTL int
+
+	// File defining named issue templates for jira_create_issue (optional)
+	IssueTemplatesFile string
+
+	// Working hours per day and days per week of worklog durations such as
+	// "1d" (0 reads them from the site's time tracking settings)
+	HoursPerDay float64
+	DaysPerWeek float64
 }
 
 // ConfluenceConfig holds Confluence-specific configuration
 type ConfluenceConfig struct {
+	Name             string // Instance name (empty for the primary instance)
 	URL              string
 	Username         string
 	APIToken         string
@@ -54,6 +91,9 @@ type ConfluenceConfig struct {
 	HTTPSProxy       string
 	SOCKSProxy       string
 	NoProxy          string
+	CACert           string // PEM CA bundle file for TLS verification
+	ClientCert       string // PEM client certificate file for mTLS
+	ClientKey        string // PEM client key file for mTLS
 	AuthMethod       AuthMethod
 }
 
@@ -67,26 +107,79 @@ type OpsgenieConfig struct {
 	SOCKSProxy    string
 	NoProxy       string
 	CustomHeaders map[string]string
+	CACert        string // PEM CA bundle file for TLS verification
+	ClientCert    string // PEM client certificate file for mTLS
+	ClientKey     string // PEM client key file for mTLS
 }
 
 // ServerConfig holds server transport configuration
 type ServerConfig struct {
-	Transport string // stdio (only supported transport)
-	Port      int    // Reserved for future use
-	Host      string // Reserved for future use
+	Transport        string // stdio (only supported transport)
+	Port             int    // Reserved for future use
+	Host             string // Reserved for future use
+	BatchConcurrency int    // Items processed at once by batch tools
+	DataDir          string // Directory for state persisted across restarts (empty disables persistence)
+	CommandToolsFile string // File defining tools that run local commands (empty disables them)
 }
 
 // SecurityConfig holds security and access control settings
 type SecurityConfig struct {
 	ReadOnlyMode bool
 	EnabledTools []string
+	ConfirmTools []string // Tools that only run once the user confirms the call
+
+	// How long to wait for the user to answer a confirmation (0 uses the
+	// server default)
+	ConfirmTimeoutSeconds int
+
+	// Register the raw request tools, which call any API path of a product
+	RawRequestTools bool
 }
 
-// LoggingConfig holds logging configuration
+// OutputConfig holds tool result size limits and field profiles. Zero disables
+// a size limit.
+type OutputConfig struct {
+	MaxChars      int // Maximum characters of a tool result
+	MaxFieldChars int // Maximum characters of any single field in a tool result
+
+	// Jira field profiles: named field sets, and the profile each tool uses
+	// when no fields are requested (keyed by tool name)
+	FieldProfiles     map[string][]string
+	ToolFieldProfiles map[string]string
+
+	// Timezone times are shown in by summarized output (IANA name, e.g.
+	// "Europe/Berlin"); empty means UTC
+	DisplayTimezone string
+}
+
+// DisplayLocation returns the timezone times are shown in
+func (o *OutputConfig) DisplayLocation() (*time.Location, error) {
+	if o == nil || o.DisplayTimezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(o.DisplayTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DISPLAY_TIMEZONE %q: %w", o.DisplayTimezone, err)
+	}
+	return loc, nil
+}
+
+// LoggingConfig holds logging and debug capture configuration
 type LoggingConfig struct {
 	Verbose     bool
 	VeryVerbose bool
 	LogToStdout bool
+
+	// Debug capture of sanitized HTTP exchanges, kept in memory and optionally
+	// written to a directory
+	DebugCapture     bool
+	DebugCaptureDir  string
+	DebugCaptureSize int // Exchanges kept in memory
+}
+
+// DebugCaptureEnabled reports whether HTTP exchanges should be captured
+func (l *LoggingConfig) DebugCaptureEnabled() bool {
+	return l != nil && (l.DebugCapture || l.DebugCaptureDir != "")
 }
 
 // ProxyConfig holds global proxy configuration
@@ -97,6 +190,47 @@ type ProxyConfig struct {
 	NoProxy    string
 }
 
+// HTTPConfig holds settings shared by the HTTP clients of all products
+type HTTPConfig struct {
+	MaxResponseSizeMB int // Maximum response body size in MiB (0 = unlimited)
+
+	// Connection pooling
+	MaxIdleConns               int // Idle connections kept open per product
+	MaxConnsPerHost            int // Maximum connections per product (0 = unlimited)
+	IdleConnTimeoutSeconds     int // How long an idle connection is kept open
+	TLSHandshakeTimeoutSeconds int // Maximum time for a TLS handshake
+
+	// Cassette file recording API interactions, or replaying them instead of
+	// sending requests
+	Cassette     string
+	CassetteMode string // "record" or "replay"
+}
+
+// MaxResponseSize returns the maximum response body size in bytes
+func (h *HTTPConfig) MaxResponseSize() int64 {
+	if h == nil {
+		return 0
+	}
+	return int64(h.MaxResponseSizeMB) << 20
+}
+
+// TracingConfig holds OpenTelemetry tracing configuration
+type TracingConfig struct {
+	Enabled     bool
+	Endpoint    string  // OTLP/HTTP endpoint URL (e.g. http://localhost:4318)
+	Insecure    bool    // Send over plain HTTP, even to an https endpoint
+	ServiceName string  // service.name resource attribute
+	SampleRatio float64 // Fraction of traces to sample (0.0 - 1.0)
+}
+
+// WebhookConfig holds the webhook receiver configuration
+type WebhookConfig struct {
+	Enabled    bool
+	Addr       string // Listen address (e.g. :8090)
+	Secret     string // Shared secret webhook senders must present
+	BufferSize int    // Events kept in memory
+}
+
 // AuthMethod represents the authentication method to use
 type AuthMethod int
 
@@ -120,12 +254,19 @@ func (a AuthMethod) String() string {
 	}
 }
 
-// Load loads configuration from environment variables, .env file, and CLI flags
+// Load loads configuration from environment variables, a config file, and CLI flags.
+// The config file may be a .env file or a structured YAML/TOML/JSON file; in
+// both cases environment variables take precedence over file values.
 func Load(configFile ...string) (*Config, error) {
 	// Load .env file if it exists (ignore errors if file doesn't exist)
 	// Use Load (not Overload) to respect environment variables set by the MCP host.
 	// This is important for MCP servers where credentials are passed via env vars.
-	if len(configFile) > 0 && configFile[0] != "" {
+	if len(configFile) > 0 && configFile[0] != "" && isStructuredConfigFile(configFile[0]) {
+		// Load structured config file, but don't override existing env vars
+		if err := loadConfigFile(configFile[0]); err != nil {
+			return nil, err
+		}
+	} else if len(configFile) > 0 && configFile[0] != "" {
 		// Load specified config file, but don't override existing env vars
 		if err := godotenv.Load(configFile[0]); err != nil {
 			return nil, fmt.Errorf("failed to load config file %s: %w", configFile[0], err)
@@ -145,8 +286,20 @@ func Load(configFile ...string) (*Config, error) {
 		Opsgenie:   loadOpsgenieConfig(),
 		Server:     loadServerConfig(),
 		Security:   loadSecurityConfig(),
+		Output:     loadOutputConfig(),
 		Logging:    loadLoggingConfig(),
 		Proxy:      loadProxyConfig(),
+		HTTP:       loadHTTPConfig(),
+		Tracing:    loadTracingConfig(),
+		Webhook:    loadWebhookConfig(),
+
+		JiraInstances:       loadJiraInstances(),
+		ConfluenceInstances: loadConfluenceInstances(),
+	}
+
+	// Resolve secret references (file:, cmd:, keychain:) in credentials
+	if err := cfg.resolveSecrets(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to resolve secrets: %w", err)
 	}
 
 	// Validate configuration
@@ -159,20 +312,50 @@ func Load(configFile ...string) (*Config, error) {
 
 // loadJiraConfig loads Jira-specific configuration
 func loadJiraConfig() *JiraConfig {
+	return loadJiraConfigWithPrefix("JIRA", getEnv("ATLASSIAN_OAUTH_ACCESS_TOKEN", ""), getEnv("ATLASSIAN_OAUTH_CLOUD_ID", ""))
+}
+
+// loadJiraInstances loads named Jira instances listed in JIRA_INSTANCES.
+// Each instance reads JIRA_<NAME>_URL, JIRA_<NAME>_USERNAME, and so on.
+func loadJiraInstances() map[string]*JiraConfig {
+	instances := make(map[string]*JiraConfig)
+	for _, name := range getEnvList("JIRA_INSTANCES", []string{}) {
+		prefix := instanceEnvPrefix("JIRA", name)
+		cfg := loadJiraConfigWithPrefix(prefix, getEnv(prefix+"_OAUTH_ACCESS_TOKEN", ""), getEnv(prefix+"_OAUTH_CLOUD_ID", ""))
+		cfg.Name = strings.ToLower(name)
+		instances[cfg.Name] = cfg
+	}
+	return instances
+}
+
+// loadJiraConfigWithPrefix loads Jira configuration from <prefix>_* environment variables
+func loadJiraConfigWithPrefix(prefix, oauthAccessToken, oauthCloudID string) *JiraConfig {
 	cfg := &JiraConfig{
-		URL:              getEnv("JIRA_URL", ""),
-		Username:         getEnv("JIRA_USERNAME", ""),
-		APIToken:         getEnv("JIRA_API_TOKEN", ""),
-		PersonalToken:    getEnv("JIRA_PERSONAL_TOKEN", ""),
-		OAuthAccessToken: getEnv("ATLASSIAN_OAUTH_ACCESS_TOKEN", ""),
-		OAuthCloudID:     getEnv("ATLASSIAN_OAUTH_CLOUD_ID", ""),
-		SSLVerify:        getEnvBool("JIRA_SSL_VERIFY", true),
-		ProjectsFilter:   getEnvList("JIRA_PROJECTS_FILTER", []string{}),
-		CustomHeaders:    parseCustomHeaders(getEnv("JIRA_CUSTOM_HEADERS", "")),
-		HTTPProxy:        getEnv("JIRA_HTTP_PROXY", ""),
-		HTTPSProxy:       getEnv("JIRA_HTTPS_PROXY", ""),
-		SOCKSProxy:       getEnv("JIRA_SOCKS_PROXY", ""),
-		NoProxy:          getEnv("JIRA_NO_PROXY", ""),
+		URL:              getEnv(prefix+"_URL", ""),
+		Username:         getEnv(prefix+"_USERNAME", ""),
+		APIToken:         getEnv(prefix+"_API_TOKEN", ""),
+		PersonalToken:    getEnv(prefix+"_PERSONAL_TOKEN", ""),
+		OAuthAccessToken: oauthAccessToken,
+		OAuthCloudID:     oauthCloudID,
+		SSLVerify:        getEnvBool(prefix+"_SSL_VERIFY", true),
+		ProjectsFilter:   getEnvList(prefix+"_PROJECTS_FILTER", []string{}),
+		CustomHeaders:    parseCustomHeaders(getEnv(prefix+"_CUSTOM_HEADERS", "")),
+		HTTPProxy:        getEnv(prefix+"_HTTP_PROXY", ""),
+		HTTPSProxy:       getEnv(prefix+"_HTTPS_PROXY", ""),
+		SOCKSProxy:       getEnv(prefix+"_SOCKS_PROXY", ""),
+		NoProxy:          getEnv(prefix+"_NO_PROXY", ""),
+		CACert:           getEnv(prefix+"_CA_CERT", ""),
+		ClientCert:       getEnv(prefix+"_CLIENT_CERT", ""),
+		ClientKey:        getEnv(prefix+"_CLIENT_KEY", ""),
+
+		AutomationWebhooks:      lowercaseKeys(parseCustomHeaders(getEnv(prefix+"_AUTOMATION_WEBHOOKS", ""))),
+		AutomationWebhookTokens: lowercaseKeys(parseCustomHeaders(getEnv(prefix+"_AUTOMATION_WEBHOOK_TOKENS", ""))),
+
+		MetadataCacheTTL:   getEnvInt(prefix+"_METADATA_CACHE_TTL", 600),
+		IssueTemplatesFile: getEnv(prefix+"_ISSUE_TEMPLATES_FILE", ""),
+
+		HoursPerDay: getEnvFloat(prefix+"_HOURS_PER_DAY", 0),
+		DaysPerWeek: getEnvFloat(prefix+"_DAYS_PER_WEEK", 0),
 	}
 
 	// Detect auth method
@@ -183,20 +366,41 @@ func loadJiraConfig() *JiraConfig {
 
 // loadConfluenceConfig loads Confluence-specific configuration
 func loadConfluenceConfig() *ConfluenceConfig {
+	return loadConfluenceConfigWithPrefix("CONFLUENCE", getEnv("ATLASSIAN_OAUTH_ACCESS_TOKEN", ""), getEnv("ATLASSIAN_OAUTH_CLOUD_ID", ""))
+}
+
+// loadConfluenceInstances loads named Confluence instances listed in CONFLUENCE_INSTANCES.
+// Each instance reads CONFLUENCE_<NAME>_URL, CONFLUENCE_<NAME>_USERNAME, and so on.
+func loadConfluenceInstances() map[string]*ConfluenceConfig {
+	instances := make(map[string]*ConfluenceConfig)
+	for _, name := range getEnvList("CONFLUENCE_INSTANCES", []string{}) {
+		prefix := instanceEnvPrefix("CONFLUENCE", name)
+		cfg := loadConfluenceConfigWithPrefix(prefix, getEnv(prefix+"_OAUTH_ACCESS_TOKEN", ""), getEnv(prefix+"_OAUTH_CLOUD_ID", ""))
+		cfg.Name = strings.ToLower(name)
+		instances[cfg.Name] = cfg
+	}
+	return instances
+}
+
+// loadConfluenceConfigWithPrefix loads Confluence configuration from <prefix>_* environment variables
+func loadConfluenceConfigWithPrefix(prefix, oauthAccessToken, oauthCloudID string) *ConfluenceConfig {
 	cfg := &ConfluenceConfig{
-		URL:              getEnv("CONFLUENCE_URL", ""),
-		Username:         getEnv("CONFLUENCE_USERNAME", ""),
-		APIToken:         getEnv("CONFLUENCE_API_TOKEN", ""),
-		PersonalToken:    getEnv("CONFLUENCE_PERSONAL_TOKEN", ""),
-		OAuthAccessToken: getEnv("ATLASSIAN_OAUTH_ACCESS_TOKEN", ""),
-		OAuthCloudID:     getEnv("ATLASSIAN_OAUTH_CLOUD_ID", ""),
-		SSLVerify:        getEnvBool("CONFLUENCE_SSL_VERIFY", true),
-		SpacesFilter:     getEnvList("CONFLUENCE_SPACES_FILTER", []string{}),
-		CustomHeaders:    parseCustomHeaders(getEnv("CONFLUENCE_CUSTOM_HEADERS", "")),
-		HTTPProxy:        getEnv("CONFLUENCE_HTTP_PROXY", ""),
-		HTTPSProxy:       getEnv("CONFLUENCE_HTTPS_PROXY", ""),
-		SOCKSProxy:       getEnv("CONFLUENCE_SOCKS_PROXY", ""),
-		NoProxy:          getEnv("CONFLUENCE_NO_PROXY", ""),
+		URL:              getEnv(prefix+"_URL", ""),
+		Username:         getEnv(prefix+"_USERNAME", ""),
+		APIToken:         getEnv(prefix+"_API_TOKEN", ""),
+		PersonalToken:    getEnv(prefix+"_PERSONAL_TOKEN", ""),
+		OAuthAccessToken: oauthAccessToken,
+		OAuthCloudID:     oauthCloudID,
+		SSLVerify:        getEnvBool(prefix+"_SSL_VERIFY", true),
+		SpacesFilter:     getEnvList(prefix+"_SPACES_FILTER", []string{}),
+		CustomHeaders:    parseCustomHeaders(getEnv(prefix+"_CUSTOM_HEADERS", "")),
+		HTTPProxy:        getEnv(prefix+"_HTTP_PROXY", ""),
+		HTTPSProxy:       getEnv(prefix+"_HTTPS_PROXY", ""),
+		SOCKSProxy:       getEnv(prefix+"_SOCKS_PROXY", ""),
+		NoProxy:          getEnv(prefix+"_NO_PROXY", ""),
+		CACert:           getEnv(prefix+"_CA_CERT", ""),
+		ClientCert:       getEnv(prefix+"_CLIENT_CERT", ""),
+		ClientKey:        getEnv(prefix+"_CLIENT_KEY", ""),
 	}
 
 	// Detect auth method
@@ -205,6 +409,12 @@ func loadConfluenceConfig() *ConfluenceConfig {
 	return cfg
 }
 
+// instanceEnvPrefix returns the environment variable prefix for a named instance,
+// e.g. ("JIRA", "sandbox-eu") -> "JIRA_SANDBOX_EU"
+func instanceEnvPrefix(product, name string) string {
+	return product + "_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
 // loadOpsgenieConfig loads Opsgenie-specific configuration
 func loadOpsgenieConfig() *OpsgenieConfig {
 	cfg := &OpsgenieConfig{
@@ -216,6 +426,9 @@ func loadOpsgenieConfig() *OpsgenieConfig {
 		HTTPSProxy:    getEnv("OPSGENIE_HTTPS_PROXY", ""),
 		SOCKSProxy:    getEnv("OPSGENIE_SOCKS_PROXY", ""),
 		NoProxy:       getEnv("OPSGENIE_NO_PROXY", ""),
+		CACert:        getEnv("OPSGENIE_CA_CERT", ""),
+		ClientCert:    getEnv("OPSGENIE_CLIENT_CERT", ""),
+		ClientKey:     getEnv("OPSGENIE_CLIENT_KEY", ""),
 	}
 
 	return cfg
@@ -227,6 +440,10 @@ func loadServerConfig() *ServerConfig {
 		Transport: getEnv("TRANSPORT", "stdio"),
 		Port:      getEnvInt("PORT", 8000),
 		Host:      getEnv("HOST", "0.0.0.0"),
+
+		BatchConcurrency: getEnvInt("BATCH_CONCURRENCY", 4),
+		DataDir:          getEnv("DATA_DIR", ""),
+		CommandToolsFile: getEnv("COMMAND_TOOLS_FILE", ""),
 	}
 }
 
@@ -235,6 +452,24 @@ func loadSecurityConfig() *SecurityConfig {
 	return &SecurityConfig{
 		ReadOnlyMode: getEnvBool("READ_ONLY_MODE", false),
 		EnabledTools: getEnvList("ENABLED_TOOLS", []string{}),
+		ConfirmTools: getEnvList("CONFIRM_TOOLS", []string{}),
+
+		ConfirmTimeoutSeconds: getEnvInt("CONFIRM_TIMEOUT", 0),
+
+		RawRequestTools: getEnvBool("RAW_REQUEST_TOOLS", false),
+	}
+}
+
+// loadOutputConfig loads tool result size limits and field profiles
+func loadOutputConfig() *OutputConfig {
+	return &OutputConfig{
+		MaxChars:      getEnvInt("MAX_OUTPUT_CHARS", 0),
+		MaxFieldChars: getEnvInt("MAX_FIELD_CHARS", 0),
+
+		FieldProfiles:     parseFieldProfiles(getEnv("JIRA_FIELD_PROFILES", "")),
+		ToolFieldProfiles: parseCustomHeaders(getEnv("JIRA_TOOL_FIELD_PROFILES", "")),
+
+		DisplayTimezone: getEnv("DISPLAY_TIMEZONE", ""),
 	}
 }
 
@@ -244,6 +479,10 @@ func loadLoggingConfig() *LoggingConfig {
 		Verbose:     getEnvBool("MCP_VERBOSE", false),
 		VeryVerbose: getEnvBool("MCP_VERY_VERBOSE", false),
 		LogToStdout: getEnvBool("MCP_LOGGING_STDOUT", false),
+
+		DebugCapture:     getEnvBool("DEBUG_CAPTURE", false),
+		DebugCaptureDir:  getEnv("DEBUG_CAPTURE_DIR", ""),
+		DebugCaptureSize: getEnvInt("DEBUG_CAPTURE_SIZE", 100),
 	}
 }
 
@@ -257,6 +496,45 @@ func loadProxyConfig() *ProxyConfig {
 	}
 }
 
+// loadHTTPConfig loads settings shared by the HTTP clients
+func loadHTTPConfig() *HTTPConfig {
+	return &HTTPConfig{
+		MaxResponseSizeMB: getEnvInt("MAX_RESPONSE_SIZE_MB", 50),
+
+		MaxIdleConns:               getEnvInt("HTTP_MAX_IDLE_CONNS", 100),
+		MaxConnsPerHost:            getEnvInt("HTTP_MAX_CONNS_PER_HOST", 0),
+		IdleConnTimeoutSeconds:     getEnvInt("HTTP_IDLE_CONN_TIMEOUT", 90),
+		TLSHandshakeTimeoutSeconds: getEnvInt("HTTP_TLS_HANDSHAKE_TIMEOUT", 10),
+
+		Cassette:     getEnv("HTTP_CASSETTE", ""),
+		CassetteMode: getEnv("HTTP_CASSETTE_MODE", "replay"),
+	}
+}
+
+// loadTracingConfig loads OpenTelemetry tracing configuration
+// Tracing is enabled implicitly when an OTLP endpoint is configured.
+func loadTracingConfig() *TracingConfig {
+	endpoint := getEnv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", ""))
+
+	return &TracingConfig{
+		Enabled:     getEnvBool("TRACING_ENABLED", endpoint != ""),
+		Endpoint:    endpoint,
+		Insecure:    getEnvBool("OTEL_EXPORTER_OTLP_INSECURE", false),
+		ServiceName: getEnv("OTEL_SERVICE_NAME", "atlas-mcp"),
+		SampleRatio: getEnvFloat("TRACING_SAMPLE_RATIO", 1.0),
+	}
+}
+
+// loadWebhookConfig loads webhook receiver configuration
+func loadWebhookConfig() *WebhookConfig {
+	return &WebhookConfig{
+		Enabled:    getEnvBool("WEBHOOK_ENABLED", false),
+		Addr:       getEnv("WEBHOOK_ADDR", "127.0.0.1:8090"),
+		Secret:     getEnv("WEBHOOK_SECRET", ""),
+		BufferSize: getEnvInt("WEBHOOK_BUFFER_SIZE", 200),
+	}
+}
+
 // Validate validates the configuration
 func (c *Config) Validate() error {
 	// At least one service must be configured
@@ -282,6 +560,31 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate named instances
+	if len(c.JiraInstances) > 0 && !jiraConfigured {
+		return fmt.Errorf("JIRA_URL is required when JIRA_INSTANCES is set")
+	}
+	for name, instance := range c.JiraInstances {
+		if name == DefaultInstanceName {
+			return fmt.Errorf("jira instance name %q is reserved", name)
+		}
+		if err := instance.Validate(); err != nil {
+			return fmt.Errorf("jira instance %q: %w", name, err)
+		}
+	}
+
+	if len(c.ConfluenceInstances) > 0 && !confluenceConfigured {
+		return fmt.Errorf("CONFLUENCE_URL is required when CONFLUENCE_INSTANCES is set")
+	}
+	for name, instance := range c.ConfluenceInstances {
+		if name == DefaultInstanceName {
+			return fmt.Errorf("confluence instance name %q is reserved", name)
+		}
+		if err := instance.Validate(); err != nil {
+			return fmt.Errorf("confluence instance %q: %w", name, err)
+		}
+	}
+
 	// Validate Opsgenie configuration if provided
 	if opsgenieConfigured {
 		if err := c.Opsgenie.Validate(); err != nil {
@@ -294,29 +597,82 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("server configuration: %w", err)
 	}
 
+	// Validate security settings if provided
+	if c.Security != nil && c.Security.ConfirmTimeoutSeconds < 0 {
+		return fmt.Errorf("CONFIRM_TIMEOUT must not be negative")
+	}
+
+	// Validate output limits if provided
+	if c.Output != nil {
+		if c.Output.MaxChars < 0 {
+			return fmt.Errorf("MAX_OUTPUT_CHARS must not be negative")
+		}
+		if c.Output.MaxFieldChars < 0 {
+			return fmt.Errorf("MAX_FIELD_CHARS must not be negative")
+		}
+		if _, err := c.Output.DisplayLocation(); err != nil {
+			return err
+		}
+	}
+
+	// Validate debug capture settings if provided
+	if c.Logging != nil && c.Logging.DebugCaptureSize < 0 {
+		return fmt.Errorf("DEBUG_CAPTURE_SIZE must not be negative")
+	}
+
+	// Validate HTTP settings if provided
+	if c.HTTP != nil {
+		if err := c.HTTP.Validate(); err != nil {
+			return fmt.Errorf("http configuration: %w", err)
+		}
+	}
+
+	// Validate tracing configuration if provided
+	if c.Tracing != nil && c.Tracing.Enabled {
+		if err := c.Tracing.Validate(); err != nil {
+			return fmt.Errorf("tracing configuration: %w", err)
+		}
+	}
+
+	// Validate webhook receiver configuration if enabled
+	if c.Webhook != nil && c.Webhook.Enabled {
+		if err := c.Webhook.Validate(); err != nil {
+			return fmt.Errorf("webhook configuration: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// EnvPrefix returns the environment variable prefix for this Jira instance
+func (j *JiraConfig) EnvPrefix() string {
+	if j.Name == "" {
+		return "JIRA"
+	}
+	return instanceEnvPrefix("JIRA", j.Name)
+}
+
 // Validate validates Jira configuration
 func (j *JiraConfig) Validate() error {
+	prefix := j.EnvPrefix()
 	if j.URL == "" {
-		return fmt.Errorf("JIRA_URL is required")
+		return fmt.Errorf("%s_URL is required", prefix)
 	}
 
 	// Validate URL format
 	if _, err := url.Parse(j.URL); err != nil {
-		return fmt.Errorf("invalid JIRA_URL: %w", err)
+		return fmt.Errorf("invalid %s_URL: %w", prefix, err)
 	}
 
 	// Validate auth configuration based on method
 	switch j.AuthMethod {
 	case AuthMethodBasic:
 		if j.Username == "" || j.APIToken == "" {
-			return fmt.Errorf("basic auth requires both JIRA_USERNAME and JIRA_API_TOKEN")
+			return fmt.Errorf("basic auth requires both %s_USERNAME and %s_API_TOKEN", prefix, prefix)
 		}
 	case AuthMethodPAT:
 		if j.PersonalToken == "" {
-			return fmt.Errorf("PAT auth requires JIRA_PERSONAL_TOKEN")
+			return fmt.Errorf("PAT auth requires %s_PERSONAL_TOKEN", prefix)
 		}
 	case AuthMethodOAuth:
 		if j.OAuthAccessToken == "" {
@@ -324,29 +680,59 @@ func (j *JiraConfig) Validate() error {
 		}
 	}
 
-	return nil
+	if j.MetadataCacheTTL < 0 {
+		return fmt.Errorf("%s_METADATA_CACHE_TTL must not be negative", prefix)
+	}
+	if j.HoursPerDay < 0 || j.HoursPerDay > 24 {
+		return fmt.Errorf("%s_HOURS_PER_DAY must be between 0 and 24", prefix)
+	}
+	if j.DaysPerWeek < 0 || j.DaysPerWeek > 7 {
+		return fmt.Errorf("%s_DAYS_PER_WEEK must be between 0 and 7", prefix)
+	}
+
+	for name, webhook := range j.AutomationWebhooks {
+		if u, err := url.Parse(webhook); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid %s_AUTOMATION_WEBHOOKS URL for %s: %q", prefix, name, webhook)
+		}
+	}
+	for name := range j.AutomationWebhookTokens {
+		if _, ok := j.AutomationWebhooks[name]; !ok {
+			return fmt.Errorf("%s_AUTOMATION_WEBHOOK_TOKENS has a token for %s, which is not in %s_AUTOMATION_WEBHOOKS", prefix, name, prefix)
+		}
+	}
+
+	return validateClientCert(prefix, j.ClientCert, j.ClientKey)
+}
+
+// EnvPrefix returns the environment variable prefix for this Confluence instance
+func (c *ConfluenceConfig) EnvPrefix() string {
+	if c.Name == "" {
+		return "CONFLUENCE"
+	}
+	return instanceEnvPrefix("CONFLUENCE", c.Name)
 }
 
 // Validate validates Confluence configuration
 func (c *ConfluenceConfig) Validate() error {
+	prefix := c.EnvPrefix()
 	if c.URL == "" {
-		return fmt.Errorf("CONFLUENCE_URL is required")
+		return fmt.Errorf("%s_URL is required", prefix)
 	}
 
 	// Validate URL format
 	if _, err := url.Parse(c.URL); err != nil {
-		return fmt.Errorf("invalid CONFLUENCE_URL: %w", err)
+		return fmt.Errorf("invalid %s_URL: %w", prefix, err)
 	}
 
 	// Validate auth configuration based on method
 	switch c.AuthMethod {
 	case AuthMethodBasic:
 		if c.Username == "" || c.APIToken == "" {
-			return fmt.Errorf("basic auth requires both CONFLUENCE_USERNAME and CONFLUENCE_API_TOKEN")
+			return fmt.Errorf("basic auth requires both %s_USERNAME and %s_API_TOKEN", prefix, prefix)
 		}
 	case AuthMethodPAT:
 		if c.PersonalToken == "" {
-			return fmt.Errorf("PAT auth requires CONFLUENCE_PERSONAL_TOKEN")
+			return fmt.Errorf("PAT auth requires %s_PERSONAL_TOKEN", prefix)
 		}
 	case AuthMethodOAuth:
 		if c.OAuthAccessToken == "" {
@@ -354,7 +740,7 @@ func (c *ConfluenceConfig) Validate() error {
 		}
 	}
 
-	return nil
+	return validateClientCert(prefix, c.ClientCert, c.ClientKey)
 }
 
 // Validate validates Opsgenie configuration
@@ -371,6 +757,15 @@ func (o *OpsgenieConfig) Validate() error {
 		}
 	}
 
+	return validateClientCert("OPSGENIE", o.ClientCert, o.ClientKey)
+}
+
+// validateClientCert checks that a client certificate and key for mTLS are
+// configured together
+func validateClientCert(prefix, cert, key string) error {
+	if (cert == "") != (key == "") {
+		return fmt.Errorf("%s_CLIENT_CERT and %s_CLIENT_KEY must be set together", prefix, prefix)
+	}
 	return nil
 }
 
@@ -386,9 +781,88 @@ func (s *ServerConfig) Validate() error {
 		s.Transport = "stdio"
 	}
 
+	if s.BatchConcurrency < 0 {
+		return fmt.Errorf("BATCH_CONCURRENCY must not be negative")
+	}
+	if s.BatchConcurrency == 0 {
+		s.BatchConcurrency = 4
+	}
+
+	return nil
+}
+
+// Validate validates HTTP client settings
+func (h *HTTPConfig) Validate() error {
+	settings := []struct {
+		name  string
+		value int
+	}{
+		{"MAX_RESPONSE_SIZE_MB", h.MaxResponseSizeMB},
+		{"HTTP_MAX_IDLE_CONNS", h.MaxIdleConns},
+		{"HTTP_MAX_CONNS_PER_HOST", h.MaxConnsPerHost},
+		{"HTTP_IDLE_CONN_TIMEOUT", h.IdleConnTimeoutSeconds},
+		{"HTTP_TLS_HANDSHAKE_TIMEOUT", h.TLSHandshakeTimeoutSeconds},
+	}
+	for _, setting := range settings {
+		if setting.value < 0 {
+			return fmt.Errorf("%s must not be negative", setting.name)
+		}
+	}
+
+	if h.Cassette != "" && h.CassetteMode != "record" && h.CassetteMode != "replay" {
+		return fmt.Errorf("HTTP_CASSETTE_MODE must be record or replay, got %q", h.CassetteMode)
+	}
+
+	return nil
+}
+
+// Validate validates tracing configuration
+func (t *TracingConfig) Validate() error {
+	if t.Endpoint != "" {
+		if _, err := url.Parse(t.Endpoint); err != nil {
+			return fmt.Errorf("invalid OTEL_EXPORTER_OTLP_ENDPOINT: %w", err)
+		}
+	}
+
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		return fmt.Errorf("TRACING_SAMPLE_RATIO must be between 0 and 1, got %v", t.SampleRatio)
+	}
+
 	return nil
 }
 
+// Validate validates webhook receiver configuration
+func (w *WebhookConfig) Validate() error {
+	if w.Addr == "" {
+		return fmt.Errorf("WEBHOOK_ADDR is required")
+	}
+
+	if w.BufferSize <= 0 {
+		return fmt.Errorf("WEBHOOK_BUFFER_SIZE must be positive, got %d", w.BufferSize)
+	}
+
+	// Events reach the agent, so only local senders may skip the secret
+	if w.Secret == "" && !isLoopbackAddr(w.Addr) {
+		return fmt.Errorf("WEBHOOK_SECRET is required when WEBHOOK_ADDR %q is not a loopback address", w.Addr)
+	}
+
+	return nil
+}
+
+// isLoopbackAddr reports whether a listen address only accepts connections
+// from the local host
+func isLoopbackAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 // IsJiraConfigured returns true if Jira is configured
 func (c *Config) IsJiraConfigured() bool {
 	return c.Jira != nil && c.Jira.URL != ""
@@ -440,6 +914,44 @@ func parseCustomHeaders(headerStr string) map[string]string {
 	return headers
 }
 
+// lowercaseKeys returns the map with its keys in lowercase
+func lowercaseKeys(m map[string]string) map[string]string {
+	lower := make(map[string]string, len(m))
+	for key, value := range m {
+		lower[strings.ToLower(key)] = value
+	}
+	return lower
+}
+
+// parseFieldProfiles parses field profiles in the format
+// "name=field1,field2;name2=field3"
+func parseFieldProfiles(profileStr string) map[string][]string {
+	profiles := make(map[string][]string)
+	if profileStr == "" {
+		return profiles
+	}
+
+	for _, entry := range strings.Split(profileStr, ";") {
+		name, list, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !ok || name == "" {
+			continue
+		}
+
+		var fields []string
+		for _, field := range strings.Split(list, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				fields = append(fields, field)
+			}
+		}
+		if len(fields) > 0 {
+			profiles[name] = fields
+		}
+	}
+
+	return profiles
+}
+
 // Helper functions for environment variable parsing
 
 func getEnv(key, defaultValue string) string {
@@ -469,6 +981,18 @@ func getEnvInt(key string, defaultValue int) int {
 	return result
 }
 
+func getEnvFloat(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	result, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
 func getEnvList(key string, defaultValue []string) []string {
 	value := os.Getenv(key)
 	if value == "" {
@@ -1,8 +1,13 @@
 package config
 
 import (
+	"context"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDetectAuthMethod(t *testing.T) {
@@ -296,6 +301,59 @@ func TestJiraConfigValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid mTLS",
+			config: &JiraConfig{
+				URL:           "https://jira.example.com",
+				PersonalToken: "pat123",
+				AuthMethod:    AuthMethodPAT,
+				CACert:        "/etc/ssl/corp-ca.pem",
+				ClientCert:    "/etc/ssl/client.pem",
+				ClientKey:     "/etc/ssl/client-key.pem",
+			},
+			wantErr: false,
+		},
+		{
+			name: "client certificate without key",
+			config: &JiraConfig{
+				URL:           "https://jira.example.com",
+				PersonalToken: "pat123",
+				AuthMethod:    AuthMethodPAT,
+				ClientCert:    "/etc/ssl/client.pem",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid automation webhooks",
+			config: &JiraConfig{
+				URL:                     "https://jira.example.com",
+				PersonalToken:           "pat123",
+				AuthMethod:              AuthMethodPAT,
+				AutomationWebhooks:      map[string]string{"escalate": "https://api-private.atlassian.com/automation/webhooks/jira/a/b/c"},
+				AutomationWebhookTokens: map[string]string{"escalate": "s3cret"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "automation webhook without URL scheme",
+			config: &JiraConfig{
+				URL:                "https://jira.example.com",
+				PersonalToken:      "pat123",
+				AuthMethod:         AuthMethodPAT,
+				AutomationWebhooks: map[string]string{"escalate": "api-private.atlassian.com/automation"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "automation webhook token without webhook",
+			config: &JiraConfig{
+				URL:                     "https://jira.example.com",
+				PersonalToken:           "pat123",
+				AuthMethod:              AuthMethodPAT,
+				AutomationWebhookTokens: map[string]string{"escalate": "s3cret"},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -386,6 +444,30 @@ func TestServerConfigValidate(t *testing.T) {
 	}
 }
 
+func TestHTTPConfigValidate(t *testing.T) {
+	valid := &HTTPConfig{
+		MaxResponseSizeMB:          50,
+		MaxIdleConns:               100,
+		IdleConnTimeoutSeconds:     90,
+		TLSHandshakeTimeoutSeconds: 10,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("HTTPConfig.Validate() error = %v", err)
+	}
+
+	negative := &HTTPConfig{MaxConnsPerHost: -1}
+	err := negative.Validate()
+	if err == nil || !strings.Contains(err.Error(), "HTTP_MAX_CONNS_PER_HOST") {
+		t.Errorf("HTTPConfig.Validate() error = %v, want HTTP_MAX_CONNS_PER_HOST error", err)
+	}
+
+	cassette := &HTTPConfig{Cassette: "session.json", CassetteMode: "rewind"}
+	err = cassette.Validate()
+	if err == nil || !strings.Contains(err.Error(), "HTTP_CASSETTE_MODE") {
+		t.Errorf("HTTPConfig.Validate() error = %v, want HTTP_CASSETTE_MODE error", err)
+	}
+}
+
 func TestConfigValidate(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -729,3 +811,379 @@ func TestIsOpsgenieConfigured(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadConfigFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name: "yaml",
+			file: "config.yaml",
+			content: `jira:
+  url: https://file.atlassian.net
+  username: file@example.com
+  api_token: file-token
+  ssl_verify: false
+  projects_filter: [PROJ1, PROJ2]
+  custom_headers:
+    X-Team: platform
+security:
+  read_only_mode: true
+`,
+		},
+		{
+			name: "toml",
+			file: "config.toml",
+			content: `[jira]
+url = "https://file.atlassian.net"
+username = "file@example.com"
+api_token = "file-token"
+ssl_verify = false
+projects_filter = ["PROJ1", "PROJ2"]
+
+[jira.custom_headers]
+X-Team = "platform"
+
+[security]
+read_only_mode = true
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"JIRA_URL", "JIRA_USERNAME", "JIRA_API_TOKEN", "JIRA_SSL_VERIFY", "JIRA_PROJECTS_FILTER", "JIRA_CUSTOM_HEADERS", "READ_ONLY_MODE"} {
+				t.Setenv(key, "")
+				os.Unsetenv(key)
+			}
+			// Environment takes precedence over the file
+			t.Setenv("JIRA_API_TOKEN", "env-token")
+
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatalf("failed to write config file: %v", err)
+			}
+
+			cfg, err := Load(path)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			if cfg.Jira.URL != "https://file.atlassian.net" {
+				t.Errorf("Jira.URL = %s, want https://file.atlassian.net", cfg.Jira.URL)
+			}
+			if cfg.Jira.APIToken != "env-token" {
+				t.Errorf("Jira.APIToken = %s, want env-token", cfg.Jira.APIToken)
+			}
+			if cfg.Jira.SSLVerify {
+				t.Error("Jira.SSLVerify = true, want false")
+			}
+			if len(cfg.Jira.ProjectsFilter) != 2 || cfg.Jira.ProjectsFilter[1] != "PROJ2" {
+				t.Errorf("Jira.ProjectsFilter = %v, want [PROJ1 PROJ2]", cfg.Jira.ProjectsFilter)
+			}
+			if cfg.Jira.CustomHeaders["x-team"] != "platform" {
+				t.Errorf("Jira.CustomHeaders = %v, want x-team=platform", cfg.Jira.CustomHeaders)
+			}
+			if !cfg.Security.ReadOnlyMode {
+				t.Error("Security.ReadOnlyMode = false, want true")
+			}
+		})
+	}
+}
+
+func TestParseConfigSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]interface{}
+		wantErr  string
+	}{
+		{
+			name: "valid settings",
+			settings: map[string]interface{}{
+				"server": map[string]interface{}{"port": 9000},
+			},
+		},
+		{
+			name: "unknown key",
+			settings: map[string]interface{}{
+				"jira": map[string]interface{}{"urll": "https://example.atlassian.net"},
+			},
+			wantErr: `unknown key "jira.urll"`,
+		},
+		{
+			name: "invalid boolean",
+			settings: map[string]interface{}{
+				"jira": map[string]interface{}{"ssl_verify": "maybe"},
+			},
+			wantErr: `invalid value for "jira.ssl_verify"`,
+		},
+		{
+			name: "relative URL",
+			settings: map[string]interface{}{
+				"confluence": map[string]interface{}{"url": "wiki"},
+			},
+			wantErr: `invalid value for "confluence.url"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseConfigSettings(tt.settings)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("parseConfigSettings() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseConfigSettings() error = %v, want containing %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadNamedInstances(t *testing.T) {
+	t.Setenv("JIRA_INSTANCES", "sandbox, eu-west")
+	t.Setenv("JIRA_SANDBOX_URL", "https://sandbox.atlassian.net")
+	t.Setenv("JIRA_SANDBOX_USERNAME", "user@example.com")
+	t.Setenv("JIRA_SANDBOX_API_TOKEN", "token123")
+	t.Setenv("JIRA_EU_WEST_URL", "https://jira.eu.example.com")
+	t.Setenv("JIRA_EU_WEST_PERSONAL_TOKEN", "pat123")
+	t.Setenv("CONFLUENCE_INSTANCES", "")
+
+	instances := loadJiraInstances()
+	if len(instances) != 2 {
+		t.Fatalf("loadJiraInstances() returned %d instances, want 2", len(instances))
+	}
+
+	sandbox := instances["sandbox"]
+	if sandbox == nil || sandbox.URL != "https://sandbox.atlassian.net" || sandbox.AuthMethod != AuthMethodBasic {
+		t.Errorf("sandbox instance = %+v, want basic auth on sandbox URL", sandbox)
+	}
+
+	eu := instances["eu-west"]
+	if eu == nil || eu.AuthMethod != AuthMethodPAT || eu.EnvPrefix() != "JIRA_EU_WEST" {
+		t.Errorf("eu-west instance = %+v, want PAT auth with JIRA_EU_WEST prefix", eu)
+	}
+
+	if got := loadConfluenceInstances(); len(got) != 0 {
+		t.Errorf("loadConfluenceInstances() returned %d instances, want 0", len(got))
+	}
+}
+
+func TestConfigValidateInstances(t *testing.T) {
+	primary := &JiraConfig{
+		URL:        "https://example.atlassian.net",
+		Username:   "user@example.com",
+		APIToken:   "token123",
+		AuthMethod: AuthMethodBasic,
+	}
+
+	tests := []struct {
+		name      string
+		jira      *JiraConfig
+		instances map[string]*JiraConfig
+		wantErr   string
+	}{
+		{
+			name: "valid instance",
+			jira: primary,
+			instances: map[string]*JiraConfig{
+				"sandbox": {Name: "sandbox", URL: "https://sandbox.atlassian.net", PersonalToken: "pat", AuthMethod: AuthMethodPAT},
+			},
+		},
+		{
+			name: "instance missing URL",
+			jira: primary,
+			instances: map[string]*JiraConfig{
+				"sandbox": {Name: "sandbox"},
+			},
+			wantErr: "JIRA_SANDBOX_URL is required",
+		},
+		{
+			name: "instances without primary",
+			jira: &JiraConfig{},
+			instances: map[string]*JiraConfig{
+				"sandbox": {Name: "sandbox", URL: "https://sandbox.atlassian.net"},
+			},
+			wantErr: "JIRA_URL is required when JIRA_INSTANCES is set",
+		},
+		{
+			name: "reserved name",
+			jira: primary,
+			instances: map[string]*JiraConfig{
+				"default": {Name: "default", URL: "https://sandbox.atlassian.net"},
+			},
+			wantErr: "reserved",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Jira:          tt.jira,
+				Confluence:    &ConfluenceConfig{},
+				Opsgenie:      &OpsgenieConfig{APIKey: "key"},
+				Server:        &ServerConfig{Transport: "stdio"},
+				JiraInstances: tt.instances,
+			}
+
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want containing %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveSecret(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(secretFile, []byte("file-secret\n"), 0o600); err != nil {
+		t.Fatalf("failed to write secret file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "plain value",
+			value: "plain-token",
+			want:  "plain-token",
+		},
+		{
+			name:  "unknown scheme is left unchanged",
+			value: "https://example.com",
+			want:  "https://example.com",
+		},
+		{
+			name:  "file reference",
+			value: "file:" + secretFile,
+			want:  "file-secret",
+		},
+		{
+			name:    "missing file",
+			value:   "file:" + filepath.Join(t.TempDir(), "missing"),
+			wantErr: true,
+		},
+		{
+			name:  "command reference",
+			value: "cmd:echo command-secret",
+			want:  "command-secret",
+		},
+		{
+			name:    "failing command",
+			value:   "cmd:exit 1",
+			wantErr: true,
+		},
+		{
+			name:    "malformed keychain reference",
+			value:   "keychain:service-only",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveSecret(context.Background(), tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveSecret() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveSecret() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigResolveSecrets(t *testing.T) {
+	cfg := &Config{
+		Jira:     &JiraConfig{APIToken: "cmd:echo jira-secret"},
+		Opsgenie: &OpsgenieConfig{APIKey: "cmd:exit 1"},
+	}
+
+	err := cfg.resolveSecrets(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "OPSGENIE_API_KEY") {
+		t.Fatalf("resolveSecrets() error = %v, want error naming OPSGENIE_API_KEY", err)
+	}
+	if cfg.Jira.APIToken != "jira-secret" {
+		t.Errorf("Jira.APIToken = %q, want jira-secret", cfg.Jira.APIToken)
+	}
+}
+
+func TestParseFieldProfiles(t *testing.T) {
+	got := parseFieldProfiles(" Triage = summary, status ,priority ; release=fixVersions;empty=;=summary")
+	want := map[string][]string{
+		"triage":  {"summary", "status", "priority"},
+		"release": {"fixVersions"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseFieldProfiles() = %v, want %v", got, want)
+	}
+}
+
+func TestParseConfigSettingsFieldProfiles(t *testing.T) {
+	values, err := parseConfigSettings(map[string]interface{}{
+		"output": map[string]interface{}{
+			"field_profiles": map[string]interface{}{
+				"triage":  []interface{}{"summary", "status"},
+				"release": "fixVersions,summary",
+			},
+			"tool_field_profiles": map[string]interface{}{"jira_search": "triage"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("parseConfigSettings() error = %v", err)
+	}
+
+	if got, want := values["JIRA_FIELD_PROFILES"], "release=fixVersions,summary;triage=summary,status"; got != want {
+		t.Errorf("JIRA_FIELD_PROFILES = %q, want %q", got, want)
+	}
+	if got, want := values["JIRA_TOOL_FIELD_PROFILES"], "jira_search=triage"; got != want {
+		t.Errorf("JIRA_TOOL_FIELD_PROFILES = %q, want %q", got, want)
+	}
+}
+
+func TestDisplayLocation(t *testing.T) {
+	loc, err := (&OutputConfig{}).DisplayLocation()
+	if err != nil || loc != time.UTC {
+		t.Errorf("DisplayLocation() = %v, %v, want UTC by default", loc, err)
+	}
+
+	if _, err := (&OutputConfig{DisplayTimezone: "Mars/Olympus_Mons"}).DisplayLocation(); err == nil || !strings.Contains(err.Error(), "DISPLAY_TIMEZONE") {
+		t.Errorf("DisplayLocation() error = %v, want DISPLAY_TIMEZONE error", err)
+	}
+}
+
+func TestWebhookConfigValidate(t *testing.T) {
+	tests := []struct {
+		addr    string
+		secret  string
+		wantErr bool
+	}{
+		{"127.0.0.1:8090", "", false},
+		{"localhost:8090", "", false},
+		{"[::1]:8090", "", false},
+		{":8090", "", true},
+		{"0.0.0.0:8090", "", true},
+		{"10.0.0.5:8090", "", true},
+		{":8090", "s3cret", false},
+	}
+
+	for _, tt := range tests {
+		cfg := &WebhookConfig{Enabled: true, Addr: tt.addr, Secret: tt.secret, BufferSize: 10}
+		if err := cfg.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate() with addr %q and secret %q error = %v, wantErr %v", tt.addr, tt.secret, err, tt.wantErr)
+		}
+	}
+}
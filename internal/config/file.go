@@ -0,0 +1,436 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// valueKind describes the expected type of a config file value
+type valueKind int
+
+const (
+	kindString valueKind = iota
+	kindBool
+	kindInt
+	kindFloat
+	kindURL
+	kindList
+	kindMap
+	kindProfiles
+)
+
+func (k valueKind) String() string {
+	switch k {
+	case kindBool:
+		return "boolean"
+	case kindInt:
+		return "integer"
+	case kindFloat:
+		return "number"
+	case kindURL:
+		return "URL"
+	case kindList:
+		return "list"
+	case kindMap:
+		return "map"
+	case kindProfiles:
+		return "map of lists"
+	default:
+		return "string"
+	}
+}
+
+// fileKey maps a dotted config file key to its environment variable
+type fileKey struct {
+	env  string
+	kind valueKind
+}
+
+// fileKeys lists every supported config file key, grouped by section.
+// Each key maps to the environment variable it sets. Named instances use
+// jira_instances.<name>.<key> and confluence_instances.<name>.<key>.
+var fileKeys = map[string]fileKey{
+	// Jira
+	"jira.url":             {"JIRA_URL", kindURL},
+	"jira.username":        {"JIRA_USERNAME", kindString},
+	"jira.api_token":       {"JIRA_API_TOKEN", kindString},
+	"jira.personal_token":  {"JIRA_PERSONAL_TOKEN", kindString},
+	"jira.ssl_verify":      {"JIRA_SSL_VERIFY", kindBool},
+	"jira.projects_filter": {"JIRA_PROJECTS_FILTER", kindList},
+	"jira.custom_headers":  {"JIRA_CUSTOM_HEADERS", kindMap},
+	"jira.http_proxy":      {"JIRA_HTTP_PROXY", kindURL},
+	"jira.https_proxy":     {"JIRA_HTTPS_PROXY", kindURL},
+	"jira.socks_proxy":     {"JIRA_SOCKS_PROXY", kindURL},
+	"jira.no_proxy":        {"JIRA_NO_PROXY", kindList},
+	"jira.ca_cert":         {"JIRA_CA_CERT", kindString},
+	"jira.client_cert":     {"JIRA_CLIENT_CERT", kindString},
+	"jira.client_key":      {"JIRA_CLIENT_KEY", kindString},
+	"jira.instances":       {"JIRA_INSTANCES", kindList},
+
+	"jira.automation_webhooks":       {"JIRA_AUTOMATION_WEBHOOKS", kindMap},
+	"jira.automation_webhook_tokens": {"JIRA_AUTOMATION_WEBHOOK_TOKENS", kindMap},
+	"jira.metadata_cache_ttl":        {"JIRA_METADATA_CACHE_TTL", kindInt},
+	"jira.issue_templates_file":      {"JIRA_ISSUE_TEMPLATES_FILE", kindString},
+	"jira.hours_per_day":             {"JIRA_HOURS_PER_DAY", kindFloat},
+	"jira.days_per_week":             {"JIRA_DAYS_PER_WEEK", kindFloat},
+
+	// Confluence
+	"confluence.url":            {"CONFLUENCE_URL", kindURL},
+	"confluence.username":       {"CONFLUENCE_USERNAME", kindString},
+	"confluence.api_token":      {"CONFLUENCE_API_TOKEN", kindString},
+	"confluence.personal_token": {"CONFLUENCE_PERSONAL_TOKEN", kindString},
+	"confluence.ssl_verify":     {"CONFLUENCE_SSL_VERIFY", kindBool},
+	"confluence.spaces_filter":  {"CONFLUENCE_SPACES_FILTER", kindList},
+	"confluence.custom_headers": {"CONFLUENCE_CUSTOM_HEADERS", kindMap},
+	"confluence.http_proxy":     {"CONFLUENCE_HTTP_PROXY", kindURL},
+	"confluence.https_proxy":    {"CONFLUENCE_HTTPS_PROXY", kindURL},
+	"confluence.socks_proxy":    {"CONFLUENCE_SOCKS_PROXY", kindURL},
+	"confluence.no_proxy":       {"CONFLUENCE_NO_PROXY", kindList},
+	"confluence.ca_cert":        {"CONFLUENCE_CA_CERT", kindString},
+	"confluence.client_cert":    {"CONFLUENCE_CLIENT_CERT", kindString},
+	"confluence.client_key":     {"CONFLUENCE_CLIENT_KEY", kindString},
+	"confluence.instances":      {"CONFLUENCE_INSTANCES", kindList},
+
+	// Opsgenie
+	"opsgenie.url":            {"OPSGENIE_URL", kindURL},
+	"opsgenie.api_key":        {"OPSGENIE_API_KEY", kindString},
+	"opsgenie.ssl_verify":     {"OPSGENIE_SSL_VERIFY", kindBool},
+	"opsgenie.custom_headers": {"OPSGENIE_CUSTOM_HEADERS", kindMap},
+	"opsgenie.http_proxy":     {"OPSGENIE_HTTP_PROXY", kindURL},
+	"opsgenie.https_proxy":    {"OPSGENIE_HTTPS_PROXY", kindURL},
+	"opsgenie.socks_proxy":    {"OPSGENIE_SOCKS_PROXY", kindURL},
+	"opsgenie.no_proxy":       {"OPSGENIE_NO_PROXY", kindList},
+	"opsgenie.ca_cert":        {"OPSGENIE_CA_CERT", kindString},
+	"opsgenie.client_cert":    {"OPSGENIE_CLIENT_CERT", kindString},
+	"opsgenie.client_key":     {"OPSGENIE_CLIENT_KEY", kindString},
+
+	// OAuth (shared by Jira and Confluence)
+	"oauth.access_token": {"ATLASSIAN_OAUTH_ACCESS_TOKEN", kindString},
+	"oauth.cloud_id":     {"ATLASSIAN_OAUTH_CLOUD_ID", kindString},
+
+	// Server
+	"server.transport":          {"TRANSPORT", kindString},
+	"server.port":               {"PORT", kindInt},
+	"server.host":               {"HOST", kindString},
+	"server.batch_concurrency":  {"BATCH_CONCURRENCY", kindInt},
+	"server.data_dir":           {"DATA_DIR", kindString},
+	"server.command_tools_file": {"COMMAND_TOOLS_FILE", kindString},
+
+	// Security
+	"security.read_only_mode":    {"READ_ONLY_MODE", kindBool},
+	"security.enabled_tools":     {"ENABLED_TOOLS", kindList},
+	"security.confirm_tools":     {"CONFIRM_TOOLS", kindList},
+	"security.confirm_timeout":   {"CONFIRM_TIMEOUT", kindInt},
+	"security.raw_request_tools": {"RAW_REQUEST_TOOLS", kindBool},
+
+	// Output
+	"output.max_chars":           {"MAX_OUTPUT_CHARS", kindInt},
+	"output.max_field_chars":     {"MAX_FIELD_CHARS", kindInt},
+	"output.field_profiles":      {"JIRA_FIELD_PROFILES", kindProfiles},
+	"output.tool_field_profiles": {"JIRA_TOOL_FIELD_PROFILES", kindMap},
+	"output.display_timezone":    {"DISPLAY_TIMEZONE", kindString},
+
+	// Logging
+	"logging.verbose":            {"MCP_VERBOSE", kindBool},
+	"logging.very_verbose":       {"MCP_VERY_VERBOSE", kindBool},
+	"logging.stdout":             {"MCP_LOGGING_STDOUT", kindBool},
+	"logging.debug_capture":      {"DEBUG_CAPTURE", kindBool},
+	"logging.debug_capture_dir":  {"DEBUG_CAPTURE_DIR", kindString},
+	"logging.debug_capture_size": {"DEBUG_CAPTURE_SIZE", kindInt},
+
+	// Global proxy
+	"proxy.http_proxy":  {"HTTP_PROXY", kindURL},
+	"proxy.https_proxy": {"HTTPS_PROXY", kindURL},
+	"proxy.socks_proxy": {"SOCKS_PROXY", kindURL},
+	"proxy.no_proxy":    {"NO_PROXY", kindList},
+
+	// HTTP
+	"http.max_response_size_mb":  {"MAX_RESPONSE_SIZE_MB", kindInt},
+	"http.max_idle_conns":        {"HTTP_MAX_IDLE_CONNS", kindInt},
+	"http.max_conns_per_host":    {"HTTP_MAX_CONNS_PER_HOST", kindInt},
+	"http.idle_conn_timeout":     {"HTTP_IDLE_CONN_TIMEOUT", kindInt},
+	"http.tls_handshake_timeout": {"HTTP_TLS_HANDSHAKE_TIMEOUT", kindInt},
+	"http.cassette":              {"HTTP_CASSETTE", kindString},
+	"http.cassette_mode":         {"HTTP_CASSETTE_MODE", kindString},
+
+	// Tracing
+	"tracing.enabled":      {"TRACING_ENABLED", kindBool},
+	"tracing.endpoint":     {"OTEL_EXPORTER_OTLP_ENDPOINT", kindURL},
+	"tracing.insecure":     {"OTEL_EXPORTER_OTLP_INSECURE", kindBool},
+	"tracing.service_name": {"OTEL_SERVICE_NAME", kindString},
+	"tracing.sample_ratio": {"TRACING_SAMPLE_RATIO", kindFloat},
+
+	// Webhooks
+	"webhook.enabled":     {"WEBHOOK_ENABLED", kindBool},
+	"webhook.addr":        {"WEBHOOK_ADDR", kindString},
+	"webhook.secret":      {"WEBHOOK_SECRET", kindString},
+	"webhook.buffer_size": {"WEBHOOK_BUFFER_SIZE", kindInt},
+}
+
+// isStructuredConfigFile reports whether the file should be parsed as YAML/TOML/JSON
+// rather than as a .env file
+func isStructuredConfigFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".toml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// loadConfigFile reads a YAML, TOML, or JSON config file and exports its values
+// as environment variables. Variables already present in the environment are
+// left untouched so env always takes precedence over the file.
+func loadConfigFile(path string) error {
+	v := viper.New()
+	v.SetConfigFile(path)
+
+	if err := v.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	values, err := parseConfigSettings(v.AllSettings())
+	if err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	for env, value := range values {
+		if _, exists := os.LookupEnv(env); exists {
+			continue
+		}
+		if err := os.Setenv(env, value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", env, err)
+		}
+	}
+
+	return nil
+}
+
+// parseConfigSettings validates nested config file settings and converts them
+// into environment variable values keyed by variable name
+func parseConfigSettings(settings map[string]interface{}) (map[string]string, error) {
+	flat := make(map[string]interface{})
+	flattenSettings("", settings, flat)
+
+	// Sort keys so the first reported error is deterministic
+	keys := make([]string, 0, len(flat))
+	for key := range flat {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := make(map[string]string, len(flat))
+	for _, key := range keys {
+		spec, ok := lookupFileKey(key)
+		if !ok {
+			return nil, fmt.Errorf("unknown key %q", key)
+		}
+
+		value, err := formatConfigValue(flat[key], spec.kind)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q: %w", key, err)
+		}
+
+		values[spec.env] = value
+	}
+
+	// Named instance sections imply the instance list unless it was set explicitly
+	for _, product := range []string{"jira", "confluence"} {
+		listEnv := strings.ToUpper(product) + "_INSTANCES"
+		if _, ok := values[listEnv]; ok {
+			continue
+		}
+		if names := instanceNames(flat, product); len(names) > 0 {
+			values[listEnv] = strings.Join(names, ",")
+		}
+	}
+
+	return values, nil
+}
+
+// lookupFileKey resolves a dotted config file key, including named instance
+// sections such as jira_instances.sandbox.url -> JIRA_SANDBOX_URL
+func lookupFileKey(key string) (fileKey, bool) {
+	if spec, ok := fileKeys[key]; ok {
+		return spec, true
+	}
+
+	parts := strings.SplitN(key, ".", 3)
+	if len(parts) != 3 {
+		return fileKey{}, false
+	}
+
+	product := strings.TrimSuffix(parts[0], "_instances")
+	if product == parts[0] || (product != "jira" && product != "confluence") {
+		return fileKey{}, false
+	}
+
+	field := parts[2]
+	if field == "oauth_access_token" || field == "oauth_cloud_id" {
+		return fileKey{instanceEnvPrefix(strings.ToUpper(product), parts[1]) + "_" + strings.ToUpper(field), kindString}, true
+	}
+
+	spec, ok := fileKeys[product+"."+field]
+	if !ok || field == "instances" {
+		return fileKey{}, false
+	}
+
+	return fileKey{instanceEnvPrefix(strings.ToUpper(product), parts[1]) + "_" + strings.ToUpper(field), spec.kind}, true
+}
+
+// instanceNames returns the sorted instance names defined in <product>_instances sections
+func instanceNames(flat map[string]interface{}, product string) []string {
+	seen := make(map[string]bool)
+	prefix := product + "_instances."
+	for key := range flat {
+		if rest, ok := strings.CutPrefix(key, prefix); ok {
+			if name, _, found := strings.Cut(rest, "."); found {
+				seen[name] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// flattenSettings flattens nested sections into dotted keys.
+// Map-typed values (custom headers, field profiles) are kept intact.
+func flattenSettings(prefix string, settings map[string]interface{}, out map[string]interface{}) {
+	for key, value := range settings {
+		fullKey := key
+		if prefix != "" {
+			fullKey = prefix + "." + key
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			if spec, known := lookupFileKey(fullKey); !known || (spec.kind != kindMap && spec.kind != kindProfiles) {
+				flattenSettings(fullKey, nested, out)
+				continue
+			}
+		}
+
+		out[fullKey] = value
+	}
+}
+
+// formatConfigValue converts a config file value into the string format
+// understood by the environment variable loaders
+func formatConfigValue(value interface{}, kind valueKind) (string, error) {
+	switch kind {
+	case kindBool:
+		switch v := value.(type) {
+		case bool:
+			return strconv.FormatBool(v), nil
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return strconv.FormatBool(b), nil
+			}
+		}
+	case kindInt:
+		switch v := value.(type) {
+		case int, int64:
+			return fmt.Sprintf("%d", v), nil
+		case float64:
+			if v == float64(int64(v)) {
+				return fmt.Sprintf("%d", int64(v)), nil
+			}
+		case string:
+			if _, err := strconv.Atoi(v); err == nil {
+				return v, nil
+			}
+		}
+	case kindFloat:
+		switch v := value.(type) {
+		case int, int64, float64:
+			return fmt.Sprintf("%v", v), nil
+		case string:
+			if _, err := strconv.ParseFloat(v, 64); err == nil {
+				return v, nil
+			}
+		}
+	case kindURL:
+		if s, ok := value.(string); ok {
+			if s == "" {
+				return s, nil
+			}
+			u, err := url.Parse(s)
+			if err != nil {
+				return "", err
+			}
+			if u.Scheme == "" || u.Host == "" {
+				return "", fmt.Errorf("expected absolute URL, got %q", s)
+			}
+			return s, nil
+		}
+	case kindList:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case []interface{}:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				items = append(items, fmt.Sprintf("%v", item))
+			}
+			return strings.Join(items, ","), nil
+		}
+	case kindMap:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			pairs := make([]string, 0, len(v))
+			for _, k := range keys {
+				pairs = append(pairs, fmt.Sprintf("%s=%v", k, v[k]))
+			}
+			return strings.Join(pairs, ","), nil
+		}
+	case kindProfiles:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case map[string]interface{}:
+			names := make([]string, 0, len(v))
+			for name := range v {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			profiles := make([]string, 0, len(v))
+			for _, name := range names {
+				fields, err := formatConfigValue(v[name], kindList)
+				if err != nil {
+					return "", fmt.Errorf("profile %q: %w", name, err)
+				}
+				profiles = append(profiles, name+"="+fields)
+			}
+			return strings.Join(profiles, ";"), nil
+		}
+	default:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case int, int64, float64, bool:
+			return fmt.Sprintf("%v", v), nil
+		}
+	}
+
+	return "", fmt.Errorf("expected %s, got %T", kind, value)
+}
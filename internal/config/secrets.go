@@ -0,0 +1,231 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const secretCommandTimeout = 10 * time.Second
+
+// SecretProvider resolves a secret reference into its plaintext value.
+// References take the form "<scheme>:<ref>", e.g. "file:/run/secrets/jira_token".
+type SecretProvider interface {
+	// Scheme returns the reference prefix handled by this provider (without the colon)
+	Scheme() string
+	// Resolve returns the secret value for the reference (without the scheme prefix)
+	Resolve(ctx context.Context, ref string) (string, error)
+}
+
+// secretProviders holds the registered providers keyed by scheme
+var secretProviders = map[string]SecretProvider{}
+
+func init() {
+	RegisterSecretProvider(&FileSecretProvider{})
+	RegisterSecretProvider(&CommandSecretProvider{})
+	RegisterSecretProvider(&KeychainSecretProvider{})
+}
+
+// RegisterSecretProvider registers a secret provider, replacing any existing
+// provider for the same scheme
+func RegisterSecretProvider(provider SecretProvider) {
+	secretProviders[provider.Scheme()] = provider
+}
+
+// ResolveSecret resolves a value that may be a secret reference.
+// Values without a registered scheme prefix are returned unchanged.
+func ResolveSecret(ctx context.Context, value string) (string, error) {
+	scheme, ref, found := strings.Cut(value, ":")
+	if !found {
+		return value, nil
+	}
+
+	provider, ok := secretProviders[scheme]
+	if !ok {
+		return value, nil
+	}
+
+	secret, err := provider.Resolve(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("%s secret: %w", scheme, err)
+	}
+
+	return secret, nil
+}
+
+// FileSecretProvider reads a secret from a file ("file:/path/to/secret")
+type FileSecretProvider struct{}
+
+// Scheme returns "file"
+func (p *FileSecretProvider) Scheme() string {
+	return "file"
+}
+
+// Resolve reads the file and returns its contents without trailing newlines
+func (p *FileSecretProvider) Resolve(ctx context.Context, ref string) (string, error) {
+	if ref == "" {
+		return "", fmt.Errorf("file path is required")
+	}
+
+	data, err := os.ReadFile(ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", ref, err)
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// CommandSecretProvider runs an external command and uses its stdout as the
+// secret ("cmd:pass show atlassian/jira")
+type CommandSecretProvider struct{}
+
+// Scheme returns "cmd"
+func (p *CommandSecretProvider) Scheme() string {
+	return "cmd"
+}
+
+// Resolve runs the command through the system shell
+func (p *CommandSecretProvider) Resolve(ctx context.Context, ref string) (string, error) {
+	if strings.TrimSpace(ref) == "" {
+		return "", fmt.Errorf("command is required")
+	}
+
+	if runtime.GOOS == "windows" {
+		return runSecretCommand(ctx, "cmd", "/C", ref)
+	}
+	return runSecretCommand(ctx, "sh", "-c", ref)
+}
+
+// KeychainSecretProvider reads a secret from the OS keychain
+// ("keychain:<service>/<account>"). macOS uses the security tool and Linux
+// uses secret-tool (libsecret).
+type KeychainSecretProvider struct{}
+
+// Scheme returns "keychain"
+func (p *KeychainSecretProvider) Scheme() string {
+	return "keychain"
+}
+
+// Resolve looks up the generic password for the service and account
+func (p *KeychainSecretProvider) Resolve(ctx context.Context, ref string) (string, error) {
+	service, account, found := strings.Cut(ref, "/")
+	if !found || service == "" || account == "" {
+		return "", fmt.Errorf("expected keychain:<service>/<account>, got %q", ref)
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		return runSecretCommand(ctx, "security", "find-generic-password", "-s", service, "-a", account, "-w")
+	case "linux", "freebsd", "openbsd":
+		return runSecretCommand(ctx, "secret-tool", "lookup", "service", service, "account", account)
+	default:
+		return "", fmt.Errorf("keychain is not supported on %s", runtime.GOOS)
+	}
+}
+
+// runSecretCommand runs a command and returns its trimmed stdout
+func runSecretCommand(ctx context.Context, name string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, secretCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s failed: %w: %s", name, err, msg)
+		}
+		return "", fmt.Errorf("%s failed: %w", name, err)
+	}
+
+	secret := strings.TrimRight(stdout.String(), "\r\n")
+	if secret == "" {
+		return "", fmt.Errorf("%s returned an empty secret", name)
+	}
+
+	return secret, nil
+}
+
+// resolveSecrets replaces secret references in credential fields with their values
+func (c *Config) resolveSecrets(ctx context.Context) error {
+	// Cache resolved references so shared values (e.g. the OAuth token used by
+	// both Jira and Confluence) only run their command once
+	resolved := make(map[string]string)
+	resolve := func(name string, value *string) error {
+		if *value == "" {
+			return nil
+		}
+		if secret, ok := resolved[*value]; ok {
+			*value = secret
+			return nil
+		}
+		secret, err := ResolveSecret(ctx, *value)
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s: %w", name, err)
+		}
+		resolved[*value] = secret
+		*value = secret
+		return nil
+	}
+
+	jiraConfigs := []*JiraConfig{c.Jira}
+	for _, instance := range c.JiraInstances {
+		jiraConfigs = append(jiraConfigs, instance)
+	}
+	for _, j := range jiraConfigs {
+		if j == nil {
+			continue
+		}
+		prefix := j.EnvPrefix()
+		if err := resolve(prefix+"_API_TOKEN", &j.APIToken); err != nil {
+			return err
+		}
+		if err := resolve(prefix+"_PERSONAL_TOKEN", &j.PersonalToken); err != nil {
+			return err
+		}
+		if err := resolve("ATLASSIAN_OAUTH_ACCESS_TOKEN", &j.OAuthAccessToken); err != nil {
+			return err
+		}
+	}
+
+	confluenceConfigs := []*ConfluenceConfig{c.Confluence}
+	for _, instance := range c.ConfluenceInstances {
+		confluenceConfigs = append(confluenceConfigs, instance)
+	}
+	for _, cf := range confluenceConfigs {
+		if cf == nil {
+			continue
+		}
+		prefix := cf.EnvPrefix()
+		if err := resolve(prefix+"_API_TOKEN", &cf.APIToken); err != nil {
+			return err
+		}
+		if err := resolve(prefix+"_PERSONAL_TOKEN", &cf.PersonalToken); err != nil {
+			return err
+		}
+		if err := resolve("ATLASSIAN_OAUTH_ACCESS_TOKEN", &cf.OAuthAccessToken); err != nil {
+			return err
+		}
+	}
+
+	if c.Opsgenie != nil {
+		if err := resolve("OPSGENIE_API_KEY", &c.Opsgenie.APIKey); err != nil {
+			return err
+		}
+	}
+
+	if c.Webhook != nil {
+		if err := resolve("WEBHOOK_SECRET", &c.Webhook.Secret); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,338 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Bind copies tool arguments into the fields of the struct dst points to, so
+// handlers don't type-assert each argument. Fields are bound by their "arg"
+// tag and may set a "default" and "validate" rules:
+//
+//	type listArgs struct {
+//		Team   string   `arg:"team,trim" validate:"required"`
+//		Limit  int      `arg:"limit" default:"20" validate:"min=1,max=100"`
+//		Status string   `arg:"status,trim" validate:"oneof=open closed"`
+//		Tags   []string `arg:"tags"` // JSON array or comma-separated string
+//		Note   string   `arg:"note"`
+//	}
+//
+// String values are bound as given, so free text such as a comment body
+// keeps its leading indentation and trailing newlines. The "trim" option
+// trims the surrounding whitespace of identifiers and enum values, before
+// they are validated.
+//
+// Slices of other types, structs, and maps are decoded as JSON, from either a
+// structured value or a JSON-encoded string. Pointer fields stay nil when the
+// argument is missing, so handlers can tell it apart from a zero or empty
+// value.
+// Embedded structs without an "arg" tag are bound field by field, so
+// arguments shared by several tools can be declared once.
+//
+// Values are coerced where the intent is clear (e.g., "20" to an int, and
+// "true" to a bool). All invalid arguments are reported at once in an
+// *ArgsError.
+func Bind(args map[string]interface{}, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind target must be a pointer to a struct, got %T", dst)
+	}
+
+	argsErr := &ArgsError{}
+	bindFields(args, v.Elem(), argsErr)
+	if len(argsErr.Problems) > 0 {
+		return argsErr
+	}
+	return nil
+}
+
+// bindFields binds the fields of a struct value, descending into embedded
+// structs
+func bindFields(args map[string]interface{}, v reflect.Value, argsErr *ArgsError) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		tag, ok := field.Tag.Lookup("arg")
+		name, option, _ := strings.Cut(tag, ",")
+		if !ok && field.Anonymous && field.Type.Kind() == reflect.Struct {
+			bindFields(args, v.Field(i), argsErr)
+			continue
+		}
+		if !ok || name == "" || name == "-" {
+			continue
+		}
+
+		// An empty string counts as missing, except for pointer fields,
+		// which record that the argument was given
+		value, present := args[name]
+		if present && isEmptyArg(value) && (value == nil || field.Type.Kind() != reflect.Pointer) {
+			present = false
+		}
+		if !present {
+			if def, ok := field.Tag.Lookup("default"); ok {
+				value, present = def, true
+			}
+		}
+
+		rules := parseRules(field.Tag.Get("validate"))
+		if !present {
+			if _, required := rules["required"]; required {
+				argsErr.add(name, "is required")
+			}
+			continue
+		}
+
+		if s, isString := value.(string); isString && option == "trim" {
+			value = strings.TrimSpace(s)
+		}
+
+		target := v.Field(i)
+		if target.Kind() == reflect.Pointer {
+			target.Set(reflect.New(target.Type().Elem()))
+			target = target.Elem()
+		}
+		if err := setArg(target, value); err != nil {
+			v.Field(i).Set(reflect.Zero(v.Field(i).Type()))
+			argsErr.add(name, err.Error())
+			continue
+		}
+		if msg := checkRules(target, rules); msg != "" {
+			argsErr.add(name, msg)
+		}
+	}
+}
+
+// ArgProblem describes an invalid tool argument
+type ArgProblem struct {
+	Arg     string `json:"arg"`
+	Message string `json:"message"`
+}
+
+// ArgsError reports every invalid argument of a tool call
+type ArgsError struct {
+	Problems []ArgProblem
+}
+
+func (e *ArgsError) add(arg, message string) {
+	e.Problems = append(e.Problems, ArgProblem{Arg: arg, Message: message})
+}
+
+// Error lists the problems, e.g. "invalid arguments: team is required; limit
+// must be at most 100"
+func (e *ArgsError) Error() string {
+	messages := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		messages[i] = p.Arg + " " + p.Message
+	}
+	return "invalid arguments: " + strings.Join(messages, "; ")
+}
+
+// ErrorCode implements CodedError
+func (e *ArgsError) ErrorCode() string { return "invalid_arguments" }
+
+// ErrorDetails implements CodedError
+func (e *ArgsError) ErrorDetails() map[string]interface{} {
+	return map[string]interface{}{"arguments": e.Problems}
+}
+
+// isEmptyArg reports whether an argument was given without a value
+func isEmptyArg(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	}
+	return false
+}
+
+// setArg stores an argument value in a field, converting it to the field type
+func setArg(field reflect.Value, value interface{}) error {
+	switch field.Kind() {
+	case reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("must be a string")
+		}
+		field.SetString(s)
+
+	case reflect.Int, reflect.Int64:
+		switch n := value.(type) {
+		case float64:
+			if n != float64(int64(n)) {
+				return fmt.Errorf("must be a whole number")
+			}
+			field.SetInt(int64(n))
+		case int:
+			field.SetInt(int64(n))
+		case int64:
+			field.SetInt(n)
+		case string:
+			i, err := strconv.ParseInt(strings.TrimSpace(n), 10, 64)
+			if err != nil {
+				return fmt.Errorf("must be a whole number")
+			}
+			field.SetInt(i)
+		default:
+			return fmt.Errorf("must be a whole number")
+		}
+
+	case reflect.Float64:
+		switch n := value.(type) {
+		case float64:
+			field.SetFloat(n)
+		case int:
+			field.SetFloat(float64(n))
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+			if err != nil {
+				return fmt.Errorf("must be a number")
+			}
+			field.SetFloat(f)
+		default:
+			return fmt.Errorf("must be a number")
+		}
+
+	case reflect.Bool:
+		switch b := value.(type) {
+		case bool:
+			field.SetBool(b)
+		case string:
+			parsed, err := strconv.ParseBool(strings.TrimSpace(b))
+			if err != nil {
+				return fmt.Errorf("must be true or false")
+			}
+			field.SetBool(parsed)
+		default:
+			return fmt.Errorf("must be true or false")
+		}
+
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return setJSONArg(field, value, "must be an array")
+		}
+		var items []string
+		switch list := value.(type) {
+		case string:
+			for _, item := range strings.Split(list, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+		case []string:
+			items = list
+		case []interface{}:
+			for _, item := range list {
+				s, ok := item.(string)
+				if !ok {
+					return fmt.Errorf("must be a list of strings")
+				}
+				items = append(items, s)
+			}
+		default:
+			return fmt.Errorf("must be a list of strings")
+		}
+		field.Set(reflect.ValueOf(items))
+
+	case reflect.Map:
+		if m, ok := value.(map[string]interface{}); ok && field.Type() == reflect.TypeOf(m) {
+			field.Set(reflect.ValueOf(m))
+			return nil
+		}
+		return setJSONArg(field, value, "must be an object")
+
+	case reflect.Struct:
+		return setJSONArg(field, value, "must be an object")
+
+	case reflect.Interface:
+		field.Set(reflect.ValueOf(value))
+
+	default:
+		return fmt.Errorf("unsupported argument type %s", field.Type())
+	}
+	return nil
+}
+
+// setJSONArg stores a structured argument, or a JSON-encoded string of one, in a
+// field by decoding it as JSON into the field type
+func setJSONArg(field reflect.Value, value interface{}, problem string) error {
+	data, isString := value.(string)
+	raw := []byte(data)
+	if !isString {
+		var err error
+		if raw, err = json.Marshal(value); err != nil {
+			return fmt.Errorf("%s", problem)
+		}
+	}
+
+	decoded := reflect.New(field.Type())
+	if err := json.Unmarshal(raw, decoded.Interface()); err != nil {
+		return fmt.Errorf("%s", problem)
+	}
+	field.Set(decoded.Elem())
+	return nil
+}
+
+// parseRules parses a validate tag, e.g. "required,min=1,oneof=a b"
+func parseRules(tag string) map[string]string {
+	rules := make(map[string]string)
+	for _, rule := range strings.Split(tag, ",") {
+		if rule = strings.TrimSpace(rule); rule == "" {
+			continue
+		}
+		name, param, _ := strings.Cut(rule, "=")
+		rules[name] = param
+	}
+	return rules
+}
+
+// checkRules validates a bound field, returning the problem or ""
+func checkRules(field reflect.Value, rules map[string]string) string {
+	if values, ok := rules["oneof"]; ok && field.Kind() == reflect.String {
+		allowed := strings.Fields(values)
+		for _, a := range allowed {
+			if field.String() == a {
+				return ""
+			}
+		}
+		sort.Strings(allowed)
+		return fmt.Sprintf("must be one of %s", strings.Join(allowed, ", "))
+	}
+
+	size, measurable := 0.0, true
+	switch field.Kind() {
+	case reflect.Int, reflect.Int64:
+		size = float64(field.Int())
+	case reflect.Float64:
+		size = field.Float()
+	case reflect.String, reflect.Slice:
+		size = float64(field.Len())
+	default:
+		measurable = false
+	}
+	if !measurable {
+		return ""
+	}
+
+	unit := ""
+	switch field.Kind() {
+	case reflect.String:
+		unit = " characters"
+	case reflect.Slice:
+		unit = " items"
+	}
+	if min, ok := rules["min"]; ok {
+		if limit, err := strconv.ParseFloat(min, 64); err == nil && size < limit {
+			return fmt.Sprintf("must be at least %s%s", min, unit)
+		}
+	}
+	if max, ok := rules["max"]; ok {
+		if limit, err := strconv.ParseFloat(max, 64); err == nil && size > limit {
+			return fmt.Sprintf("must be at most %s%s", max, unit)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,171 @@
+package mcp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testArgs struct {
+	Team    string                 `arg:"team,trim" validate:"required"`
+	Limit   int                    `arg:"limit" default:"20" validate:"min=1,max=100"`
+	Status  string                 `arg:"status,trim" default:"open" validate:"oneof=open closed"`
+	Note    string                 `arg:"note"`
+	Tags    []string               `arg:"tags"`
+	Wait    bool                   `arg:"wait"`
+	Ratio   float64                `arg:"ratio"`
+	Fields  map[string]interface{} `arg:"fields"`
+	Ignored string
+}
+
+func TestBind(t *testing.T) {
+	var got testArgs
+	err := Bind(map[string]interface{}{
+		"team":   " ops ",
+		"limit":  float64(50),
+		"tags":   []interface{}{"disk", "prod"},
+		"wait":   "true",
+		"ratio":  "0.5",
+		"fields": map[string]interface{}{"summary": "x"},
+	}, &got)
+	if err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+
+	want := testArgs{
+		Team:   "ops",
+		Limit:  50,
+		Status: "open",
+		Tags:   []string{"disk", "prod"},
+		Wait:   true,
+		Ratio:  0.5,
+		Fields: map[string]interface{}{"summary": "x"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Bind() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBindKeepsFreeText(t *testing.T) {
+	note := "    indented code block\n\nclosing line\n"
+	var got testArgs
+	if err := Bind(map[string]interface{}{"team": "ops", "status": " closed\n", "note": note}, &got); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	if got.Note != note {
+		t.Errorf("Note = %q, want the body byte for byte %q", got.Note, note)
+	}
+	if got.Status != "closed" {
+		t.Errorf("Status = %q, want the trimmed enum value", got.Status)
+	}
+}
+
+func TestBindCoercion(t *testing.T) {
+	var got testArgs
+	if err := Bind(map[string]interface{}{"team": "ops", "limit": "30", "tags": "a, b,,c"}, &got); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	if got.Limit != 30 || !reflect.DeepEqual(got.Tags, []string{"a", "b", "c"}) {
+		t.Errorf("Bind() = %+v, want limit 30 and tags a, b, c", got)
+	}
+}
+
+func TestBindJSONArguments(t *testing.T) {
+	type responder struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	}
+	var got struct {
+		Responders []responder            `arg:"responders"`
+		Owner      responder              `arg:"owner"`
+		Fields     map[string]interface{} `arg:"fields"`
+	}
+	err := Bind(map[string]interface{}{
+		"responders": []interface{}{map[string]interface{}{"type": "team", "id": "t1"}},
+		"owner":      `{"type": "user", "id": "u1"}`,
+		"fields":     `{"summary": "x"}`,
+	}, &got)
+	if err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got.Responders, []responder{{Type: "team", ID: "t1"}}) {
+		t.Errorf("Responders = %+v", got.Responders)
+	}
+	if got.Owner != (responder{Type: "user", ID: "u1"}) {
+		t.Errorf("Owner = %+v", got.Owner)
+	}
+	if got.Fields["summary"] != "x" {
+		t.Errorf("Fields = %+v", got.Fields)
+	}
+
+	err = Bind(map[string]interface{}{"responders": "not json"}, &got)
+	var argsErr *ArgsError
+	if !errors.As(err, &argsErr) || argsErr.Problems[0] != (ArgProblem{Arg: "responders", Message: "must be an array"}) {
+		t.Errorf("Bind() error = %v, want responders must be an array", err)
+	}
+}
+
+func TestBindReportsAllProblems(t *testing.T) {
+	var got testArgs
+	err := Bind(map[string]interface{}{
+		"team":   "",
+		"limit":  float64(500),
+		"status": "pending",
+		"wait":   "maybe",
+	}, &got)
+
+	var argsErr *ArgsError
+	if !errors.As(err, &argsErr) {
+		t.Fatalf("Bind() error = %v, want an *ArgsError", err)
+	}
+
+	want := []ArgProblem{
+		{Arg: "team", Message: "is required"},
+		{Arg: "limit", Message: "must be at most 100"},
+		{Arg: "status", Message: "must be one of closed, open"},
+		{Arg: "wait", Message: "must be true or false"},
+	}
+	if !reflect.DeepEqual(argsErr.Problems, want) {
+		t.Errorf("Bind() problems = %+v, want %+v", argsErr.Problems, want)
+	}
+	if msg := err.Error(); msg != "invalid arguments: team is required; limit must be at most 100; status must be one of closed, open; wait must be true or false" {
+		t.Errorf("Error() = %q", msg)
+	}
+
+	var coded CodedError
+	if !errors.As(err, &coded) || coded.ErrorCode() != "invalid_arguments" {
+		t.Errorf("ArgsError should be a CodedError with code invalid_arguments")
+	}
+}
+
+func TestBindRejectsNonStruct(t *testing.T) {
+	var n int
+	if err := Bind(map[string]interface{}{}, &n); err == nil {
+		t.Error("Bind() into an int should fail")
+	}
+}
+
+func TestBindEmbeddedAndPointers(t *testing.T) {
+	type paging struct {
+		Limit int `arg:"limit" default:"10" validate:"max=50"`
+	}
+	var got struct {
+		paging
+		Flat   *bool   `arg:"flat"`
+		Offset *int    `arg:"offset"`
+		Note   *string `arg:"note"`
+	}
+	if err := Bind(map[string]interface{}{"limit": float64(20), "flat": false, "note": ""}, &got); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	if got.Limit != 20 || got.Flat == nil || *got.Flat || got.Offset != nil || got.Note == nil || *got.Note != "" {
+		t.Errorf("Bind() = %+v, want limit 20, flat false, an empty note, and no offset", got)
+	}
+
+	err := Bind(map[string]interface{}{"limit": float64(80), "offset": "x"}, &got)
+	var argsErr *ArgsError
+	if !errors.As(err, &argsErr) || len(argsErr.Problems) != 2 {
+		t.Errorf("Bind() error = %v, want problems with limit and offset", err)
+	}
+}
@@ -0,0 +1,54 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NewChunkedJSONResult creates a tool result whose first content block is the
+// JSON summary and whose following blocks each hold a JSON array of at most
+// chunkSize items. This is not streaming: the whole result is built in memory
+// and sent as one response; chunking only splits the list into blocks a
+// client can handle one at a time. A chunkSize of zero or less puts all items
+// in one chunk.
+func NewChunkedJSONResult[T any](summary interface{}, items []T, chunkSize int) (*CallToolResult, error) {
+	if chunkSize <= 0 || chunkSize > len(items) {
+		chunkSize = max(len(items), 1)
+	}
+
+	summaryBytes, err := marshalJSON(summary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result to JSON: %w", err)
+	}
+
+	result := &CallToolResult{
+		Content: make([]Content, 0, 1+(len(items)+chunkSize-1)/chunkSize),
+	}
+	result.Content = append(result.Content, NewTextContent(string(summaryBytes)))
+
+	for start := 0; start < len(items); start += chunkSize {
+		end := min(start+chunkSize, len(items))
+
+		// Chunks are compact: indentation would add a large share of their size
+		chunkBytes, err := json.Marshal(items[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal result chunk to JSON: %w", err)
+		}
+		result.Content = append(result.Content, NewTextContent(string(chunkBytes)))
+	}
+
+	return result, nil
+}
+
+// ChunkArgs holds the "chunk_size" argument added by WithChunking. Embed it
+// in the arguments a handler binds; zero means no chunking.
+type ChunkArgs struct {
+	ChunkSize int `arg:"chunk_size" validate:"min=0"`
+}
+
+// WithChunking adds the "chunk_size" argument to the schema of a tool that
+// fetches all pages of a list
+func (s InputSchema) WithChunking() InputSchema {
+	s.Properties["chunk_size"] = NewIntegerProperty("With fetch_all, return the list as separate content blocks of at most this many items after a summary block, instead of a single JSON result")
+	return s
+}
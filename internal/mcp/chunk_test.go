@@ -0,0 +1,49 @@
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewChunkedJSONResult(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	result, err := NewChunkedJSONResult(map[string]int{"count": len(items)}, items, 2)
+	if err != nil {
+		t.Fatalf("NewChunkedJSONResult() error = %v", err)
+	}
+
+	if len(result.Content) != 4 {
+		t.Fatalf("got %d content blocks, want summary + 3 chunks", len(result.Content))
+	}
+
+	var summary map[string]int
+	if err := json.Unmarshal([]byte(result.Content[0].Text), &summary); err != nil || summary["count"] != 5 {
+		t.Errorf("summary = %q, want count 5", result.Content[0].Text)
+	}
+
+	var got []string
+	for _, block := range result.Content[1:] {
+		var chunk []string
+		if err := json.Unmarshal([]byte(block.Text), &chunk); err != nil {
+			t.Fatalf("chunk %q is not a JSON array: %v", block.Text, err)
+		}
+		if len(chunk) > 2 {
+			t.Errorf("chunk has %d items, want at most 2", len(chunk))
+		}
+		got = append(got, chunk...)
+	}
+	if len(got) != 5 || got[0] != "a" || got[4] != "e" {
+		t.Errorf("chunks hold %v, want %v", got, items)
+	}
+}
+
+func TestNewChunkedJSONResult_NoChunkSize(t *testing.T) {
+	result, err := NewChunkedJSONResult(map[string]int{"count": 3}, []int{1, 2, 3}, 0)
+	if err != nil {
+		t.Fatalf("NewChunkedJSONResult() error = %v", err)
+	}
+	if len(result.Content) != 2 || result.Content[1].Text != "[1,2,3]" {
+		t.Errorf("content = %+v, want summary and one chunk", result.Content)
+	}
+}
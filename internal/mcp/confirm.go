@@ -0,0 +1,74 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ConfirmArg is the argument that confirms a call to a tool requiring
+// confirmation
+const ConfirmArg = "confirm"
+
+// requiresConfirmation reports whether calls to a tool must be confirmed
+func (s *Server) requiresConfirmation(name string) bool {
+	return slices.Contains(s.confirmTools, name)
+}
+
+// withConfirmArg adds the confirm argument to a tool requiring confirmation
+func withConfirmArg(def *ToolDefinition) {
+	if def.InputSchema.Properties == nil {
+		def.InputSchema.Properties = make(map[string]Property)
+	}
+	def.InputSchema.Properties[ConfirmArg] = NewBooleanProperty("Set to true once the user has approved this destructive operation. Without it, the user is asked to confirm if the client supports it, and the call is refused otherwise.").WithDefault(false)
+	def.Description += " Requires confirmation."
+}
+
+// checkConfirmation applies the confirmation policy to a tool call. Calls to
+// tools requiring confirmation run if confirm=true is passed or the user
+// approves them when asked through elicitation. Otherwise it returns the
+// error result to send instead of running the tool.
+func (s *Server) checkConfirmation(ctx context.Context, name string, arguments map[string]interface{}) (*CallToolResult, error) {
+	if !s.requiresConfirmation(name) {
+		return nil, nil
+	}
+	if confirmed, _ := arguments[ConfirmArg].(bool); confirmed {
+		return nil, nil
+	}
+
+	display := make(map[string]interface{}, len(arguments))
+	for key, value := range arguments {
+		if key != ConfirmArg {
+			display[key] = value
+		}
+	}
+	argsJSON, _ := json.Marshal(display)
+
+	result, err := s.Elicit(ctx, &ElicitParams{
+		Message: fmt.Sprintf("Allow %s with arguments %s?", name, argsJSON),
+		RequestedSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				ConfirmArg: map[string]interface{}{
+					"type":        "boolean",
+					"title":       "Confirm",
+					"description": fmt.Sprintf("Run %s", name),
+				},
+			},
+			"required": []string{ConfirmArg},
+		},
+	})
+	switch {
+	case errors.Is(err, ErrElicitationUnsupported):
+		return NewErrorResult(fmt.Errorf("%s requires confirmation: ask the user to approve it, then call it again with confirm=true", name)), nil
+	case err != nil:
+		return nil, err
+	}
+
+	if confirmed, _ := result.Content[ConfirmArg].(bool); result.Action == ElicitAccept && confirmed {
+		return nil, nil
+	}
+	return NewErrorResult(fmt.Errorf("%s was not confirmed by the user", name)), nil
+}
@@ -0,0 +1,252 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// newConfirmServer creates a server where delete_thing requires confirmation
+// and counts its calls
+func newConfirmServer(t *testing.T, calls *int) *Server {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	server := NewServer(&ServerConfig{
+		Logger:       &logger,
+		ConfirmTools: []string{"delete_thing"},
+	})
+
+	handler := func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		*calls++
+		return NewSuccessResult("deleted"), nil
+	}
+	tool := NewTool("delete_thing", "Delete a thing", NewInputSchema(map[string]Property{
+		"id": NewStringProperty("Thing ID"),
+	}, "id"), handler, "test", "write")
+	if err := server.RegisterTool(tool); err != nil {
+		t.Fatalf("RegisterTool() error = %v", err)
+	}
+	return server
+}
+
+// initialize sends the initialize request with the given client capabilities
+func initialize(t *testing.T, server *Server, capabilities string) {
+	t.Helper()
+
+	params := fmt.Sprintf(`{"protocolVersion": "2024-11-05", "capabilities": %s, "clientInfo": {"name": "test-client", "version": "1.0.0"}}`, capabilities)
+	reqData, _ := json.Marshal(Request{JSONRPC: "2.0", ID: 1, Method: "initialize", Params: json.RawMessage(params)})
+	if _, err := server.HandleMessage(context.Background(), reqData); err != nil {
+		t.Fatalf("HandleMessage() error = %v", err)
+	}
+}
+
+// answerElicitations answers each elicitation request with the given result,
+// as a client would
+func answerElicitations(t *testing.T, server *Server, result ElicitResult) *[]ElicitParams {
+	t.Helper()
+
+	var asked []ElicitParams
+	server.SetSender(func(data []byte) error {
+		var req Request
+		if err := json.Unmarshal(data, &req); err != nil {
+			return err
+		}
+		if req.Method != "elicitation/create" {
+			return fmt.Errorf("unexpected request %s", req.Method)
+		}
+		var params ElicitParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return err
+		}
+		asked = append(asked, params)
+
+		go func() {
+			respData, _ := json.Marshal(NewResponse(req.ID, result))
+			if _, err := server.HandleMessage(context.Background(), respData); err != nil {
+				t.Errorf("HandleMessage() error = %v", err)
+			}
+		}()
+		return nil
+	})
+	return &asked
+}
+
+func TestConfirmArgAdded(t *testing.T) {
+	var calls int
+	server := newConfirmServer(t, &calls)
+
+	tools := server.ListTools()
+	if len(tools) != 1 {
+		t.Fatalf("ListTools() returned %d tools, want 1", len(tools))
+	}
+	if prop, ok := tools[0].InputSchema.Properties[ConfirmArg]; !ok || prop.Type != "boolean" {
+		t.Errorf("confirm property = %+v, want a boolean", prop)
+	}
+}
+
+func TestConfirmWithoutElicitation(t *testing.T) {
+	var calls int
+	server := newConfirmServer(t, &calls)
+	initialize(t, server, `{}`)
+
+	result, err := server.CallTool(context.Background(), "delete_thing", map[string]interface{}{"id": "1"})
+	if err != nil {
+		t.Fatalf("CallTool() error = %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "confirm=true") {
+		t.Errorf("CallTool() = %+v, want an error result asking for confirm=true", result)
+	}
+	if calls != 0 {
+		t.Errorf("tool ran %d times without confirmation", calls)
+	}
+
+	result, err = server.CallTool(context.Background(), "delete_thing", map[string]interface{}{"id": "1", "confirm": true})
+	if err != nil {
+		t.Fatalf("CallTool() error = %v", err)
+	}
+	if result.IsError || calls != 1 {
+		t.Errorf("CallTool() with confirm=true = %+v, calls = %d", result, calls)
+	}
+}
+
+func TestConfirmWithElicitation(t *testing.T) {
+	tests := []struct {
+		name    string
+		answer  ElicitResult
+		wantRun bool
+	}{
+		{name: "accepted", answer: ElicitResult{Action: ElicitAccept, Content: map[string]interface{}{"confirm": true}}, wantRun: true},
+		{name: "accepted without confirming", answer: ElicitResult{Action: ElicitAccept, Content: map[string]interface{}{"confirm": false}}},
+		{name: "declined", answer: ElicitResult{Action: ElicitDecline}},
+		{name: "cancelled", answer: ElicitResult{Action: ElicitCancel}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			server := newConfirmServer(t, &calls)
+			initialize(t, server, `{"elicitation": {}}`)
+			asked := answerElicitations(t, server, tt.answer)
+
+			reqData, _ := json.Marshal(Request{
+				JSONRPC: "2.0",
+				ID:      2,
+				Method:  "tools/call",
+				Params:  json.RawMessage(`{"name": "delete_thing", "arguments": {"id": "1"}}`),
+			})
+			respData, err := server.HandleMessage(context.Background(), reqData)
+			if err != nil {
+				t.Fatalf("HandleMessage() error = %v", err)
+			}
+
+			var response struct {
+				Result CallToolResult `json:"result"`
+			}
+			if err := json.Unmarshal(respData, &response); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+
+			if len(*asked) != 1 || !strings.Contains((*asked)[0].Message, `{"id":"1"}`) {
+				t.Errorf("elicitations = %+v, want one naming the arguments", *asked)
+			}
+			if ran := calls == 1; ran != tt.wantRun {
+				t.Errorf("tool ran = %v, want %v", ran, tt.wantRun)
+			}
+			if response.Result.IsError == tt.wantRun {
+				t.Errorf("result IsError = %v, want %v", response.Result.IsError, !tt.wantRun)
+			}
+		})
+	}
+}
+
+func TestConfirmElicitationTimeout(t *testing.T) {
+	var calls int
+	server := newConfirmServer(t, &calls)
+	server.elicitTimeout = 50 * time.Millisecond
+	initialize(t, server, `{"elicitation": {}}`)
+	server.SetSender(func(data []byte) error { return nil })
+
+	_, err := server.CallTool(context.Background(), "delete_thing", map[string]interface{}{"id": "1"})
+	if err == nil || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("CallTool() error = %v, want a timeout", err)
+	}
+	if calls != 0 {
+		t.Errorf("tool ran %d times without an answer", calls)
+	}
+}
+
+func TestConfirmIgnoresDuplicateAnswers(t *testing.T) {
+	var calls int
+	server := newConfirmServer(t, &calls)
+	initialize(t, server, `{"elicitation": {}}`)
+
+	var lastID interface{}
+	answer := ElicitResult{Action: ElicitAccept, Content: map[string]interface{}{"confirm": true}}
+	server.SetSender(func(data []byte) error {
+		var req Request
+		if err := json.Unmarshal(data, &req); err != nil {
+			return err
+		}
+		lastID = req.ID
+
+		// Answer twice before the first answer is read
+		respData, _ := json.Marshal(NewResponse(req.ID, answer))
+		for i := 0; i < 2; i++ {
+			if _, err := server.HandleMessage(context.Background(), respData); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := server.CallTool(context.Background(), "delete_thing", map[string]interface{}{"id": "1"})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("CallTool() error = %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("CallTool() blocked on a duplicate answer")
+	}
+	if calls != 1 {
+		t.Errorf("tool ran %d times, want 1", calls)
+	}
+
+	// A late answer to a finished request is ignored
+	respData, _ := json.Marshal(NewResponse(lastID, answer))
+	if _, err := server.HandleMessage(context.Background(), respData); err != nil {
+		t.Errorf("HandleMessage() error = %v", err)
+	}
+}
+
+func TestConfirmSkipsOtherTools(t *testing.T) {
+	var calls int
+	server := newConfirmServer(t, &calls)
+
+	tool := NewTool("get_thing", "Get a thing", NewInputSchema(nil), func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		return NewSuccessResult("thing"), nil
+	}, "test", "read")
+	if err := server.RegisterTool(tool); err != nil {
+		t.Fatalf("RegisterTool() error = %v", err)
+	}
+
+	result, err := server.CallTool(context.Background(), "get_thing", map[string]interface{}{})
+	if err != nil || result.IsError {
+		t.Errorf("CallTool() = %+v, %v, want success", result, err)
+	}
+	if _, ok := tool.InputSchema.Properties[ConfirmArg]; ok {
+		t.Error("confirm property added to a tool that does not require confirmation")
+	}
+}
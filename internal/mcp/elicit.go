@@ -0,0 +1,154 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultElicitTimeout is how long Elicit waits for the user's answer when
+// the server sets no timeout
+const DefaultElicitTimeout = 5 * time.Minute
+
+// ErrElicitationUnsupported is returned when the client cannot be asked for
+// input: it did not declare the elicitation capability, or the transport
+// cannot send requests
+var ErrElicitationUnsupported = errors.New("client does not support elicitation")
+
+// Elicitation actions
+const (
+	ElicitAccept  = "accept"
+	ElicitDecline = "decline"
+	ElicitCancel  = "cancel"
+)
+
+// ElicitParams represents the parameters of the elicitation/create request
+type ElicitParams struct {
+	Message         string                 `json:"message"`
+	RequestedSchema map[string]interface{} `json:"requestedSchema"`
+}
+
+// ElicitResult represents the client's answer to an elicitation request
+type ElicitResult struct {
+	Action  string                 `json:"action"` // ElicitAccept, ElicitDecline, or ElicitCancel
+	Content map[string]interface{} `json:"content,omitempty"`
+}
+
+// outbound tracks the requests the server sends to the client
+type outbound struct {
+	mu          sync.Mutex
+	send        func([]byte) error
+	elicitation bool // The client declared the elicitation capability
+	nextID      int
+	pending     map[string]chan *Response
+}
+
+// SetSender sets the function the server uses to send requests and
+// notifications to the client. Transports that can deliver client responses
+// back to HandleMessage set it.
+func (s *Server) SetSender(send func([]byte) error) {
+	s.outbound.mu.Lock()
+	defer s.outbound.mu.Unlock()
+	s.outbound.send = send
+}
+
+// Elicit asks the user for input through the client. It blocks until the
+// client answers, the server's elicitation timeout elapses, or the context is
+// done.
+func (s *Server) Elicit(ctx context.Context, params *ElicitParams) (*ElicitResult, error) {
+	if !s.clientSupportsElicitation() {
+		return nil, ErrElicitationUnsupported
+	}
+
+	timeout := s.elicitTimeout
+	if timeout <= 0 {
+		timeout = DefaultElicitTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	s.outbound.mu.Lock()
+	send := s.outbound.send
+	if send == nil {
+		s.outbound.mu.Unlock()
+		return nil, ErrElicitationUnsupported
+	}
+	s.outbound.nextID++
+	id := fmt.Sprintf("atlas-%d", s.outbound.nextID)
+	responses := make(chan *Response, 1)
+	if s.outbound.pending == nil {
+		s.outbound.pending = make(map[string]chan *Response)
+	}
+	s.outbound.pending[id] = responses
+	s.outbound.mu.Unlock()
+
+	defer func() {
+		s.outbound.mu.Lock()
+		delete(s.outbound.pending, id)
+		s.outbound.mu.Unlock()
+	}()
+
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal elicitation: %w", err)
+	}
+	data, err := json.Marshal(&Request{JSONRPC: "2.0", ID: id, Method: "elicitation/create", Params: rawParams})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal elicitation: %w", err)
+	}
+	if err := send(data); err != nil {
+		return nil, fmt.Errorf("failed to send elicitation: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("no answer from the user within %s: %w", timeout, ctx.Err())
+		}
+		return nil, ctx.Err()
+	case response := <-responses:
+		if response.Error != nil {
+			return nil, fmt.Errorf("elicitation failed: %s", response.Error.Message)
+		}
+		data, err := json.Marshal(response.Result)
+		if err != nil {
+			return nil, fmt.Errorf("invalid elicitation result: %w", err)
+		}
+		var result ElicitResult
+		if err := json.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("invalid elicitation result: %w", err)
+		}
+		return &result, nil
+	}
+}
+
+// handleResponse delivers a client response to the request waiting for it
+func (s *Server) handleResponse(resp *Response) {
+	id := fmt.Sprint(resp.ID)
+
+	s.outbound.mu.Lock()
+	responses, ok := s.outbound.pending[id]
+	s.outbound.mu.Unlock()
+
+	if !ok {
+		s.logDebug("ignoring response to unknown request", map[string]interface{}{"id": id})
+		return
+	}
+	// A duplicate answer must not block the reader, since nothing will take it
+	select {
+	case responses <- resp:
+	default:
+		s.logDebug("ignoring duplicate response", map[string]interface{}{"id": id})
+	}
+}
+
+// clientSupportsElicitation reports whether the client declared the
+// elicitation capability when initializing
+func (s *Server) clientSupportsElicitation() bool {
+	s.outbound.mu.Lock()
+	defer s.outbound.mu.Unlock()
+	return s.outbound.elicitation
+}
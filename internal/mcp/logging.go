@@ -0,0 +1,86 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// Log levels of log message notifications, from least to most severe
+const (
+	LogDebug     = "debug"
+	LogInfo      = "info"
+	LogNotice    = "notice"
+	LogWarning   = "warning"
+	LogError     = "error"
+	LogCritical  = "critical"
+	LogAlert     = "alert"
+	LogEmergency = "emergency"
+)
+
+var logLevels = []string{LogDebug, LogInfo, LogNotice, LogWarning, LogError, LogCritical, LogAlert, LogEmergency}
+
+// SetLevelParams represents the parameters of the logging/setLevel request
+type SetLevelParams struct {
+	Level string `json:"level"`
+}
+
+// LogMessageParams represents the parameters of a notifications/message
+// notification
+type LogMessageParams struct {
+	Level  string      `json:"level"`
+	Logger string      `json:"logger,omitempty"`
+	Data   interface{} `json:"data"`
+}
+
+// Log sends a log message notification to the client. Messages below the
+// level set by the client are dropped, as are all messages when the transport
+// cannot send notifications.
+func (s *Server) Log(level, logger string, data interface{}) error {
+	severity := slices.Index(logLevels, level)
+	if severity < 0 {
+		return fmt.Errorf("invalid log level: %s", level)
+	}
+	if severity < int(s.logLevel.Load()) {
+		return nil
+	}
+
+	s.outbound.mu.Lock()
+	send := s.outbound.send
+	s.outbound.mu.Unlock()
+	if send == nil {
+		return nil
+	}
+
+	notif, err := NewNotification("notifications/message", &LogMessageParams{Level: level, Logger: logger, Data: data})
+	if err != nil {
+		return fmt.Errorf("failed to create log message: %w", err)
+	}
+	payload, err := json.Marshal(notif)
+	if err != nil {
+		return fmt.Errorf("failed to marshal log message: %w", err)
+	}
+	return send(payload)
+}
+
+// handleSetLevel handles the logging/setLevel request
+func (s *Server) handleSetLevel(ctx context.Context, req *Request) ([]byte, error) {
+	var params SetLevelParams
+	if req.Params != nil {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			response := NewErrorResponse(req.ID, InvalidParams, "Invalid parameters", err.Error())
+			return json.Marshal(response)
+		}
+	}
+
+	severity := slices.Index(logLevels, params.Level)
+	if severity < 0 {
+		response := NewErrorResponse(req.ID, InvalidParams, fmt.Sprintf("Invalid log level: %s", params.Level), nil)
+		return json.Marshal(response)
+	}
+	s.logLevel.Store(int32(severity))
+
+	response := NewResponse(req.ID, struct{}{})
+	return json.Marshal(response)
+}
@@ -0,0 +1,60 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestServerLog(t *testing.T) {
+	logger := zerolog.Nop()
+	server := NewServer(&ServerConfig{Logger: &logger})
+
+	// Without a sender, messages are dropped
+	if err := server.Log(LogInfo, "test", "dropped"); err != nil {
+		t.Fatalf("Log() error = %v", err)
+	}
+
+	var sent []Notification
+	server.SetSender(func(data []byte) error {
+		var notif Notification
+		if err := json.Unmarshal(data, &notif); err != nil {
+			return err
+		}
+		sent = append(sent, notif)
+		return nil
+	})
+
+	reqData, _ := json.Marshal(Request{JSONRPC: "2.0", ID: 1, Method: "logging/setLevel", Params: json.RawMessage(`{"level": "warning"}`)})
+	respData, err := server.HandleMessage(context.Background(), reqData)
+	if err != nil {
+		t.Fatalf("HandleMessage() error = %v", err)
+	}
+	var response Response
+	if err := json.Unmarshal(respData, &response); err != nil || response.Error != nil {
+		t.Fatalf("logging/setLevel response = %s, %v", respData, err)
+	}
+
+	if err := server.Log(LogInfo, "test", "below level"); err != nil {
+		t.Fatalf("Log() error = %v", err)
+	}
+	if err := server.Log(LogError, "test", map[string]string{"key": "PROJ-1"}); err != nil {
+		t.Fatalf("Log() error = %v", err)
+	}
+	if err := server.Log("loud", "test", nil); err == nil {
+		t.Error("Log() with an invalid level should return an error")
+	}
+
+	if len(sent) != 1 || sent[0].Method != "notifications/message" {
+		t.Fatalf("sent = %+v, want one log message", sent)
+	}
+	var params LogMessageParams
+	if err := json.Unmarshal(sent[0].Params, &params); err != nil {
+		t.Fatalf("Failed to unmarshal params: %v", err)
+	}
+	if params.Level != LogError || params.Logger != "test" {
+		t.Errorf("params = %+v, want an error message from test", params)
+	}
+}
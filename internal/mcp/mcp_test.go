@@ -3,6 +3,8 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/rs/zerolog"
@@ -101,12 +103,15 @@ func TestListTools(t *testing.T) {
 	tool1 := NewTool("tool1", "First tool", NewInputSchema(nil), handler, "test")
 	tool2 := NewTool("tool2", "Second tool", NewInputSchema(nil), handler, "test")
 
-	registry.RegisterTool(tool1)
 	registry.RegisterTool(tool2)
+	registry.RegisterTool(tool1)
 
 	tools := registry.ListTools()
 	if len(tools) != 2 {
-		t.Errorf("ListTools() returned %d tools, want 2", len(tools))
+		t.Fatalf("ListTools() returned %d tools, want 2", len(tools))
+	}
+	if tools[0].Name != "tool1" || tools[1].Name != "tool2" {
+		t.Errorf("ListTools() = [%s %s], want tools sorted by name", tools[0].Name, tools[1].Name)
 	}
 }
 
@@ -345,6 +350,128 @@ func TestServerHandleToolsCall(t *testing.T) {
 	}
 }
 
+// notFoundError is a coded error like the API errors of the Atlassian clients
+type notFoundError struct{}
+
+func (notFoundError) Error() string     { return "HTTP 404: Issue does not exist" }
+func (notFoundError) ErrorCode() string { return "not_found" }
+func (notFoundError) ErrorDetails() map[string]interface{} {
+	return map[string]interface{}{"status": 404}
+}
+
+func TestServerHandleToolsCallCodedError(t *testing.T) {
+	logger := zerolog.Nop()
+	server := NewServer(&ServerConfig{
+		Logger: &logger,
+	})
+
+	handler := func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		return nil, fmt.Errorf("failed to get issue: %w", notFoundError{})
+	}
+	server.RegisterTool(NewTool("test_tool", "Test tool", NewInputSchema(nil), handler, "test"))
+
+	reqData, _ := json.Marshal(Request{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "tools/call",
+		Params:  json.RawMessage(`{"name": "test_tool", "arguments": {}}`),
+	})
+
+	respData, err := server.HandleMessage(context.Background(), reqData)
+	if err != nil {
+		t.Fatalf("HandleMessage() error = %v", err)
+	}
+
+	var response struct {
+		Error  *Error          `json:"error"`
+		Result *CallToolResult `json:"result"`
+	}
+	if err := json.Unmarshal(respData, &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if response.Error != nil {
+		t.Fatalf("Response contains protocol error: %v", response.Error)
+	}
+	if response.Result == nil || !response.Result.IsError {
+		t.Fatalf("Expected error result, got %+v", response.Result)
+	}
+
+	var payload struct {
+		Error   string                 `json:"error"`
+		Code    string                 `json:"code"`
+		Details map[string]interface{} `json:"details"`
+	}
+	if err := json.Unmarshal([]byte(response.Result.Content[0].Text), &payload); err != nil {
+		t.Fatalf("Error result is not JSON: %v", err)
+	}
+	if payload.Code != "not_found" || payload.Error != "failed to get issue: HTTP 404: Issue does not exist" {
+		t.Errorf("payload = %+v, want not_found with wrapped message", payload)
+	}
+	if payload.Details["status"] != float64(404) {
+		t.Errorf("details = %v, want status 404", payload.Details)
+	}
+}
+
+func TestServerHandleToolsCallInvalidArguments(t *testing.T) {
+	logger := zerolog.Nop()
+	server := NewServer(&ServerConfig{
+		Logger: &logger,
+	})
+
+	called := false
+	handler := func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		called = true
+		return NewSuccessResult("tool executed"), nil
+	}
+	schema := NewInputSchema(map[string]Property{
+		"issue_key": NewStringProperty("Issue key"),
+		"limit":     NewIntegerProperty("Max results"),
+	}, "issue_key")
+	server.RegisterTool(NewTool("test_tool", "Test tool", schema, handler, "test"))
+
+	reqData, _ := json.Marshal(Request{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "tools/call",
+		Params:  json.RawMessage(`{"name": "test_tool", "arguments": {"limit": "ten"}}`),
+	})
+
+	respData, err := server.HandleMessage(context.Background(), reqData)
+	if err != nil {
+		t.Fatalf("HandleMessage() error = %v", err)
+	}
+	if called {
+		t.Error("Handler should not run with invalid arguments")
+	}
+
+	var response struct {
+		Result *CallToolResult `json:"result"`
+	}
+	if err := json.Unmarshal(respData, &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if response.Result == nil || !response.Result.IsError {
+		t.Fatalf("Expected error result, got %s", respData)
+	}
+
+	var payload struct {
+		Code    string `json:"code"`
+		Details struct {
+			Arguments []ArgProblem `json:"arguments"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal([]byte(response.Result.Content[0].Text), &payload); err != nil {
+		t.Fatalf("Error result is not JSON: %v", err)
+	}
+	want := []ArgProblem{
+		{Arg: "issue_key", Message: "is required"},
+		{Arg: "limit", Message: "must be a whole number"},
+	}
+	if payload.Code != "invalid_arguments" || !reflect.DeepEqual(payload.Details.Arguments, want) {
+		t.Errorf("payload = %+v, want invalid_arguments with %+v", payload, want)
+	}
+}
+
 func TestServerReadOnlyMode(t *testing.T) {
 	logger := zerolog.Nop()
 	server := NewServer(&ServerConfig{
@@ -384,6 +511,42 @@ func TestServerReadOnlyMode(t *testing.T) {
 	}
 }
 
+func TestServerCallTool(t *testing.T) {
+	logger := zerolog.Nop()
+	server := NewServer(&ServerConfig{
+		Logger:       &logger,
+		ReadOnlyMode: true,
+	})
+
+	handler := func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		return NewSuccessResult("ok"), nil
+	}
+	server.RegisterTool(NewTool("read_tool", "Read tool", NewInputSchema(nil), handler, "read"))
+	server.RegisterTool(NewTool("write_tool", "Write tool", NewInputSchema(nil), handler, "write"))
+
+	tests := []struct {
+		name    string
+		tool    string
+		wantErr bool
+	}{
+		{"read tool", "read_tool", false},
+		{"write tool in read-only mode", "write_tool", true},
+		{"unknown tool", "missing_tool", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := server.CallTool(context.Background(), tt.tool, map[string]interface{}{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CallTool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && result.IsError {
+				t.Error("CallTool() returned an error result")
+			}
+		})
+	}
+}
+
 func TestPropertyHelpers(t *testing.T) {
 	stringProp := NewStringProperty("test string")
 	if stringProp.Type != "string" {
@@ -420,6 +583,19 @@ func TestPropertyHelpers(t *testing.T) {
 	if propWithDefault.Default != "default_value" {
 		t.Errorf("WithDefault() default = %v, want 'default_value'", propWithDefault.Default)
 	}
+
+	data, err := json.Marshal(NewIntegerProperty("limit").WithMinimum(1).WithMaximum(100))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"type":"integer","description":"limit","minimum":1,"maximum":100}`; string(data) != want {
+		t.Errorf("WithMinimum/WithMaximum JSON = %s, want %s", data, want)
+	}
+
+	data, _ = json.Marshal(NewStringProperty("key").WithPattern(`^[A-Z]+-\d+$`).WithEnum("A-1"))
+	if want := `{"type":"string","description":"key","enum":["A-1"],"pattern":"^[A-Z]+-\\d+$"}`; string(data) != want {
+		t.Errorf("WithPattern/WithEnum JSON = %s, want %s", data, want)
+	}
 }
 
 func TestContentHelpers(t *testing.T) {
@@ -440,6 +616,43 @@ func TestContentHelpers(t *testing.T) {
 	}
 }
 
+func TestNewJSONResultIsStable(t *testing.T) {
+	data := map[string]interface{}{
+		"total":  2,
+		"issues": []map[string]interface{}{{"summary": "Fix", "key": "PROJ-1", "fields": map[string]string{"z": "1", "a": "2"}}},
+		"at":     0,
+	}
+
+	first, err := NewJSONResult(data)
+	if err != nil {
+		t.Fatalf("NewJSONResult() error = %v", err)
+	}
+	for i := 0; i < 20; i++ {
+		again, _ := NewJSONResult(data)
+		if again.Content[0].Text != first.Content[0].Text {
+			t.Fatalf("NewJSONResult() changed between calls:\n%s\n%s", first.Content[0].Text, again.Content[0].Text)
+		}
+	}
+
+	want := `{
+  "at": 0,
+  "issues": [
+    {
+      "fields": {
+        "a": "2",
+        "z": "1"
+      },
+      "key": "PROJ-1",
+      "summary": "Fix"
+    }
+  ],
+  "total": 2
+}`
+	if first.Content[0].Text != want {
+		t.Errorf("NewJSONResult() =\n%s\nwant keys in sorted order:\n%s", first.Content[0].Text, want)
+	}
+}
+
 func TestMessageTypes(t *testing.T) {
 	request := Message{
 		JSONRPC: "2.0",
@@ -467,3 +680,43 @@ func TestMessageTypes(t *testing.T) {
 		t.Error("Message should be identified as response")
 	}
 }
+
+func TestServerAudit(t *testing.T) {
+	logger := zerolog.Nop()
+	var audited []string
+	server := NewServer(&ServerConfig{
+		Logger: &logger,
+		Audit: func(name string, arguments map[string]interface{}, result *CallToolResult, err error) {
+			audited = append(audited, fmt.Sprintf("%s %v %v", name, arguments["id"], err))
+		},
+	})
+
+	handler := func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		return NewSuccessResult("ok"), nil
+	}
+	server.RegisterTool(NewTool("read_tool", "Read tool", NewInputSchema(nil), handler, "test", "read"))
+	server.RegisterTool(NewTool("write_tool", "Write tool", NewInputSchema(nil), handler, "test", "write"))
+
+	ctx := context.Background()
+	if _, err := server.CallTool(ctx, "read_tool", map[string]interface{}{"id": "1"}); err != nil {
+		t.Fatalf("CallTool() error = %v", err)
+	}
+	if _, err := server.CallTool(ctx, "write_tool", map[string]interface{}{"id": "2"}); err != nil {
+		t.Fatalf("CallTool() error = %v", err)
+	}
+
+	reqData, _ := json.Marshal(Request{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "tools/call",
+		Params:  json.RawMessage(`{"name": "write_tool", "arguments": {"id": "3"}}`),
+	})
+	if _, err := server.HandleMessage(ctx, reqData); err != nil {
+		t.Fatalf("HandleMessage() error = %v", err)
+	}
+
+	want := []string{"write_tool 2 <nil>", "write_tool 3 <nil>"}
+	if fmt.Sprint(audited) != fmt.Sprint(want) {
+		t.Errorf("audited = %v, want %v", audited, want)
+	}
+}
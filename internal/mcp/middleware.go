@@ -0,0 +1,84 @@
+package mcp
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Middleware wraps the handler of a tool with cross-cutting behavior, such as
+// logging, metrics, access checks, or caching, so it isn't repeated in every
+// handler. It receives the tool definition to act on the tool's name and tags,
+// and returns the handler to run in place of next.
+type Middleware func(tool *ToolDefinition, next ToolHandler) ToolHandler
+
+// Chain combines middleware into one; the first runs outermost
+func Chain(middleware ...Middleware) Middleware {
+	return func(tool *ToolDefinition, next ToolHandler) ToolHandler {
+		for i := len(middleware) - 1; i >= 0; i-- {
+			next = middleware[i](tool, next)
+		}
+		return next
+	}
+}
+
+// OnlyTagged applies middleware to the tools with the given tag (e.g.,
+// "write") and leaves the other tools alone
+func OnlyTagged(tag string, mw Middleware) Middleware {
+	return func(tool *ToolDefinition, next ToolHandler) ToolHandler {
+		if !slices.Contains(tool.Tags, tag) {
+			return next
+		}
+		return mw(tool, next)
+	}
+}
+
+// AuditMiddleware records every call to a write tool with its outcome
+func AuditMiddleware(audit AuditFunc) Middleware {
+	return OnlyTagged("write", func(tool *ToolDefinition, next ToolHandler) ToolHandler {
+		return func(ctx context.Context, arguments map[string]interface{}) (*CallToolResult, error) {
+			result, err := next(ctx, arguments)
+			audit(tool.Name, arguments, result, err)
+			return result, err
+		}
+	})
+}
+
+// LoggingMiddleware logs each tool call with its duration at debug level
+func LoggingMiddleware(logger *zerolog.Logger) Middleware {
+	return func(tool *ToolDefinition, next ToolHandler) ToolHandler {
+		return func(ctx context.Context, arguments map[string]interface{}) (*CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, arguments)
+
+			logger.Debug().
+				Str("tool", tool.Name).
+				Dur("duration", time.Since(start)).
+				Bool("error", err != nil || (result != nil && result.IsError)).
+				Msg("tool call finished")
+			return result, err
+		}
+	}
+}
+
+// Use adds middleware around the handlers of all tools, including those
+// already registered. Middleware added first runs outermost. It is safe to
+// call while the server is serving; calls already running keep the
+// middleware they started with.
+func (s *Server) Use(middleware ...Middleware) {
+	s.middlewareMu.Lock()
+	defer s.middlewareMu.Unlock()
+	s.middleware = append(s.middleware, middleware...)
+}
+
+// callHandler runs the handler of a tool through the server's middleware
+func (s *Server) callHandler(ctx context.Context, tool *ToolDefinition, arguments map[string]interface{}) (*CallToolResult, error) {
+	s.middlewareMu.RLock()
+	middleware := s.middleware
+	s.middlewareMu.RUnlock()
+
+	handler := Chain(middleware...)(tool, tool.Handler)
+	return handler(ctx, arguments)
+}
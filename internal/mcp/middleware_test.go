@@ -0,0 +1,140 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// recordMiddleware appends its name to calls before and after the handler runs
+func recordMiddleware(name string, calls *[]string) Middleware {
+	return func(tool *ToolDefinition, next ToolHandler) ToolHandler {
+		return func(ctx context.Context, arguments map[string]interface{}) (*CallToolResult, error) {
+			*calls = append(*calls, name+" before")
+			result, err := next(ctx, arguments)
+			*calls = append(*calls, name+" after")
+			return result, err
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	handler := func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		calls = append(calls, "handler")
+		return NewSuccessResult("ok"), nil
+	}
+	tool := NewTool("test_tool", "Test tool", NewInputSchema(nil), handler)
+
+	wrapped := Chain(recordMiddleware("outer", &calls), recordMiddleware("inner", &calls))(tool, handler)
+	if _, err := wrapped(context.Background(), nil); err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+
+	want := []string{"outer before", "inner before", "handler", "inner after", "outer after"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestOnlyTagged(t *testing.T) {
+	var calls []string
+	handler := func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		return NewSuccessResult("ok"), nil
+	}
+	mw := OnlyTagged("write", recordMiddleware("audit", &calls))
+
+	read := NewTool("read_tool", "Read tool", NewInputSchema(nil), handler, "read")
+	mw(read, handler)(context.Background(), nil)
+	if len(calls) != 0 {
+		t.Errorf("middleware ran for a read tool: %v", calls)
+	}
+
+	write := NewTool("write_tool", "Write tool", NewInputSchema(nil), handler, "write")
+	mw(write, handler)(context.Background(), nil)
+	if len(calls) != 2 {
+		t.Errorf("middleware did not run for a write tool: %v", calls)
+	}
+}
+
+func TestServerUse(t *testing.T) {
+	logger := zerolog.Nop()
+	var calls []string
+	server := NewServer(&ServerConfig{
+		Logger:     &logger,
+		Middleware: []Middleware{recordMiddleware("config", &calls)},
+	})
+
+	handler := func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		calls = append(calls, "handler")
+		return NewSuccessResult("ok"), nil
+	}
+	server.RegisterTool(NewTool("test_tool", "Test tool", NewInputSchema(nil), handler, "read"))
+
+	// Middleware added after registration applies too, and can stop a call
+	denied := errors.New("access denied")
+	server.Use(func(tool *ToolDefinition, next ToolHandler) ToolHandler {
+		return func(ctx context.Context, arguments map[string]interface{}) (*CallToolResult, error) {
+			if arguments["user"] != "alice" {
+				return nil, denied
+			}
+			return next(ctx, arguments)
+		}
+	})
+
+	if _, err := server.CallTool(context.Background(), "test_tool", map[string]interface{}{"user": "bob"}); !errors.Is(err, denied) {
+		t.Fatalf("CallTool() error = %v, want access denied", err)
+	}
+	if _, err := server.CallTool(context.Background(), "test_tool", map[string]interface{}{"user": "alice"}); err != nil {
+		t.Fatalf("CallTool() error = %v", err)
+	}
+
+	want := []string{"config before", "config after", "config before", "handler", "config after"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestServerUseWhileServing(t *testing.T) {
+	logger := zerolog.Nop()
+	server := NewServer(&ServerConfig{Logger: &logger})
+	handler := func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		return NewSuccessResult("ok"), nil
+	}
+	server.RegisterTool(NewTool("test_tool", "Test tool", NewInputSchema(nil), handler, "read"))
+
+	var ran atomic.Int32
+	counting := func(tool *ToolDefinition, next ToolHandler) ToolHandler {
+		return func(ctx context.Context, arguments map[string]interface{}) (*CallToolResult, error) {
+			ran.Add(1)
+			return next(ctx, arguments)
+		}
+	}
+
+	// Run with -race: adding middleware must not race with running calls
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			server.Use(counting)
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := server.CallTool(context.Background(), "test_tool", nil); err != nil {
+				t.Errorf("CallTool() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	ran.Store(0)
+	if _, err := server.CallTool(context.Background(), "test_tool", nil); err != nil || ran.Load() != 10 {
+		t.Errorf("CallTool() ran %d middleware, %v; want all 10 added", ran.Load(), err)
+	}
+}
@@ -73,9 +73,10 @@ type InitializeParams struct {
 
 // ClientCapabilities represents the capabilities of the client
 type ClientCapabilities struct {
-	Tools     *ToolCapabilities     `json:"tools,omitempty"`
-	Resources *ResourceCapabilities `json:"resources,omitempty"`
-	Prompts   *PromptCapabilities   `json:"prompts,omitempty"`
+	Tools       *ToolCapabilities        `json:"tools,omitempty"`
+	Resources   *ResourceCapabilities    `json:"resources,omitempty"`
+	Prompts     *PromptCapabilities      `json:"prompts,omitempty"`
+	Elicitation *ElicitationCapabilities `json:"elicitation,omitempty"`
 }
 
 // ToolCapabilities represents tool-related capabilities
@@ -94,6 +95,10 @@ type PromptCapabilities struct {
 	ListChanged bool `json:"listChanged,omitempty"`
 }
 
+// ElicitationCapabilities represents the client's support for elicitation
+// requests
+type ElicitationCapabilities struct{}
+
 // ClientInfo represents information about the client
 type ClientInfo struct {
 	Name    string `json:"name"`
@@ -113,6 +118,7 @@ type ServerCapabilities struct {
 	Tools     *ToolsCapability     `json:"tools,omitempty"`
 	Resources *ResourcesCapability `json:"resources,omitempty"`
 	Prompts   *PromptsCapability   `json:"prompts,omitempty"`
+	Logging   *LoggingCapability   `json:"logging,omitempty"`
 }
 
 // ToolsCapability represents tool capabilities
@@ -131,6 +137,10 @@ type PromptsCapability struct {
 	ListChanged bool `json:"listChanged,omitempty"`
 }
 
+// LoggingCapability represents the server's support for log message
+// notifications
+type LoggingCapability struct{}
+
 // ServerInfo represents information about the server
 type ServerInfo struct {
 	Name    string `json:"name"`
@@ -169,7 +179,14 @@ type Property struct {
 	Description string      `json:"description,omitempty"`
 	Default     interface{} `json:"default,omitempty"`
 	Enum        []string    `json:"enum,omitempty"`
+	Pattern     string      `json:"pattern,omitempty"`
+	Minimum     *float64    `json:"minimum,omitempty"`
+	Maximum     *float64    `json:"maximum,omitempty"`
 	Items       *Property   `json:"items,omitempty"`
+
+	// Properties and Required describe the fields of an object property
+	Properties map[string]Property `json:"properties,omitempty"`
+	Required   []string            `json:"required,omitempty"`
 }
 
 // CallToolParams represents the parameters for the tools/call method
@@ -3,9 +3,17 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -14,36 +22,104 @@ const (
 	ServerVersion   = "0.1.0"
 )
 
+// tracer creates the parent span for each tool call
+var tracer = otel.Tracer("github.com/codeownersnet/atlas/internal/mcp")
+
 // Server represents the MCP server
 type Server struct {
-	registry     *ToolRegistry
-	logger       *zerolog.Logger
-	readOnlyMode bool
-	enabledTools []string
+	registry      *ToolRegistry
+	logger        *zerolog.Logger
+	readOnlyMode  bool
+	enabledTools  []string
+	outputLimits  OutputLimits
+	confirmTools  []string
+	elicitTimeout time.Duration
+	middlewareMu  sync.RWMutex // Guards middleware, which Use can change while tools run
+	middleware    []Middleware
+	outbound      outbound
+	logLevel      atomic.Int32 // Minimum level of log notifications, set by the client
 }
 
 // ServerConfig holds the configuration for the MCP server
 type ServerConfig struct {
-	Logger       *zerolog.Logger
-	ReadOnlyMode bool
-	EnabledTools []string
+	Logger        *zerolog.Logger
+	ReadOnlyMode  bool
+	EnabledTools  []string
+	OutputLimits  OutputLimits  // Default result size limits, overridable per call
+	ConfirmTools  []string      // Tools that only run once the user confirms the call
+	ElicitTimeout time.Duration // How long to wait for the user's answer to an elicitation (default DefaultElicitTimeout)
+	Audit         AuditFunc     // Records calls to write tools (optional)
+	Middleware    []Middleware  // Wraps every tool handler, first outermost (optional)
 }
 
+// AuditFunc records a call to a write tool with its outcome: the result, or
+// the error of a failed call
+type AuditFunc func(name string, arguments map[string]interface{}, result *CallToolResult, err error)
+
 // NewServer creates a new MCP server
 func NewServer(cfg *ServerConfig) *Server {
-	return &Server{
-		registry:     NewToolRegistry(),
-		logger:       cfg.Logger,
-		readOnlyMode: cfg.ReadOnlyMode,
-		enabledTools: cfg.EnabledTools,
+	s := &Server{
+		registry:      NewToolRegistry(),
+		logger:        cfg.Logger,
+		readOnlyMode:  cfg.ReadOnlyMode,
+		enabledTools:  cfg.EnabledTools,
+		outputLimits:  cfg.OutputLimits,
+		confirmTools:  cfg.ConfirmTools,
+		elicitTimeout: cfg.ElicitTimeout,
 	}
+
+	// The audit log records the outcome after all other middleware
+	if cfg.Audit != nil {
+		s.Use(AuditMiddleware(cfg.Audit))
+	}
+	s.Use(cfg.Middleware...)
+	return s
 }
 
 // RegisterTool registers a new tool
 func (s *Server) RegisterTool(def *ToolDefinition) error {
+	if s.requiresConfirmation(def.Name) {
+		withConfirmArg(def)
+	}
 	return s.registry.RegisterTool(def)
 }
 
+// ListTools returns the tools exposed to clients, honoring the enabled tools
+// list and read-only mode
+func (s *Server) ListTools() []Tool {
+	return s.registry.ListToolsFiltered(s.enabledTools, s.readOnlyMode)
+}
+
+// CallTool executes a tool directly, outside of a JSON-RPC exchange.
+// The same existence, read-only, argument and confirmation checks as
+// tools/call are applied.
+func (s *Server) CallTool(ctx context.Context, name string, arguments map[string]interface{}) (*CallToolResult, error) {
+	tool, ok := s.registry.GetTool(name)
+	if !ok {
+		return nil, fmt.Errorf("tool not found: %s", name)
+	}
+
+	if s.readOnlyMode && s.registry.hasWriteTag(tool.Tags) {
+		return nil, fmt.Errorf("write operations are disabled in read-only mode")
+	}
+
+	if err := ValidateArguments(tool.InputSchema, arguments); err != nil {
+		return nil, err
+	}
+
+	if refused, err := s.checkConfirmation(ctx, name, arguments); err != nil || refused != nil {
+		return refused, err
+	}
+
+	result, err := s.callHandler(ctx, tool, arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	LimitResult(result, outputLimitsFromArgs(s.outputLimits, arguments))
+	return result, nil
+}
+
 // HandleMessage handles an incoming JSON-RPC message
 func (s *Server) HandleMessage(ctx context.Context, data []byte) ([]byte, error) {
 	// Parse the message
@@ -65,8 +141,8 @@ func (s *Server) HandleMessage(ctx context.Context, data []byte) ([]byte, error)
 	} else if msg.IsNotification() {
 		return s.handleNotification(ctx, msg.ToNotification())
 	} else if msg.IsResponse() {
-		// Responses are not expected in this server (we're not making requests)
-		s.logDebug("ignoring response message", nil)
+		// Responses answer the requests the server sent, such as elicitations
+		s.handleResponse(msg.ToResponse())
 		return nil, nil
 	}
 
@@ -84,6 +160,8 @@ func (s *Server) handleRequest(ctx context.Context, req *Request) ([]byte, error
 		return s.handleToolsList(ctx, req)
 	case "tools/call":
 		return s.handleToolsCall(ctx, req)
+	case "logging/setLevel":
+		return s.handleSetLevel(ctx, req)
 	default:
 		response := NewErrorResponse(req.ID, MethodNotFound, fmt.Sprintf("Method not found: %s", req.Method), nil)
 		return json.Marshal(response)
@@ -123,12 +201,17 @@ func (s *Server) handleInitialize(ctx context.Context, req *Request) ([]byte, er
 		"client_version":   params.ClientInfo.Version,
 	})
 
+	s.outbound.mu.Lock()
+	s.outbound.elicitation = params.Capabilities.Elicitation != nil
+	s.outbound.mu.Unlock()
+
 	result := InitializeResult{
 		ProtocolVersion: ProtocolVersion,
 		Capabilities: ServerCapabilities{
 			Tools: &ToolsCapability{
 				ListChanged: false,
 			},
+			Logging: &LoggingCapability{},
 		},
 		ServerInfo: ServerInfo{
 			Name:    ServerName,
@@ -154,7 +237,7 @@ func (s *Server) handleToolsList(ctx context.Context, req *Request) ([]byte, err
 	s.logDebug("tools/list request", nil)
 
 	// Get filtered tools based on configuration
-	tools := s.registry.ListToolsFiltered(s.enabledTools, s.readOnlyMode)
+	tools := s.ListTools()
 
 	result := ListToolsResult{
 		Tools: tools,
@@ -180,29 +263,76 @@ func (s *Server) handleToolsCall(ctx context.Context, req *Request) ([]byte, err
 		"tool": params.Name,
 	})
 
+	// Start the parent span; Atlassian HTTP requests made by the handler become children
+	ctx, span := tracer.Start(ctx, "tools/call "+params.Name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("mcp.tool.name", params.Name)),
+	)
+	defer span.End()
+
 	// Check if tool exists
-	if _, ok := s.registry.GetTool(params.Name); !ok {
+	tool, ok := s.registry.GetTool(params.Name)
+	if !ok {
+		span.SetStatus(codes.Error, "tool not found")
 		response := NewErrorResponse(req.ID, MethodNotFound, fmt.Sprintf("Tool not found: %s", params.Name), nil)
 		return json.Marshal(response)
 	}
 
 	// Check if tool is allowed in read-only mode
-	if s.readOnlyMode {
-		tool, _ := s.registry.GetTool(params.Name)
-		if s.registry.hasWriteTag(tool.Tags) {
-			response := NewErrorResponse(req.ID, InvalidRequest, "Write operations are disabled in read-only mode", nil)
-			return json.Marshal(response)
-		}
+	if s.readOnlyMode && s.registry.hasWriteTag(tool.Tags) {
+		span.SetStatus(codes.Error, "write operation in read-only mode")
+		response := NewErrorResponse(req.ID, InvalidRequest, "Write operations are disabled in read-only mode", nil)
+		return json.Marshal(response)
+	}
+
+	// Reject arguments that don't match the input schema before the handler
+	// (or the user, when confirmation is required) sees them
+	if err := ValidateArguments(tool.InputSchema, params.Arguments); err != nil {
+		span.SetStatus(codes.Error, "invalid arguments")
+		var argsErr *ArgsError
+		errors.As(err, &argsErr)
+		response := NewResponse(req.ID, NewCodedErrorResult(err, argsErr))
+		return json.Marshal(response)
+	}
+
+	// Ask for confirmation before running tools that require it
+	refused, err := s.checkConfirmation(ctx, params.Name, params.Arguments)
+	if err != nil {
+		s.logError("tool confirmation failed", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		response := NewErrorResponse(req.ID, InternalError, "Tool confirmation failed", err.Error())
+		return json.Marshal(response)
+	}
+	if refused != nil {
+		span.SetStatus(codes.Error, "tool call not confirmed")
+		response := NewResponse(req.ID, refused)
+		return json.Marshal(response)
 	}
 
 	// Execute the tool
-	result, err := s.registry.CallTool(ctx, params.Name, params.Arguments)
+	result, err := s.callHandler(ctx, tool, params.Arguments)
 	if err != nil {
 		s.logError("tool execution failed", err)
-		response := NewErrorResponse(req.ID, InternalError, "Tool execution failed", err.Error())
-		return json.Marshal(response)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		// Errors with a code are reported to the agent as error results it
+		// can act on, rather than as protocol failures
+		var coded CodedError
+		if !errors.As(err, &coded) {
+			response := NewErrorResponse(req.ID, InternalError, "Tool execution failed", err.Error())
+			return json.Marshal(response)
+		}
+		result = NewCodedErrorResult(err, coded)
 	}
 
+	if result != nil && result.IsError {
+		span.SetStatus(codes.Error, "tool returned an error result")
+	}
+
+	LimitResult(result, outputLimitsFromArgs(s.outputLimits, params.Arguments))
+
 	response := NewResponse(req.ID, result)
 	return json.Marshal(response)
 }
@@ -3,9 +3,11 @@ package mcp
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"sync"
 
 	"github.com/rs/zerolog"
 )
@@ -16,6 +18,8 @@ type StdioTransport struct {
 	reader *bufio.Reader
 	writer io.Writer
 	logger *zerolog.Logger
+
+	writeMu sync.Mutex // Serializes responses with requests sent by the server
 }
 
 // NewStdioTransport creates a new stdio transport
@@ -32,6 +36,9 @@ func NewStdioTransport(server *Server, logger *zerolog.Logger) *StdioTransport {
 func (t *StdioTransport) Start(ctx context.Context) error {
 	t.logDebug("starting stdio transport")
 
+	// Let the server send requests (elicitations) to the client
+	t.server.SetSender(t.sendResponse)
+
 	// Channel to receive lines from stdin
 	lineChan := make(chan []byte)
 	errChan := make(chan error, 1)
@@ -44,6 +51,15 @@ func (t *StdioTransport) Start(ctx context.Context) error {
 				errChan <- err
 				return
 			}
+
+			// Responses answer requests sent while a tool call is running
+			// in the main loop, so they are delivered without waiting for it
+			if isResponse(line) {
+				if err := t.handleMessage(ctx, line); err != nil {
+					t.logError("error handling response", err)
+				}
+				continue
+			}
 			lineChan <- line
 		}
 	}()
@@ -99,8 +115,20 @@ func (t *StdioTransport) handleMessage(ctx context.Context, line []byte) error {
 	return nil
 }
 
+// isResponse reports whether a line holds a JSON-RPC response
+func isResponse(line []byte) bool {
+	var msg Message
+	if err := json.Unmarshal(line, &msg); err != nil {
+		return false
+	}
+	return msg.IsResponse()
+}
+
 // sendResponse sends a response to stdout
 func (t *StdioTransport) sendResponse(data []byte) error {
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+
 	// Write the response followed by a newline
 	if _, err := t.writer.Write(data); err != nil {
 		return fmt.Errorf("failed to write response: %w", err)
@@ -3,6 +3,7 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"sort"
 )
 
 // ToolHandler is a function that handles a tool call
@@ -55,16 +56,18 @@ func (r *ToolRegistry) GetTool(name string) (*ToolDefinition, bool) {
 	return tool, ok
 }
 
-// ListTools returns all registered tools
+// ListTools returns all registered tools, sorted by name
 func (r *ToolRegistry) ListTools() []Tool {
 	tools := make([]Tool, 0, len(r.tools))
 	for _, def := range r.tools {
 		tools = append(tools, def.Tool)
 	}
+	sortTools(tools)
 	return tools
 }
 
-// ListToolsFiltered returns tools filtered by tags and enabled list
+// ListToolsFiltered returns tools filtered by tags and enabled list, sorted
+// by name
 func (r *ToolRegistry) ListToolsFiltered(enabledTools []string, readOnlyMode bool) []Tool {
 	tools := make([]Tool, 0)
 
@@ -90,9 +93,18 @@ func (r *ToolRegistry) ListToolsFiltered(enabledTools []string, readOnlyMode boo
 		tools = append(tools, def.Tool)
 	}
 
+	sortTools(tools)
 	return tools
 }
 
+// sortTools sorts tools by name, so tool lists are stable across calls and
+// restarts
+func sortTools(tools []Tool) {
+	sort.Slice(tools, func(i, j int) bool {
+		return tools[i].Name < tools[j].Name
+	})
+}
+
 // CallTool executes a tool by name with the given arguments
 func (r *ToolRegistry) CallTool(ctx context.Context, name string, arguments map[string]interface{}) (*CallToolResult, error) {
 	handler, ok := r.handlers[name]
@@ -185,6 +197,16 @@ func NewArrayProperty(description string, items Property) Property {
 	}
 }
 
+// NewObjectProperty creates a new object property with the given fields
+func NewObjectProperty(description string, properties map[string]Property, required ...string) Property {
+	return Property{
+		Type:        "object",
+		Description: description,
+		Properties:  properties,
+		Required:    required,
+	}
+}
+
 // NewEnumProperty creates a new enum property
 func NewEnumProperty(description string, values ...string) Property {
 	return Property{
@@ -200,6 +222,31 @@ func (p Property) WithDefault(value interface{}) Property {
 	return p
 }
 
+// WithEnum restricts a property to the given values
+func (p Property) WithEnum(values ...string) Property {
+	p.Enum = values
+	return p
+}
+
+// WithPattern restricts a string property to values matching a regular
+// expression (RE2 syntax, as used by the server's validation)
+func (p Property) WithPattern(pattern string) Property {
+	p.Pattern = pattern
+	return p
+}
+
+// WithMinimum sets the smallest value a numeric property accepts
+func (p Property) WithMinimum(min float64) Property {
+	p.Minimum = &min
+	return p
+}
+
+// WithMaximum sets the largest value a numeric property accepts
+func (p Property) WithMaximum(max float64) Property {
+	p.Maximum = &max
+	return p
+}
+
 // Helper functions for creating tool results
 
 // NewTextContent creates a new text content item
@@ -226,7 +273,39 @@ func NewErrorResult(err error) *CallToolResult {
 	}
 }
 
-// NewJSONResult creates a tool result with JSON-formatted text
+// CodedError is an error with a machine-readable code and structured details,
+// such as the API errors of the Atlassian clients
+type CodedError interface {
+	error
+	ErrorCode() string
+	ErrorDetails() map[string]interface{}
+}
+
+// NewCodedErrorResult creates an error tool result whose text is a JSON object
+// with the error message, its code, and its details
+func NewCodedErrorResult(err error, coded CodedError) *CallToolResult {
+	payload := map[string]interface{}{
+		"error": err.Error(),
+		"code":  coded.ErrorCode(),
+	}
+	if details := coded.ErrorDetails(); len(details) > 0 {
+		payload["details"] = details
+	}
+
+	jsonBytes, marshalErr := marshalJSON(payload)
+	if marshalErr != nil {
+		return NewErrorResult(err)
+	}
+
+	return &CallToolResult{
+		Content: []Content{NewTextContent(string(jsonBytes))},
+		IsError: true,
+	}
+}
+
+// NewJSONResult creates a tool result with JSON-formatted text. Map keys are
+// written in sorted order and struct fields in declaration order, so the
+// same data always produces the same text.
 func NewJSONResult(data interface{}) (*CallToolResult, error) {
 	jsonBytes, err := marshalJSON(data)
 	if err != nil {
@@ -0,0 +1,231 @@
+package mcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultMaxFieldChars is the field length used when a call asks to
+	// summarize large fields and no field limit is configured
+	DefaultMaxFieldChars = 2000
+
+	// minFieldChars and minArrayItems bound how far fields and arrays are
+	// shortened to fit a result within its character budget
+	minFieldChars = 100
+	minArrayItems = 1
+)
+
+// OutputLimits controls the size of tool results. Zero values disable the
+// corresponding limit.
+type OutputLimits struct {
+	MaxChars      int // Maximum characters of the text content of a result, across all its content items
+	MaxFieldChars int // Maximum characters of any string field in a JSON result
+}
+
+// outputLimitsFromArgs applies the per-call output arguments ("max_chars" and
+// "summarize_large_fields") over the configured limits. A call can lower the
+// configured character limit, but not raise it.
+func outputLimitsFromArgs(limits OutputLimits, args map[string]interface{}) OutputLimits {
+	if v, ok := args["max_chars"].(float64); ok && v > 0 {
+		if limits.MaxChars <= 0 || int(v) < limits.MaxChars {
+			limits.MaxChars = int(v)
+		}
+	}
+	if summarize, ok := args["summarize_large_fields"].(bool); ok && summarize && limits.MaxFieldChars == 0 {
+		limits.MaxFieldChars = DefaultMaxFieldChars
+	}
+	return limits
+}
+
+// LimitResult shortens the text content of a result to fit the limits. JSON
+// content is shortened structurally: long strings are cut and long arrays are
+// elided with truncation markers, so the result stays valid JSON. Other text,
+// and JSON that cannot be shortened enough, is cut at the character budget.
+//
+// The character budget covers all text blocks of the result together: each
+// block gets what the blocks before it left, and the blocks that no longer
+// fit are replaced by a single marker.
+func LimitResult(result *CallToolResult, limits OutputLimits) {
+	if result == nil || (limits.MaxChars <= 0 && limits.MaxFieldChars <= 0) {
+		return
+	}
+
+	remaining := limits.MaxChars
+	written := false
+	omitted := 0
+	content := result.Content[:0]
+	for _, block := range result.Content {
+		if block.Type != "text" {
+			content = append(content, block)
+			continue
+		}
+		blockLimits := limits
+		if limits.MaxChars > 0 {
+			blockLimits.MaxChars = max(remaining, 1)
+		}
+		text := limitText(block.Text, blockLimits)
+		size := utf8.RuneCountInString(text)
+		// Only the first block is cut at the budget; later blocks that can't
+		// be shortened to fit are dropped with the rest
+		if written && limits.MaxChars > 0 && size > remaining {
+			remaining = 0
+			omitted++
+			continue
+		}
+		block.Text = text
+		remaining -= size
+		written = true
+		content = append(content, block)
+	}
+	if omitted > 0 {
+		content = append(content, NewTextContent(fmt.Sprintf("… [output truncated: %d more content blocks omitted; narrow the request or raise max_chars]", omitted)))
+	}
+	result.Content = content
+}
+
+// limitText shortens a single text content item
+func limitText(text string, limits OutputLimits) string {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(text)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return truncateText(text, limits.MaxChars)
+	}
+
+	fieldLimit := limits.MaxFieldChars
+	itemLimit := 0
+	if fieldLimit > 0 {
+		text = marshalLimited(value, fieldLimit, itemLimit)
+	}
+	if limits.MaxChars <= 0 || utf8.RuneCountInString(text) <= limits.MaxChars {
+		return text
+	}
+
+	// Shorten string fields first, then arrays, until the result fits
+	if fieldLimit <= 0 {
+		fieldLimit = longestString(value)
+	}
+	for fieldLimit > minFieldChars {
+		fieldLimit = max(fieldLimit/2, minFieldChars)
+		text = marshalLimited(value, fieldLimit, itemLimit)
+		if utf8.RuneCountInString(text) <= limits.MaxChars {
+			return text
+		}
+	}
+
+	itemLimit = longestArray(value)
+	for itemLimit > minArrayItems {
+		itemLimit = max(itemLimit/2, minArrayItems)
+		text = marshalLimited(value, fieldLimit, itemLimit)
+		if utf8.RuneCountInString(text) <= limits.MaxChars {
+			return text
+		}
+	}
+
+	return truncateText(text, limits.MaxChars)
+}
+
+// marshalLimited marshals a copy of value with strings cut to fieldLimit
+// characters and arrays cut to itemLimit items (zero means no limit)
+func marshalLimited(value interface{}, fieldLimit, itemLimit int) string {
+	data, err := marshalJSON(limitValue(value, fieldLimit, itemLimit))
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// limitValue returns a copy of a decoded JSON value with long strings and
+// arrays replaced by truncated versions
+func limitValue(value interface{}, fieldLimit, itemLimit int) interface{} {
+	switch v := value.(type) {
+	case string:
+		return truncateString(v, fieldLimit)
+	case []interface{}:
+		items := v
+		if itemLimit > 0 && len(items) > itemLimit {
+			items = items[:itemLimit]
+		}
+		limited := make([]interface{}, 0, len(items)+1)
+		for _, item := range items {
+			limited = append(limited, limitValue(item, fieldLimit, itemLimit))
+		}
+		if len(items) < len(v) {
+			limited = append(limited, fmt.Sprintf("… [%d more items truncated]", len(v)-len(items)))
+		}
+		return limited
+	case map[string]interface{}:
+		limited := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			limited[key] = limitValue(item, fieldLimit, itemLimit)
+		}
+		return limited
+	default:
+		return value
+	}
+}
+
+// truncateString cuts a string to limit characters with a truncation marker
+func truncateString(s string, limit int) string {
+	total := utf8.RuneCountInString(s)
+	if limit <= 0 || total <= limit {
+		return s
+	}
+	runes := []rune(s)
+	return fmt.Sprintf("%s… [truncated %d of %d chars]", string(runes[:limit]), total-limit, total)
+}
+
+// truncateText cuts text to limit characters with a truncation marker
+func truncateText(text string, limit int) string {
+	total := utf8.RuneCountInString(text)
+	if limit <= 0 || total <= limit {
+		return text
+	}
+	runes := []rune(text)
+	return fmt.Sprintf("%s\n… [output truncated: showing %d of %d chars; narrow the request or raise max_chars]", string(runes[:limit]), limit, total)
+}
+
+// longestString returns the length of the longest string in a decoded JSON value
+func longestString(value interface{}) int {
+	longest := 0
+	switch v := value.(type) {
+	case string:
+		longest = utf8.RuneCountInString(v)
+	case []interface{}:
+		for _, item := range v {
+			longest = max(longest, longestString(item))
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			longest = max(longest, longestString(item))
+		}
+	}
+	return longest
+}
+
+// longestArray returns the length of the longest array in a decoded JSON value
+func longestArray(value interface{}) int {
+	longest := 0
+	switch v := value.(type) {
+	case []interface{}:
+		longest = len(v)
+		for _, item := range v {
+			longest = max(longest, longestArray(item))
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			longest = max(longest, longestArray(item))
+		}
+	}
+	return longest
+}
+
+// WithOutputLimits adds the per-call output size arguments to a schema
+func (s InputSchema) WithOutputLimits() InputSchema {
+	s.Properties["max_chars"] = NewIntegerProperty("Maximum characters of the result. Long fields and lists are shortened with truncation markers to fit (defaults to the server limit, which it can lower but not raise)")
+	s.Properties["summarize_large_fields"] = NewBooleanProperty("Shorten long text fields (descriptions, page bodies) with truncation markers").WithDefault(false)
+	return s
+}
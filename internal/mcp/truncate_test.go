@@ -0,0 +1,118 @@
+package mcp
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestLimitResult_FieldLimit(t *testing.T) {
+	result, _ := NewJSONResult(map[string]interface{}{
+		"key":         "PROJ-1",
+		"description": strings.Repeat("a", 50),
+	})
+
+	LimitResult(result, OutputLimits{MaxFieldChars: 10})
+
+	var got map[string]string
+	if err := json.Unmarshal([]byte(result.Content[0].Text), &got); err != nil {
+		t.Fatalf("result is not valid JSON: %v", err)
+	}
+	if got["key"] != "PROJ-1" {
+		t.Errorf("key = %q, want unchanged", got["key"])
+	}
+	if want := strings.Repeat("a", 10) + "… [truncated 40 of 50 chars]"; got["description"] != want {
+		t.Errorf("description = %q, want %q", got["description"], want)
+	}
+}
+
+func TestLimitResult_MaxChars(t *testing.T) {
+	issues := make([]map[string]string, 50)
+	for i := range issues {
+		issues[i] = map[string]string{"key": "PROJ-1", "description": strings.Repeat("x", 1000)}
+	}
+	result, _ := NewJSONResult(map[string]interface{}{"issues": issues, "total": 50})
+
+	LimitResult(result, OutputLimits{MaxChars: 2000})
+
+	text := result.Content[0].Text
+	if n := utf8.RuneCountInString(text); n > 2000 {
+		t.Errorf("result has %d chars, want at most 2000", n)
+	}
+
+	var got struct {
+		Issues []interface{} `json:"issues"`
+		Total  int           `json:"total"`
+	}
+	if err := json.Unmarshal([]byte(text), &got); err != nil {
+		t.Fatalf("result is not valid JSON: %v", err)
+	}
+	if got.Total != 50 {
+		t.Errorf("total = %d, want 50", got.Total)
+	}
+	if marker, ok := got.Issues[len(got.Issues)-1].(string); !ok || !strings.Contains(marker, "more items truncated") {
+		t.Errorf("last item = %v, want an elision marker", got.Issues[len(got.Issues)-1])
+	}
+}
+
+func TestLimitResult_PlainText(t *testing.T) {
+	result := NewSuccessResult(strings.Repeat("é", 30))
+
+	LimitResult(result, OutputLimits{MaxChars: 10})
+
+	text := result.Content[0].Text
+	if !strings.HasPrefix(text, strings.Repeat("é", 10)+"\n… [output truncated: showing 10 of 30 chars") {
+		t.Errorf("unexpected truncated text: %q", text)
+	}
+}
+
+func TestLimitResult_MaxCharsAcrossBlocks(t *testing.T) {
+	items := make([]string, 100)
+	for i := range items {
+		items[i] = strings.Repeat("x", 100)
+	}
+	result, _ := NewChunkedJSONResult(map[string]int{"count": len(items)}, items, 10)
+
+	LimitResult(result, OutputLimits{MaxChars: 3000})
+
+	last := result.Content[len(result.Content)-1].Text
+	if !strings.Contains(last, "more content blocks omitted") {
+		t.Errorf("last block = %q, want an omission marker", last)
+	}
+	total := 0
+	for _, block := range result.Content[:len(result.Content)-1] {
+		total += utf8.RuneCountInString(block.Text)
+		var value interface{}
+		if err := json.Unmarshal([]byte(block.Text), &value); err != nil {
+			t.Errorf("block %q is not valid JSON: %v", block.Text, err)
+		}
+	}
+	if total > 3000 {
+		t.Errorf("blocks hold %d chars, want at most 3000", total)
+	}
+	if len(result.Content) < 3 {
+		t.Errorf("got %d blocks, want the summary and at least one chunk before the marker", len(result.Content))
+	}
+}
+
+func TestOutputLimitsFromArgs(t *testing.T) {
+	base := OutputLimits{MaxChars: 1000}
+
+	got := outputLimitsFromArgs(base, map[string]interface{}{"max_chars": float64(500), "summarize_large_fields": true})
+	if got.MaxChars != 500 || got.MaxFieldChars != DefaultMaxFieldChars {
+		t.Errorf("outputLimitsFromArgs() = %+v, want max 500 and default field limit", got)
+	}
+
+	if got := outputLimitsFromArgs(base, map[string]interface{}{"max_chars": float64(5000)}); got.MaxChars != 1000 {
+		t.Errorf("outputLimitsFromArgs() = %+v, want the server limit of 1000 kept", got)
+	}
+
+	if got := outputLimitsFromArgs(OutputLimits{}, map[string]interface{}{"max_chars": float64(5000)}); got.MaxChars != 5000 {
+		t.Errorf("outputLimitsFromArgs() without a server limit = %+v, want 5000", got)
+	}
+
+	if got := outputLimitsFromArgs(base, nil); got != base {
+		t.Errorf("outputLimitsFromArgs(nil) = %+v, want %+v", got, base)
+	}
+}
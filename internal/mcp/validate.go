@@ -0,0 +1,176 @@
+package mcp
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ValidateArguments checks tool arguments against the input schema of the
+// tool: required arguments, property types, enum values, patterns, and
+// numeric ranges. All problems are reported at once in an *ArgsError, so
+// agents can fix a call in one retry.
+//
+// Arguments the schema doesn't declare are left to the handler, and values
+// the handlers coerce anyway (numbers and booleans sent as strings, arrays
+// and objects sent as JSON-encoded strings) are accepted.
+func ValidateArguments(schema InputSchema, args map[string]interface{}) error {
+	argsErr := &ArgsError{}
+
+	for _, name := range schema.Required {
+		if isEmptyArg(args[name]) {
+			argsErr.add(name, "is required")
+		}
+	}
+
+	names := make([]string, 0, len(args))
+	for name := range args {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prop, ok := schema.Properties[name]
+		if !ok || isEmptyArg(args[name]) {
+			continue
+		}
+		if msg := checkProperty(prop, args[name]); msg != "" {
+			argsErr.add(name, msg)
+		}
+	}
+
+	if len(argsErr.Problems) > 0 {
+		return argsErr
+	}
+	return nil
+}
+
+// checkProperty validates a value against a property, returning the problem
+// or ""
+func checkProperty(prop Property, value interface{}) string {
+	switch prop.Type {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return "must be a string"
+		}
+		if len(prop.Enum) > 0 && !slices.Contains(prop.Enum, s) {
+			return "must be one of " + strings.Join(prop.Enum, ", ")
+		}
+		if prop.Pattern != "" {
+			// Patterns that don't compile are a schema bug, not the caller's
+			if re, err := regexp.Compile(prop.Pattern); err == nil && !re.MatchString(s) {
+				return "must match " + prop.Pattern
+			}
+		}
+
+	case "integer":
+		n, ok := numberArg(value)
+		if !ok || n != float64(int64(n)) {
+			return "must be a whole number"
+		}
+		return checkRange(prop, n)
+
+	case "number":
+		n, ok := numberArg(value)
+		if !ok {
+			return "must be a number"
+		}
+		return checkRange(prop, n)
+
+	case "boolean":
+		switch b := value.(type) {
+		case bool:
+		case string:
+			if _, err := strconv.ParseBool(strings.TrimSpace(b)); err != nil {
+				return "must be true or false"
+			}
+		default:
+			return "must be true or false"
+		}
+
+	case "array":
+		items, ok := decodeJSONArg(value).([]interface{})
+		if !ok {
+			return "must be an array"
+		}
+		if prop.Items == nil {
+			return ""
+		}
+		for i, item := range items {
+			if msg := checkProperty(*prop.Items, item); msg != "" {
+				return "item " + strconv.Itoa(i) + " " + msg
+			}
+		}
+
+	case "object":
+		fields, ok := decodeJSONArg(value).(map[string]interface{})
+		if !ok {
+			return "must be an object"
+		}
+		for _, name := range prop.Required {
+			if isEmptyArg(fields[name]) {
+				return name + " is required"
+			}
+		}
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			field, ok := prop.Properties[name]
+			if !ok || isEmptyArg(fields[name]) {
+				continue
+			}
+			if msg := checkProperty(field, fields[name]); msg != "" {
+				return name + " " + msg
+			}
+		}
+	}
+	return ""
+}
+
+// decodeJSONArg decodes a JSON-encoded string, which agents often send for
+// array and object arguments. Other values are returned unchanged.
+func decodeJSONArg(value interface{}) interface{} {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(s), &decoded); err != nil {
+		return value
+	}
+	return decoded
+}
+
+// numberArg converts a numeric argument, which may be sent as a string
+func numberArg(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// checkRange checks a number against the minimum and maximum of a property
+func checkRange(prop Property, n float64) string {
+	if prop.Minimum != nil && n < *prop.Minimum {
+		return "must be at least " + strconv.FormatFloat(*prop.Minimum, 'f', -1, 64)
+	}
+	if prop.Maximum != nil && n > *prop.Maximum {
+		return "must be at most " + strconv.FormatFloat(*prop.Maximum, 'f', -1, 64)
+	}
+	return ""
+}
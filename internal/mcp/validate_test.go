@@ -0,0 +1,127 @@
+package mcp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidateArguments(t *testing.T) {
+	schema := NewInputSchema(map[string]Property{
+		"key":      NewStringProperty("Issue key"),
+		"limit":    NewIntegerProperty("Max results"),
+		"wait":     NewBooleanProperty("Wait"),
+		"priority": NewEnumProperty("Priority", "P1", "P2", "P3"),
+		"labels":   NewArrayProperty("Labels", NewStringProperty("Label")),
+		"project":  NewStringProperty("Project key").WithPattern(`^[A-Z][A-Z0-9]+$`),
+		"page":     NewIntegerProperty("Page").WithMinimum(1).WithMaximum(10),
+		"responders": NewArrayProperty("Responders", NewObjectProperty("Responder", map[string]Property{
+			"type": NewEnumProperty("Type", "user", "team"),
+			"id":   NewStringProperty("ID"),
+		}, "type")),
+	}, "key")
+
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want []ArgProblem
+	}{
+		{
+			name: "valid",
+			args: map[string]interface{}{"key": "PROJ-1", "limit": float64(10), "wait": true, "priority": "P2", "labels": []interface{}{"a"}},
+		},
+		{
+			name: "coercible strings",
+			args: map[string]interface{}{"key": "PROJ-1", "limit": "10", "wait": "false"},
+		},
+		{
+			name: "empty optional values",
+			args: map[string]interface{}{"key": "PROJ-1", "priority": "", "limit": nil},
+		},
+		{
+			name: "undeclared arguments",
+			args: map[string]interface{}{"key": "PROJ-1", "extra": 1},
+		},
+		{
+			name: "missing required",
+			args: map[string]interface{}{"key": " "},
+			want: []ArgProblem{{Arg: "key", Message: "is required"}},
+		},
+		{
+			name: "wrong types",
+			args: map[string]interface{}{
+				"key":      float64(123),
+				"labels":   "a,b",
+				"limit":    float64(1.5),
+				"priority": "P9",
+				"wait":     "maybe",
+			},
+			want: []ArgProblem{
+				{Arg: "key", Message: "must be a string"},
+				{Arg: "labels", Message: "must be an array"},
+				{Arg: "limit", Message: "must be a whole number"},
+				{Arg: "priority", Message: "must be one of P1, P2, P3"},
+				{Arg: "wait", Message: "must be true or false"},
+			},
+		},
+		{
+			name: "pattern and range",
+			args: map[string]interface{}{"key": "PROJ-1", "project": "proj", "page": float64(11)},
+			want: []ArgProblem{
+				{Arg: "page", Message: "must be at most 10"},
+				{Arg: "project", Message: "must match ^[A-Z][A-Z0-9]+$"},
+			},
+		},
+		{
+			name: "below minimum as string",
+			args: map[string]interface{}{"key": "PROJ-1", "project": "PROJ", "page": "0"},
+			want: []ArgProblem{{Arg: "page", Message: "must be at least 1"}},
+		},
+		{
+			name: "objects",
+			args: map[string]interface{}{"key": "PROJ-1", "responders": []interface{}{map[string]interface{}{"type": "team", "id": "t1"}}},
+		},
+		{
+			name: "JSON-encoded array",
+			args: map[string]interface{}{"key": "PROJ-1", "responders": `[{"type": "user", "id": "u1"}]`},
+		},
+		{
+			name: "invalid object fields",
+			args: map[string]interface{}{"key": "PROJ-1", "responders": []interface{}{
+				map[string]interface{}{"type": "team"},
+				map[string]interface{}{"type": "group", "id": "g1"},
+			}},
+			want: []ArgProblem{{Arg: "responders", Message: "item 1 type must be one of user, team"}},
+		},
+		{
+			name: "missing object field",
+			args: map[string]interface{}{"key": "PROJ-1", "responders": `[{"id": "u1"}]`},
+			want: []ArgProblem{{Arg: "responders", Message: "item 0 type is required"}},
+		},
+		{
+			name: "wrong item type",
+			args: map[string]interface{}{"key": "PROJ-1", "labels": []interface{}{"a", float64(2)}},
+			want: []ArgProblem{{Arg: "labels", Message: "item 1 must be a string"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateArguments(schema, tt.args)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("ValidateArguments() error = %v", err)
+				}
+				return
+			}
+
+			var argsErr *ArgsError
+			if !errors.As(err, &argsErr) {
+				t.Fatalf("ValidateArguments() error = %v, want an *ArgsError", err)
+			}
+			if !reflect.DeepEqual(argsErr.Problems, tt.want) {
+				t.Errorf("ValidateArguments() problems = %+v, want %+v", argsErr.Problems, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package store
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// Cursor is the position of a feed read in time order: the time of the last
+// item read and the keys of the items read at that time. Feeds are read from
+// the cursor's time inclusively, so items sharing that time are not missed,
+// and the keys keep the ones already read from being returned again.
+type Cursor struct {
+	Time time.Time `json:"time"`
+	Keys []string  `json:"keys,omitempty"`
+}
+
+// Read reports whether the item with the key and time was already read
+func (c Cursor) Read(key string, t time.Time) bool {
+	return t.Before(c.Time) || (t.Equal(c.Time) && slices.Contains(c.Keys, key))
+}
+
+// Advance moves the cursor past an item. Items are passed in time order.
+func (c *Cursor) Advance(key string, t time.Time) {
+	if t.After(c.Time) {
+		c.Time = t
+		c.Keys = nil
+	}
+	if t.Equal(c.Time) && !slices.Contains(c.Keys, key) {
+		c.Keys = append(c.Keys, key)
+	}
+}
+
+// UnmarshalJSON also accepts a plain time, the format cursors were saved in
+// before they recorded keys
+func (c *Cursor) UnmarshalJSON(data []byte) error {
+	var t time.Time
+	if err := json.Unmarshal(data, &t); err == nil {
+		*c = Cursor{Time: t}
+		return nil
+	}
+
+	type cursor Cursor
+	return json.Unmarshal(data, (*cursor)(c))
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCursor(t *testing.T) {
+	t0 := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Minute)
+
+	cursor := Cursor{Time: t0}
+	if cursor.Read("jira:PROJ-1", t0) {
+		t.Error("an item at the cursor's time without a key should not be read")
+	}
+	if !cursor.Read("jira:PROJ-1", t0.Add(-time.Second)) {
+		t.Error("an item before the cursor should be read")
+	}
+
+	cursor.Advance("jira:PROJ-1", t0)
+	cursor.Advance("jira:PROJ-2", t0)
+	cursor.Advance("jira:PROJ-1", t0)
+	if !slices.Equal(cursor.Keys, []string{"jira:PROJ-1", "jira:PROJ-2"}) {
+		t.Errorf("Keys = %v, want both items at t0 once", cursor.Keys)
+	}
+	if !cursor.Read("jira:PROJ-2", t0) || cursor.Read("jira:PROJ-3", t0) {
+		t.Error("only the items advanced past at t0 should be read")
+	}
+
+	cursor.Advance("confluence:42", t1)
+	if !cursor.Time.Equal(t1) || !slices.Equal(cursor.Keys, []string{"confluence:42"}) {
+		t.Errorf("cursor = %+v, want t1 with the item at t1", cursor)
+	}
+	if cursor.Read("jira:PROJ-3", t1.Add(time.Second)) {
+		t.Error("an item after the cursor should not be read")
+	}
+}
+
+func TestCursorUnmarshal(t *testing.T) {
+	t0 := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	var cursor Cursor
+	if err := json.Unmarshal([]byte(`"2025-01-15T10:00:00Z"`), &cursor); err != nil || !cursor.Time.Equal(t0) || cursor.Keys != nil {
+		t.Errorf("Unmarshal() of a plain time = %+v, %v", cursor, err)
+	}
+
+	data, err := json.Marshal(Cursor{Time: t0, Keys: []string{"jira:PROJ-1"}})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	cursor = Cursor{}
+	if err := json.Unmarshal(data, &cursor); err != nil || !cursor.Time.Equal(t0) || !slices.Equal(cursor.Keys, []string{"jira:PROJ-1"}) {
+		t.Errorf("Unmarshal(%s) = %+v, %v", data, cursor, err)
+	}
+}
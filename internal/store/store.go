@@ -0,0 +1,211 @@
+// Package store persists small pieces of server state across restarts:
+// activity cursors and an audit log of write operations. State is kept as
+// JSON files in a data directory.
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Buckets group related keys
+const (
+	BucketCursors = "cursors" // Polling cursors, e.g. of atlas_get_recent_activity
+)
+
+// File names in the data directory
+const (
+	stateFile = "state.json"
+	auditFile = "audit.jsonl"
+)
+
+// AuditEntry records a call to a write tool
+type AuditEntry struct {
+	Time      time.Time              `json:"time"`
+	Tool      string                 `json:"tool"`
+	Arguments map[string]interface{} `json:"arguments,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+}
+
+// Store holds the persisted state. It is safe for concurrent use, and a nil
+// Store stores nothing, so callers need not check whether persistence is
+// enabled.
+type Store struct {
+	dir string
+
+	mu    sync.Mutex
+	state map[string]map[string]json.RawMessage // Values by bucket and key
+}
+
+// Open opens the store in dir, creating the directory if needed
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	s := &Store{dir: dir, state: make(map[string]map[string]json.RawMessage)}
+
+	data, err := os.ReadFile(filepath.Join(dir, stateFile))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read state: %w", err)
+	default:
+		if err := json.Unmarshal(data, &s.state); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", filepath.Join(dir, stateFile), err)
+		}
+	}
+
+	return s, nil
+}
+
+// Get reads a value into v and reports whether it was found
+func (s *Store) Get(bucket, key string, v interface{}) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+
+	s.mu.Lock()
+	raw, ok := s.state[bucket][key]
+	s.mu.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return false, fmt.Errorf("invalid %s/%s: %w", bucket, key, err)
+	}
+	return true, nil
+}
+
+// Put stores a value and saves the state
+func (s *Store) Put(bucket, key string, v interface{}) error {
+	if s == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s/%s: %w", bucket, key, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state[bucket] == nil {
+		s.state[bucket] = make(map[string]json.RawMessage)
+	}
+	s.state[bucket][key] = raw
+	return s.save()
+}
+
+// Delete removes a value and saves the state
+func (s *Store) Delete(bucket, key string) error {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.state[bucket][key]; !ok {
+		return nil
+	}
+	delete(s.state[bucket], key)
+	return s.save()
+}
+
+// Keys returns the keys of a bucket, sorted
+func (s *Store) Keys(bucket string) []string {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.state[bucket]))
+	for key := range s.state[bucket] {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Audit appends an entry to the audit log
+func (s *Store) Audit(entry AuditEntry) error {
+	if s == nil {
+		return nil
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(filepath.Join(s.dir, auditFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// save writes the state to disk atomically. The caller holds the lock.
+func (s *Store) save() error {
+	data, err := json.MarshalIndent(s.state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, stateFile+".*")
+	if err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, stateFile)); err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	return nil
+}
+
+type contextKey string
+
+const storeKey contextKey = "store"
+
+// WithStore adds the store to the context
+func WithStore(ctx context.Context, s *Store) context.Context {
+	return context.WithValue(ctx, storeKey, s)
+}
+
+// GetStore retrieves the store from the context, or nil if persistence is
+// not enabled
+func GetStore(ctx context.Context) *Store {
+	s, ok := ctx.Value(storeKey).(*Store)
+	if !ok {
+		return nil
+	}
+	return s
+}
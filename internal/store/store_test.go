@@ -0,0 +1,112 @@
+package store
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestStorePersists(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "data")
+
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	cursor := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	if err := s.Put(BucketCursors, "activity", cursor); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := s.Put("items", "create-1", "PROJ-1"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := s.Put("items", "create-2", "PROJ-2"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := s.Delete("items", "create-2"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	// Reopening reads the saved state
+	s, err = Open(dir)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	var got time.Time
+	if found, err := s.Get(BucketCursors, "activity", &got); err != nil || !found || !got.Equal(cursor) {
+		t.Errorf("Get() = %v, %v, %v, want %v", got, found, err, cursor)
+	}
+	if keys := s.Keys("items"); !slices.Equal(keys, []string{"create-1"}) {
+		t.Errorf("Keys() = %v, want [create-1]", keys)
+	}
+
+	var missing string
+	if found, err := s.Get("items", "create-3", &missing); err != nil || found {
+		t.Errorf("Get() of a missing key = %v, %v", found, err)
+	}
+
+	info, err := os.Stat(filepath.Join(dir, stateFile))
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("state file permissions = %o, want 600", perm)
+	}
+}
+
+func TestStoreAudit(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	entries := []AuditEntry{
+		{Time: time.Now().UTC(), Tool: "jira_create_issue", Arguments: map[string]interface{}{"summary": "x"}},
+		{Time: time.Now().UTC(), Tool: "jira_delete_issue", Error: "HTTP 404"},
+	}
+	for _, entry := range entries {
+		if err := s.Audit(entry); err != nil {
+			t.Fatalf("Audit() error = %v", err)
+		}
+	}
+
+	f, err := os.Open(filepath.Join(dir, auditFile))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer f.Close()
+
+	var tools []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid audit line %q: %v", scanner.Text(), err)
+		}
+		tools = append(tools, entry.Tool)
+	}
+	if !slices.Equal(tools, []string{"jira_create_issue", "jira_delete_issue"}) {
+		t.Errorf("audit log tools = %v", tools)
+	}
+}
+
+func TestNilStore(t *testing.T) {
+	var s *Store
+	if err := s.Put(BucketCursors, "activity", "x"); err != nil {
+		t.Errorf("Put() error = %v", err)
+	}
+	var value string
+	if found, err := s.Get(BucketCursors, "activity", &value); found || err != nil {
+		t.Errorf("Get() = %v, %v, want not found", found, err)
+	}
+	if err := s.Audit(AuditEntry{Tool: "jira_create_issue"}); err != nil {
+		t.Errorf("Audit() error = %v", err)
+	}
+}
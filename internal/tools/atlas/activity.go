@@ -0,0 +1,314 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/store"
+	confluencetools "github.com/codeownersnet/atlas/internal/tools/confluence"
+	jiratools "github.com/codeownersnet/atlas/internal/tools/jira"
+	opsgenietools "github.com/codeownersnet/atlas/internal/tools/opsgenie"
+	"github.com/codeownersnet/atlas/pkg/atlassian/confluence"
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+	"github.com/codeownersnet/atlas/pkg/atlassian/opsgenie"
+)
+
+// defaultActivityWindow is how far back activity is read without a cursor
+const defaultActivityWindow = time.Hour
+
+// activityItem is a change in the merged activity feed
+type activityItem struct {
+	Time    time.Time `json:"time"`
+	Product string    `json:"product"`
+	Type    string    `json:"type"` // Jira issue type, page, blogpost, or alert
+	Key     string    `json:"key"`  // Issue key, content ID, or alert tiny ID
+	Title   string    `json:"title"`
+	Status  string    `json:"status,omitempty"`
+	Actor   string    `json:"actor,omitempty"`
+}
+
+// id identifies the item across products
+func (i activityItem) id() string {
+	return i.Product + ":" + i.Key
+}
+
+// AtlasGetRecentActivityTool creates the atlas_get_recent_activity tool
+func AtlasGetRecentActivityTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"atlas_get_recent_activity",
+		"Get a merged feed of recent changes across the configured products: Jira issues updated, Confluence pages and blog posts modified, and Opsgenie alerts updated since a cursor, oldest first. Poll for new activity by passing the cursor of the previous call as since. Use this when webhooks cannot reach the server.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"since":       mcp.NewStringProperty("Only return changes at or after this time (ISO 8601, or the cursor of a previous call); changes a previous call already returned at the cursor's time are skipped. Defaults to the cursor of the previous call with the same filters when state is persisted (DATA_DIR), otherwise to one hour ago."),
+				"products":    mcp.NewStringProperty("Comma-separated products to read (jira, confluence, opsgenie). Defaults to all configured products."),
+				"project_key": mcp.NewStringProperty("Only return Jira issues of this project"),
+				"space_key":   mcp.NewStringProperty("Only return Confluence content of this space"),
+				"limit":       mcp.NewIntegerProperty("Maximum number of changes read per product, oldest first; later changes are returned by the next call").WithDefault(25),
+			},
+		),
+		atlasGetRecentActivityHandler,
+		"atlas", "read",
+	)
+}
+
+func atlasGetRecentActivityHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Since      string   `arg:"since,trim"`
+		Products   []string `arg:"products"`
+		ProjectKey string   `arg:"project_key,trim"`
+		SpaceKey   string   `arg:"space_key,trim"`
+		Limit      int      `arg:"limit" default:"25" validate:"min=1"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	// Cursors are persisted per set of filters
+	state := store.GetStore(ctx)
+	cursorKey := fmt.Sprintf("activity:%s:%s:%s", strings.Join(params.Products, ","), params.ProjectKey, params.SpaceKey)
+
+	now := time.Now().UTC()
+	cursor := store.Cursor{Time: now.Add(-defaultActivityWindow)}
+	var saved store.Cursor
+	found, err := state.Get(store.BucketCursors, cursorKey, &saved)
+	if err != nil {
+		return nil, err
+	}
+	if params.Since != "" {
+		t, err := time.Parse(time.RFC3339, params.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since %q: use an ISO 8601 time such as 2025-01-15T10:00:00Z", params.Since)
+		}
+		cursor = store.Cursor{Time: t.UTC()}
+		// Passing back the saved cursor also skips the items already read at
+		// its time
+		if found && saved.Time.Equal(cursor.Time) {
+			cursor = saved
+		}
+	} else if found {
+		cursor = saved
+	}
+	since := cursor.Time
+
+	jiraClient := jiratools.GetJiraClient(ctx)
+	confluenceClient := confluencetools.GetConfluenceClient(ctx)
+	opsgenieClient := opsgenietools.GetOpsgenieClient(ctx)
+
+	products := map[string]bool{
+		ProductJira:       jiraClient != nil,
+		ProductConfluence: confluenceClient != nil,
+		ProductOpsgenie:   opsgenieClient != nil,
+	}
+	if len(params.Products) > 0 {
+		requested := make(map[string]bool)
+		for _, product := range params.Products {
+			product = strings.ToLower(product)
+			configured, known := products[product]
+			if !known {
+				return nil, fmt.Errorf("unknown product %q: use jira, confluence, or opsgenie", product)
+			}
+			if !configured {
+				return nil, fmt.Errorf("%s is not configured", product)
+			}
+			requested[product] = true
+		}
+		products = requested
+	}
+	if countTrue(products) == 0 {
+		return nil, fmt.Errorf("no product is configured")
+	}
+
+	// The relative window covers the time since the cursor in every time
+	// zone; items are then filtered on their exact timestamps
+	window := int(math.Ceil(now.Sub(since).Minutes())) + 1
+
+	// Each product is read oldest first. A product that returned limit items
+	// may have more after its last one, so the feed ends there and the next
+	// call continues from it.
+	var items []activityItem
+	var until time.Time
+	failures := make(map[string]string)
+	record := func(product string, found []activityItem, err error) {
+		if err != nil {
+			failures[product] = err.Error()
+			return
+		}
+		var last time.Time
+		for _, item := range found {
+			if item.Time.After(last) {
+				last = item.Time
+			}
+			if !cursor.Read(item.id(), item.Time) {
+				items = append(items, item)
+			}
+		}
+		if len(found) >= params.Limit && (until.IsZero() || last.Before(until)) {
+			until = last
+		}
+	}
+
+	if products[ProductJira] {
+		found, err := jiraActivity(ctx, jiraClient, window, params.ProjectKey, params.Limit)
+		record(ProductJira, found, err)
+	}
+	if products[ProductConfluence] {
+		found, err := confluenceActivity(ctx, confluenceClient, window, params.SpaceKey, params.Limit)
+		record(ProductConfluence, found, err)
+	}
+	if products[ProductOpsgenie] {
+		found, err := opsgenieActivity(ctx, opsgenieClient, since, params.Limit)
+		record(ProductOpsgenie, found, err)
+	}
+
+	if len(failures) > 0 && len(failures) == countTrue(products) {
+		return nil, fmt.Errorf("failed to read activity: %v", failures)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Time.Before(items[j].Time)
+	})
+
+	next := cursor
+	feed := make([]activityItem, 0, len(items))
+	for _, item := range items {
+		if (!until.IsZero() && item.Time.After(until)) || next.Read(item.id(), item.Time) {
+			continue
+		}
+		next.Advance(item.id(), item.Time)
+		feed = append(feed, item)
+	}
+
+	// The cursor only advances when every product was read, so the changes
+	// of a failed product are returned by the next call
+	if len(failures) > 0 {
+		next = cursor
+	} else if err := state.Put(store.BucketCursors, cursorKey, next); err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{
+		"since":  since.Format(time.RFC3339Nano),
+		"cursor": next.Time.Format(time.RFC3339Nano),
+		"count":  len(feed),
+		"items":  feed,
+	}
+	if len(failures) > 0 {
+		result["errors"] = failures
+	}
+	return mcp.NewJSONResult(result)
+}
+
+// jiraActivity reads the issues updated in the last window minutes
+func jiraActivity(ctx context.Context, client *jira.Client, window int, projectKey string, limit int) ([]activityItem, error) {
+	jql := fmt.Sprintf("updated >= -%dm ORDER BY updated ASC", window)
+	if projectKey != "" {
+		jql = fmt.Sprintf("project = %s AND %s", jira.QuoteJQL(projectKey), jql)
+	}
+
+	result, err := client.SearchIssues(ctx, jql, &jira.SearchOptions{
+		Fields:     []string{"summary", "status", "issuetype", "updated"},
+		MaxResults: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]activityItem, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		item := activityItem{
+			Time:    issue.Fields.Updated.UTC(),
+			Product: ProductJira,
+			Type:    "issue",
+			Key:     issue.Key,
+			Title:   issue.Fields.Summary,
+		}
+		if issue.Fields.IssueType != nil {
+			item.Type = issue.Fields.IssueType.Name
+		}
+		if issue.Fields.Status != nil {
+			item.Status = issue.Fields.Status.Name
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// confluenceActivity reads the pages and blog posts modified in the last
+// window minutes
+func confluenceActivity(ctx context.Context, client *confluence.Client, window int, spaceKey string, limit int) ([]activityItem, error) {
+	cql := fmt.Sprintf(`type in (page, blogpost) AND lastmodified >= now("-%dm") ORDER BY lastmodified ASC`, window)
+	if spaceKey != "" {
+		cql = fmt.Sprintf("space = %s AND %s", confluence.QuoteCQL(spaceKey), cql)
+	}
+
+	result, err := client.SearchCQL(ctx, cql, &confluence.SearchOptions{
+		Expand: []string{"version", "space"},
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]activityItem, 0, len(result.Results))
+	for _, content := range result.Results {
+		item := activityItem{
+			Product: ProductConfluence,
+			Type:    string(content.Type),
+			Key:     content.ID,
+			Title:   content.Title,
+			Status:  string(content.Status),
+		}
+		if content.Version != nil {
+			if t, err := time.Parse(time.RFC3339, content.Version.When); err == nil {
+				item.Time = t.UTC()
+			}
+			if content.Version.By != nil {
+				item.Actor = content.Version.By.DisplayName
+			}
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// opsgenieActivity reads the alerts updated since the cursor
+func opsgenieActivity(ctx context.Context, client *opsgenie.Client, since time.Time, limit int) ([]activityItem, error) {
+	result, err := client.ListAlertsSorted(ctx, fmt.Sprintf("updatedAt >= %d", since.UnixMilli()), "updatedAt", "asc", limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]activityItem, 0, len(result.Data))
+	for _, alert := range result.Data {
+		updated := alert.CreatedAt
+		if alert.UpdatedAt != nil {
+			updated = *alert.UpdatedAt
+		}
+		items = append(items, activityItem{
+			Time:    updated.UTC(),
+			Product: ProductOpsgenie,
+			Type:    "alert",
+			Key:     alert.TinyID,
+			Title:   alert.Message,
+			Status:  string(alert.Status),
+			Actor:   alert.Owner,
+		})
+	}
+	return items, nil
+}
+
+// countTrue returns the number of true values
+func countTrue(values map[string]bool) int {
+	count := 0
+	for _, value := range values {
+		if value {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,531 @@
+package atlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+	confluencetools "github.com/codeownersnet/atlas/internal/tools/confluence"
+	jiratools "github.com/codeownersnet/atlas/internal/tools/jira"
+	opsgenietools "github.com/codeownersnet/atlas/internal/tools/opsgenie"
+	"github.com/codeownersnet/atlas/pkg/atlassian/confluence"
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+	"github.com/codeownersnet/atlas/pkg/atlassian/opsgenie"
+)
+
+// defaultPriorityMap maps Opsgenie alert priorities to Jira priority names
+var defaultPriorityMap = map[string]string{
+	"P1": "Highest",
+	"P2": "High",
+	"P3": "Medium",
+	"P4": "Low",
+	"P5": "Lowest",
+}
+
+// AtlasAlertToIssueTool creates the atlas_alert_to_issue tool
+func AtlasAlertToIssueTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"atlas_alert_to_issue",
+		"Create a Jira issue from an Opsgenie alert. The alert's message becomes the summary, its priority is mapped to a Jira priority, its tags become labels, and its description and details become the issue description. A remote link to the alert is added to the issue and a note referencing the issue is added to the alert.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"alert_id":     mcp.NewStringProperty("Opsgenie alert ID"),
+				"project_key":  mcp.NewStringProperty("Jira project key (e.g., 'OPS')"),
+				"issue_type":   mcp.NewStringProperty("Jira issue type name").WithDefault("Bug"),
+				"summary":      mcp.NewStringProperty("Issue summary (defaults to the alert message)"),
+				"priority_map": mcp.NewStringProperty("Alert priority to Jira priority name mapping as JSON object, merged over the default (P1=Highest, P2=High, P3=Medium, P4=Low, P5=Lowest). Map a priority to \"\" to leave the issue priority unset (e.g., '{\"P1\": \"Blocker\", \"P5\": \"\"}')"),
+				"copy_tags":    mcp.NewBooleanProperty("Copy the alert's tags to the issue as labels").WithDefault(true),
+				"labels":       mcp.NewStringProperty("Additional comma-separated labels"),
+				"fields":       mcp.NewStringProperty("Additional Jira fields as JSON object (e.g., '{\"components\": [{\"name\": \"API\"}]}')"),
+				"add_note":     mcp.NewBooleanProperty("Add a note referencing the issue to the alert").WithDefault(true),
+			},
+			"alert_id", "project_key",
+		),
+		atlasAlertToIssueHandler,
+		"atlas", "write",
+	)
+}
+
+func atlasAlertToIssueHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		AlertID     string   `arg:"alert_id,trim" validate:"required"`
+		ProjectKey  string   `arg:"project_key,trim" validate:"required"`
+		IssueType   string   `arg:"issue_type,trim" default:"Bug"`
+		Summary     string   `arg:"summary"`
+		PriorityMap string   `arg:"priority_map"`
+		CopyTags    bool     `arg:"copy_tags" default:"true"`
+		Labels      []string `arg:"labels"`
+		Fields      string   `arg:"fields,trim"`
+		AddNote     bool     `arg:"add_note" default:"true"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	priorityMap, err := parsePriorityMap(params.PriorityMap)
+	if err != nil {
+		return nil, err
+	}
+
+	jiraClient := jiratools.GetJiraClient(ctx)
+	if jiraClient == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	opsgenieClient := opsgenietools.GetOpsgenieClient(ctx)
+	if opsgenieClient == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	alert, err := opsgenieClient.GetAlert(ctx, params.AlertID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert: %w", err)
+	}
+	alertURL := opsgenieClient.AlertURL(alert.ID)
+
+	summary := alert.Message
+	if params.Summary != "" {
+		summary = params.Summary
+	}
+
+	fields := map[string]interface{}{
+		"project": map[string]string{
+			"key": params.ProjectKey,
+		},
+		"issuetype": map[string]string{
+			"name": params.IssueType,
+		},
+		"summary":     summary,
+		"description": alertIssueDescription(alert, alertURL),
+	}
+
+	if name := priorityMap[string(alert.Priority)]; name != "" {
+		fields["priority"] = map[string]string{"name": name}
+	}
+
+	var tags []string
+	if params.CopyTags {
+		tags = alert.Tags
+	}
+	if labels := alertLabels(tags, params.Labels); len(labels) > 0 {
+		fields["labels"] = labels
+	}
+
+	if params.Fields != "" {
+		var additionalFields map[string]interface{}
+		if err := json.Unmarshal([]byte(params.Fields), &additionalFields); err != nil {
+			return nil, fmt.Errorf("invalid fields JSON: %w", err)
+		}
+		for k, v := range additionalFields {
+			fields[k] = v
+		}
+	}
+
+	issue, err := jiraClient.CreateIssue(ctx, fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+	issueURL := jiraClient.BrowseURL(issue.Key)
+
+	// The issue exists at this point, so failures to cross-reference are
+	// reported as warnings rather than failing the call
+	var warnings []string
+
+	remoteLink := &jira.RemoteLink{
+		GlobalID: "opsgenie-alert=" + alert.ID,
+		Application: &jira.LinkApplication{
+			Type: "com.opsgenie",
+			Name: "Opsgenie",
+		},
+		Relationship: "created from",
+		Object: &jira.LinkObject{
+			URL:     alertURL,
+			Title:   alertTitle(alert),
+			Summary: string(alert.Priority),
+			Status: &jira.LinkStatus{
+				Resolved: alert.Status == opsgenie.AlertStatusClosed,
+			},
+		},
+	}
+	if _, err := jiraClient.CreateRemoteLink(ctx, issue.Key, remoteLink); err != nil {
+		warnings = append(warnings, fmt.Sprintf("failed to link alert to issue: %v", err))
+	}
+
+	if params.AddNote {
+		note := fmt.Sprintf("Jira issue %s created from this alert: %s", issue.Key, issueURL)
+		if _, err := opsgenieClient.AddNoteToAlert(ctx, alert.ID, note); err != nil {
+			warnings = append(warnings, fmt.Sprintf("failed to add note to alert: %v", err))
+		}
+	}
+
+	result := map[string]interface{}{
+		"key":       issue.Key,
+		"id":        issue.ID,
+		"issue_url": issueURL,
+		"alert_id":  alert.ID,
+		"alert_url": alertURL,
+		"message":   fmt.Sprintf("Successfully created issue %s from alert %s", issue.Key, alertTitle(alert)),
+	}
+	if len(warnings) > 0 {
+		result["warnings"] = warnings
+	}
+
+	return mcp.NewJSONResult(result)
+}
+
+// AtlasLinkIssueToPageTool creates the atlas_link_issue_to_page tool
+func AtlasLinkIssueToPageTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"atlas_link_issue_to_page",
+		"Link a Jira issue and a Confluence page in both directions: a remote link to the page is added to the issue, and a Jira issue macro (or a plain link) is appended to the page body. Running it again does not duplicate either side.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"issue_key":    mcp.NewStringProperty("Jira issue key (e.g., 'PROJ-123') or issue URL"),
+				"page_id":      mcp.NewStringProperty("Confluence page ID"),
+				"page_format":  mcp.NewEnumProperty("How the issue is referenced in the page: 'macro' renders a Jira issue macro (requires an application link between Jira and Confluence), 'link' adds a plain link", "macro", "link").WithDefault("macro"),
+				"relationship": mcp.NewStringProperty("Relationship shown on the issue's remote link").WithDefault("mentioned in"),
+			},
+			"issue_key", "page_id",
+		),
+		atlasLinkIssueToPageHandler,
+		"atlas", "write",
+	)
+}
+
+func atlasLinkIssueToPageHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		IssueKey     string `arg:"issue_key,trim" validate:"required"`
+		PageID       string `arg:"page_id,trim" validate:"required"`
+		PageFormat   string `arg:"page_format,trim" default:"macro" validate:"oneof=macro link"`
+		Relationship string `arg:"relationship,trim" default:"mentioned in"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+	issueKey := jira.ParseIssueKey(params.IssueKey)
+	if issueKey == "" {
+		return nil, fmt.Errorf("issue_key is required")
+	}
+
+	jiraClient := jiratools.GetJiraClient(ctx)
+	if jiraClient == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	confluenceClient := confluencetools.GetConfluenceClient(ctx)
+	if confluenceClient == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	issue, err := jiraClient.GetIssue(ctx, issueKey, &jira.GetIssueOptions{Fields: []string{"summary"}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+	issueURL := jiraClient.BrowseURL(issue.Key)
+
+	page, err := confluenceClient.GetPage(ctx, params.PageID, []string{"body.storage", "version"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page: %w", err)
+	}
+	pageURL := confluenceClient.PageURL(page)
+
+	// Link the page from the issue. The global ID makes Jira update an
+	// existing link to the same page instead of adding a duplicate.
+	remoteLink := &jira.RemoteLink{
+		GlobalID: "confluence-page=" + page.ID,
+		Application: &jira.LinkApplication{
+			Type: "com.atlassian.confluence",
+			Name: "Confluence",
+		},
+		Relationship: params.Relationship,
+		Object: &jira.LinkObject{
+			URL:   pageURL,
+			Title: page.Title,
+		},
+	}
+	link, err := jiraClient.CreateRemoteLink(ctx, issue.Key, remoteLink)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link page to issue: %w", err)
+	}
+
+	// Reference the issue from the page, unless it already is
+	var body string
+	if page.Body != nil && page.Body.Storage != nil {
+		body = page.Body.Storage.Value
+	}
+
+	pageUpdated := false
+	if !pageReferencesIssue(body, issue.Key, issueURL) {
+		reference := confluence.JiraIssueMacro(issue.Key)
+		if params.PageFormat == "link" {
+			reference = fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(issueURL), html.EscapeString(issue.Key))
+			if issue.Fields.Summary != "" {
+				reference += " " + html.EscapeString(issue.Fields.Summary)
+			}
+		}
+
+		version := 1
+		if page.Version != nil {
+			version = page.Version.Number
+		}
+
+		if _, err := confluenceClient.UpdatePage(ctx, page.ID, page.Title, body+"<p>"+reference+"</p>", version+1); err != nil {
+			return nil, fmt.Errorf("linked page to issue %s, but failed to update page: %w", issue.Key, err)
+		}
+		pageUpdated = true
+	}
+
+	message := fmt.Sprintf("Successfully linked issue %s and page '%s'", issue.Key, page.Title)
+	if !pageUpdated {
+		message += " (page already referenced the issue)"
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"issue_key":      issue.Key,
+		"issue_url":      issueURL,
+		"page_id":        page.ID,
+		"page_url":       pageURL,
+		"remote_link_id": link.ID,
+		"page_updated":   pageUpdated,
+		"message":        message,
+	})
+}
+
+// pageReferencesIssue reports whether a page body already contains a Jira
+// issue macro or a link for the issue
+func pageReferencesIssue(storage, issueKey, issueURL string) bool {
+	return confluence.HasJiraIssueMacro(storage, issueKey) ||
+		strings.Contains(storage, `href="`+html.EscapeString(issueURL)+`"`)
+}
+
+// AtlasGeneratePostmortemTool creates the atlas_generate_postmortem tool
+func AtlasGeneratePostmortemTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"atlas_generate_postmortem",
+		"Generate a draft postmortem Confluence page for an Opsgenie incident. Gathers the incident summary, a timeline built from the activity logs of the incident's alerts, and related Jira issues (labeled with the incident's tags), then creates the page. With a template, the template's variables (incident_id, incident_title, priority, status, opened, impacted_services, tags, description) are filled in and the timeline and issues are appended; without one, a standard postmortem layout is used.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"incident_id": mcp.NewStringProperty("Opsgenie incident ID"),
+				"space_key":   mcp.NewStringProperty("Confluence space key where the page will be created"),
+				"title":       mcp.NewStringProperty("Page title (defaults to 'Postmortem: <incident message>')"),
+				"parent_id":   mcp.NewStringProperty("Parent page ID (optional)"),
+				"template_id": mcp.NewStringProperty("Confluence page template ID (optional, see confluence_get_templates)"),
+				"project_key": mcp.NewStringProperty("Restrict related Jira issues to this project (optional)"),
+				"jql":         mcp.NewStringProperty("JQL query for related Jira issues, replacing the default tag/label match (optional)"),
+				"max_alerts":  mcp.NewIntegerProperty("Maximum number of incident alerts to include in the timeline").WithDefault(10),
+				"max_issues":  mcp.NewIntegerProperty("Maximum number of related Jira issues").WithDefault(20),
+			},
+			"incident_id", "space_key",
+		),
+		atlasGeneratePostmortemHandler,
+		"atlas", "write",
+	)
+}
+
+func atlasGeneratePostmortemHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		IncidentID string `arg:"incident_id,trim" validate:"required"`
+		SpaceKey   string `arg:"space_key,trim" validate:"required"`
+		Title      string `arg:"title"`
+		ParentID   string `arg:"parent_id,trim"`
+		TemplateID string `arg:"template_id,trim"`
+		ProjectKey string `arg:"project_key,trim"`
+		JQL        string `arg:"jql"`
+		MaxAlerts  int    `arg:"max_alerts" default:"10"`
+		MaxIssues  int    `arg:"max_issues" default:"20"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	jiraClient := jiratools.GetJiraClient(ctx)
+	if jiraClient == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	confluenceClient := confluencetools.GetConfluenceClient(ctx)
+	if confluenceClient == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	opsgenieClient := opsgenietools.GetOpsgenieClient(ctx)
+	if opsgenieClient == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	incident, err := opsgenieClient.GetIncident(ctx, params.IncidentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incident: %w", err)
+	}
+
+	alerts, timeline, err := gatherAlertTimeline(ctx, opsgenieClient, incident, params.MaxAlerts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to gather incident timeline: %w", err)
+	}
+
+	jql := params.JQL
+	if jql == "" {
+		jql = relatedIssuesJQL(incident.Tags, params.ProjectKey)
+	}
+
+	var issues []jira.Issue
+	if jql != "" {
+		result, err := jiraClient.SearchIssues(ctx, jql, &jira.SearchOptions{
+			Fields:     []string{"summary", "status"},
+			MaxResults: params.MaxIssues,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to search related issues: %w", err)
+		}
+		issues = result.Issues
+	}
+
+	data := &postmortemData{
+		Incident: incident,
+		Timeline: timeline,
+		Issues:   issues,
+		IssueURL: jiraClient.BrowseURL,
+	}
+
+	title := "Postmortem: " + incident.Message
+	if params.Title != "" {
+		title = params.Title
+	}
+
+	var body string
+	var missing []string
+	if params.TemplateID != "" {
+		template, err := confluenceClient.GetContentTemplate(ctx, params.TemplateID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get template: %w", err)
+		}
+		if template.Body == nil || template.Body.Storage == nil {
+			return nil, fmt.Errorf("template %s has no storage body", params.TemplateID)
+		}
+		body, missing = confluence.ApplyTemplateVariables(template.Body.Storage.Value, postmortemVariables(incident))
+		body += confluence.MarkdownToStorage(postmortemMarkdown(data, false))
+	} else {
+		body = confluence.MarkdownToStorage(postmortemMarkdown(data, true))
+	}
+
+	page, err := confluenceClient.CreatePage(ctx, params.SpaceKey, title, body, params.ParentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create postmortem page: %w", err)
+	}
+
+	result := map[string]interface{}{
+		"id":             page.ID,
+		"title":          page.Title,
+		"page_url":       confluenceClient.PageURL(page),
+		"incident_id":    incident.ID,
+		"alert_count":    len(alerts),
+		"timeline_count": len(timeline),
+		"issue_count":    len(issues),
+		"message":        fmt.Sprintf("Successfully created postmortem page '%s' for incident #%s", page.Title, incident.TinyID),
+	}
+	if jql != "" {
+		result["jql"] = jql
+	}
+	if len(missing) > 0 {
+		result["missing_variables"] = missing
+	}
+
+	return mcp.NewJSONResult(result)
+}
+
+// parsePriorityMap merges the priority_map argument over the default mapping
+func parsePriorityMap(mapJSON string) (map[string]string, error) {
+	priorityMap := make(map[string]string, len(defaultPriorityMap))
+	for k, v := range defaultPriorityMap {
+		priorityMap[k] = v
+	}
+
+	if mapJSON == "" {
+		return priorityMap, nil
+	}
+
+	var overrides map[string]string
+	if err := json.Unmarshal([]byte(mapJSON), &overrides); err != nil {
+		return nil, fmt.Errorf("invalid priority_map JSON: %w", err)
+	}
+	for k, v := range overrides {
+		priorityMap[strings.ToUpper(k)] = v
+	}
+
+	return priorityMap, nil
+}
+
+// alertLabels converts alert tags and extra labels into Jira labels. Jira
+// labels cannot contain spaces, so whitespace is replaced with dashes.
+func alertLabels(tags, extra []string) []string {
+	seen := make(map[string]bool)
+	var labels []string
+	for _, value := range append(append([]string{}, tags...), extra...) {
+		label := strings.Join(strings.Fields(value), "-")
+		if label == "" || seen[label] {
+			continue
+		}
+		seen[label] = true
+		labels = append(labels, label)
+	}
+	return labels
+}
+
+// alertTitle returns a short human-readable reference to an alert
+func alertTitle(alert *opsgenie.Alert) string {
+	if alert.TinyID != "" {
+		return fmt.Sprintf("#%s: %s", alert.TinyID, alert.Message)
+	}
+	return alert.Message
+}
+
+// alertIssueDescription builds a Markdown issue description from an alert
+func alertIssueDescription(alert *opsgenie.Alert, alertURL string) string {
+	var b strings.Builder
+
+	if alert.Description != "" {
+		b.WriteString(alert.Description)
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString("## Opsgenie alert\n\n")
+	fmt.Fprintf(&b, "- **Alert**: [%s](%s)\n", alertTitle(alert), alertURL)
+	if alert.Priority != "" {
+		fmt.Fprintf(&b, "- **Priority**: %s\n", alert.Priority)
+	}
+	if alert.Status != "" {
+		fmt.Fprintf(&b, "- **Status**: %s\n", alert.Status)
+	}
+	if alert.Source != "" {
+		fmt.Fprintf(&b, "- **Source**: %s\n", alert.Source)
+	}
+	if !alert.CreatedAt.IsZero() {
+		fmt.Fprintf(&b, "- **Created**: %s\n", alert.CreatedAt.UTC().Format(time.RFC3339))
+	}
+	if alert.Count > 1 {
+		fmt.Fprintf(&b, "- **Occurrences**: %d\n", alert.Count)
+	}
+	if len(alert.Tags) > 0 {
+		fmt.Fprintf(&b, "- **Tags**: %s\n", strings.Join(alert.Tags, ", "))
+	}
+
+	if len(alert.Details) > 0 {
+		keys := make([]string, 0, len(alert.Details))
+		for k := range alert.Details {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b.WriteString("\n### Details\n\n| Key | Value |\n| --- | --- |\n")
+		for _, k := range keys {
+			fmt.Fprintf(&b, "| %s | %s |\n", k, alert.Details[k])
+		}
+	}
+
+	return b.String()
+}
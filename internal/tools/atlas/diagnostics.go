@@ -0,0 +1,92 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/codeownersnet/atlas/internal/client"
+	"github.com/codeownersnet/atlas/internal/mcp"
+)
+
+type contextKey string
+
+const captureKey contextKey = "debug_capture"
+
+// WithCapture adds the HTTP debug capture to the context
+func WithCapture(ctx context.Context, capture *client.Capture) context.Context {
+	return context.WithValue(ctx, captureKey, capture)
+}
+
+// GetCapture retrieves the HTTP debug capture from the context
+func GetCapture(ctx context.Context) *client.Capture {
+	capture, ok := ctx.Value(captureKey).(*client.Capture)
+	if !ok {
+		return nil
+	}
+	return capture
+}
+
+// RegisterDiagnosticTools registers the tools for inspecting the server's
+// debug capture. They are only registered when debug capture is enabled.
+func RegisterDiagnosticTools(server *mcp.Server) error {
+	if err := server.RegisterTool(AtlasDebugCapturesTool()); err != nil {
+		return fmt.Errorf("failed to register atlas_debug_captures: %w", err)
+	}
+	return nil
+}
+
+// AtlasDebugCapturesTool creates the atlas_debug_captures tool
+func AtlasDebugCapturesTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"atlas_debug_captures",
+		"List recent HTTP requests to Jira, Confluence, and Opsgenie with their responses, captured for debugging API incompatibilities (e.g. with a Server/Data Center version). Credentials and secret headers are redacted. Only available when debug capture is enabled.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"limit":       mcp.NewIntegerProperty("Maximum number of exchanges to return, most recent last").WithDefault(10),
+				"errors_only": mcp.NewBooleanProperty("Only return exchanges that failed or got an HTTP error status").WithDefault(false),
+				"url_filter":  mcp.NewStringProperty("Only return exchanges whose URL contains this text (e.g. '/rest/api/2/issue')"),
+			},
+		),
+		atlasDebugCapturesHandler,
+		"atlas", "read",
+	)
+}
+
+func atlasDebugCapturesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ErrorsOnly bool   `arg:"errors_only"`
+		URLFilter  string `arg:"url_filter,trim"`
+		Limit      int    `arg:"limit" default:"10"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	capture := GetCapture(ctx)
+	if capture == nil {
+		return nil, fmt.Errorf("debug capture is not enabled (set DEBUG_CAPTURE=true)")
+	}
+
+	captured := capture.Exchanges()
+	exchanges := make([]client.Exchange, 0, len(captured))
+	for _, ex := range captured {
+		if params.ErrorsOnly && ex.Error == "" && ex.StatusCode < 400 {
+			continue
+		}
+		if params.URLFilter != "" && !strings.Contains(ex.URL, params.URLFilter) {
+			continue
+		}
+		exchanges = append(exchanges, ex)
+	}
+
+	if params.Limit > 0 && len(exchanges) > params.Limit {
+		exchanges = exchanges[len(exchanges)-params.Limit:]
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"captured":  len(captured),
+		"count":     len(exchanges),
+		"exchanges": exchanges,
+	})
+}
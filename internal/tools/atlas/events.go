@@ -0,0 +1,92 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/webhook"
+)
+
+const eventsKey contextKey = "webhook_events"
+
+// WithEvents adds the webhook receiver to the context
+func WithEvents(ctx context.Context, receiver *webhook.Receiver) context.Context {
+	return context.WithValue(ctx, eventsKey, receiver)
+}
+
+// GetEvents retrieves the webhook receiver from the context
+func GetEvents(ctx context.Context) *webhook.Receiver {
+	receiver, ok := ctx.Value(eventsKey).(*webhook.Receiver)
+	if !ok {
+		return nil
+	}
+	return receiver
+}
+
+// RegisterEventTools registers the tools for reading webhook events. They are
+// only registered when the webhook receiver is enabled.
+func RegisterEventTools(server *mcp.Server) error {
+	if err := server.RegisterTool(AtlasGetRecentEventsTool()); err != nil {
+		return fmt.Errorf("failed to register atlas_get_recent_events: %w", err)
+	}
+	return nil
+}
+
+// AtlasGetRecentEventsTool creates the atlas_get_recent_events tool
+func AtlasGetRecentEventsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"atlas_get_recent_events",
+		"Get recent Jira, Confluence, and Opsgenie events received by webhook (issue updates, page edits, alert changes), oldest first. Poll for new events by passing the last_id of the previous call as after. Only available when the webhook receiver is enabled.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"after":           mcp.NewIntegerProperty("Only return events with an ID greater than this (the last_id of a previous call)").WithDefault(0),
+				"product":         mcp.NewEnumProperty("Only return events of this product", webhook.ProductJira, webhook.ProductConfluence, webhook.ProductOpsgenie),
+				"type":            mcp.NewStringProperty("Only return events whose type contains this text (e.g. 'issue_updated', 'page_created', 'alert_close')"),
+				"key":             mcp.NewStringProperty("Only return events about this issue key, page ID, or alert tiny ID"),
+				"limit":           mcp.NewIntegerProperty("Maximum number of events to return, most recent kept").WithDefault(20),
+				"include_payload": mcp.NewBooleanProperty("Include the raw webhook payload of each event").WithDefault(false),
+			},
+		),
+		atlasGetRecentEventsHandler,
+		"atlas", "read",
+	)
+}
+
+func atlasGetRecentEventsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Product        string `arg:"product,trim"`
+		Type           string `arg:"type,trim"`
+		Key            string `arg:"key,trim"`
+		IncludePayload bool   `arg:"include_payload"`
+		After          int    `arg:"after"`
+		Limit          int    `arg:"limit" default:"20"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	receiver := GetEvents(ctx)
+	if receiver == nil {
+		return nil, fmt.Errorf("webhook receiver is not enabled (set WEBHOOK_ENABLED=true)")
+	}
+
+	events := receiver.Events(webhook.Filter{
+		After:   int64(params.After),
+		Product: params.Product,
+		Type:    params.Type,
+		Key:     params.Key,
+		Limit:   params.Limit,
+	})
+	if !params.IncludePayload {
+		for i := range events {
+			events[i].Payload = nil
+		}
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"count":   len(events),
+		"events":  events,
+		"last_id": receiver.LastID(),
+	})
+}
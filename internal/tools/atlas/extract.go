@@ -0,0 +1,199 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/batch"
+	confluencetools "github.com/codeownersnet/atlas/internal/tools/confluence"
+	jiratools "github.com/codeownersnet/atlas/internal/tools/jira"
+	"github.com/codeownersnet/atlas/pkg/atlassian/confluence"
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+)
+
+// maxSummaryLength is the longest summary Jira accepts
+const maxSummaryLength = 255
+
+// AtlasCreateIssuesFromPageTool creates the atlas_create_issues_from_page tool
+func AtlasCreateIssuesFromPageTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"atlas_create_issues_from_page",
+		"Create Jira issues from the action items of a Confluence page (meeting notes, specs). Items are the page's open task list items, or the lines matching a pattern (by default lines like 'TODO: ...' or 'Action: ...'). Each issue gets a remote link back to the page, and the result maps every source line to the issue created for it. Use dry_run to review the items first.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"page_id":           mcp.NewStringProperty("Confluence page ID"),
+				"project_key":       mcp.NewStringProperty("Jira project key the issues are created in (e.g., 'PROJ')"),
+				"issue_type":        mcp.NewStringProperty("Jira issue type name").WithDefault("Task"),
+				"source":            mcp.NewEnumProperty("Where items come from: 'tasks' for task list items, 'pattern' for lines matching the pattern", "tasks", "pattern").WithDefault("tasks"),
+				"pattern":           mcp.NewStringProperty("Regular expression matched against each line of the page for source 'pattern'; its first capture group, if any, is the summary. Defaults to lines starting with TODO, Action, Action item, or AI."),
+				"include_completed": mcp.NewBooleanProperty("Also create issues for completed tasks").WithDefault(false),
+				"labels":            mcp.NewStringProperty("Comma-separated labels added to every issue"),
+				"max_issues":        mcp.NewIntegerProperty("Maximum number of issues to create; further items are skipped").WithDefault(20),
+				"dry_run":           mcp.NewBooleanProperty("Only return the items found, without creating issues").WithDefault(false),
+				"concurrency":       mcp.NewIntegerProperty("Number of issues created at once (defaults to the server's BATCH_CONCURRENCY, max 16)"),
+			},
+			"page_id", "project_key",
+		),
+		atlasCreateIssuesFromPageHandler,
+		"atlas", "write",
+	)
+}
+
+func atlasCreateIssuesFromPageHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		PageID           string   `arg:"page_id,trim" validate:"required"`
+		ProjectKey       string   `arg:"project_key,trim" validate:"required"`
+		IssueType        string   `arg:"issue_type,trim" default:"Task"`
+		Source           string   `arg:"source,trim" default:"tasks" validate:"oneof=tasks pattern"`
+		Pattern          string   `arg:"pattern"`
+		IncludeCompleted bool     `arg:"include_completed"`
+		Labels           []string `arg:"labels"`
+		MaxIssues        int      `arg:"max_issues" default:"20"`
+		DryRun           bool     `arg:"dry_run"`
+		Concurrency      int      `arg:"concurrency"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	pattern := confluence.DefaultActionItemPattern
+	if params.Pattern != "" {
+		compiled, err := regexp.Compile(params.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+		pattern = compiled
+	}
+	labels := alertLabels(nil, params.Labels)
+
+	jiraClient := jiratools.GetJiraClient(ctx)
+	if jiraClient == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	confluenceClient := confluencetools.GetConfluenceClient(ctx)
+	if confluenceClient == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	page, err := confluenceClient.GetPage(ctx, params.PageID, []string{"body.storage"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page: %w", err)
+	}
+	pageURL := confluenceClient.PageURL(page)
+
+	var storage string
+	if page.Body != nil && page.Body.Storage != nil {
+		storage = page.Body.Storage.Value
+	}
+
+	var items []confluence.ExtractedItem
+	if params.Source == "tasks" {
+		items, err = confluence.ExtractTasks(storage, params.IncludeCompleted)
+	} else {
+		items, err = confluence.ExtractPattern(storage, pattern)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read page: %w", err)
+	}
+
+	var skipped []confluence.ExtractedItem
+	if params.MaxIssues > 0 && len(items) > params.MaxIssues {
+		items, skipped = items[:params.MaxIssues], items[params.MaxIssues:]
+	}
+
+	if params.DryRun || len(items) == 0 {
+		result := map[string]interface{}{
+			"page_id":  page.ID,
+			"page_url": pageURL,
+			"items":    items,
+			"count":    len(items),
+			"message":  fmt.Sprintf("Found %d items in page '%s'", len(items), page.Title),
+		}
+		if len(skipped) > 0 {
+			result["skipped"] = skipped
+		}
+		return mcp.NewJSONResult(result)
+	}
+
+	// Jira's bulk create endpoint rejects the whole batch on the first invalid
+	// issue, so issues are created concurrently one by one
+	results := batch.Run(ctx, items, batch.Concurrency(ctx, params.Concurrency), func(ctx context.Context, item confluence.ExtractedItem) (interface{}, error) {
+		fields := map[string]interface{}{
+			"project":     map[string]string{"key": params.ProjectKey},
+			"issuetype":   map[string]string{"name": params.IssueType},
+			"summary":     truncateSummary(item.Summary),
+			"description": fmt.Sprintf("From Confluence page [%s](%s), line %d:\n\n> %s", page.Title, pageURL, item.Line, item.Source),
+		}
+		if len(labels) > 0 {
+			fields["labels"] = labels
+		}
+
+		issue, err := jiraClient.CreateIssue(ctx, fields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create issue for line %d: %w", item.Line, err)
+		}
+
+		created := map[string]interface{}{
+			"line":      item.Line,
+			"source":    item.Source,
+			"key":       issue.Key,
+			"issue_url": jiraClient.BrowseURL(issue.Key),
+		}
+
+		remoteLink := &jira.RemoteLink{
+			GlobalID: "confluence-page=" + page.ID,
+			Application: &jira.LinkApplication{
+				Type: "com.atlassian.confluence",
+				Name: "Confluence",
+			},
+			Relationship: "created from",
+			Object: &jira.LinkObject{
+				URL:   pageURL,
+				Title: page.Title,
+			},
+		}
+		if _, err := jiraClient.CreateRemoteLink(ctx, issue.Key, remoteLink); err != nil {
+			created["warning"] = fmt.Sprintf("failed to link page to issue: %v", err)
+		}
+
+		return created, nil
+	})
+
+	created := make([]interface{}, 0, results.Succeeded)
+	var errors []string
+	for _, item := range results.Items {
+		if item.Status == batch.StatusOK {
+			created = append(created, item.Result)
+		} else {
+			errors = append(errors, item.Error)
+		}
+	}
+
+	result := map[string]interface{}{
+		"page_id":   page.ID,
+		"page_url":  pageURL,
+		"created":   created,
+		"succeeded": results.Succeeded,
+		"failed":    results.Failed,
+		"message":   fmt.Sprintf("Created %d issues from page '%s'", results.Succeeded, page.Title),
+	}
+	if len(errors) > 0 {
+		result["errors"] = errors
+	}
+	if len(skipped) > 0 {
+		result["skipped"] = skipped
+	}
+	return mcp.NewJSONResult(result)
+}
+
+// truncateSummary shortens a summary to the length Jira accepts
+func truncateSummary(summary string) string {
+	runes := []rune(summary)
+	if len(runes) <= maxSummaryLength {
+		return summary
+	}
+	return string(runes[:maxSummaryLength-1]) + "…"
+}
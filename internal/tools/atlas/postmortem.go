@@ -0,0 +1,175 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+	"github.com/codeownersnet/atlas/pkg/atlassian/opsgenie"
+)
+
+// postmortemTimeLayout is the time format used in postmortem timelines
+const postmortemTimeLayout = "2006-01-02 15:04:05 MST"
+
+// timelineEntry is a single event in a postmortem timeline
+type timelineEntry struct {
+	Time  time.Time `json:"time"`
+	Alert string    `json:"alert,omitempty"`
+	Event string    `json:"event"`
+	Owner string    `json:"owner,omitempty"`
+}
+
+// postmortemData holds everything gathered for a postmortem
+type postmortemData struct {
+	Incident *opsgenie.Incident
+	Timeline []timelineEntry
+	Issues   []jira.Issue
+	IssueURL func(key string) string
+}
+
+// gatherAlertTimeline collects the activity logs of the incident's alerts,
+// oldest first. At most maxAlerts alerts are read.
+func gatherAlertTimeline(ctx context.Context, client *opsgenie.Client, incident *opsgenie.Incident, maxAlerts int) ([]*opsgenie.Alert, []timelineEntry, error) {
+	timeline := []timelineEntry{{
+		Time:  incident.CreatedAt.Time,
+		Event: fmt.Sprintf("Incident #%s opened: %s", incident.TinyID, incident.Message),
+	}}
+
+	alertIDs, err := client.GetIncidentAlertIDs(ctx, incident.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(alertIDs) > maxAlerts {
+		alertIDs = alertIDs[:maxAlerts]
+	}
+
+	alerts := make([]*opsgenie.Alert, 0, len(alertIDs))
+	for _, id := range alertIDs {
+		alert, err := client.GetAlert(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		alerts = append(alerts, alert)
+
+		logs, err := client.ListAlertLogs(ctx, id, &opsgenie.AlertActivityOptions{Order: "asc", Limit: 100})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, entry := range logs.Data {
+			timeline = append(timeline, timelineEntry{
+				Time:  entry.CreatedAt.Time,
+				Alert: alertTitle(alert),
+				Event: entry.Log,
+				Owner: entry.Owner,
+			})
+		}
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Time.Before(timeline[j].Time)
+	})
+
+	return alerts, timeline, nil
+}
+
+// relatedIssuesJQL builds the JQL query matching issues labeled with any of
+// the tags, optionally restricted to a project
+func relatedIssuesJQL(tags []string, projectKey string) string {
+	labels := alertLabels(tags, nil)
+	if len(labels) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, len(labels))
+	for i, label := range labels {
+		quoted[i] = jira.QuoteJQL(label)
+	}
+
+	jql := fmt.Sprintf("labels in (%s)", strings.Join(quoted, ", "))
+	if projectKey != "" {
+		jql = fmt.Sprintf("project = %s AND %s", jira.QuoteJQL(projectKey), jql)
+	}
+	return jql + " ORDER BY created DESC"
+}
+
+// postmortemMarkdown renders the generated postmortem sections as Markdown.
+// With placeholders, empty root cause, impact, and action item sections are
+// added for the authors to complete.
+func postmortemMarkdown(data *postmortemData, placeholders bool) string {
+	incident := data.Incident
+	var b strings.Builder
+
+	b.WriteString("## Summary\n\n")
+	fmt.Fprintf(&b, "- **Incident**: #%s %s\n", incident.TinyID, incident.Message)
+	fmt.Fprintf(&b, "- **Priority**: %s\n", incident.Priority)
+	fmt.Fprintf(&b, "- **Status**: %s\n", incident.Status)
+	fmt.Fprintf(&b, "- **Opened**: %s\n", incident.CreatedAt.UTC().Format(postmortemTimeLayout))
+	if len(incident.ImpactedServices) > 0 {
+		fmt.Fprintf(&b, "- **Impacted services**: %s\n", strings.Join(incident.ImpactedServices, ", "))
+	}
+	if len(incident.Tags) > 0 {
+		fmt.Fprintf(&b, "- **Tags**: %s\n", strings.Join(incident.Tags, ", "))
+	}
+	if incident.Description != "" {
+		b.WriteString("\n")
+		b.WriteString(incident.Description)
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n## Timeline\n\n")
+	b.WriteString("| Time (UTC) | Alert | Event |\n| --- | --- | --- |\n")
+	for _, entry := range data.Timeline {
+		event := entry.Event
+		if entry.Owner != "" && entry.Owner != "System" {
+			event = fmt.Sprintf("%s (%s)", event, entry.Owner)
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s |\n",
+			entry.Time.UTC().Format(postmortemTimeLayout), tableCell(entry.Alert), tableCell(event))
+	}
+
+	b.WriteString("\n## Related Jira issues\n\n")
+	if len(data.Issues) == 0 {
+		b.WriteString("No related issues found.\n")
+	} else {
+		b.WriteString("| Issue | Summary | Status |\n| --- | --- | --- |\n")
+		for _, issue := range data.Issues {
+			status := ""
+			if issue.Fields.Status != nil {
+				status = issue.Fields.Status.Name
+			}
+			fmt.Fprintf(&b, "| [%s](%s) | %s | %s |\n",
+				issue.Key, data.IssueURL(issue.Key), tableCell(issue.Fields.Summary), status)
+		}
+	}
+
+	if placeholders {
+		b.WriteString("\n## Root cause\n\n_To be completed._\n")
+		b.WriteString("\n## Impact\n\n_To be completed._\n")
+		b.WriteString("\n## Action items\n\n_To be completed._\n")
+	}
+
+	return b.String()
+}
+
+// postmortemVariables returns the template variable values for an incident
+func postmortemVariables(incident *opsgenie.Incident) map[string]string {
+	return map[string]string{
+		"incident_id":       incident.TinyID,
+		"incident_title":    incident.Message,
+		"priority":          string(incident.Priority),
+		"status":            string(incident.Status),
+		"opened":            incident.CreatedAt.UTC().Format(postmortemTimeLayout),
+		"impacted_services": strings.Join(incident.ImpactedServices, ", "),
+		"tags":              strings.Join(incident.Tags, ", "),
+		"description":       incident.Description,
+	}
+}
+
+// tableCell escapes a value for use in a Markdown table cell
+func tableCell(value string) string {
+	value = strings.ReplaceAll(value, "|", `\|`)
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,63 @@
+package atlas
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/session"
+)
+
+// AtlasSetContextTool creates the atlas_set_context tool
+func AtlasSetContextTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"atlas_set_context",
+		"Set session defaults that tools fall back to when a required argument is omitted: the Jira project (project_key), the Jira board (board_id), and the Confluence space (space_key). Call it without arguments to see the current defaults. Pass an empty string to unset a default.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				session.JiraProject:     mcp.NewStringProperty("Default Jira project key (e.g., 'PROJ')"),
+				session.JiraBoard:       mcp.NewIntegerProperty("Default Jira board ID"),
+				session.ConfluenceSpace: mcp.NewStringProperty("Default Confluence space key (e.g., 'DOCS')"),
+				"clear":                 mcp.NewBooleanProperty("Unset all defaults before applying the other arguments").WithDefault(false),
+			},
+		),
+		atlasSetContextHandler,
+		"atlas", "read",
+	)
+}
+
+func atlasSetContextHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	defaults := session.GetDefaults(ctx)
+	if defaults == nil {
+		return nil, fmt.Errorf("session context not available")
+	}
+
+	var params struct {
+		Clear bool `arg:"clear"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Clear {
+		defaults.Clear()
+	}
+
+	for _, key := range session.Keys {
+		switch value := args[key].(type) {
+		case string:
+			defaults.Set(key, value)
+		case float64:
+			if value > 0 {
+				defaults.Set(key, strconv.Itoa(int(value)))
+			} else {
+				defaults.Set(key, "")
+			}
+		}
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"context": defaults.All(),
+	})
+}
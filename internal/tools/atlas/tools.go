@@ -0,0 +1,65 @@
+package atlas
+
+import (
+	"fmt"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/session"
+)
+
+// Products that cross-product tools can depend on
+const (
+	ProductJira       = "jira"
+	ProductConfluence = "confluence"
+	ProductOpsgenie   = "opsgenie"
+)
+
+// RegisterAtlasTools registers the cross-product tools whose products are all
+// configured and returns the number of tools registered
+func RegisterAtlasTools(server *mcp.Server, configured ...string) (int, error) {
+	tools := []struct {
+		name     string
+		tool     *mcp.ToolDefinition
+		requires []string
+	}{
+		// Read operations
+		{"atlas_set_context", AtlasSetContextTool(), nil},
+		{"atlas_get_recent_activity", AtlasGetRecentActivityTool(), nil},
+
+		// Write operations
+		{"atlas_alert_to_issue", AtlasAlertToIssueTool(), []string{ProductJira, ProductOpsgenie}},
+		{"atlas_link_issue_to_page", AtlasLinkIssueToPageTool(), []string{ProductJira, ProductConfluence}},
+		{"atlas_generate_postmortem", AtlasGeneratePostmortemTool(), []string{ProductJira, ProductConfluence, ProductOpsgenie}},
+		{"atlas_create_issues_from_page", AtlasCreateIssuesFromPageTool(), []string{ProductJira, ProductConfluence}},
+	}
+
+	available := make(map[string]bool, len(configured))
+	for _, product := range configured {
+		available[product] = true
+	}
+
+	count := 0
+	for _, t := range tools {
+		if !hasAll(available, t.requires) {
+			continue
+		}
+		session.WithDefaultArgs(t.tool, session.JiraArgs)
+		session.WithDefaultArgs(t.tool, session.ConfluenceArgs)
+		if err := server.RegisterTool(t.tool); err != nil {
+			return count, fmt.Errorf("failed to register %s: %w", t.name, err)
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// hasAll reports whether all required products are available
+func hasAll(available map[string]bool, required []string) bool {
+	for _, product := range required {
+		if !available[product] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+// Package batch runs the per-item calls of batch tools concurrently with
+// bounded parallelism and aggregates their results.
+package batch
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	// DefaultConcurrency is the number of items processed at once when no
+	// concurrency is configured
+	DefaultConcurrency = 4
+
+	// MaxConcurrency caps the concurrency of a batch
+	MaxConcurrency = 16
+)
+
+// Item status values
+const (
+	StatusOK    = "ok"
+	StatusError = "error"
+)
+
+type contextKey string
+
+const concurrencyKey contextKey = "batch_concurrency"
+
+// ItemResult is the outcome of a single batch item
+type ItemResult struct {
+	Index  int         `json:"index"`
+	Status string      `json:"status"` // StatusOK or StatusError
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// Result aggregates the outcomes of a batch in item order
+type Result struct {
+	Items     []ItemResult `json:"items"`
+	Succeeded int          `json:"succeeded"`
+	Failed    int          `json:"failed"`
+}
+
+// WithConcurrency sets the default batch concurrency in the context
+func WithConcurrency(ctx context.Context, concurrency int) context.Context {
+	return context.WithValue(ctx, concurrencyKey, concurrency)
+}
+
+// Concurrency returns the concurrency for a tool call: the requested
+// "concurrency" argument if positive, otherwise the context default, capped
+// at MaxConcurrency
+func Concurrency(ctx context.Context, requested int) int {
+	concurrency, ok := ctx.Value(concurrencyKey).(int)
+	if !ok || concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	if requested > 0 {
+		concurrency = requested
+	}
+	return min(concurrency, MaxConcurrency)
+}
+
+// Run calls fn for each item with at most concurrency calls in flight and
+// returns the results in item order. Items not started before the context is
+// cancelled fail with the context's error.
+func Run[T any](ctx context.Context, items []T, concurrency int, fn func(ctx context.Context, item T) (interface{}, error)) *Result {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	results := make([]ItemResult, len(items))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, item := range items {
+		if err := acquire(ctx, sem); err != nil {
+			results[i] = ItemResult{Index: i, Status: StatusError, Error: err.Error()}
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, item T) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			value, err := fn(ctx, item)
+			if err != nil {
+				results[i] = ItemResult{Index: i, Status: StatusError, Error: err.Error()}
+				return
+			}
+			results[i] = ItemResult{Index: i, Status: StatusOK, Result: value}
+		}(i, item)
+	}
+	wg.Wait()
+
+	result := &Result{Items: results}
+	for _, item := range results {
+		if item.Status == StatusOK {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+	return result
+}
+
+// acquire takes a worker slot, failing once the context is cancelled
+func acquire(ctx context.Context, sem chan struct{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
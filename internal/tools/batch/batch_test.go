@@ -0,0 +1,85 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	result := Run(context.Background(), items, 3, func(ctx context.Context, n int) (interface{}, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			old := peak.Load()
+			if current <= old || peak.CompareAndSwap(old, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if n%4 == 0 {
+			return nil, errors.New("divisible by four")
+		}
+		return n * 10, nil
+	})
+
+	if peak.Load() > 3 {
+		t.Errorf("peak concurrency = %d, want at most 3", peak.Load())
+	}
+	if result.Succeeded != 6 || result.Failed != 2 {
+		t.Errorf("succeeded/failed = %d/%d, want 6/2", result.Succeeded, result.Failed)
+	}
+	for i, item := range result.Items {
+		if item.Index != i {
+			t.Errorf("item %d has index %d", i, item.Index)
+		}
+	}
+	if got := result.Items[3]; got.Status != StatusError || got.Error != "divisible by four" {
+		t.Errorf("item 3 = %+v, want error", got)
+	}
+	if got := result.Items[4]; got.Status != StatusOK || got.Result != 50 {
+		t.Errorf("item 4 = %+v, want result 50", got)
+	}
+}
+
+func TestRunCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := Run(ctx, []string{"a", "b"}, 1, func(ctx context.Context, s string) (interface{}, error) {
+		return s, nil
+	})
+
+	if result.Failed != 2 {
+		t.Errorf("failed = %d, want 2", result.Failed)
+	}
+	for _, item := range result.Items {
+		if item.Status != StatusError || item.Error != context.Canceled.Error() {
+			t.Errorf("item %d = %+v, want cancelled", item.Index, item)
+		}
+	}
+}
+
+func TestConcurrency(t *testing.T) {
+	ctx := context.Background()
+	if got := Concurrency(ctx, 0); got != DefaultConcurrency {
+		t.Errorf("Concurrency() = %d, want default %d", got, DefaultConcurrency)
+	}
+
+	ctx = WithConcurrency(ctx, 8)
+	if got := Concurrency(ctx, 0); got != 8 {
+		t.Errorf("Concurrency() = %d, want configured 8", got)
+	}
+	if got := Concurrency(ctx, 2); got != 2 {
+		t.Errorf("Concurrency() = %d, want argument 2", got)
+	}
+	if got := Concurrency(ctx, 100); got != MaxConcurrency {
+		t.Errorf("Concurrency() = %d, want cap %d", got, MaxConcurrency)
+	}
+}
@@ -0,0 +1,245 @@
+// Package command registers tools that run local commands, declared in a
+// tools file, so organizations can expose internal scripts and APIs to agents
+// without forking the server.
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/spf13/viper"
+)
+
+const (
+	// DefaultTimeout bounds a command that declares no timeout
+	DefaultTimeout = 30 * time.Second
+
+	// maxOutput caps the output kept from a command
+	maxOutput = 1 << 20
+
+	// waitDelay bounds the wait for the output of a killed command, which
+	// processes it started can hold open
+	waitDelay = time.Second
+)
+
+// namePattern matches valid tool and argument names
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// inheritedEnv lists the environment variables commands inherit. Credentials
+// of the server are deliberately not passed on.
+var inheritedEnv = []string{"PATH", "HOME", "USER", "LANG", "TMPDIR", "TZ"}
+
+// Definition declares a tool that runs a command. The command is run
+// directly, without a shell; the arguments of a call are passed as a JSON
+// object on stdin and as ATLAS_ARG_<NAME> environment variables. The output
+// of the command is the result of the tool.
+type Definition struct {
+	Name        string              `mapstructure:"name"`
+	Description string              `mapstructure:"description"`
+	Command     []string            `mapstructure:"command"`
+	Arguments   map[string]Argument `mapstructure:"arguments"`
+	Write       bool                `mapstructure:"write"`   // Changes something, so read-only mode and the audit log apply
+	Timeout     time.Duration       `mapstructure:"timeout"` // Defaults to DefaultTimeout
+	Dir         string              `mapstructure:"dir"`     // Working directory
+	Env         []string            `mapstructure:"env"`     // Extra environment variables as NAME=value
+}
+
+// Argument declares an argument of a command tool
+type Argument struct {
+	Type        string   `mapstructure:"type"` // string (default), integer, number, or boolean
+	Description string   `mapstructure:"description"`
+	Required    bool     `mapstructure:"required"`
+	Enum        []string `mapstructure:"enum"`
+}
+
+// Load reads the tool definitions of a YAML, TOML, or JSON file with a
+// top-level "tools" list
+func Load(path string) ([]Definition, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read command tools file %s: %w", path, err)
+	}
+
+	var file struct {
+		Tools []Definition `mapstructure:"tools"`
+	}
+	if err := v.Unmarshal(&file); err != nil {
+		return nil, fmt.Errorf("invalid command tools file %s: %w", path, err)
+	}
+
+	for _, def := range file.Tools {
+		if err := def.Validate(); err != nil {
+			return nil, fmt.Errorf("command tools file %s: %w", path, err)
+		}
+	}
+	return file.Tools, nil
+}
+
+// Validate checks that a definition can be registered as a tool
+func (d *Definition) Validate() error {
+	if !namePattern.MatchString(d.Name) {
+		return fmt.Errorf("tool name %q must be lowercase letters, digits, and underscores", d.Name)
+	}
+	if strings.TrimSpace(d.Description) == "" {
+		return fmt.Errorf("tool %s needs a description", d.Name)
+	}
+	if len(d.Command) == 0 || d.Command[0] == "" {
+		return fmt.Errorf("tool %s needs a command", d.Name)
+	}
+	for _, entry := range d.Env {
+		if name, _, ok := strings.Cut(entry, "="); !ok || name == "" {
+			return fmt.Errorf("tool %s: env entry %q must be NAME=value", d.Name, entry)
+		}
+	}
+	for name, arg := range d.Arguments {
+		if !namePattern.MatchString(name) {
+			return fmt.Errorf("tool %s: argument name %q must be lowercase letters, digits, and underscores", d.Name, name)
+		}
+		switch arg.Type {
+		case "", "string", "integer", "number", "boolean":
+		default:
+			return fmt.Errorf("tool %s: argument %s has unsupported type %q", d.Name, name, arg.Type)
+		}
+	}
+	return nil
+}
+
+// Tool returns the tool definition, tagged "command" and "read" or "write"
+func (d *Definition) Tool() *mcp.ToolDefinition {
+	properties := make(map[string]mcp.Property, len(d.Arguments))
+	var required []string
+	for name, arg := range d.Arguments {
+		argType := arg.Type
+		if argType == "" {
+			argType = "string"
+		}
+		prop := mcp.NewProperty(argType, arg.Description)
+		if len(arg.Enum) > 0 {
+			prop = prop.WithEnum(arg.Enum...)
+		}
+		properties[name] = prop
+
+		if arg.Required {
+			required = append(required, name)
+		}
+	}
+	sort.Strings(required)
+
+	access := "read"
+	if d.Write {
+		access = "write"
+	}
+	return mcp.NewTool(d.Name, d.Description, mcp.NewInputSchema(properties, required...), d.run, "command", access)
+}
+
+// RegisterCommandTools registers a tool for each definition
+func RegisterCommandTools(server *mcp.Server, defs []Definition) error {
+	for i := range defs {
+		if err := server.RegisterTool(defs[i].Tool()); err != nil {
+			return fmt.Errorf("failed to register command tool %s: %w", defs[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// run runs the command with the arguments of a call. A command that fails or
+// times out produces an error result with its stderr.
+func (d *Definition) run(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	timeout := d.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	input, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode arguments: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, d.Command[0], d.Command[1:]...)
+	cmd.Dir = d.Dir
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Env = d.environment(args)
+	cmd.WaitDelay = waitDelay
+	killProcessGroup(cmd)
+
+	stdout := &limitedBuffer{limit: maxOutput}
+	stderr := &limitedBuffer{limit: maxOutput}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Run()
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return mcp.NewErrorResult(fmt.Errorf("%s timed out after %s", d.Name, timeout)), nil
+	case err != nil:
+		message := fmt.Sprintf("%s failed: %v", d.Name, err)
+		if detail := strings.TrimSpace(stderr.String()); detail != "" {
+			message += ": " + detail
+		}
+		return mcp.NewErrorResult(errors.New(message)), nil
+	}
+
+	return mcp.NewSuccessResult(stdout.String()), nil
+}
+
+// environment returns the environment of the command: the inherited
+// variables, the definition's variables, and one variable per declared
+// argument
+func (d *Definition) environment(args map[string]interface{}) []string {
+	var env []string
+	for _, name := range inheritedEnv {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	env = append(env, d.Env...)
+	for name := range d.Arguments {
+		if value, ok := args[name]; ok {
+			env = append(env, "ATLAS_ARG_"+strings.ToUpper(name)+"="+argString(value))
+		}
+	}
+	return env
+}
+
+// argString formats an argument for an environment variable: strings as is,
+// and other values as JSON
+func argString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// limitedBuffer keeps the first limit bytes written to it and discards the rest
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.Len(); room > 0 {
+		if len(p) > room {
+			b.Buffer.Write(p[:room])
+		} else {
+			b.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
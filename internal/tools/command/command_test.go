@@ -0,0 +1,172 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tools.yaml")
+	err := os.WriteFile(path, []byte(`
+tools:
+  - name: deploy_status
+    description: Report the deployment status of a service
+    command: ["./deploy-status", "--json"]
+    timeout: 5s
+    env: ["REGION=eu"]
+    arguments:
+      service:
+        description: Service name
+        required: true
+      environment:
+        enum: [staging, production]
+  - name: restart_service
+    description: Restart a service
+    command: ["./restart"]
+    write: true
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defs, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(defs) != 2 {
+		t.Fatalf("Load() returned %d definitions, want 2", len(defs))
+	}
+
+	status := defs[0]
+	if status.Timeout != 5*time.Second || !reflect.DeepEqual(status.Env, []string{"REGION=eu"}) {
+		t.Errorf("deploy_status = %+v", status)
+	}
+
+	tool := status.Tool()
+	if !reflect.DeepEqual(tool.InputSchema.Required, []string{"service"}) {
+		t.Errorf("required = %v, want [service]", tool.InputSchema.Required)
+	}
+	if prop := tool.InputSchema.Properties["environment"]; prop.Type != "string" || len(prop.Enum) != 2 {
+		t.Errorf("environment property = %+v", prop)
+	}
+	if !reflect.DeepEqual(tool.Tags, []string{"command", "read"}) {
+		t.Errorf("tags = %v", tool.Tags)
+	}
+	if tags := defs[1].Tool().Tags; !reflect.DeepEqual(tags, []string{"command", "write"}) {
+		t.Errorf("restart_service tags = %v", tags)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]Definition{
+		"bad name":        {Name: "Deploy Status", Description: "d", Command: []string{"x"}},
+		"no description":  {Name: "deploy", Command: []string{"x"}},
+		"no command":      {Name: "deploy", Description: "d"},
+		"bad type":        {Name: "deploy", Description: "d", Command: []string{"x"}, Arguments: map[string]Argument{"n": {Type: "date"}}},
+		"bad env":         {Name: "deploy", Description: "d", Command: []string{"x"}, Env: []string{"REGION"}},
+		"bad argument id": {Name: "deploy", Description: "d", Command: []string{"x"}, Arguments: map[string]Argument{"a-b": {}}},
+	}
+	for name, def := range tests {
+		if err := def.Validate(); err == nil {
+			t.Errorf("%s: Validate() should fail", name)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	def := Definition{
+		Name:        "echo_args",
+		Description: "Echo the arguments",
+		Command:     []string{"sh", "-c", `printf '%s %s ' "$ATLAS_ARG_SERVICE" "$REGION"; cat`},
+		Env:         []string{"REGION=eu"},
+		Arguments:   map[string]Argument{"service": {}},
+	}
+
+	result, err := def.Tool().Handler(context.Background(), map[string]interface{}{"service": "api"})
+	if err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+	if result.IsError || result.Content[0].Text != `api eu {"service":"api"}` {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestRunFailure(t *testing.T) {
+	def := Definition{
+		Name:        "fail",
+		Description: "Fail",
+		Command:     []string{"sh", "-c", "echo 'service not found' >&2; exit 3"},
+	}
+
+	result, err := def.Tool().Handler(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "exit status 3: service not found") {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	def := Definition{
+		Name:        "slow",
+		Description: "Sleep",
+		Command:     []string{"sleep", "5"},
+		Timeout:     50 * time.Millisecond,
+	}
+
+	result, err := def.Tool().Handler(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+	if !result.IsError || result.Content[0].Text != "slow timed out after 50ms" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestRunTimeoutKillsChildren(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("process groups are not killed on Windows")
+	}
+	def := Definition{
+		Name:        "slow",
+		Description: "Sleep in a child process",
+		Command:     []string{"sh", "-c", "sleep 5 & sleep 5"},
+		Timeout:     50 * time.Millisecond,
+	}
+
+	start := time.Now()
+	result, err := def.Tool().Handler(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+	if !result.IsError || result.Content[0].Text != "slow timed out after 50ms" {
+		t.Errorf("result = %+v", result)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("handler returned after %s, want the child killed with the command", elapsed)
+	}
+}
+
+func TestRunDoesNotLeakServerEnvironment(t *testing.T) {
+	t.Setenv("JIRA_API_TOKEN", "secret")
+	def := Definition{
+		Name:        "env",
+		Description: "Print the environment",
+		Command:     []string{"sh", "-c", "env"},
+	}
+
+	result, err := def.Tool().Handler(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+	if strings.Contains(result.Content[0].Text, "secret") {
+		t.Errorf("command environment includes server credentials: %s", result.Content[0].Text)
+	}
+}
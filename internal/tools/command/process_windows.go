@@ -0,0 +1,7 @@
+package command
+
+import "os/exec"
+
+// killProcessGroup leaves cancellation to exec.Cmd on Windows, which kills
+// the command's process only
+func killProcessGroup(cmd *exec.Cmd) {}
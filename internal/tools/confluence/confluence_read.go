@@ -3,10 +3,12 @@ package confluence
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/batch"
 	"github.com/codeownersnet/atlas/pkg/atlassian/confluence"
 )
 
@@ -14,27 +16,55 @@ import (
 func ConfluenceSearchTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"confluence_search",
-		"Search Confluence content using CQL (Confluence Query Language) or simple text search. Automatically detects query type.",
+		"Search Confluence content using CQL (Confluence Query Language) or simple text search. Automatically detects query type. Space and type filters can be given as arguments instead of CQL.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
-				"query":  mcp.NewStringProperty("Search query. Can be CQL (e.g., 'type=page AND space=DOCS') or simple text for full-text search"),
-				"expand": mcp.NewStringProperty("Resources to expand (e.g., 'body.storage,version,space'). Comma-separated."),
+				"query":     mcp.NewStringProperty("Search query. Can be CQL (e.g., 'type=page AND space=DOCS') or simple text for full-text search. Optional when space_key or type is given"),
+				"space_key": mcp.NewStringProperty("Restrict results to these spaces (comma-separated space keys)"),
+				"type":      mcp.NewStringProperty("Restrict results to these content types (comma-separated: page, blogpost, comment, attachment)"),
+				"expand":    mcp.NewStringProperty("Resources to expand (e.g., 'body.storage,version,space'). Comma-separated. With an excerpt, paths are relative to each result (e.g., 'content.space')."),
+				"excerpt": mcp.NewEnumProperty("Excerpt strategy. Anything other than 'none' returns a text excerpt for each result (results are then wrapped as {content, excerpt, url})",
+					"none", "highlight", "indexed", "highlight_unescaped", "indexed_unescaped").
+					WithDefault("none"),
 				"limit": mcp.NewIntegerProperty("Maximum number of results to return (default 25)").
 					WithDefault(25),
 				"start": mcp.NewIntegerProperty("Starting index for pagination (0-based)").
 					WithDefault(0),
+				"cursor": mcp.NewStringProperty("Pagination cursor from a previous result's nextCursor (Cloud). Use instead of start"),
+				"fetch_all": mcp.NewBooleanProperty("Follow pagination and return every matching result, up to 1000 (start, cursor, and limit are ignored; not supported with excerpts)").
+					WithDefault(false),
+				"convert_to_markdown": mcp.NewBooleanProperty("Return result bodies as Markdown instead of storage format (expands body.storage if no body is requested)").
+					WithDefault(false),
+				"excerpt_format": mcp.NewEnumProperty("How excerpts are cleaned up: markdown (matched terms in bold), text, or raw (as returned, with XHTML and highlight markers)",
+					"markdown", "text", "raw").
+					WithDefault("markdown"),
+				"compact": mcp.NewBooleanProperty("Return compact rows (id, title, type, space, url, last_modified, snippet) instead of full results. The snippet is the cleaned excerpt, or the start of the body when a body is expanded").
+					WithDefault(false),
 			},
-			"query",
-		),
+		).WithChunking(),
 		confluenceSearchHandler,
 		"confluence", "read",
 	)
 }
 
 func confluenceSearchHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	query, ok := args["query"].(string)
-	if !ok || query == "" {
-		return nil, fmt.Errorf("query is required")
+	var params struct {
+		Query             string   `arg:"query"`
+		ConvertToMarkdown bool     `arg:"convert_to_markdown"`
+		FetchAll          bool     `arg:"fetch_all"`
+		Compact           bool     `arg:"compact"`
+		Start             int      `arg:"start"`
+		Limit             int      `arg:"limit" default:"25"`
+		Expand            []string `arg:"expand"`
+		Cursor            string   `arg:"cursor,trim"`
+		SpaceKeys         []string `arg:"space_key"`
+		Types             []string `arg:"type"`
+		ExcerptFormat     string   `arg:"excerpt_format,trim"`
+		Excerpt           string   `arg:"excerpt"`
+		mcp.ChunkArgs
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetConfluenceClient(ctx)
@@ -43,19 +73,139 @@ func confluenceSearchHandler(ctx context.Context, args map[string]interface{}) (
 	}
 
 	opts := &confluence.SearchOptions{
-		Start: getIntArg(args, "start", 0),
-		Limit: getIntArg(args, "limit", 25),
+		Start:     params.Start,
+		Limit:     params.Limit,
+		Expand:    params.Expand,
+		Cursor:    params.Cursor,
+		SpaceKeys: params.SpaceKeys,
+		Types:     params.Types,
+	}
+
+	if strings.TrimSpace(params.Query) == "" && len(opts.SpaceKeys) == 0 && len(opts.Types) == 0 {
+		return nil, fmt.Errorf("query is required unless space_key or type is given")
+	}
+
+	excerptFormat := confluence.ExcerptFormatMarkdown
+	if params.ExcerptFormat != "" {
+		excerptFormat = confluence.ExcerptFormat(params.ExcerptFormat)
+	}
+
+	// Excerpts are only returned by the site search API, which nests content in each result
+	if params.Excerpt != "" && params.Excerpt != "none" {
+		if params.FetchAll {
+			return nil, fmt.Errorf("fetch_all is not supported with excerpts")
+		}
+		opts.Excerpt = params.Excerpt
+		if params.ConvertToMarkdown {
+			opts.Expand = withBodyExpand(opts.Expand, "content.")
+		}
+		if params.Compact {
+			opts.Expand = withExpand(opts.Expand, "content.space")
+		}
+
+		result, err := client.SiteSearch(ctx, params.Query, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search: %w", err)
+		}
+
+		if params.ConvertToMarkdown {
+			for _, item := range result.Results {
+				if item.Content == nil {
+					continue
+				}
+				if err := item.Content.ConvertBodyToMarkdown(); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if params.Compact {
+			return mcp.NewJSONResult(map[string]interface{}{
+				"results":    client.SiteSearchSnippets(result, excerptFormat),
+				"start":      result.Start,
+				"size":       result.Size,
+				"totalSize":  result.TotalSize,
+				"nextCursor": result.NextCursor,
+			})
+		}
+
+		for i := range result.Results {
+			result.Results[i].Excerpt = confluence.CleanExcerpt(result.Results[i].Excerpt, excerptFormat)
+		}
+		return mcp.NewJSONResult(result)
 	}
 
-	if expand, ok := args["expand"].(string); ok && expand != "" {
-		opts.Expand = strings.Split(expand, ",")
+	if params.ConvertToMarkdown {
+		opts.Expand = withBodyExpand(opts.Expand, "")
+	}
+	if params.Compact {
+		opts.Expand = withExpand(opts.Expand, "space", "version")
 	}
 
-	result, err := client.Search(ctx, query, opts)
+	var result *confluence.SearchResult
+	var err error
+	if params.FetchAll {
+		opts.Start, opts.Cursor = 0, ""
+		result, err = client.SearchAll(ctx, params.Query, opts, fetchAllLimit)
+	} else {
+		result, err = client.Search(ctx, params.Query, opts)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to search: %w", err)
 	}
 
+	if params.ConvertToMarkdown {
+		for i := range result.Results {
+			if err := result.Results[i].ConvertBodyToMarkdown(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if params.FetchAll {
+		response := map[string]interface{}{
+			"count":     len(result.Results),
+			"truncated": result.HasMore(),
+		}
+
+		// The total is omitted when Confluence does not report it and the limit was reached
+		total := result.TotalSize
+		if total == 0 && !result.HasMore() {
+			total = len(result.Results)
+		}
+		if total > 0 {
+			response["total"] = total
+		}
+		if result.HasMore() {
+			response["note"] = fmt.Sprintf("Results stop at the fetch_all limit of %d; narrow the query to see the rest", fetchAllLimit)
+		}
+
+		if params.Compact {
+			snippets := client.ContentSnippets(result.Results)
+			if params.ChunkSize > 0 {
+				return mcp.NewChunkedJSONResult(response, snippets, params.ChunkSize)
+			}
+			response["results"] = snippets
+			return mcp.NewJSONResult(response)
+		}
+
+		if params.ChunkSize > 0 {
+			return mcp.NewChunkedJSONResult(response, result.Results, params.ChunkSize)
+		}
+		response["results"] = result.Results
+		return mcp.NewJSONResult(response)
+	}
+
+	if params.Compact {
+		return mcp.NewJSONResult(map[string]interface{}{
+			"results":    client.ContentSnippets(result.Results),
+			"start":      result.Start,
+			"size":       result.Size,
+			"totalSize":  result.TotalSize,
+			"nextCursor": result.NextCursor,
+		})
+	}
+
 	return mcp.NewJSONResult(result)
 }
 
@@ -70,36 +220,47 @@ func ConfluenceGetPageTool() *mcp.ToolDefinition {
 				"title":     mcp.NewStringProperty("Page title (requires space_key)"),
 				"space_key": mcp.NewStringProperty("Space key (required when using title)"),
 				"expand":    mcp.NewStringProperty("Resources to expand (e.g., 'body.storage,version,space'). Comma-separated."),
+				"convert_to_markdown": mcp.NewBooleanProperty("Return the page body as Markdown instead of storage format (expands body.storage if no body is requested)").
+					WithDefault(false),
 			},
-		),
+		).WithOutputLimits(),
 		confluenceGetPageHandler,
 		"confluence", "read",
 	)
 }
 
 func confluenceGetPageHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ConvertToMarkdown bool     `arg:"convert_to_markdown"`
+		Expand            []string `arg:"expand"`
+		PageID            string   `arg:"page_id,trim"`
+		Title             string   `arg:"title"`
+		SpaceKey          string   `arg:"space_key,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
 	client := GetConfluenceClient(ctx)
 	if client == nil {
 		return nil, fmt.Errorf("Confluence client not available")
 	}
 
-	var expand []string
-	if expandStr, ok := args["expand"].(string); ok && expandStr != "" {
-		expand = strings.Split(expandStr, ",")
+	if params.ConvertToMarkdown {
+		params.Expand = withBodyExpand(params.Expand, "")
 	}
 
 	var page *confluence.Content
 	var err error
 
 	// Check if we're getting by ID or by title+space
-	if pageID, ok := args["page_id"].(string); ok && pageID != "" {
-		page, err = client.GetPage(ctx, pageID, expand)
-	} else if title, ok := args["title"].(string); ok && title != "" {
-		spaceKey, ok := args["space_key"].(string)
-		if !ok || spaceKey == "" {
-			return nil, fmt.Errorf("space_key is required when using title")
-		}
-		page, err = client.GetPageByTitle(ctx, spaceKey, title, expand)
+	if params.PageID != "" {
+		page, err = client.GetPage(ctx, params.PageID, params.Expand)
+	} else if params.Title != "" {
+		if params.SpaceKey == "" {
+			return nil, &mcp.ArgsError{Problems: []mcp.ArgProblem{{Arg: "space_key", Message: "is required when using title"}}}
+		}
+		page, err = client.GetPageByTitle(ctx, params.SpaceKey, params.Title, params.Expand)
 	} else {
 		return nil, fmt.Errorf("either page_id or (title and space_key) must be provided")
 	}
@@ -108,9 +269,79 @@ func confluenceGetPageHandler(ctx context.Context, args map[string]interface{})
 		return nil, fmt.Errorf("failed to get page: %w", err)
 	}
 
+	if params.ConvertToMarkdown {
+		if err := page.ConvertBodyToMarkdown(); err != nil {
+			return nil, err
+		}
+	}
+
 	return mcp.NewJSONResult(page)
 }
 
+// ConfluenceResolveURLTool creates the confluence_resolve_url tool
+func ConfluenceResolveURLTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_resolve_url",
+		"Resolve a Confluence URL pasted by a user to the page or blog post it points to. Accepts pretty URLs (/spaces/KEY/pages/123/Title), viewpage.action?pageId=123, display URLs (/display/KEY/Title), and tiny links (/x/AbCd). Returns the content ID, type, title, space, version, and web URL.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"url":    mcp.NewStringProperty("Confluence URL (required)"),
+				"expand": mcp.NewStringProperty("Additional resources to expand (e.g., 'body.storage,ancestors'). Comma-separated. Space and version are always expanded."),
+			},
+			"url",
+		),
+		confluenceResolveURLHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceResolveURLHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		URL    string   `arg:"url,trim" validate:"required"`
+		Expand []string `arg:"expand"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	params.Expand = withExpand(params.Expand, "space", "version")
+
+	target, content, err := client.ResolveContentURL(ctx, params.URL, params.Expand)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve URL: %w", err)
+	}
+
+	response := map[string]interface{}{
+		"id":          content.ID,
+		"type":        content.Type,
+		"title":       content.Title,
+		"status":      content.Status,
+		"url":         client.PageURL(content),
+		"resolved_by": "id",
+		"content":     content,
+	}
+	if target.ID == "" {
+		response["resolved_by"] = "title"
+	}
+	if target.TinyID != "" {
+		response["resolved_by"] = "tiny_link"
+	}
+	if content.Space != nil {
+		response["space_key"] = content.Space.Key
+	}
+	if content.Version != nil {
+		response["version"] = content.Version.Number
+		response["last_modified"] = content.Version.When
+	}
+
+	return mcp.NewJSONResult(response)
+}
+
 // ConfluenceGetPageChildrenTool creates the confluence_get_page_children tool
 func ConfluenceGetPageChildrenTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
@@ -131,9 +362,13 @@ func ConfluenceGetPageChildrenTool() *mcp.ToolDefinition {
 }
 
 func confluenceGetPageChildrenHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	pageID, ok := args["page_id"].(string)
-	if !ok || pageID == "" {
-		return nil, fmt.Errorf("page_id is required")
+	var params struct {
+		PageID string   `arg:"page_id,trim" validate:"required"`
+		Limit  int      `arg:"limit" default:"25"`
+		Expand []string `arg:"expand"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetConfluenceClient(ctx)
@@ -141,14 +376,7 @@ func confluenceGetPageChildrenHandler(ctx context.Context, args map[string]inter
 		return nil, fmt.Errorf("Confluence client not available")
 	}
 
-	var expand []string
-	if expandStr, ok := args["expand"].(string); ok && expandStr != "" {
-		expand = strings.Split(expandStr, ",")
-	}
-
-	limit := getIntArg(args, "limit", 25)
-
-	children, err := client.GetPageChildren(ctx, pageID, expand, limit)
+	children, err := client.GetPageChildren(ctx, params.PageID, params.Expand, params.Limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get page children: %w", err)
 	}
@@ -159,17 +387,126 @@ func confluenceGetPageChildrenHandler(ctx context.Context, args map[string]inter
 	})
 }
 
+// maxPageTreeDepth is the deepest tree a call can request
+const maxPageTreeDepth = 5
+
+// maxPageTreePages bounds the pages of a tree, and so the requests a single
+// tree call makes (one per page)
+const maxPageTreePages = 200
+
+// ConfluenceGetPageTreeTool creates the confluence_get_page_tree tool
+func ConfluenceGetPageTreeTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_get_page_tree",
+		fmt.Sprintf("Get the hierarchy of descendant pages below a Confluence page (IDs and titles) in a single call, breadth first. The tree holds at most %d pages; pages whose children were not fetched because of this cap are marked unexplored. Call again on such a page to continue.", maxPageTreePages),
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"page_id": mcp.NewStringProperty("Root page ID"),
+				"depth": mcp.NewIntegerProperty(fmt.Sprintf("Number of levels to descend (default 2, max %d)", maxPageTreeDepth)).
+					WithDefault(2),
+				"limit": mcp.NewIntegerProperty("Maximum number of children to return per page (default 25)").
+					WithDefault(25),
+			},
+			"page_id",
+		),
+		confluenceGetPageTreeHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceGetPageTreeHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		PageID string `arg:"page_id,trim" validate:"required"`
+		Depth  int    `arg:"depth" default:"2"`
+		Limit  int    `arg:"limit" default:"25"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	if params.Depth < 1 || params.Depth > maxPageTreeDepth {
+		return nil, fmt.Errorf("depth must be between 1 and %d", maxPageTreeDepth)
+	}
+
+	if params.Limit < 1 {
+		params.Limit = 25
+	}
+
+	tree, err := client.GetPageTree(ctx, params.PageID, params.Depth, params.Limit, maxPageTreePages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page tree: %w", err)
+	}
+
+	return mcp.NewJSONResult(tree)
+}
+
+// ConfluenceGetPageAncestorsTool creates the confluence_get_page_ancestors tool
+func ConfluenceGetPageAncestorsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_get_page_ancestors",
+		"Get the ancestors of a Confluence page, from the space root down to the direct parent.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"page_id": mcp.NewStringProperty("Page ID"),
+			},
+			"page_id",
+		),
+		confluenceGetPageAncestorsHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceGetPageAncestorsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		PageID string `arg:"page_id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	ancestors, err := client.GetPageAncestors(ctx, params.PageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page ancestors: %w", err)
+	}
+
+	results := make([]map[string]interface{}, 0, len(ancestors))
+	for _, ancestor := range ancestors {
+		results = append(results, map[string]interface{}{
+			"id":    ancestor.ID,
+			"title": ancestor.Title,
+		})
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"ancestors": results,
+		"total":     len(results),
+	})
+}
+
 // ConfluenceGetCommentsTool creates the confluence_get_comments tool
 func ConfluenceGetCommentsTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"confluence_get_comments",
-		"Get comments for a Confluence page.",
+		"Get comments for a Confluence page. Can filter by footer, inline, or resolved comments and include threaded replies.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"page_id": mcp.NewStringProperty("Page ID"),
 				"expand":  mcp.NewStringProperty("Resources to expand (e.g., 'body.storage,version'). Comma-separated."),
 				"limit": mcp.NewIntegerProperty("Maximum number of comments to return (default 25)").
 					WithDefault(25),
+				"location": mcp.NewEnumProperty("Only return comments at this location (optional)", "footer", "inline", "resolved"),
+				"include_replies": mcp.NewBooleanProperty("Include replies; each reply lists its parent comments in 'ancestors'").
+					WithDefault(false),
 			},
 			"page_id",
 		),
@@ -179,9 +516,15 @@ func ConfluenceGetCommentsTool() *mcp.ToolDefinition {
 }
 
 func confluenceGetCommentsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	pageID, ok := args["page_id"].(string)
-	if !ok || pageID == "" {
-		return nil, fmt.Errorf("page_id is required")
+	var params struct {
+		PageID         string   `arg:"page_id,trim" validate:"required"`
+		Limit          int      `arg:"limit" default:"25"`
+		Expand         []string `arg:"expand"`
+		Location       string   `arg:"location,trim"`
+		IncludeReplies bool     `arg:"include_replies"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetConfluenceClient(ctx)
@@ -189,14 +532,22 @@ func confluenceGetCommentsHandler(ctx context.Context, args map[string]interface
 		return nil, fmt.Errorf("Confluence client not available")
 	}
 
-	var expand []string
-	if expandStr, ok := args["expand"].(string); ok && expandStr != "" {
-		expand = strings.Split(expandStr, ",")
+	opts := &confluence.GetCommentsOptions{
+		Expand:         params.Expand,
+		Limit:          params.Limit,
+		Location:       confluence.CommentLocation(params.Location),
+		IncludeReplies: params.IncludeReplies,
 	}
 
-	limit := getIntArg(args, "limit", 25)
+	// Location and threading details are needed to make sense of filtered or nested results
+	if opts.Location != "" || opts.IncludeReplies {
+		opts.Expand = append(opts.Expand, "extensions.location", "extensions.inlineProperties", "extensions.resolution")
+		if opts.IncludeReplies {
+			opts.Expand = append(opts.Expand, "ancestors")
+		}
+	}
 
-	comments, err := client.GetComments(ctx, pageID, expand, limit)
+	comments, err := client.GetPageComments(ctx, params.PageID, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get comments: %w", err)
 	}
@@ -227,9 +578,13 @@ func ConfluenceGetLabelsTool() *mcp.ToolDefinition {
 }
 
 func confluenceGetLabelsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	contentID, ok := args["content_id"].(string)
-	if !ok || contentID == "" {
-		return nil, fmt.Errorf("content_id is required")
+	var params struct {
+		ContentID string `arg:"content_id,trim" validate:"required"`
+		Limit     int    `arg:"limit" default:"100"`
+		Prefix    string `arg:"prefix,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetConfluenceClient(ctx)
@@ -237,14 +592,7 @@ func confluenceGetLabelsHandler(ctx context.Context, args map[string]interface{}
 		return nil, fmt.Errorf("Confluence client not available")
 	}
 
-	prefix := ""
-	if p, ok := args["prefix"].(string); ok {
-		prefix = p
-	}
-
-	limit := getIntArg(args, "limit", 100)
-
-	labels, err := client.GetLabels(ctx, contentID, prefix, limit)
+	labels, err := client.GetLabels(ctx, params.ContentID, params.Prefix, params.Limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get labels: %w", err)
 	}
@@ -255,6 +603,335 @@ func confluenceGetLabelsHandler(ctx context.Context, args map[string]interface{}
 	})
 }
 
+// ConfluenceSearchByLabelTool creates the confluence_search_by_label tool
+func ConfluenceSearchByLabelTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_search_by_label",
+		"Find Confluence content with a given label, optionally limited to a space.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"label":     mcp.NewStringProperty("Label name"),
+				"space_key": mcp.NewStringProperty("Limit results to this space (optional)"),
+				"limit": mcp.NewIntegerProperty("Maximum number of results to return (default 25)").
+					WithDefault(25),
+			},
+			"label",
+		),
+		confluenceSearchByLabelHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceSearchByLabelHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Label    string `arg:"label,trim" validate:"required"`
+		SpaceKey string `arg:"space_key,trim"`
+		Limit    int    `arg:"limit" default:"25"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	results, err := client.SearchByLabel(ctx, params.Label, params.SpaceKey, params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search by label: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"results": results,
+		"total":   len(results),
+	})
+}
+
+// ConfluenceListBlogPostsTool creates the confluence_list_blogposts tool
+func ConfluenceListBlogPostsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_list_blogposts",
+		"List Confluence blog posts, newest first, optionally filtered by space and creation date range.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"space_key": mcp.NewStringProperty("Space key to list blog posts from (optional, all spaces if omitted)"),
+				"from":      mcp.NewStringProperty("Only include posts created on or after this date (YYYY-MM-DD)"),
+				"to":        mcp.NewStringProperty("Only include posts created on or before this date (YYYY-MM-DD)"),
+				"expand":    mcp.NewStringProperty("Resources to expand (e.g., 'body.storage,version,space'). Comma-separated."),
+				"limit": mcp.NewIntegerProperty("Maximum number of blog posts to return (default 25)").
+					WithDefault(25),
+				"start": mcp.NewIntegerProperty("Starting index for pagination (0-based)").
+					WithDefault(0),
+				"cursor": mcp.NewStringProperty("Pagination cursor from a previous result's nextCursor (Cloud). Use instead of start"),
+				"convert_to_markdown": mcp.NewBooleanProperty("Return blog post bodies as Markdown instead of storage format (expands body.storage if no body is requested). Posts whose body can't be converted keep their storage body and are listed in errors").
+					WithDefault(false),
+			},
+		),
+		confluenceListBlogPostsHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceListBlogPostsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ConvertToMarkdown bool     `arg:"convert_to_markdown"`
+		Start             int      `arg:"start"`
+		Limit             int      `arg:"limit" default:"25"`
+		Expand            []string `arg:"expand"`
+		SpaceKey          string   `arg:"space_key,trim"`
+		From              string   `arg:"from,trim"`
+		To                string   `arg:"to,trim"`
+		Cursor            string   `arg:"cursor,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	opts := &confluence.ListBlogPostsOptions{
+		Start: params.Start,
+		Limit: params.Limit,
+	}
+	opts.SpaceKey = params.SpaceKey
+	opts.From = params.From
+	opts.To = params.To
+	opts.Cursor = params.Cursor
+
+	opts.Expand = params.Expand
+	if params.ConvertToMarkdown {
+		opts.Expand = withBodyExpand(opts.Expand, "")
+	}
+
+	result, err := client.ListBlogPosts(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// A post that can't be converted keeps its storage body, so one malformed
+	// post doesn't fail the whole list
+	var errors []string
+	if params.ConvertToMarkdown {
+		for i := range result.Results {
+			if err := result.Results[i].ConvertBodyToMarkdown(); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+
+	return mcp.NewJSONResult(struct {
+		*confluence.SearchResult
+		Errors []string `json:"errors,omitempty"`
+	}{result, errors})
+}
+
+// ConfluenceGetRestrictionsTool creates the confluence_get_restrictions tool
+func ConfluenceGetRestrictionsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_get_restrictions",
+		"Get the view (read) and edit (update) restrictions of a Confluence page: the users and groups allowed to perform each operation.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"page_id": mcp.NewStringProperty("Page ID"),
+			},
+			"page_id",
+		),
+		confluenceGetRestrictionsHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceGetRestrictionsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		PageID string `arg:"page_id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	restrictions, err := client.GetRestrictions(ctx, params.PageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get restrictions: %w", err)
+	}
+
+	return mcp.NewJSONResult(summarizeRestrictions(params.PageID, restrictions))
+}
+
+// summarizeRestrictions condenses restrictions into users and groups per operation
+func summarizeRestrictions(pageID string, restrictions []confluence.ContentRestriction) map[string]interface{} {
+	operations := make(map[string]interface{})
+	restricted := false
+
+	for _, r := range restrictions {
+		users := []map[string]string{}
+		groups := []string{}
+		if r.Restrictions != nil {
+			if r.Restrictions.User != nil {
+				for _, u := range r.Restrictions.User.Results {
+					user := map[string]string{"displayName": u.DisplayName}
+					if u.AccountID != "" {
+						user["accountId"] = u.AccountID
+					}
+					if u.Username != "" {
+						user["username"] = u.Username
+					}
+					users = append(users, user)
+				}
+			}
+			if r.Restrictions.Group != nil {
+				for _, g := range r.Restrictions.Group.Results {
+					groups = append(groups, g.Name)
+				}
+			}
+		}
+
+		if len(users) > 0 || len(groups) > 0 {
+			restricted = true
+		}
+		operations[r.Operation] = map[string]interface{}{
+			"users":  users,
+			"groups": groups,
+		}
+	}
+
+	return map[string]interface{}{
+		"page_id":      pageID,
+		"restricted":   restricted,
+		"restrictions": operations,
+	}
+}
+
+// ConfluenceGetTemplatesTool creates the confluence_get_templates tool
+func ConfluenceGetTemplatesTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_get_templates",
+		"List page templates available in a space and/or globally. Use the returned template ID with confluence_create_page_from_template.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"space_key": mcp.NewStringProperty("Space key to list space templates for (optional, only global templates are listed if omitted)"),
+				"include_global": mcp.NewBooleanProperty("Also list global templates when space_key is given (default true)").
+					WithDefault(true),
+				"limit": mcp.NewIntegerProperty("Maximum number of templates to return per scope (default 25)").
+					WithDefault(25),
+			},
+		),
+		confluenceGetTemplatesHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceGetTemplatesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		SpaceKey      string `arg:"space_key,trim"`
+		Limit         int    `arg:"limit" default:"25"`
+		IncludeGlobal bool   `arg:"include_global" default:"true"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	templates := []map[string]interface{}{}
+	addTemplates := func(key, scope string) error {
+		result, err := client.GetPageTemplates(ctx, key, 0, params.Limit)
+		if err != nil {
+			return fmt.Errorf("failed to get %s templates: %w", scope, err)
+		}
+		for _, t := range result.Results {
+			templates = append(templates, map[string]interface{}{
+				"id":          t.TemplateID,
+				"name":        t.Name,
+				"description": t.Description,
+				"type":        t.TemplateType,
+				"scope":       scope,
+			})
+		}
+		return nil
+	}
+
+	if params.SpaceKey != "" {
+		if err := addTemplates(params.SpaceKey, "space"); err != nil {
+			return nil, err
+		}
+	}
+	if params.SpaceKey == "" || params.IncludeGlobal {
+		if err := addTemplates("", "global"); err != nil {
+			return nil, err
+		}
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"templates": templates,
+		"total":     len(templates),
+	})
+}
+
+// ConfluenceGetPropertiesTool creates the confluence_get_properties tool
+func ConfluenceGetPropertiesTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_get_properties",
+		"Get content properties (JSON values stored on a page by key). Returns one property when key is given, otherwise all properties.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"content_id": mcp.NewStringProperty("Content ID (page ID, blogpost ID, etc.)"),
+				"key":        mcp.NewStringProperty("Property key (optional, returns all properties if omitted)"),
+				"limit": mcp.NewIntegerProperty("Maximum number of properties to return when listing (default 50)").
+					WithDefault(50),
+			},
+			"content_id",
+		),
+		confluenceGetPropertiesHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceGetPropertiesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ContentID string `arg:"content_id,trim" validate:"required"`
+		Limit     int    `arg:"limit" default:"50"`
+		Key       string `arg:"key,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	if params.Key != "" {
+		property, err := client.GetContentProperty(ctx, params.ContentID, params.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get property: %w", err)
+		}
+		return mcp.NewJSONResult(property)
+	}
+
+	properties, err := client.GetContentProperties(ctx, params.ContentID, params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get properties: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"properties": properties,
+		"total":      len(properties),
+	})
+}
+
 // ConfluenceSearchUserTool creates the confluence_search_user tool
 func ConfluenceSearchUserTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
@@ -274,9 +951,12 @@ func ConfluenceSearchUserTool() *mcp.ToolDefinition {
 }
 
 func confluenceSearchUserHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	query, ok := args["query"].(string)
-	if !ok || query == "" {
-		return nil, fmt.Errorf("query is required")
+	var params struct {
+		Query string `arg:"query" validate:"required"`
+		Limit int    `arg:"limit" default:"25"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetConfluenceClient(ctx)
@@ -284,9 +964,7 @@ func confluenceSearchUserHandler(ctx context.Context, args map[string]interface{
 		return nil, fmt.Errorf("Confluence client not available")
 	}
 
-	limit := getIntArg(args, "limit", 25)
-
-	users, err := client.SearchUsersByName(ctx, query, limit)
+	users, err := client.SearchUsersByName(ctx, params.Query, params.Limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search users: %w", err)
 	}
@@ -297,21 +975,347 @@ func confluenceSearchUserHandler(ctx context.Context, args map[string]interface{
 	})
 }
 
-// Helper function to get integer argument with default
-func getIntArg(args map[string]interface{}, key string, defaultVal int) int {
-	if val, ok := args[key]; ok {
-		switch v := val.(type) {
-		case int:
-			return v
-		case int64:
-			return int(v)
-		case float64:
-			return int(v)
-		case string:
-			if i, err := strconv.Atoi(v); err == nil {
-				return i
+// ConfluenceGetCurrentUserTool creates the confluence_get_current_user tool
+func ConfluenceGetCurrentUserTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_get_current_user",
+		"Get the account the server is authenticated as: account ID (Cloud) or username (Server/DC), display name, and groups. Use it to resolve 'me' and to verify credentials at the start of a session.",
+		mcp.NewInputSchema(map[string]mcp.Property{}),
+		confluenceGetCurrentUserHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceGetCurrentUserHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	response := map[string]interface{}{
+		"user":       user,
+		"deployment": client.GetDeploymentType(),
+	}
+
+	// Groups are best effort, like in jira_get_myself
+	id := user.AccountID
+	if id == "" {
+		id = user.Username
+	}
+	if groups, err := client.GetUserGroups(ctx, id); err == nil {
+		response["groups"] = groups
+	} else {
+		response["groups_error"] = err.Error()
+	}
+
+	return mcp.NewJSONResult(response)
+}
+
+// withBodyExpand adds body.storage to expand unless a body representation is
+// already requested. The prefix addresses nested content (e.g. "content.").
+func withBodyExpand(expand []string, prefix string) []string {
+	for _, e := range expand {
+		if strings.HasPrefix(strings.TrimSpace(e), prefix+"body.") {
+			return expand
+		}
+	}
+	return append(expand, prefix+"body.storage")
+}
+
+// withExpand adds expansions that are not requested yet
+func withExpand(expand []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, e := range expand {
+			if strings.TrimSpace(e) == value {
+				found = true
+				break
 			}
 		}
+		if !found {
+			expand = append(expand, value)
+		}
 	}
-	return defaultVal
+	return expand
+}
+
+// fetchAllLimit caps the number of results returned with fetch_all
+const fetchAllLimit = 1000
+
+// ConfluenceGetTasksTool creates the confluence_get_tasks tool
+func ConfluenceGetTasksTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_get_tasks",
+		"List inline tasks (action items) of Confluence pages, filtered by page, space, assignee, and status. On Server/DC a page_id is required, as tasks can only be read from a page there. Check tasks off with confluence_complete_task.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"page_id":   mcp.NewStringProperty("Only tasks of this page (required on Server/DC)"),
+				"space_key": mcp.NewStringProperty("Only tasks of this space (Cloud only)"),
+				"assignee":  mcp.NewStringProperty("Only tasks assigned to this user: an account ID (Cloud) or username (Server/DC), or '@me' for the authenticated user"),
+				"status": mcp.NewEnumProperty("Only tasks with this status (default incomplete)", "incomplete", "complete", "all").
+					WithDefault("incomplete"),
+				"limit": mcp.NewIntegerProperty("Maximum number of tasks to return (default 50)").
+					WithDefault(50).WithMinimum(1).WithMaximum(fetchAllLimit),
+			},
+		),
+		confluenceGetTasksHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceGetTasksHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		PageID   string `arg:"page_id,trim"`
+		SpaceKey string `arg:"space_key,trim"`
+		Assignee string `arg:"assignee,trim"`
+		Status   string `arg:"status,trim" default:"incomplete" validate:"oneof=incomplete complete all"`
+		Limit    int    `arg:"limit" default:"50" validate:"min=1,max=1000"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	opts := &confluence.GetTasksOptions{PageID: params.PageID, Assignee: params.Assignee, Limit: params.Limit}
+	if params.Status != "all" {
+		opts.Status = confluence.TaskStatus(params.Status)
+	}
+	// "me" is kept as an alias of the Jira tools' "@me"
+	if params.Assignee == "@me" || strings.EqualFold(params.Assignee, "me") {
+		user, err := client.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current user: %w", err)
+		}
+		opts.Assignee = user.AccountID
+		if opts.Assignee == "" {
+			opts.Assignee = user.Username
+		}
+	}
+	if params.SpaceKey != "" {
+		// The tasks API filters by space ID rather than key
+		space, err := client.GetSpace(ctx, params.SpaceKey, nil)
+		if err != nil {
+			return nil, err
+		}
+		opts.SpaceID = space.GetID()
+	}
+
+	tasks, err := client.GetTasks(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]map[string]interface{}, 0, len(tasks))
+	for _, task := range tasks {
+		result := map[string]interface{}{
+			"id":     task.ID,
+			"status": task.Status,
+			"text":   task.Text,
+		}
+		for key, value := range map[string]string{
+			"page_id":      task.PageID,
+			"blog_post_id": task.BlogPostID,
+			"assignee":     task.AssignedTo,
+			"due":          task.DueAt,
+			"completed_by": task.CompletedBy,
+			"completed_at": task.CompletedAt,
+		} {
+			if value != "" {
+				result[key] = value
+			}
+		}
+		results = append(results, result)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"tasks": results,
+		"count": len(results),
+	})
+}
+
+// ConfluenceGetSpacePermissionsTool creates the confluence_get_space_permissions tool
+func ConfluenceGetSpacePermissionsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_get_space_permissions",
+		"Audit who can do what in a Confluence space. Lists each permission (e.g. 'read:space', 'create:page', 'administer:space') with the users and groups holding it, and each user or group with its permissions. Requires space admin rights.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"space_key": mcp.NewStringProperty("Space key (e.g., 'DOCS')"),
+			},
+			"space_key",
+		),
+		confluenceGetSpacePermissionsHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceGetSpacePermissionsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		SpaceKey string `arg:"space_key,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	perms, err := client.GetSpacePermissions(ctx, params.SpaceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invert the permissions, so each user and group is listed once with
+	// everything it may do
+	type subject struct {
+		Type        string   `json:"type"`
+		ID          string   `json:"id"`
+		Name        string   `json:"name,omitempty"`
+		Permissions []string `json:"permissions"`
+	}
+	var subjects []*subject
+	byKey := make(map[string]*subject)
+	grant := func(kind string, s confluence.PermissionSubject, permission string) {
+		key := kind + ":" + s.ID
+		if byKey[key] == nil {
+			byKey[key] = &subject{Type: kind, ID: s.ID, Name: s.Name}
+			subjects = append(subjects, byKey[key])
+		}
+		byKey[key].Permissions = append(byKey[key].Permissions, permission)
+	}
+	for _, perm := range perms {
+		for _, user := range perm.Users {
+			grant("user", user, perm.Key())
+		}
+		for _, group := range perm.Groups {
+			grant("group", group, perm.Key())
+		}
+		if perm.Anonymous {
+			grant("anonymous", confluence.PermissionSubject{ID: "anonymous"}, perm.Key())
+		}
+	}
+	sort.SliceStable(subjects, func(i, j int) bool {
+		if subjects[i].Type != subjects[j].Type {
+			return subjects[i].Type < subjects[j].Type
+		}
+		return subjects[i].ID < subjects[j].ID
+	})
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"space_key":   params.SpaceKey,
+		"permissions": perms,
+		"subjects":    subjects,
+	})
+}
+
+// pageViewsDateFormat is the layout of the page views start date
+const pageViewsDateFormat = "2006-01-02"
+
+// maxPageViewsPages caps the pages of one confluence_get_page_views call
+const maxPageViewsPages = 50
+
+// ConfluenceGetPageViewsTool creates the confluence_get_page_views tool
+func ConfluenceGetPageViewsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_get_page_views",
+		"Get how often pages were viewed, and by how many distinct users, from a date until now (Confluence Cloud analytics only). Pages are sorted by views, so stale (rarely viewed) and high-traffic documentation stand out.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"page_ids": mcp.NewArrayProperty(fmt.Sprintf("IDs of the pages to get views for (max %d)", maxPageViewsPages), mcp.NewStringProperty("Page ID")),
+				"from":     mcp.NewStringProperty("Count views from this day on (YYYY-MM-DD, defaults to 30 days ago). The analytics API has no end date: counts always run until now"),
+				"all_time": mcp.NewBooleanProperty("Count all views since the pages were created, ignoring from").WithDefault(false),
+				"order":    mcp.NewEnumProperty("Sort pages by views, most viewed first (desc) or least viewed first (asc)", "desc", "asc").WithDefault("desc"),
+				"concurrency": mcp.NewIntegerProperty("Number of pages read at once (defaults to the server's BATCH_CONCURRENCY, max 16)").
+					WithMinimum(1).WithMaximum(16),
+			},
+			"page_ids",
+		),
+		confluenceGetPageViewsHandler,
+		"confluence", "read",
+	)
+}
+
+func confluenceGetPageViewsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		PageIDs     []string `arg:"page_ids" validate:"required"`
+		From        string   `arg:"from,trim"`
+		AllTime     bool     `arg:"all_time"`
+		Order       string   `arg:"order,trim" default:"desc" validate:"oneof=desc asc"`
+		Concurrency int      `arg:"concurrency"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+	if len(params.PageIDs) > maxPageViewsPages {
+		return nil, &mcp.ArgsError{Problems: []mcp.ArgProblem{{
+			Arg:     "page_ids",
+			Message: fmt.Sprintf("at most %d pages can be given, got %d", maxPageViewsPages, len(params.PageIDs)),
+		}}}
+	}
+
+	var since time.Time
+	if !params.AllTime {
+		since = time.Now().AddDate(0, 0, -30)
+		if params.From != "" {
+			t, err := time.Parse(pageViewsDateFormat, params.From)
+			if err != nil {
+				return nil, &mcp.ArgsError{Problems: []mcp.ArgProblem{{Arg: "from", Message: fmt.Sprintf("invalid date %q: use YYYY-MM-DD", params.From)}}}
+			}
+			since = t
+		}
+		since = time.Date(since.Year(), since.Month(), since.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+	if !client.IsCloud() {
+		return nil, fmt.Errorf("page views are only available on Confluence Cloud")
+	}
+
+	results := batch.Run(ctx, params.PageIDs, batch.Concurrency(ctx, params.Concurrency), func(ctx context.Context, pageID string) (interface{}, error) {
+		return client.GetPageViews(ctx, pageID, since)
+	})
+
+	pages := make([]*confluence.PageViews, 0, len(params.PageIDs))
+	var errors []string
+	for _, item := range results.Items {
+		if item.Status != batch.StatusOK {
+			errors = append(errors, item.Error)
+			continue
+		}
+		pages = append(pages, item.Result.(*confluence.PageViews))
+	}
+	sort.SliceStable(pages, func(i, j int) bool {
+		if params.Order == "asc" {
+			return pages[i].Views < pages[j].Views
+		}
+		return pages[i].Views > pages[j].Views
+	})
+
+	result := map[string]interface{}{
+		"pages": pages,
+		"count": len(pages),
+	}
+	if params.AllTime {
+		result["range"] = "all time"
+	} else {
+		result["from"] = since.Format(pageViewsDateFormat)
+	}
+	if len(errors) > 0 {
+		result["errors"] = errors
+	}
+	return mcp.NewJSONResult(result)
 }
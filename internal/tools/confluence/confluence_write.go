@@ -2,9 +2,11 @@ package confluence
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/pkg/atlassian/confluence"
 )
 
 // ConfluenceCreatePageTool creates the confluence_create_page tool
@@ -17,7 +19,7 @@ func ConfluenceCreatePageTool() *mcp.ToolDefinition {
 				"space_key": mcp.NewStringProperty("Space key where the page will be created (e.g., 'DOCS')"),
 				"title":     mcp.NewStringProperty("Page title"),
 				"body":      mcp.NewStringProperty("Page content/body"),
-				"format": mcp.NewStringProperty("Content format: 'storage' (Confluence storage format, default), 'markdown', or 'wiki'").
+				"format": mcp.NewStringProperty("Content format: 'storage' (Confluence storage format, default), 'markdown', or 'wiki'. Markdown supports tables, fenced code blocks (code macro), '> [!NOTE]' / '> [!TIP]' / '> [!WARNING]' alerts (info panels), 'jira:PROJ-123' for a live Jira issue, and a 'jira-table: <JQL>' line for a live table of Jira issues").
 					WithDefault("storage"),
 				"parent_id": mcp.NewStringProperty("Parent page ID (optional, for creating child pages)"),
 			},
@@ -29,19 +31,15 @@ func ConfluenceCreatePageTool() *mcp.ToolDefinition {
 }
 
 func confluenceCreatePageHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	spaceKey, ok := args["space_key"].(string)
-	if !ok || spaceKey == "" {
-		return nil, fmt.Errorf("space_key is required")
+	var params struct {
+		SpaceKey string `arg:"space_key,trim" validate:"required"`
+		Title    string `arg:"title" validate:"required"`
+		Body     string `arg:"body" validate:"required"`
+		Format   string `arg:"format,trim"`
+		ParentID string `arg:"parent_id,trim"`
 	}
-
-	title, ok := args["title"].(string)
-	if !ok || title == "" {
-		return nil, fmt.Errorf("title is required")
-	}
-
-	body, ok := args["body"].(string)
-	if !ok || body == "" {
-		return nil, fmt.Errorf("body is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetConfluenceClient(ctx)
@@ -49,50 +47,172 @@ func confluenceCreatePageHandler(ctx context.Context, args map[string]interface{
 		return nil, fmt.Errorf("Confluence client not available")
 	}
 
-	// Get format (default to storage)
-	format := "storage"
-	if f, ok := args["format"].(string); ok && f != "" {
-		format = f
+	// Convert content based on format (default to storage)
+	contentBody, err := convertToStorage(ctx, client, params.Body, params.Format)
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert content based on format
-	var contentBody string
-	var err error
+	page, err := client.CreatePage(ctx, params.SpaceKey, params.Title, contentBody, params.ParentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create page: %w", err)
+	}
+
+	result := map[string]interface{}{
+		"id":      page.ID,
+		"title":   page.Title,
+		"message": fmt.Sprintf("Successfully created page '%s'", page.Title),
+	}
+
+	// Add web UI link if available from page metadata
+	if page.Links != nil && page.Links.WebUI != "" {
+		result["webui"] = page.Links.WebUI
+	}
+
+	return mcp.NewJSONResult(result)
+}
+
+// convertToStorage converts a body in the given format ("storage", "markdown",
+// or "wiki"; empty means storage) to Confluence storage format
+func convertToStorage(ctx context.Context, client *confluence.Client, body, format string) (string, error) {
 	switch format {
 	case "markdown":
-		contentBody, err = client.ConvertMarkdownToStorage(ctx, body)
+		contentBody, err := client.ConvertMarkdownToStorage(ctx, body)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert markdown to storage format: %w", err)
+			return "", fmt.Errorf("failed to convert markdown to storage format: %w", err)
 		}
+		return contentBody, nil
 	case "wiki":
-		contentBody, err = client.ConvertWikiToStorage(ctx, body)
+		contentBody, err := client.ConvertWikiToStorage(ctx, body)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert wiki to storage format: %w", err)
+			return "", fmt.Errorf("failed to convert wiki to storage format: %w", err)
 		}
-	case "storage":
-		contentBody = body
+		return contentBody, nil
+	case "storage", "":
+		return body, nil
 	default:
-		return nil, fmt.Errorf("unsupported format: %s. Use 'storage', 'markdown', or 'wiki'", format)
+		return "", fmt.Errorf("unsupported format: %s. Use 'storage', 'markdown', or 'wiki'", format)
+	}
+}
+
+// ConfluenceCreateBlogPostTool creates the confluence_create_blogpost tool
+func ConfluenceCreateBlogPostTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_create_blogpost",
+		"Create a new Confluence blog post in a space, e.g. for announcements or retrospectives. Supports Markdown, Wiki markup, and Confluence storage format.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"space_key": mcp.NewStringProperty("Space key where the blog post will be published (e.g., 'ENG')"),
+				"title":     mcp.NewStringProperty("Blog post title"),
+				"body":      mcp.NewStringProperty("Blog post content/body"),
+				"format": mcp.NewStringProperty("Content format: 'storage' (default), 'markdown', or 'wiki'. Markdown is converted to storage format, including tables, code macros, info panels, and Jira issue macros ('jira:PROJ-123', or a 'jira-table: <JQL>' line)").
+					WithDefault("storage"),
+			},
+			"space_key", "title", "body",
+		),
+		confluenceCreateBlogPostHandler,
+		"confluence", "write",
+	)
+}
+
+func confluenceCreateBlogPostHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		SpaceKey string `arg:"space_key,trim" validate:"required"`
+		Title    string `arg:"title" validate:"required"`
+		Body     string `arg:"body" validate:"required"`
+		Format   string `arg:"format,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
 	}
 
-	// Get optional parent ID
-	parentID := ""
-	if p, ok := args["parent_id"].(string); ok {
-		parentID = p
+	contentBody, err := convertToStorage(ctx, client, params.Body, params.Format)
+	if err != nil {
+		return nil, err
 	}
 
-	page, err := client.CreatePage(ctx, spaceKey, title, contentBody, parentID)
+	post, err := client.CreateBlogPost(ctx, params.SpaceKey, params.Title, contentBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create page: %w", err)
+		return nil, fmt.Errorf("failed to create blog post: %w", err)
+	}
+
+	result := map[string]interface{}{
+		"id":      post.ID,
+		"title":   post.Title,
+		"message": fmt.Sprintf("Successfully created blog post '%s'", post.Title),
+	}
+
+	if post.Links != nil && post.Links.WebUI != "" {
+		result["webui"] = post.Links.WebUI
+	}
+
+	return mcp.NewJSONResult(result)
+}
+
+// ConfluenceCreatePageFromTemplateTool creates the confluence_create_page_from_template tool
+func ConfluenceCreatePageFromTemplateTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_create_page_from_template",
+		"Create a new Confluence page from a page template (see confluence_get_templates), filling in the template's variables.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"template_id": mcp.NewStringProperty("Template ID"),
+				"space_key":   mcp.NewStringProperty("Space key where the page will be created"),
+				"title":       mcp.NewStringProperty("Page title"),
+				"parent_id":   mcp.NewStringProperty("Parent page ID (optional, for creating child pages)"),
+				"variables":   mcp.NewStringProperty(`Template variable values as a JSON object (e.g., '{"owner": "Jane Doe", "date": "2024-05-01"}'). Variables without a value are left empty and reported`),
+			},
+			"template_id", "space_key", "title",
+		),
+		confluenceCreatePageFromTemplateHandler,
+		"confluence", "write",
+	)
+}
+
+func confluenceCreatePageFromTemplateHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		TemplateID string                 `arg:"template_id,trim" validate:"required"`
+		SpaceKey   string                 `arg:"space_key,trim" validate:"required"`
+		Title      string                 `arg:"title" validate:"required"`
+		ParentID   string                 `arg:"parent_id,trim"`
+		Variables  map[string]interface{} `arg:"variables"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]string, len(params.Variables))
+	for name, value := range params.Variables {
+		if str, ok := value.(string); ok {
+			vars[name] = str
+		} else {
+			vars[name] = fmt.Sprint(value)
+		}
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	page, missing, err := client.CreatePageFromTemplate(ctx, params.TemplateID, params.SpaceKey, params.Title, params.ParentID, vars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create page from template: %w", err)
 	}
 
 	result := map[string]interface{}{
 		"id":      page.ID,
 		"title":   page.Title,
-		"message": fmt.Sprintf("Successfully created page '%s'", page.Title),
+		"message": fmt.Sprintf("Successfully created page '%s' from template %s", page.Title, params.TemplateID),
+	}
+	if len(missing) > 0 {
+		result["missing_variables"] = missing
 	}
-
-	// Add web UI link if available from page metadata
 	if page.Links != nil && page.Links.WebUI != "" {
 		result["webui"] = page.Links.WebUI
 	}
@@ -111,8 +231,12 @@ func ConfluenceUpdatePageTool() *mcp.ToolDefinition {
 				"title":   mcp.NewStringProperty("New page title (optional, keeps existing if not provided)"),
 				"body":    mcp.NewStringProperty("New page content/body"),
 				"version": mcp.NewIntegerProperty("Current version number of the page (required for conflict detection)"),
-				"format": mcp.NewStringProperty("Content format: 'storage' (default), 'markdown', or 'wiki'").
+				"format": mcp.NewStringProperty("Content format: 'storage' (default), 'markdown', or 'wiki'. Markdown is converted to storage format, including tables, code macros, info panels, and Jira issue macros ('jira:PROJ-123', or a 'jira-table: <JQL>' line)").
 					WithDefault("storage"),
+				"minor_edit": mcp.NewBooleanProperty("Record the change as a minor edit, which doesn't notify watchers (e.g., for typo fixes and housekeeping)").
+					WithDefault(false),
+				"notify_watchers": mcp.NewBooleanProperty("Notify the page's watchers of the change; false makes it a minor edit").
+					WithDefault(true),
 			},
 			"page_id", "body", "version",
 		),
@@ -122,19 +246,17 @@ func ConfluenceUpdatePageTool() *mcp.ToolDefinition {
 }
 
 func confluenceUpdatePageHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	pageID, ok := args["page_id"].(string)
-	if !ok || pageID == "" {
-		return nil, fmt.Errorf("page_id is required")
+	var params struct {
+		PageID         string `arg:"page_id,trim" validate:"required"`
+		Body           string `arg:"body" validate:"required"`
+		Format         string `arg:"format,trim"`
+		MinorEdit      bool   `arg:"minor_edit"`
+		Version        int    `arg:"version" validate:"required,min=1"`
+		Title          string `arg:"title"`
+		NotifyWatchers *bool  `arg:"notify_watchers"`
 	}
-
-	body, ok := args["body"].(string)
-	if !ok || body == "" {
-		return nil, fmt.Errorf("body is required")
-	}
-
-	version := getIntArg(args, "version", 0)
-	if version == 0 {
-		return nil, fmt.Errorf("version is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetConfluenceClient(ctx)
@@ -143,43 +265,29 @@ func confluenceUpdatePageHandler(ctx context.Context, args map[string]interface{
 	}
 
 	// Get the current page to get the title if not provided
-	currentPage, err := client.GetPage(ctx, pageID, nil)
+	currentPage, err := client.GetPage(ctx, params.PageID, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current page: %w", err)
 	}
 
 	title := currentPage.Title
-	if t, ok := args["title"].(string); ok && t != "" {
-		title = t
+	if params.Title != "" {
+		title = params.Title
 	}
 
-	// Get format (default to storage)
-	format := "storage"
-	if f, ok := args["format"].(string); ok && f != "" {
-		format = f
+	// Convert content based on format (default to storage)
+	contentBody, err := convertToStorage(ctx, client, params.Body, params.Format)
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert content based on format
-	var contentBody string
-	switch format {
-	case "markdown":
-		contentBody, err = client.ConvertMarkdownToStorage(ctx, body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert markdown to storage format: %w", err)
-		}
-	case "wiki":
-		contentBody, err = client.ConvertWikiToStorage(ctx, body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert wiki to storage format: %w", err)
-		}
-	case "storage":
-		contentBody = body
-	default:
-		return nil, fmt.Errorf("unsupported format: %s. Use 'storage', 'markdown', or 'wiki'", format)
+	// Confluence only skips notifying watchers of minor edits
+	if params.NotifyWatchers != nil && !*params.NotifyWatchers {
+		params.MinorEdit = true
 	}
 
 	// Update the page with incremented version
-	page, err := client.UpdatePage(ctx, pageID, title, contentBody, version+1)
+	page, err := client.UpdatePageWithOptions(ctx, params.PageID, title, contentBody, params.Version+1, &confluence.UpdatePageOptions{MinorEdit: params.MinorEdit})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update page: %w", err)
 	}
@@ -209,9 +317,11 @@ func ConfluenceDeletePageTool() *mcp.ToolDefinition {
 }
 
 func confluenceDeletePageHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	pageID, ok := args["page_id"].(string)
-	if !ok || pageID == "" {
-		return nil, fmt.Errorf("page_id is required")
+	var params struct {
+		PageID string `arg:"page_id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetConfluenceClient(ctx)
@@ -219,12 +329,12 @@ func confluenceDeletePageHandler(ctx context.Context, args map[string]interface{
 		return nil, fmt.Errorf("Confluence client not available")
 	}
 
-	err := client.DeletePage(ctx, pageID)
+	err := client.DeletePage(ctx, params.PageID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete page: %w", err)
 	}
 
-	return mcp.NewSuccessResult(fmt.Sprintf("Successfully deleted page %s", pageID)), nil
+	return mcp.NewSuccessResult(fmt.Sprintf("Successfully deleted page %s", params.PageID)), nil
 }
 
 // ConfluenceAddLabelTool creates the confluence_add_label tool
@@ -247,14 +357,13 @@ func ConfluenceAddLabelTool() *mcp.ToolDefinition {
 }
 
 func confluenceAddLabelHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	contentID, ok := args["content_id"].(string)
-	if !ok || contentID == "" {
-		return nil, fmt.Errorf("content_id is required")
+	var params struct {
+		ContentID string `arg:"content_id,trim" validate:"required"`
+		Name      string `arg:"name" validate:"required"`
+		Prefix    string `arg:"prefix,trim" default:"global"`
 	}
-
-	name, ok := args["name"].(string)
-	if !ok || name == "" {
-		return nil, fmt.Errorf("name is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetConfluenceClient(ctx)
@@ -262,12 +371,7 @@ func confluenceAddLabelHandler(ctx context.Context, args map[string]interface{})
 		return nil, fmt.Errorf("Confluence client not available")
 	}
 
-	prefix := "global"
-	if p, ok := args["prefix"].(string); ok && p != "" {
-		prefix = p
-	}
-
-	label, err := client.AddLabel(ctx, contentID, name, prefix)
+	label, err := client.AddLabel(ctx, params.ContentID, params.Name, params.Prefix)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add label: %w", err)
 	}
@@ -275,7 +379,262 @@ func confluenceAddLabelHandler(ctx context.Context, args map[string]interface{})
 	return mcp.NewJSONResult(map[string]interface{}{
 		"name":    label.Name,
 		"prefix":  label.Prefix,
-		"message": fmt.Sprintf("Successfully added label '%s' to content %s", label.Name, contentID),
+		"message": fmt.Sprintf("Successfully added label '%s' to content %s", label.Name, params.ContentID),
+	})
+}
+
+// ConfluenceAddLabelsTool creates the confluence_add_labels tool
+func ConfluenceAddLabelsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_add_labels",
+		"Add several labels to a Confluence page or other content in one request.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"content_id": mcp.NewStringProperty("Content ID (page ID, blogpost ID, etc.)"),
+				"labels":     mcp.NewStringProperty("Comma-separated label names (e.g., 'runbook,team-infra')"),
+				"prefix": mcp.NewStringProperty("Label prefix: 'global' (default), 'my', or 'team'").
+					WithDefault("global"),
+			},
+			"content_id", "labels",
+		),
+		confluenceAddLabelsHandler,
+		"confluence", "write",
+	)
+}
+
+func confluenceAddLabelsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ContentID string   `arg:"content_id,trim" validate:"required"`
+		Labels    []string `arg:"labels" validate:"required"`
+		Prefix    string   `arg:"prefix,trim" default:"global"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	var requests []confluence.CreateLabelRequest
+	for _, name := range params.Labels {
+		requests = append(requests, confluence.CreateLabelRequest{Name: name, Prefix: params.Prefix})
+	}
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("labels must contain at least one label name")
+	}
+
+	labels, err := client.AddLabels(ctx, params.ContentID, requests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add labels: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"labels":  labels,
+		"message": fmt.Sprintf("Successfully added %d label(s) to content %s", len(requests), params.ContentID),
+	})
+}
+
+// ConfluenceRemoveLabelTool creates the confluence_remove_label tool
+func ConfluenceRemoveLabelTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_remove_label",
+		"Remove a label from a Confluence page or other content.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"content_id": mcp.NewStringProperty("Content ID (page ID, blogpost ID, etc.)"),
+				"name":       mcp.NewStringProperty("Label name to remove"),
+			},
+			"content_id", "name",
+		),
+		confluenceRemoveLabelHandler,
+		"confluence", "write",
+	)
+}
+
+func confluenceRemoveLabelHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ContentID string `arg:"content_id,trim" validate:"required"`
+		Name      string `arg:"name" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	if err := client.RemoveLabel(ctx, params.ContentID, params.Name); err != nil {
+		return nil, fmt.Errorf("failed to remove label: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"message": fmt.Sprintf("Successfully removed label '%s' from content %s", params.Name, params.ContentID),
+	})
+}
+
+// ConfluenceSetRestrictionsTool creates the confluence_set_restrictions tool
+func ConfluenceSetRestrictionsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_set_restrictions",
+		"Set who can view (read) or edit (update) a Confluence page. Replaces the users and groups for the given operation; restrictions for the other operation are kept. Pass no users or groups to remove the restriction.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"page_id":   mcp.NewStringProperty("Page ID"),
+				"operation": mcp.NewEnumProperty("Operation to restrict", confluence.RestrictionOperationRead, confluence.RestrictionOperationUpdate),
+				"users":     mcp.NewStringProperty("Comma-separated users allowed to perform the operation (account IDs on Cloud, usernames on Server/Data Center)"),
+				"groups":    mcp.NewStringProperty("Comma-separated group names allowed to perform the operation"),
+			},
+			"page_id", "operation",
+		),
+		confluenceSetRestrictionsHandler,
+		"confluence", "write",
+	)
+}
+
+func confluenceSetRestrictionsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		PageID    string   `arg:"page_id,trim" validate:"required"`
+		Operation string   `arg:"operation,trim" validate:"required,oneof=read update"`
+		Users     []string `arg:"users"`
+		Groups    []string `arg:"groups"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	// Setting restrictions replaces all of them, so carry over the other operation
+	current, err := client.GetRestrictions(ctx, params.PageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current restrictions: %w", err)
+	}
+
+	updates := []confluence.ContentRestrictionUpdate{
+		client.NewRestrictionUpdate(params.Operation, params.Users, params.Groups),
+	}
+	for _, r := range current {
+		if r.Operation == params.Operation || r.Restrictions == nil {
+			continue
+		}
+		var users, groups []string
+		if r.Restrictions.User != nil {
+			for _, u := range r.Restrictions.User.Results {
+				if u.AccountID != "" {
+					users = append(users, u.AccountID)
+				} else {
+					users = append(users, u.Username)
+				}
+			}
+		}
+		if r.Restrictions.Group != nil {
+			for _, g := range r.Restrictions.Group.Results {
+				groups = append(groups, g.Name)
+			}
+		}
+		if len(users) > 0 || len(groups) > 0 {
+			updates = append(updates, client.NewRestrictionUpdate(r.Operation, users, groups))
+		}
+	}
+
+	restrictions, err := client.SetRestrictions(ctx, params.PageID, updates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set restrictions: %w", err)
+	}
+
+	return mcp.NewJSONResult(summarizeRestrictions(params.PageID, restrictions))
+}
+
+// ConfluenceSetPropertyTool creates the confluence_set_property tool
+func ConfluenceSetPropertyTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_set_property",
+		"Create or update a content property: a JSON value stored on a page under a key, useful for lightweight structured metadata. Updates bump the property version automatically.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"content_id": mcp.NewStringProperty("Content ID (page ID, blogpost ID, etc.)"),
+				"key":        mcp.NewStringProperty("Property key"),
+				"value":      mcp.NewStringProperty("Property value as JSON (e.g., '{\"owner\": \"team-a\", \"reviewed\": true}'). Text that is not valid JSON is stored as a string"),
+			},
+			"content_id", "key", "value",
+		),
+		confluenceSetPropertyHandler,
+		"confluence", "write",
+	)
+}
+
+func confluenceSetPropertyHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ContentID string  `arg:"content_id,trim" validate:"required"`
+		Key       string  `arg:"key,trim" validate:"required"`
+		Value     *string `arg:"value" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(*params.Value), &value); err != nil {
+		value = *params.Value
+	}
+
+	property, err := client.SetContentProperty(ctx, params.ContentID, params.Key, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set property: %w", err)
+	}
+
+	return mcp.NewJSONResult(property)
+}
+
+// ConfluenceDeletePropertyTool creates the confluence_delete_property tool
+func ConfluenceDeletePropertyTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_delete_property",
+		"Delete a content property from a page.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"content_id": mcp.NewStringProperty("Content ID (page ID, blogpost ID, etc.)"),
+				"key":        mcp.NewStringProperty("Property key"),
+			},
+			"content_id", "key",
+		),
+		confluenceDeletePropertyHandler,
+		"confluence", "write",
+	)
+}
+
+func confluenceDeletePropertyHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ContentID string `arg:"content_id,trim" validate:"required"`
+		Key       string `arg:"key,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	if err := client.DeleteContentProperty(ctx, params.ContentID, params.Key); err != nil {
+		return nil, fmt.Errorf("failed to delete property: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"message": fmt.Sprintf("Successfully deleted property '%s' from content %s", params.Key, params.ContentID),
 	})
 }
 
@@ -283,11 +642,17 @@ func confluenceAddLabelHandler(ctx context.Context, args map[string]interface{})
 func ConfluenceAddCommentTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"confluence_add_comment",
-		"Add a comment to a Confluence page. Comments are useful for collaboration and feedback.",
+		"Add a comment to a Confluence page. Adds a footer comment by default, a reply when parent_comment_id is set, or an inline comment anchored to page text when inline_selection is set (Cloud only).",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"page_id": mcp.NewStringProperty("Page ID to comment on"),
 				"body":    mcp.NewStringProperty("Comment text/body (in Confluence storage format or plain text)"),
+				"format": mcp.NewEnumProperty("Body format: 'storage' (default) or 'markdown'", "storage", "markdown").
+					WithDefault("storage"),
+				"parent_comment_id": mcp.NewStringProperty("Comment ID to reply to (optional, creates a threaded reply)"),
+				"inline_selection":  mcp.NewStringProperty("Exact page text to anchor an inline comment to (optional, Cloud only)"),
+				"selection_match_index": mcp.NewIntegerProperty("Which occurrence of inline_selection to anchor to when it appears more than once (0-based, default 0)").
+					WithDefault(0),
 			},
 			"page_id", "body",
 		),
@@ -297,14 +662,16 @@ func ConfluenceAddCommentTool() *mcp.ToolDefinition {
 }
 
 func confluenceAddCommentHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	pageID, ok := args["page_id"].(string)
-	if !ok || pageID == "" {
-		return nil, fmt.Errorf("page_id is required")
+	var params struct {
+		PageID              string `arg:"page_id,trim" validate:"required"`
+		Body                string `arg:"body" validate:"required"`
+		ParentCommentID     string `arg:"parent_comment_id,trim"`
+		InlineSelection     string `arg:"inline_selection"`
+		SelectionMatchIndex int    `arg:"selection_match_index"`
+		Format              string `arg:"format,trim"`
 	}
-
-	body, ok := args["body"].(string)
-	if !ok || body == "" {
-		return nil, fmt.Errorf("body is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetConfluenceClient(ctx)
@@ -312,13 +679,162 @@ func confluenceAddCommentHandler(ctx context.Context, args map[string]interface{
 		return nil, fmt.Errorf("Confluence client not available")
 	}
 
-	comment, err := client.AddComment(ctx, pageID, body)
+	if params.Format == "markdown" {
+		params.Body = confluence.MarkdownToStorage(params.Body)
+	}
+
+	if params.ParentCommentID != "" && params.InlineSelection != "" {
+		return nil, fmt.Errorf("parent_comment_id and inline_selection cannot be combined; replies inherit the parent's anchor")
+	}
+
+	var comment *confluence.Comment
+	var err error
+	kind := "comment"
+	switch {
+	case params.ParentCommentID != "":
+		comment, err = client.ReplyToComment(ctx, params.PageID, params.ParentCommentID, params.Body)
+		kind = "reply"
+	case params.InlineSelection != "":
+		comment, err = client.AddInlineComment(ctx, &confluence.InlineCommentOptions{
+			PageID:     params.PageID,
+			Body:       params.Body,
+			Selection:  params.InlineSelection,
+			MatchIndex: params.SelectionMatchIndex,
+		})
+		kind = "inline comment"
+	default:
+		comment, err = client.AddComment(ctx, params.PageID, params.Body)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to add comment: %w", err)
+		return nil, fmt.Errorf("failed to add %s: %w", kind, err)
 	}
 
 	return mcp.NewJSONResult(map[string]interface{}{
 		"id":      comment.ID,
-		"message": fmt.Sprintf("Successfully added comment to page %s", pageID),
+		"message": fmt.Sprintf("Successfully added %s to page %s", kind, params.PageID),
+	})
+}
+
+// ConfluenceWatchPageTool creates the confluence_watch_page tool
+func ConfluenceWatchPageTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_watch_page",
+		"Watch a Confluence page, so you (or the given user) are notified when it changes or gets comments.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"page_id": mcp.NewStringProperty("Page ID to watch"),
+				"user":    mcp.NewStringProperty("Account ID (Cloud) or username (Server/DC) of the user who should watch the page; the authenticated user if omitted (see confluence_search_user)"),
+			},
+			"page_id",
+		),
+		confluenceWatchPageHandler,
+		"confluence", "write",
+	)
+}
+
+func confluenceWatchPageHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		PageID string `arg:"page_id,trim" validate:"required"`
+		User   string `arg:"user,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	if err := client.WatchPage(ctx, params.PageID, params.User); err != nil {
+		return nil, err
+	}
+	return mcp.NewSuccessResult(fmt.Sprintf("%s now watching page %s", watcherName(params.User), params.PageID)), nil
+}
+
+// ConfluenceUnwatchPageTool creates the confluence_unwatch_page tool
+func ConfluenceUnwatchPageTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_unwatch_page",
+		"Stop watching a Confluence page, so you (or the given user) are no longer notified of its changes.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"page_id": mcp.NewStringProperty("Page ID to stop watching"),
+				"user":    mcp.NewStringProperty("Account ID (Cloud) or username (Server/DC) of the user who should stop watching the page; the authenticated user if omitted"),
+			},
+			"page_id",
+		),
+		confluenceUnwatchPageHandler,
+		"confluence", "write",
+	)
+}
+
+func confluenceUnwatchPageHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		PageID string `arg:"page_id,trim" validate:"required"`
+		User   string `arg:"user,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	if err := client.UnwatchPage(ctx, params.PageID, params.User); err != nil {
+		return nil, err
+	}
+	return mcp.NewSuccessResult(fmt.Sprintf("%s no longer watching page %s", watcherName(params.User), params.PageID)), nil
+}
+
+// watcherName describes the user of a watch tool call in its result
+func watcherName(user string) string {
+	if user == "" {
+		return "You are"
+	}
+	return "User " + user + " is"
+}
+
+// ConfluenceCompleteTaskTool creates the confluence_complete_task tool
+func ConfluenceCompleteTaskTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"confluence_complete_task",
+		"Check off an inline task (action item) of a Confluence page. Get task IDs with confluence_get_tasks. On Server/DC the task's page is updated as a minor edit.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"task_id": mcp.NewStringProperty("Task ID, as returned by confluence_get_tasks"),
+				"page_id": mcp.NewStringProperty("Page the task is on (required on Server/DC, where task IDs are only unique within a page)"),
+			},
+			"task_id",
+		),
+		confluenceCompleteTaskHandler,
+		"confluence", "write",
+	)
+}
+
+func confluenceCompleteTaskHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		TaskID string `arg:"task_id,trim" validate:"required"`
+		PageID string `arg:"page_id,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetConfluenceClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Confluence client not available")
+	}
+
+	task, err := client.CompleteTask(ctx, params.PageID, params.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"task":    task,
+		"message": fmt.Sprintf("Successfully completed task %s", params.TaskID),
 	})
 }
@@ -3,15 +3,23 @@ package confluence
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/codeownersnet/atlas/internal/config"
 	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/raw"
+	"github.com/codeownersnet/atlas/internal/tools/session"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
 	"github.com/codeownersnet/atlas/pkg/atlassian/confluence"
 )
 
 // Context key for storing Confluence client
 type contextKey string
 
-const confluenceClientKey contextKey = "confluence_client"
+const (
+	confluenceClientKey    contextKey = "confluence_client"
+	confluenceInstancesKey contextKey = "confluence_instances"
+)
 
 // WithConfluenceClient adds a Confluence client to the context
 func WithConfluenceClient(ctx context.Context, client *confluence.Client) context.Context {
@@ -27,8 +35,24 @@ func GetConfluenceClient(ctx context.Context) *confluence.Client {
 	return client
 }
 
-// RegisterConfluenceTools registers all Confluence tools with the MCP server
-func RegisterConfluenceTools(server *mcp.Server) error {
+// WithConfluenceInstances adds named Confluence clients to the context
+func WithConfluenceInstances(ctx context.Context, clients map[string]*confluence.Client) context.Context {
+	return context.WithValue(ctx, confluenceInstancesKey, clients)
+}
+
+// GetConfluenceInstance retrieves a named Confluence client from the context
+func GetConfluenceInstance(ctx context.Context, name string) *confluence.Client {
+	clients, ok := ctx.Value(confluenceInstancesKey).(map[string]*confluence.Client)
+	if !ok {
+		return nil
+	}
+	return clients[strings.ToLower(name)]
+}
+
+// RegisterConfluenceTools registers all Confluence tools with the MCP server.
+// When instance names are given, every tool accepts an optional "instance"
+// argument that selects which named Confluence client handles the call.
+func RegisterConfluenceTools(server *mcp.Server, instances ...string) error {
 	tools := []struct {
 		name string
 		tool *mcp.ToolDefinition
@@ -36,20 +60,46 @@ func RegisterConfluenceTools(server *mcp.Server) error {
 		// Read operations
 		{"confluence_search", ConfluenceSearchTool()},
 		{"confluence_get_page", ConfluenceGetPageTool()},
+		{"confluence_resolve_url", ConfluenceResolveURLTool()},
 		{"confluence_get_page_children", ConfluenceGetPageChildrenTool()},
+		{"confluence_get_page_tree", ConfluenceGetPageTreeTool()},
+		{"confluence_get_page_ancestors", ConfluenceGetPageAncestorsTool()},
 		{"confluence_get_comments", ConfluenceGetCommentsTool()},
 		{"confluence_get_labels", ConfluenceGetLabelsTool()},
+		{"confluence_search_by_label", ConfluenceSearchByLabelTool()},
+		{"confluence_list_blogposts", ConfluenceListBlogPostsTool()},
 		{"confluence_search_user", ConfluenceSearchUserTool()},
+		{"confluence_get_current_user", ConfluenceGetCurrentUserTool()},
+		{"confluence_get_restrictions", ConfluenceGetRestrictionsTool()},
+		{"confluence_get_properties", ConfluenceGetPropertiesTool()},
+		{"confluence_get_templates", ConfluenceGetTemplatesTool()},
+		{"confluence_get_tasks", ConfluenceGetTasksTool()},
+		{"confluence_get_page_views", ConfluenceGetPageViewsTool()},
+		{"confluence_get_space_permissions", ConfluenceGetSpacePermissionsTool()},
 
 		// Write operations
 		{"confluence_create_page", ConfluenceCreatePageTool()},
+		{"confluence_create_page_from_template", ConfluenceCreatePageFromTemplateTool()},
 		{"confluence_update_page", ConfluenceUpdatePageTool()},
 		{"confluence_delete_page", ConfluenceDeletePageTool()},
+		{"confluence_create_blogpost", ConfluenceCreateBlogPostTool()},
 		{"confluence_add_label", ConfluenceAddLabelTool()},
+		{"confluence_add_labels", ConfluenceAddLabelsTool()},
+		{"confluence_remove_label", ConfluenceRemoveLabelTool()},
 		{"confluence_add_comment", ConfluenceAddCommentTool()},
+		{"confluence_set_restrictions", ConfluenceSetRestrictionsTool()},
+		{"confluence_set_property", ConfluenceSetPropertyTool()},
+		{"confluence_delete_property", ConfluenceDeletePropertyTool()},
+		{"confluence_watch_page", ConfluenceWatchPageTool()},
+		{"confluence_unwatch_page", ConfluenceUnwatchPageTool()},
+		{"confluence_complete_task", ConfluenceCompleteTaskTool()},
 	}
 
 	for _, t := range tools {
+		session.WithDefaultArgs(t.tool, session.ConfluenceArgs)
+		if len(instances) > 0 {
+			withInstanceArg(t.tool, instances)
+		}
 		if err := server.RegisterTool(t.tool); err != nil {
 			return fmt.Errorf("failed to register %s: %w", t.name, err)
 		}
@@ -57,3 +107,47 @@ func RegisterConfluenceTools(server *mcp.Server) error {
 
 	return nil
 }
+
+// RegisterConfluenceRawRequestTool registers confluence_raw_request, which
+// sends a request to any Confluence API path. In read-only mode it only sends
+// GET requests.
+func RegisterConfluenceRawRequestTool(server *mcp.Server, readOnly bool, instances ...string) error {
+	tool := raw.Tool("confluence", "Confluence", "/rest/api/space?limit=10", readOnly, func(ctx context.Context, method, path string, body []byte) (*atlassian.RawResponse, error) {
+		client := GetConfluenceClient(ctx)
+		if client == nil {
+			return nil, fmt.Errorf("Confluence client not available")
+		}
+		return client.Raw(ctx, method, path, body)
+	})
+	if len(instances) > 0 {
+		withInstanceArg(tool, instances)
+	}
+	return server.RegisterTool(tool)
+}
+
+// withInstanceArg adds the "instance" argument to a tool and wraps its handler
+// so the selected named client replaces the primary client in the context
+func withInstanceArg(def *mcp.ToolDefinition, instances []string) {
+	def.InputSchema.Properties["instance"] = mcp.NewEnumProperty(
+		"Confluence instance to use (defaults to the primary instance)",
+		append([]string{config.DefaultInstanceName}, instances...)...,
+	).WithDefault(config.DefaultInstanceName)
+
+	handler := def.Handler
+	def.Handler = func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+		var params struct {
+			Instance string `arg:"instance,trim"`
+		}
+		if err := mcp.Bind(args, &params); err != nil {
+			return nil, err
+		}
+		if params.Instance != "" && params.Instance != config.DefaultInstanceName {
+			client := GetConfluenceInstance(ctx, params.Instance)
+			if client == nil {
+				return nil, fmt.Errorf("Confluence instance %q is not configured", params.Instance)
+			}
+			ctx = WithConfluenceClient(ctx, client)
+		}
+		return handler(ctx, args)
+	}
+}
@@ -0,0 +1,41 @@
+// Package display holds the output settings shared by the tools of all
+// products, such as the timezone that times in summarized output are shown in.
+package display
+
+import (
+	"context"
+	"time"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+)
+
+type contextKey string
+
+const locationKey contextKey = "display_location"
+
+// WithLocation sets the timezone times are shown in
+func WithLocation(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, locationKey, loc)
+}
+
+// Location returns the timezone times are shown in, UTC if none is set
+func Location(ctx context.Context) *time.Location {
+	if loc, ok := ctx.Value(locationKey).(*time.Location); ok && loc != nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// Now returns the current time in the display timezone. Summaries render
+// times in the location of the now they are given, so passing it shows them
+// in the display timezone.
+func Now(ctx context.Context) time.Time {
+	return time.Now().In(Location(ctx))
+}
+
+// ParseTime parses an ISO 8601 time or a time expression such as "now-2d",
+// "start-of-week", or "tomorrow 09:00" relative to Now(ctx), so days and
+// times of day are those of the display timezone
+func ParseTime(ctx context.Context, expr string) (time.Time, error) {
+	return atlassian.ParseTime(expr, Now(ctx))
+}
@@ -0,0 +1,38 @@
+package display
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLocation(t *testing.T) {
+	ctx := context.Background()
+	if got := Location(ctx); got != time.UTC {
+		t.Errorf("Location() = %v, want UTC by default", got)
+	}
+
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	ctx = WithLocation(ctx, loc)
+	if got := Location(ctx); got != loc {
+		t.Errorf("Location() = %v, want %v", got, loc)
+	}
+	if got := Now(ctx).Location(); got != loc {
+		t.Errorf("Now() location = %v, want %v", got, loc)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	ctx := WithLocation(context.Background(), loc)
+
+	got, err := ParseTime(ctx, "tomorrow 09:00")
+	if err != nil {
+		t.Fatalf("ParseTime() error = %v", err)
+	}
+	tomorrow := time.Now().In(loc).AddDate(0, 0, 1)
+	want := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 9, 0, 0, 0, loc)
+	if !got.Equal(want) {
+		t.Errorf("ParseTime() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"context"
+	"errors"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+)
+
+// hintedError adds remediation hints to a Jira API error, so the agent can
+// correct the call instead of retrying it unchanged
+type hintedError struct {
+	err    error
+	apiErr *atlassian.Error
+	hints  []string
+}
+
+func (e *hintedError) Error() string     { return e.err.Error() }
+func (e *hintedError) Unwrap() error     { return e.err }
+func (e *hintedError) ErrorCode() string { return e.apiErr.ErrorCode() }
+
+// ErrorDetails returns the details of the API error with the hints
+func (e *hintedError) ErrorDetails() map[string]interface{} {
+	details := e.apiErr.ErrorDetails()
+	details["hints"] = e.hints
+	return details
+}
+
+// withRemediationHints wraps the handler of a tool so API errors it returns
+// carry remediation hints
+func withRemediationHints(def *mcp.ToolDefinition) {
+	handler := def.Handler
+	def.Handler = func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+		result, err := handler(ctx, args)
+		if err == nil {
+			return result, nil
+		}
+
+		var apiErr *atlassian.Error
+		if !errors.As(err, &apiErr) {
+			return result, err
+		}
+		if hints := jira.RemediationHints(apiErr); len(hints) > 0 {
+			return result, &hintedError{err: err, apiErr: apiErr, hints: hints}
+		}
+		return result, err
+	}
+}
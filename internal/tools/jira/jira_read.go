@@ -3,10 +3,13 @@ package jira
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"math"
+	"slices"
 	"strings"
 
 	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/batch"
+	"github.com/codeownersnet/atlas/internal/tools/display"
 	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
 )
 
@@ -14,25 +17,42 @@ import (
 func JiraGetIssueTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"jira_get_issue",
-		"Get detailed information about a Jira issue by key or ID. Supports field filtering ('essential', '*all', or comma-separated field names) and relationship expansion.",
+		"Get detailed information about a Jira issue by key or ID. Supports field profiles ('essential', 'issue-triage', ...), '*all', or comma-separated field names and relationship expansion.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"issue_key": mcp.NewStringProperty("Issue key (e.g., 'PROJ-123') or ID"),
-				"fields": mcp.NewStringProperty("Fields to retrieve: 'essential' (default), '*all', or comma-separated field names (e.g., 'summary,status,assignee')").
-					WithDefault("essential"),
-				"expand": mcp.NewStringProperty("Resources to expand (e.g., 'changelog,renderedFields'). Comma-separated."),
+				"fields":    mcp.NewStringProperty("Fields to retrieve: a field profile ('essential' (default), 'issue-summary', 'issue-triage', or a configured profile), '*all', or comma-separated field names (e.g., 'summary,status,assignee')"),
+				"expand":    mcp.NewStringProperty("Resources to expand (e.g., 'changelog,renderedFields,versionedRepresentations'). Comma-separated."),
+				"rendered_fields": mcp.NewBooleanProperty("Also return the fields rendered as HTML (renderedFields), e.g. to show a description as Jira displays it").
+					WithDefault(false),
+				"properties": mcp.NewStringProperty("Comma-separated issue property keys to return, or '*all' for every property"),
+				"fields_by_keys": mcp.NewBooleanProperty("Fields in 'fields' are field keys rather than IDs").
+					WithDefault(false),
+				"update_history": mcp.NewBooleanProperty("Add the issue to the authenticated user's recently viewed issues").
+					WithDefault(false),
+				"format": mcp.NewEnumProperty("Output format: 'json' (default) or 'markdown', a compact document with ADF converted to markdown that uses far fewer tokens", "json", "markdown").
+					WithDefault("json"),
 			},
 			"issue_key",
-		),
+		).WithOutputLimits(),
 		jiraGetIssueHandler,
 		"jira", "read",
 	)
 }
 
 func jiraGetIssueHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issueKey, ok := args["issue_key"].(string)
-	if !ok || issueKey == "" {
-		return nil, fmt.Errorf("issue_key is required")
+	var params struct {
+		IssueKey       string   `arg:"issue_key,trim" validate:"required"`
+		Fields         string   `arg:"fields,trim"`
+		Expand         []string `arg:"expand"`
+		RenderedFields bool     `arg:"rendered_fields"`
+		Properties     []string `arg:"properties"`
+		FieldsByKeys   bool     `arg:"fields_by_keys"`
+		UpdateHistory  bool     `arg:"update_history"`
+		Format         string   `arg:"format,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -40,27 +60,35 @@ func jiraGetIssueHandler(ctx context.Context, args map[string]interface{}) (*mcp
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	opts := &jira.GetIssueOptions{}
-
-	// Handle fields parameter
-	if fields, ok := args["fields"].(string); ok && fields != "" {
-		if fields == "*all" {
-			opts.Fields = []string{"*all"}
-		} else if fields != "essential" {
-			opts.Fields = strings.Split(fields, ",")
-		}
+	opts := &jira.GetIssueOptions{
+		Fields:        resolveFields(ctx, "jira_get_issue", params.Fields),
+		Expand:        params.Expand,
+		Properties:    params.Properties,
+		FieldsByKeys:  params.FieldsByKeys,
+		UpdateHistory: params.UpdateHistory,
 	}
-
-	// Handle expand parameter
-	if expand, ok := args["expand"].(string); ok && expand != "" {
-		opts.Expand = strings.Split(expand, ",")
+	if params.RenderedFields && !slices.Contains(opts.Expand, "renderedFields") {
+		opts.Expand = append(opts.Expand, "renderedFields")
 	}
 
-	issue, err := client.GetIssue(ctx, issueKey, opts)
+	issue, err := client.GetIssue(ctx, params.IssueKey, opts)
+	if jira.IsArchived(err) {
+		// Archived issues are a state to report, not a failure
+		return mcp.NewJSONResult(map[string]interface{}{
+			"key":      params.IssueKey,
+			"status":   "archived",
+			"archived": true,
+			"message":  fmt.Sprintf("Issue %s is archived: it must be restored in Jira before it can be read or changed", params.IssueKey),
+			"error":    err.Error(),
+		})
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get issue: %w", err)
 	}
 
+	if markdownFormat(params.Format) {
+		return mcp.NewSuccessResult(issue.ToMarkdownAt(display.Now(ctx))), nil
+	}
 	return mcp.NewJSONResult(issue)
 }
 
@@ -68,28 +96,41 @@ func jiraGetIssueHandler(ctx context.Context, args map[string]interface{}) (*mcp
 func JiraSearchTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"jira_search",
-		"Search for Jira issues using JQL (Jira Query Language). Supports pagination and field filtering.",
+		"Search for Jira issues using JQL (Jira Query Language). Supports pagination and field filtering. On Jira Cloud, get the next page by passing the result's nextPageToken as next_page_token; start_at only works on Server/Data Center.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
-				"jql": mcp.NewStringProperty("JQL query string (e.g., 'project = PROJ AND status = Open')"),
-				"fields": mcp.NewStringProperty("Fields to retrieve: 'essential' (default), '*all', or comma-separated field names").
-					WithDefault("essential"),
-				"start_at": mcp.NewIntegerProperty("Starting index for pagination (0-based)").
+				"jql":    mcp.NewStringProperty("JQL query string (e.g., 'project = PROJ AND status = Open'). '@me' stands for the authenticated user (e.g., 'assignee = @me')"),
+				"fields": mcp.NewStringProperty("Fields to retrieve: a field profile ('issue-summary' (default), 'essential', 'issue-triage', or a configured profile), '*all', or comma-separated field names"),
+				"start_at": mcp.NewIntegerProperty("Starting index for pagination (0-based). Server/Data Center only").
 					WithDefault(0),
+				"next_page_token": mcp.NewStringProperty("nextPageToken of the previous result, to get the next page (Jira Cloud)"),
 				"max_results": mcp.NewIntegerProperty("Maximum number of results to return (default 50)").
 					WithDefault(50),
+				"fetch_all": mcp.NewBooleanProperty("Follow pagination and return every matching issue, up to 1000 (start_at, next_page_token, and max_results are ignored)").
+					WithDefault(false),
+				"format": mcp.NewEnumProperty("Output format: 'json' (default) or 'markdown', a compact document with ADF converted to markdown that uses far fewer tokens", "json", "markdown").
+					WithDefault("json"),
 			},
 			"jql",
-		),
+		).WithOutputLimits().WithChunking(),
 		jiraSearchHandler,
 		"jira", "read",
 	)
 }
 
 func jiraSearchHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	jql, ok := args["jql"].(string)
-	if !ok || jql == "" {
-		return nil, fmt.Errorf("jql is required")
+	var params struct {
+		mcp.ChunkArgs
+		JQL           string `arg:"jql" validate:"required"`
+		Fields        string `arg:"fields,trim"`
+		StartAt       int    `arg:"start_at" default:"0"`
+		NextPageToken string `arg:"next_page_token,trim"`
+		MaxResults    int    `arg:"max_results" default:"50"`
+		FetchAll      bool   `arg:"fetch_all"`
+		Format        string `arg:"format,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -97,40 +138,114 @@ func jiraSearchHandler(ctx context.Context, args map[string]interface{}) (*mcp.C
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
+	jql := jira.ResolveMeJQL(params.JQL)
+
 	opts := &jira.SearchOptions{
-		StartAt:    getIntArg(args, "start_at", 0),
-		MaxResults: getIntArg(args, "max_results", 50),
-	}
-
-	// Handle fields parameter
-	if fields, ok := args["fields"].(string); ok && fields != "" {
-		if fields == "*all" {
-			opts.Fields = []string{"*all"}
-		} else if fields == "essential" {
-			// Essential fields for search results
-			opts.Fields = []string{
-				"summary", "status", "assignee", "reporter", "priority",
-				"issuetype", "project", "created", "updated", "key",
-			}
-		} else {
-			opts.Fields = strings.Split(fields, ",")
+		Fields:        resolveFields(ctx, "jira_search", params.Fields),
+		StartAt:       params.StartAt,
+		MaxResults:    params.MaxResults,
+		NextPageToken: params.NextPageToken,
+	}
+
+	if params.FetchAll {
+		opts.StartAt, opts.NextPageToken = 0, ""
+		result, err := client.SearchAllIssues(ctx, jql, opts, fetchAllLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search issues: %w", err)
 		}
-	} else {
-		// Default to essential fields if not specified
-		opts.Fields = []string{
-			"summary", "status", "assignee", "reporter", "priority",
-			"issuetype", "project", "created", "updated", "key",
+		if markdownFormat(params.Format) {
+			return mcp.NewSuccessResult(result.ToMarkdownAt(display.Now(ctx))), nil
 		}
+		return fetchAllResult(result, params.ChunkSize)
 	}
 
+	if err := checkSearchPage(client, params.StartAt); err != nil {
+		return nil, err
+	}
 	result, err := client.SearchIssues(ctx, jql, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search issues: %w", err)
 	}
 
+	if markdownFormat(params.Format) {
+		return mcp.NewSuccessResult(result.ToMarkdownAt(display.Now(ctx))), nil
+	}
 	return mcp.NewJSONResult(result)
 }
 
+// checkSearchPage rejects an offset where searches page with tokens, as on
+// Jira Cloud, instead of silently returning the first page again
+func checkSearchPage(client *jira.Client, startAt int) error {
+	if startAt > 0 && client.TokenPagination() {
+		return &mcp.ArgsError{Problems: []mcp.ArgProblem{{Arg: "start_at", Message: "only works on Jira Server/Data Center: on Jira Cloud, pass the nextPageToken of the previous result as next_page_token"}}}
+	}
+	return nil
+}
+
+// JiraBuildJQLTool creates the jira_build_jql tool
+func JiraBuildJQLTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_build_jql",
+		"Build a valid JQL query from structured filters, with values quoted and escaped. Pass the result to jira_search instead of writing JQL by hand. List arguments are comma-separated and match any of their values.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"project":       mcp.NewStringProperty("Project keys (e.g., 'PROJ,OPS')"),
+				"issue_type":    mcp.NewStringProperty("Issue type names (e.g., 'Bug,Story')"),
+				"status":        mcp.NewStringProperty("Status names (e.g., 'To Do,In Progress')"),
+				"assignee":      mcp.NewStringProperty("Assignee account ID (Cloud) or username (Server/DC), '@me' for the authenticated user, or 'unassigned'"),
+				"reporter":      mcp.NewStringProperty("Reporter account ID (Cloud) or username (Server/DC), or '@me'"),
+				"labels":        mcp.NewStringProperty("Labels (e.g., 'backend,urgent')"),
+				"text":          mcp.NewStringProperty("Full-text search in summary, description, and comments"),
+				"updated_since": mcp.NewStringProperty("Only issues updated since a relative date (e.g., '-7d', '-2w') or a date (e.g., '2025-01-15')"),
+				"created_since": mcp.NewStringProperty("Only issues created since a relative date (e.g., '-7d', '-2w') or a date (e.g., '2025-01-15')"),
+				"order_by":      mcp.NewStringProperty("Sort fields with optional ASC/DESC (e.g., 'priority DESC, updated')"),
+			},
+		),
+		jiraBuildJQLHandler,
+		"jira", "read",
+	)
+}
+
+func jiraBuildJQLHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Projects     []string `arg:"project"`
+		IssueTypes   []string `arg:"issue_type"`
+		Statuses     []string `arg:"status"`
+		Assignee     string   `arg:"assignee,trim"`
+		Reporter     string   `arg:"reporter,trim"`
+		Labels       []string `arg:"labels"`
+		Text         string   `arg:"text"`
+		UpdatedSince string   `arg:"updated_since,trim"`
+		CreatedSince string   `arg:"created_since,trim"`
+		OrderBy      string   `arg:"order_by,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	filter := &jira.JQLFilter{
+		Projects:     params.Projects,
+		IssueTypes:   params.IssueTypes,
+		Statuses:     params.Statuses,
+		Assignee:     params.Assignee,
+		Reporter:     params.Reporter,
+		Labels:       params.Labels,
+		Text:         params.Text,
+		UpdatedSince: params.UpdatedSince,
+		CreatedSince: params.CreatedSince,
+		OrderBy:      params.OrderBy,
+	}
+
+	jql, err := filter.JQL()
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"jql": jql,
+	})
+}
+
 // JiraSearchFieldsTool creates the jira_search_fields tool
 func JiraSearchFieldsTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
@@ -147,6 +262,13 @@ func JiraSearchFieldsTool() *mcp.ToolDefinition {
 }
 
 func jiraSearchFieldsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Query string `arg:"query"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
 	client := GetJiraClient(ctx)
 	if client == nil {
 		return nil, fmt.Errorf("Jira client not available")
@@ -158,8 +280,8 @@ func jiraSearchFieldsHandler(ctx context.Context, args map[string]interface{}) (
 	}
 
 	// Filter fields if query is provided
-	if query, ok := args["query"].(string); ok && query != "" {
-		query = strings.ToLower(query)
+	if params.Query != "" {
+		query := strings.ToLower(params.Query)
 		filtered := make([]jira.Field, 0)
 		for _, field := range fields {
 			if strings.Contains(strings.ToLower(field.Name), query) ||
@@ -180,10 +302,12 @@ func jiraSearchFieldsHandler(ctx context.Context, args map[string]interface{}) (
 func JiraGetAllProjectsTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"jira_get_all_projects",
-		"List all accessible Jira projects with optional expansion of project details.",
+		"List all accessible Jira projects with optional expansion of project details. Archived projects are left out unless include_archived is set.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"expand": mcp.NewStringProperty("Resources to expand (e.g., 'description,lead,issueTypes'). Comma-separated."),
+				"include_archived": mcp.NewBooleanProperty("Also list archived projects, marked with archived: true (listing them may require admin permissions)").
+					WithDefault(false),
 			},
 		),
 		jiraGetAllProjectsHandler,
@@ -192,15 +316,22 @@ func JiraGetAllProjectsTool() *mcp.ToolDefinition {
 }
 
 func jiraGetAllProjectsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Expand          []string `arg:"expand"`
+		IncludeArchived bool     `arg:"include_archived"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
 	client := GetJiraClient(ctx)
 	if client == nil {
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	opts := &jira.GetProjectsOptions{}
-
-	if expand, ok := args["expand"].(string); ok && expand != "" {
-		opts.Expand = strings.Split(expand, ",")
+	opts := &jira.GetProjectsOptions{
+		Expand:          params.Expand,
+		IncludeArchived: params.IncludeArchived,
 	}
 
 	projects, err := client.GetAllProjects(ctx, opts)
@@ -218,28 +349,38 @@ func jiraGetAllProjectsHandler(ctx context.Context, args map[string]interface{})
 func JiraGetProjectIssuesTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"jira_get_project_issues",
-		"Get all issues for a specific Jira project with pagination support.",
+		"Get all issues for a specific Jira project with pagination support. On Jira Cloud, get the next page by passing the result's nextPageToken as next_page_token; start_at only works on Server/Data Center.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"project_key": mcp.NewStringProperty("Project key (e.g., 'PROJ')"),
-				"fields": mcp.NewStringProperty("Fields to retrieve: 'essential' (default), '*all', or comma-separated field names").
-					WithDefault("essential"),
-				"start_at": mcp.NewIntegerProperty("Starting index for pagination (0-based)").
+				"fields":      mcp.NewStringProperty("Fields to retrieve: a field profile ('issue-summary' (default), 'essential', 'issue-triage', or a configured profile), '*all', or comma-separated field names"),
+				"start_at": mcp.NewIntegerProperty("Starting index for pagination (0-based). Server/Data Center only").
 					WithDefault(0),
+				"next_page_token": mcp.NewStringProperty("nextPageToken of the previous result, to get the next page (Jira Cloud)"),
 				"max_results": mcp.NewIntegerProperty("Maximum number of results to return (default 50)").
 					WithDefault(50),
+				"fetch_all": mcp.NewBooleanProperty("Follow pagination and return every matching issue, up to 1000 (start_at, next_page_token, and max_results are ignored)").
+					WithDefault(false),
 			},
 			"project_key",
-		),
+		).WithChunking(),
 		jiraGetProjectIssuesHandler,
 		"jira", "read",
 	)
 }
 
 func jiraGetProjectIssuesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	projectKey, ok := args["project_key"].(string)
-	if !ok || projectKey == "" {
-		return nil, fmt.Errorf("project_key is required")
+	var params struct {
+		mcp.ChunkArgs
+		ProjectKey    string `arg:"project_key,trim" validate:"required"`
+		Fields        string `arg:"fields,trim"`
+		StartAt       int    `arg:"start_at" default:"0"`
+		NextPageToken string `arg:"next_page_token,trim"`
+		MaxResults    int    `arg:"max_results" default:"50"`
+		FetchAll      bool   `arg:"fetch_all"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -248,19 +389,25 @@ func jiraGetProjectIssuesHandler(ctx context.Context, args map[string]interface{
 	}
 
 	opts := &jira.SearchOptions{
-		StartAt:    getIntArg(args, "start_at", 0),
-		MaxResults: getIntArg(args, "max_results", 50),
+		Fields:        resolveFields(ctx, "jira_get_project_issues", params.Fields),
+		StartAt:       params.StartAt,
+		MaxResults:    params.MaxResults,
+		NextPageToken: params.NextPageToken,
 	}
 
-	if fields, ok := args["fields"].(string); ok && fields != "" {
-		if fields == "*all" {
-			opts.Fields = []string{"*all"}
-		} else if fields != "essential" {
-			opts.Fields = strings.Split(fields, ",")
+	if params.FetchAll {
+		opts.StartAt, opts.NextPageToken = 0, ""
+		result, err := client.SearchAllIssues(ctx, jira.ProjectIssuesJQL(params.ProjectKey), opts, fetchAllLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project issues: %w", err)
 		}
+		return fetchAllResult(result, params.ChunkSize)
 	}
 
-	result, err := client.GetProjectIssues(ctx, projectKey, opts)
+	if err := checkSearchPage(client, params.StartAt); err != nil {
+		return nil, err
+	}
+	result, err := client.GetProjectIssues(ctx, params.ProjectKey, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project issues: %w", err)
 	}
@@ -285,9 +432,11 @@ func JiraGetProjectVersionsTool() *mcp.ToolDefinition {
 }
 
 func jiraGetProjectVersionsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	projectKey, ok := args["project_key"].(string)
-	if !ok || projectKey == "" {
-		return nil, fmt.Errorf("project_key is required")
+	var params struct {
+		ProjectKey string `arg:"project_key,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -295,7 +444,7 @@ func jiraGetProjectVersionsHandler(ctx context.Context, args map[string]interfac
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	versions, err := client.GetProjectVersions(ctx, projectKey)
+	versions, err := client.GetProjectVersions(ctx, params.ProjectKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project versions: %w", err)
 	}
@@ -306,6 +455,88 @@ func jiraGetProjectVersionsHandler(ctx context.Context, args map[string]interfac
 	})
 }
 
+// JiraGetSecurityLevelsTool creates the jira_get_security_levels tool
+func JiraGetSecurityLevelsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_get_security_levels",
+		"Get the issue security levels of a Jira project that the authenticated user can set. Pass a level's name or ID as security_level to jira_create_issue to restrict who can see the issue.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"project_key": mcp.NewStringProperty("Project key (e.g., 'PROJ')"),
+			},
+			"project_key",
+		),
+		jiraGetSecurityLevelsHandler,
+		"jira", "read",
+	)
+}
+
+func jiraGetSecurityLevelsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ProjectKey string `arg:"project_key,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	levels, err := client.GetProjectSecurityLevels(ctx, params.ProjectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get security levels: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"security_levels": levels,
+		"total":           len(levels),
+	})
+}
+
+// JiraGetProjectRolesTool creates the jira_get_project_roles tool
+func JiraGetProjectRolesTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_get_project_roles",
+		"Get the roles of a Jira project (e.g., Administrators, Developers) with the users and groups in each role.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"project_key": mcp.NewStringProperty("Project key (e.g., 'PROJ')"),
+				"role":        mcp.NewStringProperty("Only return the role with this name"),
+			},
+			"project_key",
+		),
+		jiraGetProjectRolesHandler,
+		"jira", "read",
+	)
+}
+
+func jiraGetProjectRolesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ProjectKey string `arg:"project_key,trim" validate:"required"`
+		Role       string `arg:"role,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	roles, err := client.GetProjectRoles(ctx, params.ProjectKey, params.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project roles: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"roles": roles,
+		"total": len(roles),
+	})
+}
+
 // JiraGetTransitionsTool creates the jira_get_transitions tool
 func JiraGetTransitionsTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
@@ -323,9 +554,11 @@ func JiraGetTransitionsTool() *mcp.ToolDefinition {
 }
 
 func jiraGetTransitionsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issueKey, ok := args["issue_key"].(string)
-	if !ok || issueKey == "" {
-		return nil, fmt.Errorf("issue_key is required")
+	var params struct {
+		IssueKey string `arg:"issue_key,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -333,7 +566,7 @@ func jiraGetTransitionsHandler(ctx context.Context, args map[string]interface{})
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	transitions, err := client.GetTransitions(ctx, issueKey)
+	transitions, err := client.GetTransitions(ctx, params.IssueKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transitions: %w", err)
 	}
@@ -361,9 +594,11 @@ func JiraGetWorklogTool() *mcp.ToolDefinition {
 }
 
 func jiraGetWorklogHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issueKey, ok := args["issue_key"].(string)
-	if !ok || issueKey == "" {
-		return nil, fmt.Errorf("issue_key is required")
+	var params struct {
+		IssueKey string `arg:"issue_key,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -371,15 +606,136 @@ func jiraGetWorklogHandler(ctx context.Context, args map[string]interface{}) (*m
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	worklogs, err := client.GetWorklogs(ctx, issueKey)
+	worklogs, err := client.GetWorklogs(ctx, params.IssueKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get worklogs: %w", err)
 	}
 
+	seconds := 0
+	for _, worklog := range worklogs {
+		seconds += worklog.TimeSpentSeconds
+	}
+
 	return mcp.NewJSONResult(map[string]interface{}{
-		"worklogs": worklogs,
-		"total":    len(worklogs),
+		"worklogs":   worklogs,
+		"total":      len(worklogs),
+		"total_time": client.TimeTracking(ctx).FormatDuration(seconds),
+	})
+}
+
+// worklogDateFormat is the layout of worklog report dates
+const worklogDateFormat = "2006-01-02"
+
+// JiraWorklogReportTool creates the jira_worklog_report tool
+func JiraWorklogReportTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_worklog_report",
+		"Report the time logged on the issues of a JQL query or project within a date range, grouped by user and by issue, with totals in hours and as Jira durations (e.g. '1d 2h'). Only worklogs started within the range count.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"jql":         mcp.NewStringProperty("JQL selecting the issues (e.g., 'sprint in openSprints()'); combined with project_key if both are given"),
+				"project_key": mcp.NewStringProperty("Project whose issues are reported"),
+				"from":        mcp.NewStringProperty("First day of the range (YYYY-MM-DD or an expression such as 'start-of-week'; defaults to 7 days before to)"),
+				"to":          mcp.NewStringProperty("Last day of the range, inclusive (YYYY-MM-DD or an expression such as 'yesterday'; defaults to today)"),
+				"max_issues":  mcp.NewIntegerProperty("Maximum number of issues whose worklogs are read").WithDefault(200),
+				"concurrency": mcp.NewIntegerProperty("Number of issues whose worklogs are read at once (defaults to the server's BATCH_CONCURRENCY, max 16)").
+					WithMinimum(1).WithMaximum(16),
+			},
+		),
+		jiraWorklogReportHandler,
+		"jira", "read",
+	)
+}
+
+func jiraWorklogReportHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		JQL         string `arg:"jql"`
+		ProjectKey  string `arg:"project_key,trim"`
+		From        string `arg:"from,trim"`
+		To          string `arg:"to,trim"`
+		MaxIssues   int    `arg:"max_issues" default:"200" validate:"min=1"`
+		Concurrency int    `arg:"concurrency"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	if params.JQL == "" && params.ProjectKey == "" {
+		return nil, fmt.Errorf("jql or project_key is required")
+	}
+
+	to := display.Now(ctx)
+	if params.To != "" {
+		t, err := display.ParseTime(ctx, params.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to: %w", err)
+		}
+		to = t
+	}
+	from := to.AddDate(0, 0, -7)
+	if params.From != "" {
+		t, err := display.ParseTime(ctx, params.From)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from: %w", err)
+		}
+		from = t
+	}
+	fromDay, toDay := from.Format(worklogDateFormat), to.Format(worklogDateFormat)
+	if fromDay > toDay {
+		return nil, fmt.Errorf("from %s is after to %s", fromDay, toDay)
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	// worklogDate narrows the search to issues with time logged in the range;
+	// the worklogs themselves are filtered on their start day
+	var clauses []string
+	if params.ProjectKey != "" {
+		clauses = append(clauses, "project = "+jira.QuoteJQL(params.ProjectKey))
+	}
+	if params.JQL != "" {
+		clauses = append(clauses, "("+jira.StripOrderBy(params.JQL)+")")
+	}
+	clauses = append(clauses, fmt.Sprintf(`worklogDate >= "%s" AND worklogDate <= "%s"`, fromDay, toDay))
+	query := strings.Join(clauses, " AND ") + " ORDER BY key ASC"
+
+	searchResult, err := client.SearchAllIssues(ctx, query, &jira.SearchOptions{Fields: []string{"summary"}}, params.MaxIssues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	results := batch.Run(ctx, searchResult.Issues, batch.Concurrency(ctx, params.Concurrency), func(ctx context.Context, issue jira.Issue) (interface{}, error) {
+		return client.GetWorklogs(ctx, issue.Key)
 	})
+
+	worklogs := make(map[string][]jira.Worklog, len(searchResult.Issues))
+	var errors []string
+	for _, item := range results.Items {
+		if item.Status != batch.StatusOK {
+			errors = append(errors, item.Error)
+			continue
+		}
+		worklogs[searchResult.Issues[item.Index].Key] = item.Result.([]jira.Worklog)
+	}
+
+	report := jira.BuildWorklogReport(searchResult.Issues, worklogs, fromDay, toDay, client.TimeTracking(ctx))
+
+	result := map[string]interface{}{
+		"report":         report,
+		"issues_scanned": len(searchResult.Issues),
+		"jql":            query,
+	}
+	if searchResult.HasMore() {
+		result["truncated"] = true
+		result["message"] = fmt.Sprintf("Only the first %d issues were read; raise max_issues or narrow the query for complete totals", len(searchResult.Issues))
+	}
+	if len(errors) > 0 {
+		result["errors"] = errors
+	}
+	return mcp.NewJSONResult(result)
 }
 
 // JiraGetAgileBoardsTool creates the jira_get_agile_boards tool
@@ -400,23 +756,24 @@ func JiraGetAgileBoardsTool() *mcp.ToolDefinition {
 }
 
 func jiraGetAgileBoardsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	client := GetJiraClient(ctx)
-	if client == nil {
-		return nil, fmt.Errorf("Jira client not available")
+	var params struct {
+		ProjectKey string `arg:"project_key,trim"`
+		BoardType  string `arg:"board_type,trim"`
+		Name       string `arg:"name"`
 	}
-
-	opts := &jira.GetBoardsOptions{}
-
-	if projectKey, ok := args["project_key"].(string); ok && projectKey != "" {
-		opts.ProjectKeyOrID = projectKey
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
-	if boardType, ok := args["board_type"].(string); ok && boardType != "" {
-		opts.BoardType = boardType
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	if name, ok := args["name"].(string); ok && name != "" {
-		opts.Name = name
+	opts := &jira.GetBoardsOptions{
+		ProjectKeyOrID: params.ProjectKey,
+		BoardType:      params.BoardType,
+		Name:           params.Name,
 	}
 
 	boards, err := client.GetBoards(ctx, opts)
@@ -438,6 +795,7 @@ func JiraGetBoardIssuesTool() *mcp.ToolDefinition {
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"board_id": mcp.NewIntegerProperty("Board ID"),
+				"fields":   mcp.NewStringProperty("Fields to retrieve: a field profile ('issue-summary' (default), 'essential', 'issue-triage', or a configured profile), '*all', or comma-separated field names"),
 				"start_at": mcp.NewIntegerProperty("Starting index for pagination (0-based)").
 					WithDefault(0),
 				"max_results": mcp.NewIntegerProperty("Maximum number of results to return (default 50)").
@@ -451,9 +809,14 @@ func JiraGetBoardIssuesTool() *mcp.ToolDefinition {
 }
 
 func jiraGetBoardIssuesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	boardID := getIntArg(args, "board_id", 0)
-	if boardID == 0 {
-		return nil, fmt.Errorf("board_id is required")
+	var params struct {
+		BoardID    int    `arg:"board_id" validate:"required"`
+		Fields     string `arg:"fields,trim"`
+		StartAt    int    `arg:"start_at" default:"0"`
+		MaxResults int    `arg:"max_results" default:"50"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -462,11 +825,12 @@ func jiraGetBoardIssuesHandler(ctx context.Context, args map[string]interface{})
 	}
 
 	opts := &jira.SearchOptions{
-		StartAt:    getIntArg(args, "start_at", 0),
-		MaxResults: getIntArg(args, "max_results", 50),
+		Fields:     resolveFields(ctx, "jira_get_board_issues", params.Fields),
+		StartAt:    params.StartAt,
+		MaxResults: params.MaxResults,
 	}
 
-	result, err := client.GetBoardIssues(ctx, boardID, opts)
+	result, err := client.GetBoardIssues(ctx, params.BoardID, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get board issues: %w", err)
 	}
@@ -482,7 +846,8 @@ func JiraGetSprintsFromBoardTool() *mcp.ToolDefinition {
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"board_id": mcp.NewIntegerProperty("Board ID"),
-				"state":    mcp.NewStringProperty("Filter by sprint state: 'future', 'active', or 'closed'"),
+				"state": mcp.NewStringProperty("Filter by sprint state: 'future', 'active', or 'closed'").
+					WithEnum("future", "active", "closed"),
 			},
 			"board_id",
 		),
@@ -492,9 +857,12 @@ func JiraGetSprintsFromBoardTool() *mcp.ToolDefinition {
 }
 
 func jiraGetSprintsFromBoardHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	boardID := getIntArg(args, "board_id", 0)
-	if boardID == 0 {
-		return nil, fmt.Errorf("board_id is required")
+	var params struct {
+		BoardID int    `arg:"board_id" validate:"required"`
+		State   string `arg:"state,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -502,12 +870,7 @@ func jiraGetSprintsFromBoardHandler(ctx context.Context, args map[string]interfa
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	state := ""
-	if s, ok := args["state"].(string); ok {
-		state = s
-	}
-
-	sprints, err := client.GetBoardSprints(ctx, boardID, state)
+	sprints, err := client.GetBoardSprints(ctx, params.BoardID, params.State)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get sprints: %w", err)
 	}
@@ -526,6 +889,7 @@ func JiraGetSprintIssuesTool() *mcp.ToolDefinition {
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"sprint_id": mcp.NewIntegerProperty("Sprint ID"),
+				"fields":    mcp.NewStringProperty("Fields to retrieve: a field profile ('issue-summary' (default), 'essential', 'issue-triage', or a configured profile), '*all', or comma-separated field names"),
 				"start_at": mcp.NewIntegerProperty("Starting index for pagination (0-based)").
 					WithDefault(0),
 				"max_results": mcp.NewIntegerProperty("Maximum number of results to return (default 50)").
@@ -539,9 +903,14 @@ func JiraGetSprintIssuesTool() *mcp.ToolDefinition {
 }
 
 func jiraGetSprintIssuesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	sprintID := getIntArg(args, "sprint_id", 0)
-	if sprintID == 0 {
-		return nil, fmt.Errorf("sprint_id is required")
+	var params struct {
+		SprintID   int    `arg:"sprint_id" validate:"required"`
+		Fields     string `arg:"fields,trim"`
+		StartAt    int    `arg:"start_at" default:"0"`
+		MaxResults int    `arg:"max_results" default:"50"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -550,11 +919,12 @@ func jiraGetSprintIssuesHandler(ctx context.Context, args map[string]interface{}
 	}
 
 	opts := &jira.SearchOptions{
-		StartAt:    getIntArg(args, "start_at", 0),
-		MaxResults: getIntArg(args, "max_results", 50),
+		Fields:     resolveFields(ctx, "jira_get_sprint_issues", params.Fields),
+		StartAt:    params.StartAt,
+		MaxResults: params.MaxResults,
 	}
 
-	result, err := client.GetSprintIssues(ctx, sprintID, opts)
+	result, err := client.GetSprintIssues(ctx, params.SprintID, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get sprint issues: %w", err)
 	}
@@ -562,6 +932,108 @@ func jiraGetSprintIssuesHandler(ctx context.Context, args map[string]interface{}
 	return mcp.NewJSONResult(result)
 }
 
+// JiraSprintReportTool creates the jira_sprint_report tool
+func JiraSprintReportTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_sprint_report",
+		"Summarize a sprint: completed vs. not completed (carried over) issues, issues removed from the sprint, scope added after the sprint started, and story point totals. Uses the Jira Software sprint report when available, otherwise computes the report from the sprint's issues.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"sprint_id":          mcp.NewIntegerProperty("Sprint ID"),
+				"board_id":           mcp.NewIntegerProperty("Board ID for the sprint report (defaults to the sprint's origin board)"),
+				"story_points_field": mcp.NewStringProperty("Story points field ID used when the report is computed (e.g., 'customfield_10016'; detected automatically if omitted)"),
+			},
+			"sprint_id",
+		),
+		jiraSprintReportHandler,
+		"jira", "read",
+	)
+}
+
+func jiraSprintReportHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		SprintID         int    `arg:"sprint_id" validate:"required"`
+		BoardID          int    `arg:"board_id"`
+		StoryPointsField string `arg:"story_points_field,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	sprint, err := client.GetSprint(ctx, params.SprintID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sprint: %w", err)
+	}
+
+	if params.BoardID == 0 {
+		params.BoardID = sprint.OriginBoardID
+	}
+
+	var report *jira.SprintReport
+	var fallbackReason string
+	if params.BoardID > 0 {
+		report, err = client.GetSprintReport(ctx, params.BoardID, params.SprintID)
+		if err != nil {
+			fallbackReason = err.Error()
+		}
+	} else {
+		fallbackReason = "sprint has no board"
+	}
+
+	if report == nil {
+		pointsField := params.StoryPointsField
+		if pointsField == "" {
+			if field, err := client.GetStoryPointsField(ctx); err == nil {
+				pointsField = field.ID
+			}
+		}
+
+		report, err = client.ComputeSprintReport(ctx, params.SprintID, pointsField)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute sprint report: %w", err)
+		}
+	}
+	report.Sprint = sprint
+
+	completionRate := 0.0
+	if report.TotalPoints > 0 {
+		completionRate = report.CompletedPoints / report.TotalPoints * 100
+	} else if total := len(report.Completed) + len(report.NotCompleted); total > 0 {
+		completionRate = float64(len(report.Completed)) / float64(total) * 100
+	}
+
+	result := map[string]interface{}{
+		"sprint": sprint,
+		"source": report.Source,
+		"summary": map[string]interface{}{
+			"completed_issues":     len(report.Completed),
+			"not_completed_issues": len(report.NotCompleted),
+			"removed_issues":       len(report.Removed),
+			"added_issues":         len(report.AddedKeys),
+			"completed_points":     report.CompletedPoints,
+			"not_completed_points": report.NotCompletedPoints,
+			"removed_points":       report.RemovedPoints,
+			"added_points":         report.AddedPoints,
+			"total_points":         report.TotalPoints,
+			"completion_rate":      math.Round(completionRate*10) / 10,
+		},
+		"completed":     report.Completed,
+		"not_completed": report.NotCompleted,
+		"removed":       report.Removed,
+		"added_keys":    report.AddedKeys,
+	}
+	if fallbackReason != "" {
+		result["fallback_reason"] = fallbackReason
+	}
+
+	return mcp.NewJSONResult(result)
+}
+
 // JiraGetIssueLinkTypesTool creates the jira_get_issue_link_types tool
 func JiraGetIssueLinkTypesTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
@@ -607,6 +1079,14 @@ func JiraGetUserProfileTool() *mcp.ToolDefinition {
 }
 
 func jiraGetUserProfileHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		AccountID string `arg:"account_id,trim"`
+		Username  string `arg:"username,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
 	client := GetJiraClient(ctx)
 	if client == nil {
 		return nil, fmt.Errorf("Jira client not available")
@@ -615,10 +1095,10 @@ func jiraGetUserProfileHandler(ctx context.Context, args map[string]interface{})
 	var user *jira.User
 	var err error
 
-	if accountID, ok := args["account_id"].(string); ok && accountID != "" {
-		user, err = client.GetUser(ctx, accountID)
-	} else if username, ok := args["username"].(string); ok && username != "" {
-		user, err = client.GetUser(ctx, username)
+	if params.AccountID != "" {
+		user, err = client.GetUser(ctx, params.AccountID)
+	} else if params.Username != "" {
+		user, err = client.GetUser(ctx, params.Username)
 	} else {
 		return nil, fmt.Errorf("either account_id (Cloud) or username (Server/DC) is required")
 	}
@@ -630,21 +1110,592 @@ func jiraGetUserProfileHandler(ctx context.Context, args map[string]interface{})
 	return mcp.NewJSONResult(user)
 }
 
-// Helper function to get integer argument with default
-func getIntArg(args map[string]interface{}, key string, defaultVal int) int {
-	if val, ok := args[key]; ok {
-		switch v := val.(type) {
-		case int:
-			return v
-		case int64:
-			return int(v)
-		case float64:
-			return int(v)
-		case string:
-			if i, err := strconv.Atoi(v); err == nil {
-				return i
+// JiraSearchUsersTool creates the jira_search_users tool
+func JiraSearchUsersTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_search_users",
+		"Search for Jira users by name, username, or email. With project_key or issue_key, only returns users who can be assigned issues there. Returns the account ID (Cloud) or username (Server/DC) to use in assignee, reporter, and other user fields.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"query":       mcp.NewStringProperty("Search query (name, username, or email)"),
+				"project_key": mcp.NewStringProperty("Only return users assignable to issues in this project (e.g., 'PROJ')"),
+				"issue_key":   mcp.NewStringProperty("Only return users assignable to this issue (e.g., 'PROJ-123')"),
+				"max_results": mcp.NewIntegerProperty("Maximum number of users to return (default 50)").
+					WithDefault(50),
+			},
+			"query",
+		),
+		jiraSearchUsersHandler,
+		"jira", "read",
+	)
+}
+
+func jiraSearchUsersHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Query      string `arg:"query" validate:"required"`
+		ProjectKey string `arg:"project_key,trim"`
+		IssueKey   string `arg:"issue_key,trim"`
+		MaxResults int    `arg:"max_results" default:"50"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	var users []jira.User
+	var err error
+	if params.ProjectKey != "" || params.IssueKey != "" {
+		users, err = client.FindAssignableUsers(ctx, params.ProjectKey, params.IssueKey, params.Query, params.MaxResults)
+	} else {
+		users, err = client.SearchUsers(ctx, params.Query, params.MaxResults)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"users": users,
+		"total": len(users),
+	})
+}
+
+// JiraGetGroupsTool creates the jira_get_groups tool
+func JiraGetGroupsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_get_groups",
+		"Find Jira groups by name, or list the groups of a user. Group names are used to restrict comment and worklog visibility.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"query":      mcp.NewStringProperty("Only return groups whose name contains this text (lists groups if omitted)"),
+				"account_id": mcp.NewStringProperty("Return the groups of this user instead (Cloud account ID, or '@me')"),
+				"username":   mcp.NewStringProperty("Return the groups of this user instead (Server/DC username, or '@me')"),
+				"max_results": mcp.NewIntegerProperty("Maximum number of groups to return (default 50)").
+					WithDefault(50),
+			},
+		),
+		jiraGetGroupsHandler,
+		"jira", "read",
+	)
+}
+
+func jiraGetGroupsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		AccountID  string `arg:"account_id,trim"`
+		Username   string `arg:"username,trim"`
+		Query      string `arg:"query"`
+		MaxResults int    `arg:"max_results" default:"50"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	if params.AccountID == "" {
+		params.AccountID = params.Username
+	}
+
+	if params.AccountID != "" {
+		id, err := client.ResolveMe(ctx, params.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve @me: %w", err)
+		}
+		groups, err := client.GetUserGroups(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user groups: %w", err)
+		}
+		return mcp.NewJSONResult(map[string]interface{}{
+			"user":   id,
+			"groups": groups,
+			"total":  len(groups),
+		})
+	}
+
+	groups, err := client.FindGroups(ctx, params.Query, params.MaxResults)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find groups: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"groups": groups,
+		"total":  len(groups),
+	})
+}
+
+// JiraGetMyselfTool creates the jira_get_myself tool
+func JiraGetMyselfTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_get_myself",
+		"Get the account the server is authenticated as: account ID (Cloud) or username (Server/DC), display name, email, and groups. Use it to resolve 'me' (e.g. to assign issues to yourself) and to verify credentials at the start of a session.",
+		mcp.NewInputSchema(map[string]mcp.Property{}),
+		jiraGetMyselfHandler,
+		"jira", "read",
+	)
+}
+
+func jiraGetMyselfHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	user, err := client.Myself(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	response := map[string]interface{}{
+		"user":       user,
+		"deployment": client.GetDeploymentType(),
+	}
+
+	// Groups are best effort: listing them may need more permissions than
+	// reading the account itself
+	id := user.AccountID
+	if id == "" {
+		id = user.Name
+	}
+	if groups, err := client.GetUserGroups(ctx, id); err == nil {
+		response["groups"] = groups
+	} else {
+		response["groups_error"] = err.Error()
+	}
+
+	return mcp.NewJSONResult(response)
+}
+
+// defaultPermissionKeys are the permissions checked when none are requested:
+// those the write tools need
+var defaultPermissionKeys = []string{
+	"BROWSE_PROJECTS",
+	"CREATE_ISSUES",
+	"EDIT_ISSUES",
+	"TRANSITION_ISSUES",
+	"DELETE_ISSUES",
+	"ASSIGN_ISSUES",
+	"ADD_COMMENTS",
+	"WORK_ON_ISSUES",
+	"LINK_ISSUES",
+	"SCHEDULE_ISSUES",
+}
+
+// JiraGetMyPermissionsTool creates the jira_get_my_permissions tool
+func JiraGetMyPermissionsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_get_my_permissions",
+		"Check which permissions the authenticated user holds, globally or in a project or issue. Use it before a write (create, edit, transition, delete, comment, log work) to find out whether it is allowed instead of running into a 403.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"project_key": mcp.NewStringProperty("Check permissions in this project"),
+				"issue_key":   mcp.NewStringProperty("Check permissions on this issue (e.g., 'PROJ-123'); takes the issue's security level into account"),
+				"permissions": mcp.NewStringProperty("Comma-separated permission keys to check (e.g., 'EDIT_ISSUES,DELETE_ISSUES'). Defaults to the permissions the write tools need: " + strings.Join(defaultPermissionKeys, ", ")),
+			},
+		),
+		jiraGetMyPermissionsHandler,
+		"jira", "read",
+	)
+}
+
+func jiraGetMyPermissionsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ProjectKey  string   `arg:"project_key,trim"`
+		IssueKey    string   `arg:"issue_key,trim"`
+		Permissions []string `arg:"permissions"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	opts := &jira.MyPermissionsOptions{
+		ProjectKey:  params.ProjectKey,
+		IssueKey:    params.IssueKey,
+		Permissions: defaultPermissionKeys,
+	}
+	if len(params.Permissions) > 0 {
+		opts.Permissions = nil
+		for _, key := range params.Permissions {
+			opts.Permissions = append(opts.Permissions, strings.ToUpper(key))
+		}
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	permissions, err := client.GetMyPermissions(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions: %w", err)
+	}
+
+	granted := []string{}
+	denied := []string{}
+	for _, key := range opts.Permissions {
+		// Unknown keys are reported as denied
+		if permissions[key].HavePermission {
+			granted = append(granted, key)
+		} else {
+			denied = append(denied, key)
+		}
+	}
+
+	response := map[string]interface{}{
+		"granted":     granted,
+		"denied":      denied,
+		"permissions": permissions,
+	}
+	if opts.IssueKey != "" {
+		response["issue_key"] = opts.IssueKey
+	} else if opts.ProjectKey != "" {
+		response["project_key"] = opts.ProjectKey
+	}
+	return mcp.NewJSONResult(response)
+}
+
+// fetchAllLimit caps the number of issues returned with fetch_all
+const fetchAllLimit = 1000
+
+// fetchAllResult formats the issues gathered by a fetch_all call. The total
+// is omitted when Jira does not report it (Cloud) and the limit was reached.
+// With a chunk size, the issues follow the summary in separate blocks.
+func fetchAllResult(result *jira.SearchResult, chunkSize int) (*mcp.CallToolResult, error) {
+	response := map[string]interface{}{
+		"count":     len(result.Issues),
+		"truncated": result.HasMore(),
+	}
+
+	total := result.Total
+	if total == 0 && !result.HasMore() {
+		total = len(result.Issues)
+	}
+	if total > 0 {
+		response["total"] = total
+	}
+	if result.HasMore() {
+		response["note"] = fmt.Sprintf("Results stop at the fetch_all limit of %d issues; narrow the query to see the rest", fetchAllLimit)
+	}
+
+	if chunkSize > 0 {
+		return mcp.NewChunkedJSONResult(response, result.Issues, chunkSize)
+	}
+
+	response["issues"] = result.Issues
+	return mcp.NewJSONResult(response)
+}
+
+// markdownFormat reports whether the format argument asks for markdown output
+func markdownFormat(format string) bool {
+	return strings.EqualFold(format, "markdown")
+}
+
+// JiraSearchAssetsTool creates the jira_search_assets tool
+func JiraSearchAssetsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_search_assets",
+		"Search Assets (Jira Service Management's CMDB, formerly Insight) with an AQL query, e.g. objectType = \"Server\" AND Name like \"db\", or Key = \"CMDB-42\". Returns each object's key, label, type, and attribute values by name. Use it to look up the servers, services, and other configuration items an incident or issue refers to.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"aql": mcp.NewStringProperty("AQL query (e.g., 'objectType = \"Application\" AND Owner = \"Payments\"')"),
+				"start_at": mcp.NewIntegerProperty("Starting index for pagination (0-based)").
+					WithDefault(0),
+				"max_results": mcp.NewIntegerProperty("Maximum number of results to return (default 25)").
+					WithDefault(25).WithMinimum(1).WithMaximum(100),
+			},
+			"aql",
+		),
+		jiraSearchAssetsHandler,
+		"jira", "read",
+	)
+}
+
+func jiraSearchAssetsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		AQL        string `arg:"aql" validate:"required"`
+		StartAt    int    `arg:"start_at" default:"0"`
+		MaxResults int    `arg:"max_results" default:"25"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	result, err := client.SearchAssets(ctx, params.AQL, params.StartAt, params.MaxResults)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search assets: %w", err)
+	}
+
+	objects := make([]map[string]interface{}, 0, len(result.Objects))
+	for i := range result.Objects {
+		objects = append(objects, assetSummary(&result.Objects[i]))
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"objects":     objects,
+		"start_at":    result.StartAt,
+		"max_results": result.MaxResults,
+		"total":       result.Total,
+	})
+}
+
+// JiraGetAssetTool creates the jira_get_asset tool
+func JiraGetAssetTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_get_asset",
+		"Get an Assets (CMDB) object by its key or ID, with its type and attribute values by name. Referenced objects are shown as 'label (key)'.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"object": mcp.NewStringProperty("Object key (e.g., 'CMDB-42') or numeric object ID"),
+			},
+			"object",
+		),
+		jiraGetAssetHandler,
+		"jira", "read",
+	)
+}
+
+func jiraGetAssetHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Object string `arg:"object,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	asset, err := client.GetAsset(ctx, params.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get asset: %w", err)
+	}
+
+	return mcp.NewJSONResult(assetSummary(asset))
+}
+
+// assetSummary returns the fields of an Assets object that agents need, with
+// attributes by name instead of the API's nested attribute IDs
+func assetSummary(asset *jira.AssetObject) map[string]interface{} {
+	summary := map[string]interface{}{
+		"id":         asset.ID,
+		"key":        asset.Key,
+		"label":      asset.Label,
+		"attributes": asset.AttributeValues(),
+	}
+	if asset.ObjectType != nil {
+		summary["type"] = asset.ObjectType.Name
+	}
+	if asset.Updated != "" {
+		summary["updated"] = asset.Updated
+	}
+	return summary
+}
+
+// JiraGetIssuePropertyTool creates the jira_get_issue_property tool
+func JiraGetIssuePropertyTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_get_issue_property",
+		"Get a property of a Jira issue: structured JSON metadata stored on the issue by integrations or with jira_set_issue_property, outside its fields. Omit property_key to list the keys of the issue's properties.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"issue_key":    mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
+				"property_key": mcp.NewStringProperty("Property key (e.g., 'com.example.triage')"),
+			},
+			"issue_key",
+		),
+		jiraGetIssuePropertyHandler,
+		"jira", "read",
+	)
+}
+
+func jiraGetIssuePropertyHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		IssueKey    string `arg:"issue_key,trim" validate:"required"`
+		PropertyKey string `arg:"property_key,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	if params.PropertyKey == "" {
+		keys, err := client.GetIssuePropertyKeys(ctx, params.IssueKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue property keys: %w", err)
+		}
+		return mcp.NewJSONResult(map[string]interface{}{
+			"issue_key": params.IssueKey,
+			"keys":      keys,
+			"total":     len(keys),
+		})
+	}
+
+	property, err := client.GetIssueProperty(ctx, params.IssueKey, params.PropertyKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue property: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"issue_key": params.IssueKey,
+		"key":       property.Key,
+		"value":     property.Value,
+	})
+}
+
+// JiraRefreshMetadataTool creates the jira_refresh_metadata tool
+func JiraRefreshMetadataTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_refresh_metadata",
+		"Drop the cached Jira field list and create metadata (issue types and create screen fields) so the next lookup fetches them again. Use it after a field, issue type, or screen was changed in Jira and tools still act on the old configuration.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"project_key": mcp.NewStringProperty("Only refresh the create metadata of this project (and the field list); refreshes everything if omitted"),
+			},
+		),
+		jiraRefreshMetadataHandler,
+		"jira", "read",
+	)
+}
+
+func jiraRefreshMetadataHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ProjectKey string `arg:"project_key,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	client.InvalidateMetadata(params.ProjectKey)
+
+	if params.ProjectKey == "" {
+		return mcp.NewSuccessResult("Cleared all cached Jira metadata"), nil
+	}
+	return mcp.NewSuccessResult(fmt.Sprintf("Cleared the cached field list and the create metadata of %s", params.ProjectKey)), nil
+}
+
+// JiraGetAllLabelsTool creates the jira_get_all_labels tool
+func JiraGetAllLabelsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_get_all_labels",
+		"List the labels in use in Jira, optionally only those containing a search text. Check existing labels before adding one, to reuse them instead of creating near-duplicates (e.g., 'frontend' vs 'front-end'). On Server/Data Center only labels starting with the query are found, and the number of results is limited.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"query": mcp.NewStringProperty("Text the labels contain (Cloud) or start with (Server/Data Center); lists all labels if omitted"),
+				"limit": mcp.NewIntegerProperty("Maximum number of labels to return").
+					WithDefault(100).
+					WithMinimum(1).
+					WithMaximum(1000),
+			},
+		),
+		jiraGetAllLabelsHandler,
+		"jira", "read",
+	)
+}
+
+func jiraGetAllLabelsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Query string `arg:"query"`
+		Limit int    `arg:"limit" default:"100" validate:"min=1,max=1000"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	labels, err := client.GetLabels(ctx, params.Query, params.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"labels": labels,
+		"count":  len(labels),
+	})
+}
+
+// JiraGetBoardConfigurationTool creates the jira_get_board_configuration tool
+func JiraGetBoardConfigurationTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_get_board_configuration",
+		"Get the configuration of a Jira agile board: its columns and the statuses mapped to each, the estimation field, and the quick filters. Use it to translate a board column (e.g., 'move to Review') into a status; with issue_key, the issue's transitions into each column are listed, ready for jira_transition_issue.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"board_id":  mcp.NewIntegerProperty("Board ID"),
+				"issue_key": mcp.NewStringProperty("Issue to list the transitions into each column for (e.g., 'PROJ-123')"),
+			},
+			"board_id",
+		),
+		jiraGetBoardConfigurationHandler,
+		"jira", "read",
+	)
+}
+
+func jiraGetBoardConfigurationHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		BoardID  int    `arg:"board_id" validate:"required"`
+		IssueKey string `arg:"issue_key,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	cfg, err := client.GetBoardConfiguration(ctx, params.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board configuration: %w", err)
+	}
+	if params.IssueKey == "" {
+		return mcp.NewJSONResult(cfg)
+	}
+
+	transitions, err := client.GetTransitions(ctx, params.IssueKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transitions: %w", err)
+	}
+
+	// Transitions by the column of their target status
+	columns := make(map[string][]map[string]string, len(cfg.Columns))
+	for _, col := range cfg.Columns {
+		columns[col.Name] = []map[string]string{}
+		for _, t := range transitions {
+			if col.HasStatus(t.To.ID) {
+				columns[col.Name] = append(columns[col.Name], map[string]string{"id": t.ID, "name": t.Name, "to": t.To.Name})
 			}
 		}
 	}
-	return defaultVal
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"configuration":         cfg,
+		"issue_key":             params.IssueKey,
+		"transitions_by_column": columns,
+	})
 }
@@ -4,12 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"regexp"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/batch"
+	"github.com/codeownersnet/atlas/internal/tools/display"
 	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
 )
 
@@ -17,14 +17,17 @@ import (
 func JiraCreateIssueTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"jira_create_issue",
-		"Create a new Jira issue. Requires project key, issue type, and summary at minimum. Supports custom fields and Epic linking.",
+		"Create a new Jira issue. Requires project key, issue type, and summary at minimum. Supports custom fields, Epic linking, and configured issue templates.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
-				"project_key": mcp.NewStringProperty("Project key (e.g., 'PROJ')"),
-				"issue_type":  mcp.NewStringProperty("Issue type name (e.g., 'Bug', 'Story', 'Task')"),
-				"summary":     mcp.NewStringProperty("Issue summary/title"),
-				"description": mcp.NewStringProperty("Issue description. Supports rich Markdown formatting: ## headings, **bold**, *italic*, `code`, ~~strikethrough~~, ++underline++, links [text](url), lists, tables, code blocks (```lang```). Blockquotes (> text), panels ([info], [warning], [error], [success]), expand sections (<details>Title</details>), mentions (@username), status ([status:Done]), and emoji (:smile:) are also supported. Jira wiki markup (h2., *bold*, {code}, etc.) is auto-converted."),
-				"fields":      mcp.NewStringProperty("Additional fields as JSON object (e.g., '{\"priority\": {\"name\": \"High\"}, \"labels\": [\"bug\"]}'). Use for custom fields and standard fields."),
+				"project_key":    mcp.NewStringProperty("Project key (e.g., 'PROJ')"),
+				"issue_type":     mcp.NewStringProperty("Issue type name (e.g., 'Bug', 'Story', 'Task')"),
+				"summary":        mcp.NewStringProperty("Issue summary/title"),
+				"description":    mcp.NewStringProperty("Issue description. Supports rich Markdown formatting: ## headings, **bold**, *italic*, `code`, ~~strikethrough~~, ++underline++, links [text](url), lists, tables, code blocks (```lang```). Blockquotes (> text), panels ([info], [warning], [error], [success]), expand sections (<details>Title</details>), mentions (@username), status ([status:Done]), and emoji (:smile:) are also supported. Jira wiki markup (h2., *bold*, {code}, etc.) is auto-converted."),
+				"assignee":       mcp.NewStringProperty("Assignee account ID (Cloud) or username (Server/DC), or '@me' for the authenticated user"),
+				"security_level": mcp.NewStringProperty("Issue security level name or ID restricting who can see the issue (see jira_get_security_levels)"),
+				"fields":         mcp.NewStringProperty("Additional fields as JSON object (e.g., '{\"priority\": {\"name\": \"High\"}, \"labels\": [\"bug\"]}'). Use for custom fields and standard fields; custom fields can be given by name (e.g., '{\"Story Points\": 5}'). User fields accept '@me' (e.g., '{\"reporter\": \"@me\"}')."),
+				"template":       mcp.NewStringProperty("Name of a configured issue template (e.g., 'bug-report') that fills in the description skeleton, fields, labels, and components. Values given in the other arguments take precedence; labels and components are merged."),
 			},
 			"project_key", "issue_type", "summary",
 		),
@@ -34,19 +37,18 @@ func JiraCreateIssueTool() *mcp.ToolDefinition {
 }
 
 func jiraCreateIssueHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	projectKey, ok := args["project_key"].(string)
-	if !ok || projectKey == "" {
-		return nil, fmt.Errorf("project_key is required")
-	}
-
-	issueType, ok := args["issue_type"].(string)
-	if !ok || issueType == "" {
-		return nil, fmt.Errorf("issue_type is required")
+	var params struct {
+		ProjectKey    string `arg:"project_key,trim" validate:"required"`
+		IssueType     string `arg:"issue_type,trim" validate:"required"`
+		Summary       string `arg:"summary" validate:"required"`
+		Description   string `arg:"description"`
+		Assignee      string `arg:"assignee,trim"`
+		SecurityLevel string `arg:"security_level,trim"`
+		Fields        string `arg:"fields,trim"`
+		Template      string `arg:"template,trim"`
 	}
-
-	summary, ok := args["summary"].(string)
-	if !ok || summary == "" {
-		return nil, fmt.Errorf("summary is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -57,23 +59,35 @@ func jiraCreateIssueHandler(ctx context.Context, args map[string]interface{}) (*
 	// Build fields map
 	fields := map[string]interface{}{
 		"project": map[string]string{
-			"key": projectKey,
+			"key": params.ProjectKey,
 		},
 		"issuetype": map[string]string{
-			"name": issueType,
+			"name": params.IssueType,
 		},
-		"summary": summary,
+		"summary": params.Summary,
 	}
 
 	// Add description if provided
-	if description, ok := args["description"].(string); ok && description != "" {
-		fields["description"] = description
+	if params.Description != "" {
+		fields["description"] = params.Description
+	}
+
+	if params.Assignee != "" {
+		fields["assignee"] = client.UserRef(params.Assignee)
+	}
+
+	if params.SecurityLevel != "" {
+		id, err := client.ResolveSecurityLevel(ctx, params.ProjectKey, params.SecurityLevel)
+		if err != nil {
+			return nil, err
+		}
+		fields["security"] = map[string]string{"id": id}
 	}
 
 	// Parse additional fields if provided
-	if fieldsJSON, ok := args["fields"].(string); ok && fieldsJSON != "" {
+	if params.Fields != "" {
 		var additionalFields map[string]interface{}
-		if err := json.Unmarshal([]byte(fieldsJSON), &additionalFields); err != nil {
+		if err := json.Unmarshal([]byte(params.Fields), &additionalFields); err != nil {
 			return nil, fmt.Errorf("invalid fields JSON: %w", err)
 		}
 		// Merge additional fields
@@ -82,6 +96,26 @@ func jiraCreateIssueHandler(ctx context.Context, args map[string]interface{}) (*
 		}
 	}
 
+	if params.Template != "" {
+		template, err := client.IssueTemplate(params.Template)
+		if err != nil {
+			return nil, err
+		}
+		template.Apply(fields)
+	}
+
+	if err := client.ResolveUserFields(ctx, fields); err != nil {
+		return nil, fmt.Errorf("failed to resolve @me: %w", err)
+	}
+
+	// Field names and required fields are checked against the cached
+	// metadata; when it can't be fetched, Jira validates the request alone
+	if err := client.ResolveFieldNames(ctx, fields); err == nil {
+		if missing, err := client.MissingRequiredFields(ctx, params.ProjectKey, params.IssueType, fields); err == nil && len(missing) > 0 {
+			return nil, missingFieldsError(missing, params.ProjectKey, params.IssueType)
+		}
+	}
+
 	issue, err := client.CreateIssue(ctx, fields)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create issue: %w", err)
@@ -95,6 +129,23 @@ func jiraCreateIssueHandler(ctx context.Context, args map[string]interface{}) (*
 	})
 }
 
+// missingFieldsError reports the required fields missing from a new issue
+// as invalid arguments, with the allowed values of each field
+func missingFieldsError(missing []jira.CreateMetaField, projectKey, issueType string) error {
+	argsErr := &mcp.ArgsError{}
+	for _, f := range missing {
+		message := fmt.Sprintf("must include %s (%s), which %s %s requires", f.Name, f.FieldID, projectKey, issueType)
+		if allowed := f.AllowedValueNames(); len(allowed) > 0 {
+			if len(allowed) > 10 {
+				allowed = append(allowed[:10], "...")
+			}
+			message += "; allowed values: " + strings.Join(allowed, ", ")
+		}
+		argsErr.Problems = append(argsErr.Problems, mcp.ArgProblem{Arg: "fields", Message: message})
+	}
+	return argsErr
+}
+
 // JiraUpdateIssueTool creates the jira_update_issue tool
 func JiraUpdateIssueTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
@@ -102,9 +153,11 @@ func JiraUpdateIssueTool() *mcp.ToolDefinition {
 		"Update an existing Jira issue. Can update any field including custom fields. Description field supports rich Markdown formatting: ## headings, **bold**, *italic*, `code`, ~~strikethrough~~, ++underline++, links, lists, tables, code blocks. Blockquotes (> text), panels ([info], [warning], [error], [success]), expand sections (<details>Title</details>), mentions (@username), status ([status:Done]), and emoji (:smile:) are also supported.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
-				"issue_key": mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
-				"fields":    mcp.NewStringProperty("Fields to update as JSON object (e.g., '{\"summary\": \"New title\", \"priority\": {\"name\": \"High\"}}')"),
-				"update":    mcp.NewStringProperty("Update operations as JSON object (e.g., '{\"labels\": [{\"add\": \"new-label\"}]}')"),
+				"issue_key":          mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
+				"fields":             mcp.NewStringProperty("Fields to update as JSON object (e.g., '{\"summary\": \"New title\", \"priority\": {\"name\": \"High\"}}'); custom fields can be given by name. User fields accept '@me' (e.g., '{\"assignee\": \"@me\"}')."),
+				"update":             mcp.NewStringProperty("Update operations as JSON object (e.g., '{\"labels\": [{\"add\": \"new-label\"}]}')"),
+				"append_description": mcp.NewStringProperty("Markdown to add to the description, keeping its existing content and formatting. Appended to the end unless replace_section is given."),
+				"replace_section":    mcp.NewStringProperty("Heading of the description section (e.g., 'Impact') whose content is replaced by append_description, up to the next heading of the same or a higher level. A missing section is added at the end."),
 			},
 			"issue_key",
 		),
@@ -114,9 +167,15 @@ func JiraUpdateIssueTool() *mcp.ToolDefinition {
 }
 
 func jiraUpdateIssueHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issueKey, ok := args["issue_key"].(string)
-	if !ok || issueKey == "" {
-		return nil, fmt.Errorf("issue_key is required")
+	var params struct {
+		IssueKey          string  `arg:"issue_key,trim" validate:"required"`
+		Fields            string  `arg:"fields,trim"`
+		Update            string  `arg:"update"`
+		AppendDescription *string `arg:"append_description"`
+		ReplaceSection    string  `arg:"replace_section"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -128,29 +187,58 @@ func jiraUpdateIssueHandler(ctx context.Context, args map[string]interface{}) (*
 	var update map[string]interface{}
 
 	// Parse fields JSON
-	if fieldsJSON, ok := args["fields"].(string); ok && fieldsJSON != "" {
-		if err := json.Unmarshal([]byte(fieldsJSON), &fields); err != nil {
+	if params.Fields != "" {
+		if err := json.Unmarshal([]byte(params.Fields), &fields); err != nil {
 			return nil, fmt.Errorf("invalid fields JSON: %w", err)
 		}
+		if err := client.ResolveUserFields(ctx, fields); err != nil {
+			return nil, fmt.Errorf("failed to resolve @me: %w", err)
+		}
+		// Unresolved names are left for Jira to reject
+		_ = client.ResolveFieldNames(ctx, fields)
 	}
 
 	// Parse update JSON
-	if updateJSON, ok := args["update"].(string); ok && updateJSON != "" {
-		if err := json.Unmarshal([]byte(updateJSON), &update); err != nil {
+	if params.Update != "" {
+		if err := json.Unmarshal([]byte(params.Update), &update); err != nil {
 			return nil, fmt.Errorf("invalid update JSON: %w", err)
 		}
 	}
 
-	if fields == nil && update == nil {
-		return nil, fmt.Errorf("either fields or update must be provided")
+	// An empty append_description clears a section, but has to be given
+	var appendDescription string
+	if params.AppendDescription != nil {
+		appendDescription = *params.AppendDescription
+	}
+	if params.ReplaceSection != "" && params.AppendDescription == nil {
+		return nil, fmt.Errorf("replace_section requires append_description with the new section content")
 	}
+	patchDescription := appendDescription != "" || params.ReplaceSection != ""
 
-	err := client.UpdateIssue(ctx, issueKey, fields, update)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update issue: %w", err)
+	if fields == nil && update == nil && !patchDescription {
+		return nil, fmt.Errorf("either fields, update, or append_description must be provided")
+	}
+	if _, ok := fields["description"]; ok && patchDescription {
+		return nil, fmt.Errorf("the description field cannot be set together with append_description")
 	}
 
-	return mcp.NewSuccessResult(fmt.Sprintf("Successfully updated issue %s", issueKey)), nil
+	if fields != nil || update != nil {
+		if err := client.UpdateIssue(ctx, params.IssueKey, fields, update); err != nil {
+			return nil, fmt.Errorf("failed to update issue: %w", err)
+		}
+	}
+
+	if patchDescription {
+		added, err := client.PatchDescription(ctx, params.IssueKey, &jira.DescriptionPatch{Content: appendDescription, Section: params.ReplaceSection})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update description: %w", err)
+		}
+		if added {
+			return mcp.NewSuccessResult(fmt.Sprintf("Successfully updated issue %s (section %q was not found and was added at the end of the description)", params.IssueKey, params.ReplaceSection)), nil
+		}
+	}
+
+	return mcp.NewSuccessResult(fmt.Sprintf("Successfully updated issue %s", params.IssueKey)), nil
 }
 
 // JiraDeleteIssueTool creates the jira_delete_issue tool
@@ -171,9 +259,12 @@ func JiraDeleteIssueTool() *mcp.ToolDefinition {
 }
 
 func jiraDeleteIssueHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issueKey, ok := args["issue_key"].(string)
-	if !ok || issueKey == "" {
-		return nil, fmt.Errorf("issue_key is required")
+	var params struct {
+		IssueKey       string `arg:"issue_key,trim" validate:"required"`
+		DeleteSubtasks bool   `arg:"delete_subtasks"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -181,28 +272,26 @@ func jiraDeleteIssueHandler(ctx context.Context, args map[string]interface{}) (*
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	deleteSubtasks := false
-	if val, ok := args["delete_subtasks"].(bool); ok {
-		deleteSubtasks = val
-	}
-
-	err := client.DeleteIssue(ctx, issueKey, deleteSubtasks)
+	err := client.DeleteIssue(ctx, params.IssueKey, params.DeleteSubtasks)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete issue: %w", err)
 	}
 
-	return mcp.NewSuccessResult(fmt.Sprintf("Successfully deleted issue %s", issueKey)), nil
+	return mcp.NewSuccessResult(fmt.Sprintf("Successfully deleted issue %s", params.IssueKey)), nil
 }
 
 // JiraAddCommentTool creates the jira_add_comment tool
 func JiraAddCommentTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"jira_add_comment",
-		"Add a comment to a Jira issue. Supports rich Markdown formatting: ## headings, **bold**, *italic*, `code`, ~~strikethrough~~, ++underline++, links [text](url), lists, tables, code blocks. Blockquotes (> text), panels ([info], [warning], [error], [success]), expand sections (<details>Title</details>), mentions (@username), status ([status:Done]), and emoji (:smile:) are also supported. Jira wiki markup is auto-converted.",
+		"Add a comment to a Jira issue. Supports rich Markdown formatting: ## headings, **bold**, *italic*, `code`, ~~strikethrough~~, ++underline++, links [text](url), lists, tables, code blocks. Blockquotes (> text), panels ([info], [warning], [error], [success]), expand sections (<details>Title</details>), mentions (@username), status ([status:Done]), and emoji (:smile:) are also supported. Jira wiki markup is auto-converted. Visibility can be restricted to a group or project role. Use reply_to to answer another comment, quoting it and mentioning its author.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
-				"issue_key": mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
-				"body":      mcp.NewStringProperty("Comment text/body"),
+				"issue_key":        mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
+				"body":             mcp.NewStringProperty("Comment text/body"),
+				"visibility_type":  mcp.NewEnumProperty("Restrict visibility to a group or a project role (requires visibility_value)", "group", "role"),
+				"visibility_value": mcp.NewStringProperty("Group name (see jira_get_groups) or project role name (e.g., 'Developers')"),
+				"reply_to":         mcp.NewStringProperty("ID of a comment on the issue to reply to. The reply quotes the comment and mentions its author, and keeps its visibility unless visibility_type is given."),
 			},
 			"issue_key", "body",
 		),
@@ -212,14 +301,14 @@ func JiraAddCommentTool() *mcp.ToolDefinition {
 }
 
 func jiraAddCommentHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issueKey, ok := args["issue_key"].(string)
-	if !ok || issueKey == "" {
-		return nil, fmt.Errorf("issue_key is required")
+	var params struct {
+		visibilityArgs
+		IssueKey string `arg:"issue_key,trim" validate:"required"`
+		Body     string `arg:"body" validate:"required"`
+		ReplyTo  string `arg:"reply_to,trim"`
 	}
-
-	body, ok := args["body"].(string)
-	if !ok || body == "" {
-		return nil, fmt.Errorf("body is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -227,14 +316,24 @@ func jiraAddCommentHandler(ctx context.Context, args map[string]interface{}) (*m
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	comment, err := client.AddComment(ctx, issueKey, body, nil)
+	visibility, err := params.visibility()
+	if err != nil {
+		return nil, err
+	}
+
+	var comment *jira.Comment
+	if params.ReplyTo != "" {
+		comment, err = client.ReplyToComment(ctx, params.IssueKey, params.ReplyTo, params.Body, visibility)
+	} else {
+		comment, err = client.AddComment(ctx, params.IssueKey, params.Body, visibility)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to add comment: %w", err)
 	}
 
 	return mcp.NewJSONResult(map[string]interface{}{
 		"id":      comment.ID,
-		"message": fmt.Sprintf("Successfully added comment to issue %s", issueKey),
+		"message": fmt.Sprintf("Successfully added comment to issue %s", params.IssueKey),
 	})
 }
 
@@ -247,7 +346,7 @@ func JiraTransitionIssueTool() *mcp.ToolDefinition {
 			map[string]mcp.Property{
 				"issue_key":     mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
 				"transition_id": mcp.NewStringProperty("Transition ID or name"),
-				"comment":       mcp.NewStringProperty("Optional comment to add with the transition"),
+				"comment":       mcp.NewStringProperty("Optional comment to add with the transition (Markdown supported)"),
 			},
 			"issue_key", "transition_id",
 		),
@@ -257,14 +356,13 @@ func JiraTransitionIssueTool() *mcp.ToolDefinition {
 }
 
 func jiraTransitionIssueHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issueKey, ok := args["issue_key"].(string)
-	if !ok || issueKey == "" {
-		return nil, fmt.Errorf("issue_key is required")
+	var params struct {
+		IssueKey     string `arg:"issue_key,trim" validate:"required"`
+		TransitionID string `arg:"transition_id,trim" validate:"required"`
+		Comment      string `arg:"comment"`
 	}
-
-	transitionID, ok := args["transition_id"].(string)
-	if !ok || transitionID == "" {
-		return nil, fmt.Errorf("transition_id is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -272,33 +370,27 @@ func jiraTransitionIssueHandler(ctx context.Context, args map[string]interface{}
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	// Build fields map for optional comment
-	fields := make(map[string]interface{})
-	if c, ok := args["comment"].(string); ok && c != "" {
-		fields["comment"] = []map[string]string{
-			{"add": c},
-		}
-	}
-
-	err := client.TransitionIssue(ctx, issueKey, transitionID, fields)
+	err := client.TransitionIssueWithComment(ctx, params.IssueKey, params.TransitionID, nil, params.Comment)
 	if err != nil {
 		return nil, fmt.Errorf("failed to transition issue: %w", err)
 	}
 
-	return mcp.NewSuccessResult(fmt.Sprintf("Successfully transitioned issue %s", issueKey)), nil
+	return mcp.NewSuccessResult(fmt.Sprintf("Successfully transitioned issue %s", params.IssueKey)), nil
 }
 
 // JiraAddWorklogTool creates the jira_add_worklog tool
 func JiraAddWorklogTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"jira_add_worklog",
-		"Add a worklog entry to a Jira issue for time tracking. Time spent should be in Jira format (e.g., '2h 30m', '1d', '3w').",
+		"Add a worklog entry to a Jira issue for time tracking. Time spent should be in Jira format (e.g., '2h 30m', '1d', '3w'); days and weeks count the site's working hours per day and days per week. Visibility can be restricted to a group or project role.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
-				"issue_key":  mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
-				"time_spent": mcp.NewStringProperty("Time spent in Jira format (e.g., '2h 30m', '1d', '3w')"),
-				"comment":    mcp.NewStringProperty("Work description/comment"),
-				"started":    mcp.NewStringProperty("When the work was started (ISO 8601 format, e.g., '2025-01-15T10:00:00.000+0000'). Defaults to now."),
+				"issue_key":        mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
+				"time_spent":       mcp.NewStringProperty("Time spent in Jira format (e.g., '2h 30m', '1d', '3w')"),
+				"comment":          mcp.NewStringProperty("Work description/comment"),
+				"started":          mcp.NewStringProperty("When the work was started (ISO 8601 format, e.g., '2025-01-15T10:00:00.000+0000', or an expression such as 'today 09:00' or 'now-2h'). Defaults to now."),
+				"visibility_type":  mcp.NewEnumProperty("Restrict visibility to a group or a project role (requires visibility_value)", "group", "role"),
+				"visibility_value": mcp.NewStringProperty("Group name (see jira_get_groups) or project role name (e.g., 'Developers')"),
 			},
 			"issue_key", "time_spent",
 		),
@@ -308,14 +400,15 @@ func JiraAddWorklogTool() *mcp.ToolDefinition {
 }
 
 func jiraAddWorklogHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issueKey, ok := args["issue_key"].(string)
-	if !ok || issueKey == "" {
-		return nil, fmt.Errorf("issue_key is required")
+	var params struct {
+		visibilityArgs
+		IssueKey  string `arg:"issue_key,trim" validate:"required"`
+		TimeSpent string `arg:"time_spent,trim" validate:"required"`
+		Comment   string `arg:"comment"`
+		Started   string `arg:"started,trim"`
 	}
-
-	timeSpent, ok := args["time_spent"].(string)
-	if !ok || timeSpent == "" {
-		return nil, fmt.Errorf("time_spent is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -323,36 +416,44 @@ func jiraAddWorklogHandler(ctx context.Context, args map[string]interface{}) (*m
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	// Convert time spent to seconds first
-	timeSpentSeconds, err := parseJiraTime(timeSpent)
+	// Convert time spent to seconds with the site's working days and weeks
+	timeTracking := client.TimeTracking(ctx)
+	timeSpentSeconds, err := timeTracking.ParseDuration(params.TimeSpent)
 	if err != nil {
 		return nil, fmt.Errorf("invalid time_spent format: %w", err)
 	}
 
+	visibility, err := params.visibility()
+	if err != nil {
+		return nil, err
+	}
+
 	// Build worklog request
 	req := &jira.CreateWorklogRequest{
 		TimeSpentSeconds: timeSpentSeconds,
+		Comment:          params.Comment,
+		Visibility:       visibility,
 	}
 
-	if c, ok := args["comment"].(string); ok && c != "" {
-		req.Comment = c
-	}
-
-	if s, ok := args["started"].(string); ok && s != "" {
-		req.Started = s
-	} else {
-		// Default to current time in ISO 8601 format
-		req.Started = time.Now().Format("2006-01-02T15:04:05.000-0700")
+	started := display.Now(ctx)
+	if params.Started != "" {
+		started, err = display.ParseTime(ctx, params.Started)
+		if err != nil {
+			return nil, fmt.Errorf("invalid started: %w", err)
+		}
 	}
+	req.Started = started.Format("2006-01-02T15:04:05.000-0700")
 
-	worklog, err := client.AddWorklog(ctx, issueKey, req)
+	worklog, err := client.AddWorklog(ctx, params.IssueKey, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add worklog: %w", err)
 	}
 
 	return mcp.NewJSONResult(map[string]interface{}{
-		"id":      worklog.ID,
-		"message": fmt.Sprintf("Successfully added worklog to issue %s", issueKey),
+		"id":         worklog.ID,
+		"time_spent": timeTracking.FormatDuration(timeSpentSeconds),
+		"started":    req.Started,
+		"message":    fmt.Sprintf("Successfully added worklog to issue %s", params.IssueKey),
 	})
 }
 
@@ -374,14 +475,12 @@ func JiraLinkToEpicTool() *mcp.ToolDefinition {
 }
 
 func jiraLinkToEpicHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issueKey, ok := args["issue_key"].(string)
-	if !ok || issueKey == "" {
-		return nil, fmt.Errorf("issue_key is required")
+	var params struct {
+		IssueKey string `arg:"issue_key,trim" validate:"required"`
+		EpicKey  string `arg:"epic_key,trim" validate:"required"`
 	}
-
-	epicKey, ok := args["epic_key"].(string)
-	if !ok || epicKey == "" {
-		return nil, fmt.Errorf("epic_key is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -389,12 +488,12 @@ func jiraLinkToEpicHandler(ctx context.Context, args map[string]interface{}) (*m
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	err := client.LinkToEpic(ctx, issueKey, epicKey)
+	err := client.LinkToEpic(ctx, params.IssueKey, params.EpicKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to link to epic: %w", err)
 	}
 
-	return mcp.NewSuccessResult(fmt.Sprintf("Successfully linked issue %s to epic %s", issueKey, epicKey)), nil
+	return mcp.NewSuccessResult(fmt.Sprintf("Successfully linked issue %s to epic %s", params.IssueKey, params.EpicKey)), nil
 }
 
 // JiraCreateIssueLinkTool creates the jira_create_issue_link tool
@@ -417,19 +516,14 @@ func JiraCreateIssueLinkTool() *mcp.ToolDefinition {
 }
 
 func jiraCreateIssueLinkHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	fromKey, ok := args["from_key"].(string)
-	if !ok || fromKey == "" {
-		return nil, fmt.Errorf("from_key is required")
+	var params struct {
+		FromKey  string `arg:"from_key,trim" validate:"required"`
+		ToKey    string `arg:"to_key,trim" validate:"required"`
+		LinkType string `arg:"link_type,trim" validate:"required"`
+		Comment  string `arg:"comment"`
 	}
-
-	toKey, ok := args["to_key"].(string)
-	if !ok || toKey == "" {
-		return nil, fmt.Errorf("to_key is required")
-	}
-
-	linkType, ok := args["link_type"].(string)
-	if !ok || linkType == "" {
-		return nil, fmt.Errorf("link_type is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -439,19 +533,19 @@ func jiraCreateIssueLinkHandler(ctx context.Context, args map[string]interface{}
 
 	// Build optional comment
 	var commentObj *jira.Comment
-	if c, ok := args["comment"].(string); ok && c != "" {
+	if params.Comment != "" {
 		commentObj = &jira.Comment{
-			Body: jira.NewDescription(c),
+			Body: jira.NewDescription(params.Comment),
 		}
 	}
 
 	// Use the helper method that looks up the link type by name
-	_, err := client.CreateIssueLinkByName(ctx, linkType, fromKey, toKey, commentObj)
+	_, err := client.CreateIssueLinkByName(ctx, params.LinkType, params.FromKey, params.ToKey, commentObj)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create issue link: %w", err)
 	}
 
-	return mcp.NewSuccessResult(fmt.Sprintf("Successfully linked %s to %s with type '%s'", fromKey, toKey, linkType)), nil
+	return mcp.NewSuccessResult(fmt.Sprintf("Successfully linked %s to %s with type '%s'", params.FromKey, params.ToKey, params.LinkType)), nil
 }
 
 // JiraCreateRemoteIssueLinkTool creates the jira_create_remote_issue_link tool
@@ -473,19 +567,13 @@ func JiraCreateRemoteIssueLinkTool() *mcp.ToolDefinition {
 }
 
 func jiraCreateRemoteIssueLinkHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issueKey, ok := args["issue_key"].(string)
-	if !ok || issueKey == "" {
-		return nil, fmt.Errorf("issue_key is required")
+	var params struct {
+		IssueKey string `arg:"issue_key,trim" validate:"required"`
+		URL      string `arg:"url,trim" validate:"required"`
+		Title    string `arg:"title" validate:"required"`
 	}
-
-	url, ok := args["url"].(string)
-	if !ok || url == "" {
-		return nil, fmt.Errorf("url is required")
-	}
-
-	title, ok := args["title"].(string)
-	if !ok || title == "" {
-		return nil, fmt.Errorf("title is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -495,19 +583,19 @@ func jiraCreateRemoteIssueLinkHandler(ctx context.Context, args map[string]inter
 
 	remoteLink := &jira.RemoteLink{
 		Object: &jira.LinkObject{
-			URL:   url,
-			Title: title,
+			URL:   params.URL,
+			Title: params.Title,
 		},
 	}
 
-	result, err := client.CreateRemoteLink(ctx, issueKey, remoteLink)
+	result, err := client.CreateRemoteLink(ctx, params.IssueKey, remoteLink)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create remote link: %w", err)
 	}
 
 	return mcp.NewJSONResult(map[string]interface{}{
 		"id":      result.ID,
-		"message": fmt.Sprintf("Successfully created remote link on issue %s", issueKey),
+		"message": fmt.Sprintf("Successfully created remote link on issue %s", params.IssueKey),
 	})
 }
 
@@ -528,9 +616,11 @@ func JiraRemoveIssueLinkTool() *mcp.ToolDefinition {
 }
 
 func jiraRemoveIssueLinkHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	linkID, ok := args["link_id"].(string)
-	if !ok || linkID == "" {
-		return nil, fmt.Errorf("link_id is required")
+	var params struct {
+		LinkID string `arg:"link_id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -538,25 +628,25 @@ func jiraRemoveIssueLinkHandler(ctx context.Context, args map[string]interface{}
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	err := client.DeleteIssueLink(ctx, linkID)
+	err := client.DeleteIssueLink(ctx, params.LinkID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to remove issue link: %w", err)
 	}
 
-	return mcp.NewSuccessResult(fmt.Sprintf("Successfully removed link %s", linkID)), nil
+	return mcp.NewSuccessResult(fmt.Sprintf("Successfully removed link %s", params.LinkID)), nil
 }
 
 // JiraCreateSprintTool creates the jira_create_sprint tool
 func JiraCreateSprintTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"jira_create_sprint",
-		"Create a new sprint in a Jira Scrum board.",
+		"Create a new sprint in a Jira Scrum board. Kanban boards have no sprints; the board's type is checked first.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"board_id":   mcp.NewIntegerProperty("Board ID where the sprint will be created"),
 				"name":       mcp.NewStringProperty("Sprint name"),
-				"start_date": mcp.NewStringProperty("Sprint start date (ISO 8601 format, e.g., '2025-01-15T10:00:00.000Z')"),
-				"end_date":   mcp.NewStringProperty("Sprint end date (ISO 8601 format, e.g., '2025-01-29T10:00:00.000Z')"),
+				"start_date": mcp.NewStringProperty("Sprint start date (ISO 8601 format, e.g., '2025-01-15T10:00:00.000Z', or an expression such as 'start-of-week+7d')"),
+				"end_date":   mcp.NewStringProperty("Sprint end date (ISO 8601 format, e.g., '2025-01-29T10:00:00.000Z', or an expression)"),
 				"goal":       mcp.NewStringProperty("Sprint goal/objective"),
 			},
 			"board_id", "name",
@@ -567,14 +657,15 @@ func JiraCreateSprintTool() *mcp.ToolDefinition {
 }
 
 func jiraCreateSprintHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	boardID := getIntArg(args, "board_id", 0)
-	if boardID == 0 {
-		return nil, fmt.Errorf("board_id is required")
+	var params struct {
+		BoardID   int    `arg:"board_id" validate:"required"`
+		Name      string `arg:"name" validate:"required"`
+		StartDate string `arg:"start_date,trim"`
+		EndDate   string `arg:"end_date,trim"`
+		Goal      string `arg:"goal"`
 	}
-
-	name, ok := args["name"].(string)
-	if !ok || name == "" {
-		return nil, fmt.Errorf("name is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -583,20 +674,34 @@ func jiraCreateSprintHandler(ctx context.Context, args map[string]interface{}) (
 	}
 
 	req := &jira.CreateSprintRequest{
-		Name:          name,
-		OriginBoardID: boardID,
+		Name:          params.Name,
+		OriginBoardID: params.BoardID,
+		Goal:          params.Goal,
 	}
 
-	if startDate, ok := args["start_date"].(string); ok && startDate != "" {
-		req.StartDate = startDate
+	if params.StartDate != "" {
+		t, err := display.ParseTime(ctx, params.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_date: %w", err)
+		}
+		req.StartDate = t.Format(sprintDateFormat)
 	}
 
-	if endDate, ok := args["end_date"].(string); ok && endDate != "" {
-		req.EndDate = endDate
+	if params.EndDate != "" {
+		t, err := display.ParseTime(ctx, params.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_date: %w", err)
+		}
+		req.EndDate = t.Format(sprintDateFormat)
 	}
 
-	if goal, ok := args["goal"].(string); ok && goal != "" {
-		req.Goal = goal
+	// Jira's error for a board without sprints doesn't name the cause
+	board, err := client.GetBoard(ctx, params.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	if !board.HasSprints() {
+		return nil, fmt.Errorf("board %d (%s) is a Kanban board, and Kanban boards have no sprints: use a Scrum board (jira_get_agile_boards with board_type=scrum) or create one with jira_create_board", params.BoardID, board.Name)
 	}
 
 	sprint, err := client.CreateSprint(ctx, req)
@@ -616,15 +721,17 @@ func jiraCreateSprintHandler(ctx context.Context, args map[string]interface{}) (
 func JiraUpdateSprintTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"jira_update_sprint",
-		"Update an existing sprint. Can update name, dates, goal, and state (start/close sprint).",
+		"Update an existing sprint. Can update name, dates, goal, and state (start/close sprint). Sprints go from future to active to closed: starting a sprint requires a start and end date (given here or already set), complete_date is only accepted when closing, and a closed sprint only takes a new name or goal. The update is checked against the sprint's current state before it is sent.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
-				"sprint_id":  mcp.NewIntegerProperty("Sprint ID"),
-				"name":       mcp.NewStringProperty("New sprint name"),
-				"start_date": mcp.NewStringProperty("New start date (ISO 8601 format)"),
-				"end_date":   mcp.NewStringProperty("New end date (ISO 8601 format)"),
-				"goal":       mcp.NewStringProperty("New sprint goal"),
-				"state":      mcp.NewStringProperty("Sprint state: 'future', 'active', or 'closed'"),
+				"sprint_id":     mcp.NewIntegerProperty("Sprint ID"),
+				"name":          mcp.NewStringProperty("New sprint name"),
+				"start_date":    mcp.NewStringProperty("New start date (ISO 8601 format or an expression such as 'today 09:00')"),
+				"end_date":      mcp.NewStringProperty("New end date (ISO 8601 format or an expression such as 'now+2w')"),
+				"complete_date": mcp.NewStringProperty("When the sprint was completed, only when closing it (ISO 8601 format or an expression; defaults to now)"),
+				"goal":          mcp.NewStringProperty("New sprint goal"),
+				"state": mcp.NewStringProperty("Sprint state: 'future', 'active' (start the sprint), or 'closed' (close the sprint)").
+					WithEnum(jira.SprintStateFuture, jira.SprintStateActive, jira.SprintStateClosed),
 			},
 			"sprint_id",
 		),
@@ -634,9 +741,17 @@ func JiraUpdateSprintTool() *mcp.ToolDefinition {
 }
 
 func jiraUpdateSprintHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	sprintID := getIntArg(args, "sprint_id", 0)
-	if sprintID == 0 {
-		return nil, fmt.Errorf("sprint_id is required")
+	var params struct {
+		SprintID     int    `arg:"sprint_id" validate:"required"`
+		Name         string `arg:"name"`
+		StartDate    string `arg:"start_date,trim"`
+		EndDate      string `arg:"end_date,trim"`
+		CompleteDate string `arg:"complete_date,trim"`
+		Goal         string `arg:"goal"`
+		State        string `arg:"state,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -644,39 +759,58 @@ func jiraUpdateSprintHandler(ctx context.Context, args map[string]interface{}) (
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	req := &jira.UpdateSprintRequest{}
-	hasUpdate := false
-
-	if name, ok := args["name"].(string); ok && name != "" {
-		req.Name = name
-		hasUpdate = true
+	req := &jira.UpdateSprintRequest{
+		Name:  params.Name,
+		Goal:  params.Goal,
+		State: params.State,
 	}
+	hasUpdate := req.Name != "" || req.Goal != "" || req.State != ""
 
-	if startDate, ok := args["start_date"].(string); ok && startDate != "" {
-		req.StartDate = startDate
-		hasUpdate = true
+	dates := []struct {
+		arg   string
+		value string
+		field *string
+	}{
+		{"start_date", params.StartDate, &req.StartDate},
+		{"end_date", params.EndDate, &req.EndDate},
+		{"complete_date", params.CompleteDate, &req.CompleteDate},
 	}
-
-	if endDate, ok := args["end_date"].(string); ok && endDate != "" {
-		req.EndDate = endDate
+	for _, date := range dates {
+		if date.value == "" {
+			continue
+		}
+		t, err := display.ParseTime(ctx, date.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", date.arg, err)
+		}
+		*date.field = t.Format(sprintDateFormat)
 		hasUpdate = true
 	}
 
-	if goal, ok := args["goal"].(string); ok && goal != "" {
-		req.Goal = goal
-		hasUpdate = true
+	if !hasUpdate {
+		return nil, fmt.Errorf("at least one field to update must be provided")
 	}
 
-	if state, ok := args["state"].(string); ok && state != "" {
-		req.State = state
-		hasUpdate = true
+	current, err := client.GetSprint(ctx, params.SprintID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sprint: %w", err)
+	}
+	if err := current.CheckUpdate(req); err != nil {
+		return nil, err
 	}
 
-	if !hasUpdate {
-		return nil, fmt.Errorf("at least one field to update must be provided")
+	// Jira checks the dates of a sprint being started in the request, so
+	// the dates already set are sent along
+	if req.State == jira.SprintStateActive && current.State == jira.SprintStateFuture {
+		if req.StartDate == "" {
+			req.StartDate = current.StartDate.Format(sprintDateFormat)
+		}
+		if req.EndDate == "" {
+			req.EndDate = current.EndDate.Format(sprintDateFormat)
+		}
 	}
 
-	sprint, err := client.UpdateSprint(ctx, sprintID, req)
+	sprint, err := client.UpdateSprint(ctx, params.SprintID, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update sprint: %w", err)
 	}
@@ -685,10 +819,104 @@ func jiraUpdateSprintHandler(ctx context.Context, args map[string]interface{}) (
 		"id":      sprint.ID,
 		"name":    sprint.Name,
 		"state":   sprint.State,
-		"message": fmt.Sprintf("Successfully updated sprint %d", sprintID),
+		"message": fmt.Sprintf("Successfully updated sprint %d", params.SprintID),
 	})
 }
 
+// sprintDateFormat is the layout of the dates sent to the Agile API
+const sprintDateFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// JiraCreateBoardTool creates the jira_create_board tool
+func JiraCreateBoardTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_create_board",
+		"Create a Scrum or Kanban board. A board shows the issues of a saved filter: give filter_id, or jql to save a new filter named after the board. Scrum boards plan work in sprints; Kanban boards have no sprints.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"name":        mcp.NewStringProperty("Board name"),
+				"type":        mcp.NewEnumProperty("Board type", jira.BoardTypeScrum, jira.BoardTypeKanban),
+				"filter_id":   mcp.NewIntegerProperty("ID of the saved filter selecting the board's issues"),
+				"jql":         mcp.NewStringProperty("JQL selecting the board's issues, saved as a new filter (e.g., 'project = PROJ ORDER BY Rank ASC'); use instead of filter_id"),
+				"project_key": mcp.NewStringProperty("Project the board belongs to (optional)"),
+			},
+			"name", "type",
+		),
+		jiraCreateBoardHandler,
+		"jira", "write",
+	)
+}
+
+func jiraCreateBoardHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Name       string `arg:"name" validate:"required"`
+		Type       string `arg:"type,trim" validate:"required"`
+		FilterID   int    `arg:"filter_id"`
+		JQL        string `arg:"jql"`
+		ProjectKey string `arg:"project_key,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+	if params.Type != jira.BoardTypeScrum && params.Type != jira.BoardTypeKanban {
+		return nil, &mcp.ArgsError{Problems: []mcp.ArgProblem{{Arg: "type", Message: fmt.Sprintf("type must be %s or %s", jira.BoardTypeScrum, jira.BoardTypeKanban)}}}
+	}
+	if (params.FilterID == 0) == (params.JQL == "") {
+		return nil, &mcp.ArgsError{Problems: []mcp.ArgProblem{{Arg: "filter_id", Message: "exactly one of filter_id and jql is required"}}}
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	result := map[string]interface{}{}
+	var filterID string
+	req := &jira.CreateBoardRequest{
+		Name:     params.Name,
+		Type:     params.Type,
+		FilterID: params.FilterID,
+	}
+	if params.ProjectKey != "" {
+		req.Location = &jira.CreateBoardLocation{Type: "project", ProjectKeyOrID: params.ProjectKey}
+	}
+
+	if params.JQL != "" {
+		filter, err := client.CreateFilter(ctx, &jira.CreateFilterRequest{
+			Name:        fmt.Sprintf("Filter for %s", params.Name),
+			Description: fmt.Sprintf("Issues of the %s board", params.Name),
+			JQL:         jira.ResolveMeJQL(params.JQL),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to save the board's filter: %w", err)
+		}
+		id, err := strconv.Atoi(filter.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected filter ID %q", filter.ID)
+		}
+		req.FilterID = id
+		filterID = filter.ID
+		result["filter"] = map[string]interface{}{"id": filter.ID, "name": filter.Name, "jql": filter.JQL}
+	}
+
+	board, err := client.CreateBoard(ctx, req)
+	if err != nil {
+		// The filter saved for the board would be left over
+		if filterID != "" {
+			if deleteErr := client.DeleteFilter(ctx, filterID); deleteErr != nil {
+				return nil, fmt.Errorf("failed to create board: %w (its filter %s was saved but could not be deleted: %v)", err, filterID, deleteErr)
+			}
+		}
+		return nil, fmt.Errorf("failed to create board: %w", err)
+	}
+
+	result["id"] = board.ID
+	result["name"] = board.Name
+	result["type"] = board.Type
+	result["filter_id"] = req.FilterID
+	result["message"] = fmt.Sprintf("Successfully created %s board '%s'", board.Type, board.Name)
+	return mcp.NewJSONResult(result)
+}
+
 // JiraCreateVersionTool creates the jira_create_version tool
 func JiraCreateVersionTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
@@ -710,14 +938,15 @@ func JiraCreateVersionTool() *mcp.ToolDefinition {
 }
 
 func jiraCreateVersionHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	projectKey, ok := args["project_key"].(string)
-	if !ok || projectKey == "" {
-		return nil, fmt.Errorf("project_key is required")
+	var params struct {
+		ProjectKey  string `arg:"project_key,trim" validate:"required"`
+		Name        string `arg:"name" validate:"required"`
+		Description string `arg:"description"`
+		ReleaseDate string `arg:"release_date,trim"`
+		Released    bool   `arg:"released"`
 	}
-
-	name, ok := args["name"].(string)
-	if !ok || name == "" {
-		return nil, fmt.Errorf("name is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -726,20 +955,11 @@ func jiraCreateVersionHandler(ctx context.Context, args map[string]interface{})
 	}
 
 	req := &jira.CreateVersionRequest{
-		Name:    name,
-		Project: projectKey,
-	}
-
-	if description, ok := args["description"].(string); ok && description != "" {
-		req.Description = description
-	}
-
-	if releaseDate, ok := args["release_date"].(string); ok && releaseDate != "" {
-		req.ReleaseDate = releaseDate
-	}
-
-	if released, ok := args["released"].(bool); ok {
-		req.Released = released
+		Name:        params.Name,
+		Project:     params.ProjectKey,
+		Description: params.Description,
+		ReleaseDate: params.ReleaseDate,
+		Released:    params.Released,
 	}
 
 	version, err := client.CreateVersion(ctx, req)
@@ -750,7 +970,7 @@ func jiraCreateVersionHandler(ctx context.Context, args map[string]interface{})
 	return mcp.NewJSONResult(map[string]interface{}{
 		"id":      version.ID,
 		"name":    version.Name,
-		"message": fmt.Sprintf("Successfully created version '%s' in project %s", version.Name, projectKey),
+		"message": fmt.Sprintf("Successfully created version '%s' in project %s", version.Name, params.ProjectKey),
 	})
 }
 
@@ -761,7 +981,13 @@ func JiraBatchCreateIssuesTool() *mcp.ToolDefinition {
 		"Create multiple Jira issues in a single batch operation. More efficient than creating issues one by one.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
-				"issues": mcp.NewStringProperty("JSON array of issue definitions. Each issue should have fields object with project, issuetype, summary, etc. Example: '[{\"fields\": {\"project\": {\"key\": \"PROJ\"}, \"issuetype\": {\"name\": \"Task\"}, \"summary\": \"Issue 1\"}}, {...}]'"),
+				"issues": mcp.NewArrayProperty("Issues to create", mcp.NewObjectProperty(
+					"An issue definition. Example: {\"fields\": {\"project\": {\"key\": \"PROJ\"}, \"issuetype\": {\"name\": \"Task\"}, \"summary\": \"Issue 1\"}}",
+					map[string]mcp.Property{
+						"fields": mcp.NewObjectProperty("Issue fields such as project, issuetype, and summary. User fields accept '@me'", nil),
+					},
+					"fields",
+				)),
 			},
 			"issues",
 		),
@@ -771,9 +997,14 @@ func JiraBatchCreateIssuesTool() *mcp.ToolDefinition {
 }
 
 func jiraBatchCreateIssuesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	issuesJSON, ok := args["issues"].(string)
-	if !ok || issuesJSON == "" {
-		return nil, fmt.Errorf("issues is required")
+	// Issues may be an array or, from older clients, a JSON-encoded string
+	var params struct {
+		Issues []struct {
+			Fields map[string]interface{} `json:"fields"`
+		} `arg:"issues" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -781,20 +1012,16 @@ func jiraBatchCreateIssuesHandler(ctx context.Context, args map[string]interface
 		return nil, fmt.Errorf("Jira client not available")
 	}
 
-	// Parse issues array
-	var issuesArray []map[string]interface{}
-	if err := json.Unmarshal([]byte(issuesJSON), &issuesArray); err != nil {
-		return nil, fmt.Errorf("invalid issues JSON: %w", err)
-	}
-
 	// Extract fields from each issue
-	issuesFields := make([]map[string]interface{}, len(issuesArray))
-	for i, issue := range issuesArray {
-		if fields, ok := issue["fields"].(map[string]interface{}); ok {
-			issuesFields[i] = fields
-		} else {
+	issuesFields := make([]map[string]interface{}, len(params.Issues))
+	for i, issue := range params.Issues {
+		if issue.Fields == nil {
 			return nil, fmt.Errorf("issue at index %d is missing 'fields' object", i)
 		}
+		if err := client.ResolveUserFields(ctx, issue.Fields); err != nil {
+			return nil, fmt.Errorf("failed to resolve @me: %w", err)
+		}
+		issuesFields[i] = issue.Fields
 	}
 
 	result, err := client.BatchCreateIssues(ctx, issuesFields)
@@ -830,11 +1057,13 @@ func jiraBatchCreateIssuesHandler(ctx context.Context, args map[string]interface
 func JiraBatchCreateVersionsTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"jira_batch_create_versions",
-		"Create multiple fix versions in a single batch operation.",
+		"Create multiple fix versions in a single batch operation. Versions are created concurrently and each gets its own result.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"project_key": mcp.NewStringProperty("Project key (e.g., 'PROJ')"),
 				"versions":    mcp.NewStringProperty("JSON array of version definitions. Example: '[{\"name\": \"1.0.0\", \"description\": \"First release\"}, {\"name\": \"1.1.0\"}]'"),
+				"concurrency": mcp.NewIntegerProperty("Number of versions created at once (defaults to the server's BATCH_CONCURRENCY, max 16)").
+					WithMinimum(1).WithMaximum(16),
 			},
 			"project_key", "versions",
 		),
@@ -844,14 +1073,13 @@ func JiraBatchCreateVersionsTool() *mcp.ToolDefinition {
 }
 
 func jiraBatchCreateVersionsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	projectKey, ok := args["project_key"].(string)
-	if !ok || projectKey == "" {
-		return nil, fmt.Errorf("project_key is required")
+	var params struct {
+		ProjectKey  string `arg:"project_key,trim" validate:"required"`
+		Versions    string `arg:"versions" validate:"required"`
+		Concurrency int    `arg:"concurrency"`
 	}
-
-	versionsJSON, ok := args["versions"].(string)
-	if !ok || versionsJSON == "" {
-		return nil, fmt.Errorf("versions is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetJiraClient(ctx)
@@ -861,24 +1089,20 @@ func jiraBatchCreateVersionsHandler(ctx context.Context, args map[string]interfa
 
 	// Parse versions array
 	var versionsArray []map[string]interface{}
-	if err := json.Unmarshal([]byte(versionsJSON), &versionsArray); err != nil {
+	if err := json.Unmarshal([]byte(params.Versions), &versionsArray); err != nil {
 		return nil, fmt.Errorf("invalid versions JSON: %w", err)
 	}
 
-	// Create versions one by one (Jira doesn't have a batch version endpoint)
-	created := make([]map[string]interface{}, 0)
-	errors := make([]string, 0)
-
-	for i, versionData := range versionsArray {
+	// Jira has no batch version endpoint, so versions are created concurrently
+	result := batch.Run(ctx, versionsArray, batch.Concurrency(ctx, params.Concurrency), func(ctx context.Context, versionData map[string]interface{}) (interface{}, error) {
 		name, ok := versionData["name"].(string)
 		if !ok || name == "" {
-			errors = append(errors, fmt.Sprintf("version at index %d is missing 'name'", i))
-			continue
+			return nil, fmt.Errorf("version is missing 'name'")
 		}
 
 		req := &jira.CreateVersionRequest{
 			Name:    name,
-			Project: projectKey,
+			Project: params.ProjectKey,
 		}
 
 		if description, ok := versionData["description"].(string); ok {
@@ -895,54 +1119,461 @@ func jiraBatchCreateVersionsHandler(ctx context.Context, args map[string]interfa
 
 		version, err := client.CreateVersion(ctx, req)
 		if err != nil {
-			errors = append(errors, fmt.Sprintf("failed to create version '%s': %v", name, err))
-			continue
+			return nil, fmt.Errorf("failed to create version '%s': %w", name, err)
 		}
 
-		created = append(created, map[string]interface{}{
+		return map[string]interface{}{
 			"id":   version.ID,
 			"name": version.Name,
-		})
+		}, nil
+	})
+
+	created := make([]interface{}, 0, result.Succeeded)
+	errors := make([]string, 0, result.Failed)
+	for _, item := range result.Items {
+		if item.Status == batch.StatusOK {
+			created = append(created, item.Result)
+		} else {
+			errors = append(errors, fmt.Sprintf("version at index %d: %s", item.Index, item.Error))
+		}
 	}
 
 	return mcp.NewJSONResult(map[string]interface{}{
-		"created": created,
-		"errors":  errors,
-		"message": fmt.Sprintf("Successfully created %d versions", len(created)),
+		"created":   created,
+		"errors":    errors,
+		"results":   result.Items,
+		"succeeded": result.Succeeded,
+		"failed":    result.Failed,
+		"message":   fmt.Sprintf("Successfully created %d versions", result.Succeeded),
 	})
 }
 
-// parseJiraTime converts Jira time format (e.g., "2h 30m", "1d", "3w") to seconds
-func parseJiraTime(timeStr string) (int, error) {
-	// Regex to match time units: w (weeks), d (days), h (hours), m (minutes)
-	re := regexp.MustCompile(`(\d+)([wdhm])`)
-	matches := re.FindAllStringSubmatch(strings.ToLower(strings.TrimSpace(timeStr)), -1)
+// visibilityArgs are the visibility arguments of the comment and worklog tools
+type visibilityArgs struct {
+	VisibilityType  string `arg:"visibility_type,trim" validate:"oneof=group role"`
+	VisibilityValue string `arg:"visibility_value,trim"`
+}
 
-	if len(matches) == 0 {
-		return 0, fmt.Errorf("invalid time format: %s (expected format like '2h 30m', '1d', '3w')", timeStr)
+// visibility builds the comment or worklog visibility restriction, or nil if
+// unrestricted
+func (v visibilityArgs) visibility() (*jira.Visibility, error) {
+	if v.VisibilityType == "" && v.VisibilityValue == "" {
+		return nil, nil
+	}
+	if v.VisibilityType == "" {
+		return nil, &mcp.ArgsError{Problems: []mcp.ArgProblem{{Arg: "visibility_type", Message: "is required with visibility_value"}}}
+	}
+	if v.VisibilityValue == "" {
+		return nil, &mcp.ArgsError{Problems: []mcp.ArgProblem{{Arg: "visibility_value", Message: "is required with visibility_type"}}}
 	}
 
-	totalSeconds := 0
-	for _, match := range matches {
-		value, err := strconv.Atoi(match[1])
-		if err != nil {
-			return 0, fmt.Errorf("invalid number in time format: %s", match[1])
-		}
-
-		unit := match[2]
-		switch unit {
-		case "w":
-			totalSeconds += value * 5 * 8 * 60 * 60 // 5 days * 8 hours
-		case "d":
-			totalSeconds += value * 8 * 60 * 60 // 8 hours
-		case "h":
-			totalSeconds += value * 60 * 60
-		case "m":
-			totalSeconds += value * 60
+	return &jira.Visibility{Type: v.VisibilityType, Value: v.VisibilityValue}, nil
+}
+
+// JiraUpdateAssetTool creates the jira_update_asset tool
+func JiraUpdateAssetTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_update_asset",
+		"Update attributes of an Assets (CMDB) object, such as its status, owner, or IP address. Attributes are given by name; other attributes are left unchanged.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"object":     mcp.NewStringProperty("Object key (e.g., 'CMDB-42') or numeric object ID"),
+				"attributes": mcp.NewObjectProperty("Attribute values by attribute name, e.g. {\"Status\": \"Retired\", \"Tags\": [\"db\", \"eu\"]}. A list sets a multi-value attribute, null clears an attribute, and references to other objects take the referenced object's key", nil),
+			},
+			"object", "attributes",
+		),
+		jiraUpdateAssetHandler,
+		"jira", "write",
+	)
+}
+
+func jiraUpdateAssetHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Object     string                 `arg:"object,trim" validate:"required"`
+		Attributes map[string]interface{} `arg:"attributes" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]string, len(params.Attributes))
+	for name, value := range params.Attributes {
+		switch v := value.(type) {
+		case nil:
+			values[name] = nil
+		case []interface{}:
+			for _, item := range v {
+				values[name] = append(values[name], fmt.Sprint(item))
+			}
 		default:
-			return 0, fmt.Errorf("unknown time unit: %s", unit)
+			values[name] = []string{fmt.Sprint(v)}
+		}
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	asset, err := client.UpdateAssetAttributes(ctx, params.Object, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update asset: %w", err)
+	}
+
+	result := assetSummary(asset)
+	result["message"] = fmt.Sprintf("Successfully updated %d attributes of %s", len(values), asset.Key)
+	return mcp.NewJSONResult(result)
+}
+
+// JiraTriggerAutomationTool creates the jira_trigger_automation tool
+func JiraTriggerAutomationTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_trigger_automation",
+		"Trigger an existing Jira Automation rule through its incoming webhook, instead of reimplementing what the rule does. Only rules whose webhooks the server administrator configured can be triggered; calling with an unknown rule lists them. The rule runs asynchronously, so check its effects (or the rule's audit log) afterwards.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"rule":       mcp.NewStringProperty("Name of the configured automation rule (e.g., 'escalate-incident')"),
+				"issue_keys": mcp.NewArrayProperty("Issues the rule should run on (e.g., ['PROJ-123']), for rules that take issues from the webhook request", mcp.NewStringProperty("Issue key or URL")),
+				"data":       mcp.NewObjectProperty("Data for the rule, available in smart values as {{webhookData.data.<name>}}", nil),
+			},
+			"rule",
+		),
+		jiraTriggerAutomationHandler,
+		"jira", "write",
+	)
+}
+
+func jiraTriggerAutomationHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Rule      string                 `arg:"rule,trim" validate:"required"`
+		IssueKeys []string               `arg:"issue_keys"`
+		Data      map[string]interface{} `arg:"data"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	trigger := &jira.AutomationTrigger{Data: params.Data}
+	for _, key := range params.IssueKeys {
+		trigger.IssueKeys = append(trigger.IssueKeys, jira.ParseIssueKey(key))
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	if err := client.TriggerAutomation(ctx, params.Rule, trigger); err != nil {
+		return nil, err
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"rule":       params.Rule,
+		"issue_keys": trigger.IssueKeys,
+		"message":    fmt.Sprintf("Triggered automation rule %s; it runs asynchronously", params.Rule),
+	})
+}
+
+// JiraSetIssuePropertyTool creates the jira_set_issue_property tool
+func JiraSetIssuePropertyTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_set_issue_property",
+		"Store structured JSON metadata on a Jira issue under a property key, replacing any previous value. Use it to keep state (e.g., triage results, sync markers) on an issue without creating custom fields; properties don't appear in the issue view and don't notify watchers. Read it back with jira_get_issue_property.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"issue_key":    mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
+				"property_key": mcp.NewStringProperty("Property key; prefix it with your integration's name to avoid clashes (e.g., 'com.example.triage')"),
+				"value":        mcp.NewObjectProperty("JSON object to store (at most 32 KB), e.g. {\"severity\": \"high\", \"checked\": true}", nil),
+			},
+			"issue_key", "property_key", "value",
+		),
+		jiraSetIssuePropertyHandler,
+		"jira", "write",
+	)
+}
+
+func jiraSetIssuePropertyHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		IssueKey    string                 `arg:"issue_key,trim" validate:"required"`
+		PropertyKey string                 `arg:"property_key,trim" validate:"required,max=255"`
+		Value       map[string]interface{} `arg:"value" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	if err := client.SetIssueProperty(ctx, params.IssueKey, params.PropertyKey, params.Value); err != nil {
+		return nil, fmt.Errorf("failed to set issue property: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"issue_key": params.IssueKey,
+		"key":       params.PropertyKey,
+		"message":   fmt.Sprintf("Successfully set property %s on %s", params.PropertyKey, params.IssueKey),
+	})
+}
+
+// JiraAddLabelsTool creates the jira_add_labels tool
+func JiraAddLabelsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_add_labels",
+		"Add labels to a Jira issue, keeping its existing labels. Labels can't contain spaces. Use jira_get_all_labels first to reuse existing labels instead of inventing near-duplicates.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"issue_key": mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
+				"labels":    mcp.NewArrayProperty("Labels to add (e.g., ['backend', 'needs-triage'])", mcp.NewStringProperty("Label")),
+			},
+			"issue_key", "labels",
+		),
+		jiraAddLabelsHandler,
+		"jira", "write",
+	)
+}
+
+func jiraAddLabelsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		IssueKey string   `arg:"issue_key,trim" validate:"required"`
+		Labels   []string `arg:"labels" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	argsErr := &mcp.ArgsError{}
+	for _, label := range params.Labels {
+		if err := jira.ValidateLabel(label); err != nil {
+			argsErr.Problems = append(argsErr.Problems, mcp.ArgProblem{Arg: "labels", Message: err.Error()})
+		}
+	}
+	if len(argsErr.Problems) > 0 {
+		return nil, argsErr
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	if err := client.UpdateLabels(ctx, params.IssueKey, params.Labels, nil); err != nil {
+		return nil, fmt.Errorf("failed to add labels: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"issue_key": params.IssueKey,
+		"added":     params.Labels,
+		"message":   fmt.Sprintf("Successfully added %d label(s) to %s", len(params.Labels), params.IssueKey),
+	})
+}
+
+// JiraRemoveLabelsTool creates the jira_remove_labels tool
+func JiraRemoveLabelsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_remove_labels",
+		"Remove labels from a Jira issue, keeping its other labels. Labels the issue doesn't have are ignored.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"issue_key": mcp.NewStringProperty("Issue key (e.g., 'PROJ-123')"),
+				"labels":    mcp.NewArrayProperty("Labels to remove", mcp.NewStringProperty("Label")),
+			},
+			"issue_key", "labels",
+		),
+		jiraRemoveLabelsHandler,
+		"jira", "write",
+	)
+}
+
+func jiraRemoveLabelsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		IssueKey string   `arg:"issue_key,trim" validate:"required"`
+		Labels   []string `arg:"labels" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	if err := client.UpdateLabels(ctx, params.IssueKey, nil, params.Labels); err != nil {
+		return nil, fmt.Errorf("failed to remove labels: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"issue_key": params.IssueKey,
+		"removed":   params.Labels,
+		"message":   fmt.Sprintf("Successfully removed %d label(s) from %s", len(params.Labels), params.IssueKey),
+	})
+}
+
+// sprintMoveChunk is the most issues the Agile API moves to a sprint at once
+const sprintMoveChunk = 50
+
+// JiraMoveIssuesTool creates the jira_move_issues tool
+func JiraMoveIssuesTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"jira_move_issues",
+		"Move all issues of a fix version or sprint to another one, e.g. when a release slips or unfinished work rolls over to the next sprint. A fix version is replaced on each issue, keeping its other fix versions. Use dry_run to review the affected issues first, and jql to move only some of them (e.g., 'statusCategory != Done').",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"kind":        mcp.NewEnumProperty("What to move the issues between", "fix_version", "sprint"),
+				"from":        mcp.NewStringProperty("Version name or ID, or sprint ID, the issues are in"),
+				"to":          mcp.NewStringProperty("Version name or ID, or sprint ID, to move the issues to"),
+				"project_key": mcp.NewStringProperty("Project of the versions (required for fix_version)"),
+				"jql":         mcp.NewStringProperty("Only move the issues that also match this JQL"),
+				"dry_run":     mcp.NewBooleanProperty("Only list the issues that would be moved").WithDefault(false),
+				"max_issues": mcp.NewIntegerProperty("Maximum number of issues to move").
+					WithDefault(500).WithMinimum(1).WithMaximum(2000),
+				"concurrency": mcp.NewIntegerProperty("Number of updates run at once (defaults to the server's BATCH_CONCURRENCY, max 16)").
+					WithMinimum(1).WithMaximum(16),
+			},
+			"kind", "from", "to",
+		),
+		jiraMoveIssuesHandler,
+		"jira", "write",
+	)
+}
+
+func jiraMoveIssuesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Kind        string `arg:"kind,trim" validate:"required,oneof=fix_version sprint"`
+		From        string `arg:"from,trim" validate:"required"`
+		To          string `arg:"to,trim" validate:"required"`
+		ProjectKey  string `arg:"project_key,trim"`
+		JQL         string `arg:"jql"`
+		DryRun      bool   `arg:"dry_run"`
+		MaxIssues   int    `arg:"max_issues" default:"500" validate:"min=1,max=2000"`
+		Concurrency int    `arg:"concurrency"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetJiraClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Jira client not available")
+	}
+
+	var clause, from, to string
+	var move func(ctx context.Context, keys []string) error
+	var chunk int
+	switch params.Kind {
+	case "fix_version":
+		if params.ProjectKey == "" {
+			return nil, &mcp.ArgsError{Problems: []mcp.ArgProblem{{Arg: "project_key", Message: "project_key is required to move issues between fix versions"}}}
+		}
+		fromVersion, err := client.ResolveVersion(ctx, params.ProjectKey, params.From)
+		if err != nil {
+			return nil, err
+		}
+		toVersion, err := client.ResolveVersion(ctx, params.ProjectKey, params.To)
+		if err != nil {
+			return nil, err
+		}
+		clause = "fixVersion = " + fromVersion.ID
+		from, to = fromVersion.Name, toVersion.Name
+		// Fix versions are set issue by issue
+		chunk = 1
+		move = func(ctx context.Context, keys []string) error {
+			return client.MoveIssueFixVersion(ctx, keys[0], fromVersion.ID, toVersion.ID)
+		}
+
+	case "sprint":
+		fromID, err1 := strconv.Atoi(params.From)
+		toID, err2 := strconv.Atoi(params.To)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("sprints are given by ID (see jira_get_sprints_from_board)")
+		}
+		fromSprint, err := client.GetSprint(ctx, fromID)
+		if err != nil {
+			return nil, err
+		}
+		toSprint, err := client.GetSprint(ctx, toID)
+		if err != nil {
+			return nil, err
+		}
+		if toSprint.State == "closed" {
+			return nil, fmt.Errorf("sprint %s is closed; issues can only be moved to a future or active sprint", toSprint.Name)
+		}
+		clause = fmt.Sprintf("sprint = %d", fromID)
+		from, to = fromSprint.Name, toSprint.Name
+		chunk = sprintMoveChunk
+		move = func(ctx context.Context, keys []string) error {
+			return client.MoveIssuesToSprint(ctx, toID, keys)
+		}
+	}
+
+	query := clause
+	if params.JQL != "" {
+		query += " AND (" + jira.StripOrderBy(params.JQL) + ")"
+	}
+	query += " ORDER BY key ASC"
+
+	searchResult, err := client.SearchAllIssues(ctx, query, &jira.SearchOptions{Fields: []string{"summary", "status"}}, params.MaxIssues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	affected := make([]map[string]interface{}, 0, len(searchResult.Issues))
+	keys := make([]string, 0, len(searchResult.Issues))
+	for _, issue := range searchResult.Issues {
+		item := map[string]interface{}{"key": issue.Key, "summary": issue.Fields.Summary}
+		if issue.Fields.Status != nil {
+			item["status"] = issue.Fields.Status.Name
 		}
+		affected = append(affected, item)
+		keys = append(keys, issue.Key)
 	}
 
-	return totalSeconds, nil
+	result := map[string]interface{}{
+		"from":   from,
+		"to":     to,
+		"issues": affected,
+		"count":  len(affected),
+		"jql":    query,
+	}
+	if searchResult.HasMore() {
+		result["truncated"] = true
+	}
+
+	if params.DryRun {
+		result["dry_run"] = true
+		result["message"] = fmt.Sprintf("%d issues would be moved from %s to %s", len(affected), from, to)
+		return mcp.NewJSONResult(result)
+	}
+
+	var chunks [][]string
+	for start := 0; start < len(keys); start += chunk {
+		chunks = append(chunks, keys[start:min(start+chunk, len(keys))])
+	}
+	moves := batch.Run(ctx, chunks, batch.Concurrency(ctx, params.Concurrency), func(ctx context.Context, keys []string) (interface{}, error) {
+		return nil, move(ctx, keys)
+	})
+
+	moved := make([]string, 0, len(keys))
+	var errors []string
+	for _, item := range moves.Items {
+		if item.Status == batch.StatusOK {
+			moved = append(moved, chunks[item.Index]...)
+		} else {
+			errors = append(errors, fmt.Sprintf("%s: %s", strings.Join(chunks[item.Index], ", "), item.Error))
+		}
+	}
+
+	message := fmt.Sprintf("Moved %d of %d issues from %s to %s", len(moved), len(keys), from, to)
+	if searchResult.HasMore() {
+		message += "; more issues remain, run the tool again to move them"
+	}
+	result["moved"] = moved
+	result["message"] = message
+	if len(errors) > 0 {
+		result["errors"] = errors
+	}
+	return mcp.NewJSONResult(result)
 }
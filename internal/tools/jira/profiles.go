@@ -0,0 +1,61 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+)
+
+const fieldProfilesKey contextKey = "jira_field_profiles"
+
+// defaultToolProfiles is the field profile each issue tool uses when the
+// call does not request fields
+var defaultToolProfiles = map[string]string{
+	"jira_get_issue":          jira.FieldProfileEssential,
+	"jira_search":             jira.FieldProfileIssueSummary,
+	"jira_get_project_issues": jira.FieldProfileIssueSummary,
+	"jira_get_board_issues":   jira.FieldProfileIssueSummary,
+	"jira_get_sprint_issues":  jira.FieldProfileIssueSummary,
+}
+
+// fieldProfiles holds the configured field profiles and per-tool defaults
+type fieldProfiles struct {
+	profiles jira.FieldProfiles
+	tools    map[string]string
+}
+
+// WithFieldProfiles adds custom field profiles and per-tool default profiles
+// to the context. Custom profiles are merged over the built-in profiles.
+func WithFieldProfiles(ctx context.Context, custom map[string][]string, toolDefaults map[string]string) (context.Context, error) {
+	settings := &fieldProfiles{
+		profiles: jira.DefaultFieldProfiles().Merge(custom),
+		tools:    make(map[string]string, len(defaultToolProfiles)),
+	}
+	for tool, profile := range defaultToolProfiles {
+		settings.tools[tool] = profile
+	}
+
+	for tool, profile := range toolDefaults {
+		if _, ok := defaultToolProfiles[tool]; !ok {
+			return ctx, fmt.Errorf("tool %s does not support field profiles", tool)
+		}
+		if !settings.profiles.Has(profile) {
+			return ctx, fmt.Errorf("unknown field profile %q for tool %s (available: %v)", profile, tool, settings.profiles.Names())
+		}
+		settings.tools[tool] = profile
+	}
+
+	return context.WithValue(ctx, fieldProfilesKey, settings), nil
+}
+
+// resolveFields returns the fields requested by the "fields" argument of a
+// tool call, falling back to the tool's default profile
+func resolveFields(ctx context.Context, tool, spec string) []string {
+	settings, ok := ctx.Value(fieldProfilesKey).(*fieldProfiles)
+	if !ok {
+		settings = &fieldProfiles{profiles: jira.DefaultFieldProfiles(), tools: defaultToolProfiles}
+	}
+
+	return settings.profiles.Resolve(spec, settings.tools[tool])
+}
@@ -3,15 +3,23 @@ package jira
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/codeownersnet/atlas/internal/config"
 	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/raw"
+	"github.com/codeownersnet/atlas/internal/tools/session"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
 	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
 )
 
 // Context key for storing Jira client
 type contextKey string
 
-const jiraClientKey contextKey = "jira_client"
+const (
+	jiraClientKey    contextKey = "jira_client"
+	jiraInstancesKey contextKey = "jira_instances"
+)
 
 // WithJiraClient adds a Jira client to the context
 func WithJiraClient(ctx context.Context, client *jira.Client) context.Context {
@@ -27,8 +35,25 @@ func GetJiraClient(ctx context.Context) *jira.Client {
 	return client
 }
 
-// RegisterJiraTools registers all Jira tools with the MCP server
-func RegisterJiraTools(server *mcp.Server) error {
+// WithJiraInstances adds named Jira clients to the context
+func WithJiraInstances(ctx context.Context, clients map[string]*jira.Client) context.Context {
+	return context.WithValue(ctx, jiraInstancesKey, clients)
+}
+
+// GetJiraInstance retrieves a named Jira client from the context
+func GetJiraInstance(ctx context.Context, name string) *jira.Client {
+	clients, ok := ctx.Value(jiraInstancesKey).(map[string]*jira.Client)
+	if !ok {
+		return nil
+	}
+	return clients[strings.ToLower(name)]
+}
+
+// RegisterJiraTools registers all Jira tools with the MCP server.
+// When instance names are given, every tool accepts an optional "instance"
+// argument that selects which named Jira client handles the call. API errors
+// returned by the tools carry remediation hints.
+func RegisterJiraTools(server *mcp.Server, instances ...string) error {
 	tools := []struct {
 		name string
 		tool *mcp.ToolDefinition
@@ -36,18 +61,33 @@ func RegisterJiraTools(server *mcp.Server) error {
 		// Read operations
 		{"jira_get_issue", JiraGetIssueTool()},
 		{"jira_search", JiraSearchTool()},
+		{"jira_build_jql", JiraBuildJQLTool()},
 		{"jira_search_fields", JiraSearchFieldsTool()},
 		{"jira_get_all_projects", JiraGetAllProjectsTool()},
 		{"jira_get_project_issues", JiraGetProjectIssuesTool()},
 		{"jira_get_project_versions", JiraGetProjectVersionsTool()},
+		{"jira_get_security_levels", JiraGetSecurityLevelsTool()},
+		{"jira_get_project_roles", JiraGetProjectRolesTool()},
 		{"jira_get_transitions", JiraGetTransitionsTool()},
 		{"jira_get_worklog", JiraGetWorklogTool()},
+		{"jira_worklog_report", JiraWorklogReportTool()},
 		{"jira_get_agile_boards", JiraGetAgileBoardsTool()},
 		{"jira_get_board_issues", JiraGetBoardIssuesTool()},
 		{"jira_get_sprints_from_board", JiraGetSprintsFromBoardTool()},
 		{"jira_get_sprint_issues", JiraGetSprintIssuesTool()},
+		{"jira_sprint_report", JiraSprintReportTool()},
 		{"jira_get_issue_link_types", JiraGetIssueLinkTypesTool()},
 		{"jira_get_user_profile", JiraGetUserProfileTool()},
+		{"jira_get_myself", JiraGetMyselfTool()},
+		{"jira_get_my_permissions", JiraGetMyPermissionsTool()},
+		{"jira_search_users", JiraSearchUsersTool()},
+		{"jira_get_groups", JiraGetGroupsTool()},
+		{"jira_search_assets", JiraSearchAssetsTool()},
+		{"jira_get_asset", JiraGetAssetTool()},
+		{"jira_get_issue_property", JiraGetIssuePropertyTool()},
+		{"jira_refresh_metadata", JiraRefreshMetadataTool()},
+		{"jira_get_all_labels", JiraGetAllLabelsTool()},
+		{"jira_get_board_configuration", JiraGetBoardConfigurationTool()},
 
 		// Write operations
 		{"jira_create_issue", JiraCreateIssueTool()},
@@ -62,12 +102,25 @@ func RegisterJiraTools(server *mcp.Server) error {
 		{"jira_remove_issue_link", JiraRemoveIssueLinkTool()},
 		{"jira_create_sprint", JiraCreateSprintTool()},
 		{"jira_update_sprint", JiraUpdateSprintTool()},
+		{"jira_create_board", JiraCreateBoardTool()},
 		{"jira_create_version", JiraCreateVersionTool()},
 		{"jira_batch_create_issues", JiraBatchCreateIssuesTool()},
 		{"jira_batch_create_versions", JiraBatchCreateVersionsTool()},
+		{"jira_update_asset", JiraUpdateAssetTool()},
+		{"jira_trigger_automation", JiraTriggerAutomationTool()},
+		{"jira_set_issue_property", JiraSetIssuePropertyTool()},
+		{"jira_add_labels", JiraAddLabelsTool()},
+		{"jira_remove_labels", JiraRemoveLabelsTool()},
+		{"jira_move_issues", JiraMoveIssuesTool()},
 	}
 
 	for _, t := range tools {
+		session.WithDefaultArgs(t.tool, session.JiraArgs)
+		withIssueKeyArgs(t.tool)
+		withRemediationHints(t.tool)
+		if len(instances) > 0 {
+			withInstanceArg(t.tool, instances)
+		}
 		if err := server.RegisterTool(t.tool); err != nil {
 			return fmt.Errorf("failed to register %s: %w", t.name, err)
 		}
@@ -75,3 +128,87 @@ func RegisterJiraTools(server *mcp.Server) error {
 
 	return nil
 }
+
+// RegisterJiraRawRequestTool registers jira_raw_request, which sends a
+// request to any Jira API path. In read-only mode it only sends GET requests.
+func RegisterJiraRawRequestTool(server *mcp.Server, readOnly bool, instances ...string) error {
+	tool := raw.Tool("jira", "Jira", "/rest/api/2/field", readOnly, func(ctx context.Context, method, path string, body []byte) (*atlassian.RawResponse, error) {
+		client := GetJiraClient(ctx)
+		if client == nil {
+			return nil, fmt.Errorf("Jira client not available")
+		}
+		return client.Raw(ctx, method, path, body)
+	})
+	withRemediationHints(tool)
+	if len(instances) > 0 {
+		withInstanceArg(tool, instances)
+	}
+	return server.RegisterTool(tool)
+}
+
+// issueKeyArgs are the arguments that hold a single issue key
+var issueKeyArgs = []string{"issue_key", "epic_key", "from_key", "to_key"}
+
+// issueKeyArgPattern matches what an issue key argument accepts: a key such
+// as PROJ-123, a numeric issue ID, or an issue URL
+const issueKeyArgPattern = `^\s*([A-Za-z][A-Za-z0-9_]*-[0-9]+|[0-9]+|https?://\S+)\s*$`
+
+// withIssueKeyArgs wraps the handler of a tool so its issue key arguments
+// also accept issue URLs, which users paste constantly
+func withIssueKeyArgs(def *mcp.ToolDefinition) {
+	var keyArgs []string
+	for _, arg := range issueKeyArgs {
+		prop, ok := def.InputSchema.Properties[arg]
+		if !ok {
+			continue
+		}
+		keyArgs = append(keyArgs, arg)
+		prop.Description += " (an issue URL such as https://example.atlassian.net/browse/PROJ-123 also works)"
+		prop = prop.WithPattern(issueKeyArgPattern)
+		def.InputSchema.Properties[arg] = prop
+	}
+	if len(keyArgs) == 0 {
+		return
+	}
+
+	handler := def.Handler
+	def.Handler = func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+		parsed := make(map[string]interface{}, len(args))
+		for name, value := range args {
+			parsed[name] = value
+		}
+		for _, arg := range keyArgs {
+			if value, ok := args[arg].(string); ok {
+				parsed[arg] = jira.ParseIssueKey(value)
+			}
+		}
+		return handler(ctx, parsed)
+	}
+}
+
+// withInstanceArg adds the "instance" argument to a tool and wraps its handler
+// so the selected named client replaces the primary client in the context
+func withInstanceArg(def *mcp.ToolDefinition, instances []string) {
+	def.InputSchema.Properties["instance"] = mcp.NewEnumProperty(
+		"Jira instance to use (defaults to the primary instance)",
+		append([]string{config.DefaultInstanceName}, instances...)...,
+	).WithDefault(config.DefaultInstanceName)
+
+	handler := def.Handler
+	def.Handler = func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+		var params struct {
+			Instance string `arg:"instance,trim"`
+		}
+		if err := mcp.Bind(args, &params); err != nil {
+			return nil, err
+		}
+		if params.Instance != "" && params.Instance != config.DefaultInstanceName {
+			client := GetJiraInstance(ctx, params.Instance)
+			if client == nil {
+				return nil, fmt.Errorf("Jira instance %q is not configured", params.Instance)
+			}
+			ctx = WithJiraClient(ctx, client)
+		}
+		return handler(ctx, args)
+	}
+}
@@ -4,9 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/display"
+	"github.com/codeownersnet/atlas/pkg/atlassian/opsgenie"
 )
 
 // toJSON converts a value to a JSON string
@@ -35,9 +36,11 @@ func OpsgenieGetAlertTool() *mcp.ToolDefinition {
 }
 
 func opsgenieGetAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		ID string `arg:"id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -45,7 +48,7 @@ func opsgenieGetAlertHandler(ctx context.Context, args map[string]interface{}) (
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	alert, err := client.GetAlert(ctx, id)
+	alert, err := client.GetAlert(ctx, params.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get alert: %w", err)
 	}
@@ -55,69 +58,124 @@ func opsgenieGetAlertHandler(ctx context.Context, args map[string]interface{}) (
 
 // OpsgenieListAlertsTool creates the opsgenie_list_alerts tool
 func OpsgenieListAlertsTool() *mcp.ToolDefinition {
+	properties := alertQueryProperties()
+	properties["limit"] = mcp.NewIntegerProperty("Maximum number of alerts to return (default 20, max 100)").
+		WithMinimum(1).WithMaximum(100).
+		WithDefault(20)
+	properties["offset"] = mcp.NewIntegerProperty("Number of alerts to skip for pagination (default 0)").
+		WithDefault(0)
+	properties["fetch_all"] = mcp.NewBooleanProperty("Follow pagination and return every matching alert, up to 1000 (limit and offset are ignored)").
+		WithDefault(false)
+	properties["summarize"] = mcp.NewBooleanProperty("Return compact rows (id, message, priority, status, age, creation time in the display timezone, owner, tags) instead of full alerts; use it to triage many alerts").
+		WithDefault(false)
+
 	return mcp.NewTool(
 		"opsgenie_list_alerts",
-		"List and search Opsgenie alerts with optional query filtering and pagination. Query syntax supports field:value pairs (e.g., 'status:open priority:P1').",
-		mcp.NewInputSchema(
-			map[string]mcp.Property{
-				"query": mcp.NewStringProperty("Search query to filter alerts (e.g., 'status:open', 'priority:P1'). Leave empty to list all."),
-				"limit": mcp.NewIntegerProperty("Maximum number of alerts to return (default 20, max 100)").
-					WithDefault(20),
-				"offset": mcp.NewIntegerProperty("Number of alerts to skip for pagination (default 0)").
-					WithDefault(0),
-			},
-		),
+		"List and search Opsgenie alerts with optional filtering and pagination. Prefer the structured filters (status, priority, tags, teams, created_after, created_before); they are combined with AND and compiled into Opsgenie query syntax. Pages include next_offset and prev_offset to pass as offset. Set summarize for compact rows with the alert's age.",
+		mcp.NewInputSchema(properties).WithChunking(),
 		opsgenieListAlertsHandler,
 		"opsgenie", "read",
 	)
 }
 
 func opsgenieListAlertsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		alertFilterArgs
+		mcp.ChunkArgs
+		Limit     int  `arg:"limit" default:"20"`
+		Offset    int  `arg:"offset" default:"0"`
+		FetchAll  bool `arg:"fetch_all"`
+		Summarize bool `arg:"summarize"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
 	client := GetOpsgenieClient(ctx)
 	if client == nil {
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	query := ""
-	if q, ok := args["query"].(string); ok {
-		query = q
+	query, err := params.alertFilterArgs.query(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	limit := getIntArg(args, "limit", 20)
-	offset := getIntArg(args, "offset", 0)
+	if params.FetchAll {
+		result, err := client.ListAllAlerts(ctx, query, fetchAllLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list alerts: %w", err)
+		}
 
-	result, err := client.ListAlerts(ctx, query, limit, offset)
+		response := map[string]interface{}{
+			"count":     len(result.Data),
+			"total":     len(result.Data),
+			"truncated": false,
+		}
+		if len(result.Data) >= fetchAllLimit && result.Paging != nil && result.Paging.Next != "" {
+			total, err := client.CountAlerts(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count alerts: %w", err)
+			}
+			response["total"] = total
+			response["truncated"] = total > len(result.Data)
+			if total > len(result.Data) {
+				response["note"] = fmt.Sprintf("Results stop at the fetch_all limit of %d alerts; narrow the filters to see the rest", fetchAllLimit)
+			}
+		}
+
+		if params.ChunkSize > 0 {
+			if params.Summarize {
+				return mcp.NewChunkedJSONResult(response, opsgenie.SummarizeAlerts(result.Data, display.Now(ctx)), params.ChunkSize)
+			}
+			return mcp.NewChunkedJSONResult(response, result.Data, params.ChunkSize)
+		}
+		response["data"] = alertRows(ctx, result.Data, params.Summarize)
+		return mcp.NewJSONResult(response)
+	}
+
+	result, err := client.ListAlerts(ctx, query, params.Limit, params.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list alerts: %w", err)
 	}
 
-	return mcp.NewJSONResult(result)
+	return mcp.NewJSONResult(pageResult(alertRows(ctx, result.Data, params.Summarize), len(result.Data), result.Paging))
+}
+
+// alertRows returns the alerts as compact summaries when summarize is set,
+// and unchanged otherwise
+func alertRows(ctx context.Context, alerts []opsgenie.Alert, summarize bool) interface{} {
+	if summarize {
+		return opsgenie.SummarizeAlerts(alerts, display.Now(ctx))
+	}
+	return alerts
 }
 
 // OpsgenieCountAlertsTool creates the opsgenie_count_alerts tool
 func OpsgenieCountAlertsTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"opsgenie_count_alerts",
-		"Get the count of Opsgenie alerts matching a query. Useful for understanding alert volume without fetching all alert details.",
-		mcp.NewInputSchema(
-			map[string]mcp.Property{
-				"query": mcp.NewStringProperty("Search query to filter alerts (e.g., 'status:open', 'priority:P1'). Leave empty to count all."),
-			},
-		),
+		"Get the count of Opsgenie alerts matching the filters. Useful for understanding alert volume without fetching all alert details. Accepts the same structured filters as opsgenie_list_alerts.",
+		mcp.NewInputSchema(alertQueryProperties()),
 		opsgenieCountAlertsHandler,
 		"opsgenie", "read",
 	)
 }
 
 func opsgenieCountAlertsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params alertFilterArgs
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
 	client := GetOpsgenieClient(ctx)
 	if client == nil {
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	query := ""
-	if q, ok := args["query"].(string); ok {
-		query = q
+	query, err := params.query(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	count, err := client.CountAlerts(ctx, query)
@@ -131,6 +189,210 @@ func opsgenieCountAlertsHandler(ctx context.Context, args map[string]interface{}
 	})
 }
 
+// alertQueryProperties returns the alert filter properties shared by the list and count tools
+func alertQueryProperties() map[string]mcp.Property {
+	return map[string]mcp.Property{
+		"query": mcp.NewStringProperty("Raw Opsgenie search query (e.g., 'message: disk'), combined with the structured filters. Leave empty to match all."),
+		"status": mcp.NewEnumProperty("Alert state",
+			opsgenie.AlertQueryStatusOpen, opsgenie.AlertQueryStatusClosed,
+			opsgenie.AlertQueryStatusAcknowledged, opsgenie.AlertQueryStatusUnacknowledged),
+		"priority":       mcp.NewStringProperty("Comma-separated priorities; matches any (e.g., 'P1,P2')"),
+		"tags":           mcp.NewStringProperty("Comma-separated tags; matches alerts having all of them"),
+		"teams":          mcp.NewStringProperty("Comma-separated team names; matches any"),
+		"created_after":  mcp.NewStringProperty("Only alerts created at or after this time (ISO 8601, e.g., '2024-05-01T00:00:00Z', or an expression such as 'now-24h' or 'start-of-week')"),
+		"created_before": mcp.NewStringProperty("Only alerts created before this time (ISO 8601 or an expression such as 'today')"),
+	}
+}
+
+// alertFilterArgs are the alert filter arguments shared by the list and
+// count tools
+type alertFilterArgs struct {
+	Query         string   `arg:"query"`
+	Status        string   `arg:"status,trim"`
+	Priorities    []string `arg:"priority"`
+	Tags          []string `arg:"tags"`
+	Teams         []string `arg:"teams"`
+	CreatedAfter  string   `arg:"created_after,trim"`
+	CreatedBefore string   `arg:"created_before,trim"`
+}
+
+// query compiles the alert filters into an Opsgenie query string
+func (f alertFilterArgs) query(ctx context.Context) (string, error) {
+	q := &opsgenie.AlertQuery{
+		Query:      f.Query,
+		Status:     f.Status,
+		Priorities: f.Priorities,
+		Tags:       f.Tags,
+		Teams:      f.Teams,
+	}
+
+	if f.CreatedAfter != "" {
+		t, err := display.ParseTime(ctx, f.CreatedAfter)
+		if err != nil {
+			return "", fmt.Errorf("invalid created_after: %w", err)
+		}
+		q.CreatedAfter = t
+	}
+	if f.CreatedBefore != "" {
+		t, err := display.ParseTime(ctx, f.CreatedBefore)
+		if err != nil {
+			return "", fmt.Errorf("invalid created_before: %w", err)
+		}
+		q.CreatedBefore = t
+	}
+
+	if err := q.Validate(); err != nil {
+		return "", err
+	}
+
+	return q.String(), nil
+}
+
+// OpsgenieListAlertNotesTool creates the opsgenie_list_alert_notes tool
+func OpsgenieListAlertNotesTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_list_alert_notes",
+		"List the notes of an Opsgenie alert by ID, with pagination. Use nextOffset from the result as offset to fetch the next page.",
+		mcp.NewInputSchema(
+			alertActivityProperties(),
+			"id",
+		),
+		opsgenieListAlertNotesHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieListAlertNotesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		alertActivityArgs
+		ID string `arg:"id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	result, err := client.ListAlertNotes(ctx, params.ID, params.options())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert notes: %w", err)
+	}
+
+	return mcp.NewJSONResult(result)
+}
+
+// OpsgenieListAlertLogsTool creates the opsgenie_list_alert_logs tool
+func OpsgenieListAlertLogsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_list_alert_logs",
+		"List the activity log of an Opsgenie alert by ID (creation, acknowledgements, notifications, escalations, etc.), with pagination. Useful for reconstructing alert timelines. Use nextOffset from the result as offset to fetch the next page.",
+		mcp.NewInputSchema(
+			alertActivityProperties(),
+			"id",
+		),
+		opsgenieListAlertLogsHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieListAlertLogsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		alertActivityArgs
+		ID string `arg:"id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	result, err := client.ListAlertLogs(ctx, params.ID, params.options())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert logs: %w", err)
+	}
+
+	return mcp.NewJSONResult(result)
+}
+
+// OpsgenieListAlertRecipientsTool creates the opsgenie_list_alert_recipients tool
+func OpsgenieListAlertRecipientsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_list_alert_recipients",
+		"List the users notified about an Opsgenie alert by ID, with each user's notification state and method.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"id": mcp.NewStringProperty("Alert ID (required)"),
+			},
+			"id",
+		),
+		opsgenieListAlertRecipientsHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieListAlertRecipientsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ID string `arg:"id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	recipients, err := client.ListAlertRecipients(ctx, params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert recipients: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"recipients": recipients,
+		"total":      len(recipients),
+	})
+}
+
+// alertActivityProperties returns the input properties shared by the alert notes and logs tools
+func alertActivityProperties() map[string]mcp.Property {
+	return map[string]mcp.Property{
+		"id":     mcp.NewStringProperty("Alert ID (required)"),
+		"offset": mcp.NewStringProperty("Offset to start from, as returned in nextOffset of a previous page"),
+		"direction": mcp.NewEnumProperty("Paging direction relative to offset (default next)", "next", "prev").
+			WithDefault("next"),
+		"order": mcp.NewEnumProperty("Sort order by creation time (default desc)", "asc", "desc").
+			WithDefault("desc"),
+		"limit": mcp.NewIntegerProperty("Maximum number of entries to return (default 20, max 100)").
+			WithMinimum(1).WithMaximum(100).
+			WithDefault(20),
+	}
+}
+
+// alertActivityArgs are the pagination arguments of the alert notes/logs tools
+type alertActivityArgs struct {
+	Offset    string `arg:"offset,trim"`
+	Direction string `arg:"direction,trim"`
+	Order     string `arg:"order,trim"`
+	Limit     int    `arg:"limit" default:"20"`
+}
+
+// options builds the pagination options of an alert notes/logs request
+func (a alertActivityArgs) options() *opsgenie.AlertActivityOptions {
+	return &opsgenie.AlertActivityOptions{
+		Offset:    a.Offset,
+		Direction: a.Direction,
+		Order:     a.Order,
+		Limit:     a.Limit,
+	}
+}
+
 // OpsgenieGetRequestStatusTool creates the opsgenie_get_request_status tool
 func OpsgenieGetRequestStatusTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
@@ -148,9 +410,11 @@ func OpsgenieGetRequestStatusTool() *mcp.ToolDefinition {
 }
 
 func opsgenieGetRequestStatusHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	requestID, ok := args["request_id"].(string)
-	if !ok || requestID == "" {
-		return nil, fmt.Errorf("request_id is required")
+	var params struct {
+		RequestID string `arg:"request_id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -158,7 +422,7 @@ func opsgenieGetRequestStatusHandler(ctx context.Context, args map[string]interf
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	status, err := client.GetRequestStatus(ctx, requestID)
+	status, err := client.GetRequestStatus(ctx, params.RequestID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get request status: %w", err)
 	}
@@ -183,9 +447,11 @@ func OpsgenieGetIncidentTool() *mcp.ToolDefinition {
 }
 
 func opsgenieGetIncidentHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		ID string `arg:"id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -193,7 +459,7 @@ func opsgenieGetIncidentHandler(ctx context.Context, args map[string]interface{}
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	incident, err := client.GetIncident(ctx, id)
+	incident, err := client.GetIncident(ctx, params.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get incident: %w", err)
 	}
@@ -205,43 +471,154 @@ func opsgenieGetIncidentHandler(ctx context.Context, args map[string]interface{}
 func OpsgenieListIncidentsTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"opsgenie_list_incidents",
-		"List and search Opsgenie incidents with optional query filtering and pagination. Query syntax supports field:value pairs (e.g., 'status:open priority:P1').",
+		"List and search Opsgenie incidents with optional query filtering and pagination. Query syntax supports field:value pairs (e.g., 'status:open priority:P1'). Pages include next_offset and prev_offset to pass as offset.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"query": mcp.NewStringProperty("Search query to filter incidents (e.g., 'status:open', 'priority:P1'). Leave empty to list all."),
 				"limit": mcp.NewIntegerProperty("Maximum number of incidents to return (default 20, max 100)").
+					WithMinimum(1).WithMaximum(100).
 					WithDefault(20),
 				"offset": mcp.NewIntegerProperty("Number of incidents to skip for pagination (default 0)").
 					WithDefault(0),
+				"fetch_all": mcp.NewBooleanProperty("Follow pagination and return every matching incident, up to 1000 (limit and offset are ignored)").
+					WithDefault(false),
 			},
-		),
+		).WithChunking(),
 		opsgenieListIncidentsHandler,
 		"opsgenie", "read",
 	)
 }
 
 func opsgenieListIncidentsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		mcp.ChunkArgs
+		Query    string `arg:"query"`
+		Limit    int    `arg:"limit" default:"20"`
+		Offset   int    `arg:"offset" default:"0"`
+		FetchAll bool   `arg:"fetch_all"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
 	client := GetOpsgenieClient(ctx)
 	if client == nil {
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	query := ""
-	if q, ok := args["query"].(string); ok {
-		query = q
-	}
+	if params.FetchAll {
+		result, err := client.ListAllIncidents(ctx, params.Query, fetchAllLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list incidents: %w", err)
+		}
+
+		total := max(result.TotalCount, len(result.Data))
+		response := map[string]interface{}{
+			"count":     len(result.Data),
+			"total":     total,
+			"truncated": total > len(result.Data),
+		}
+		if total > len(result.Data) {
+			response["note"] = fmt.Sprintf("Results stop at the fetch_all limit of %d incidents; narrow the query to see the rest", fetchAllLimit)
+		}
 
-	limit := getIntArg(args, "limit", 20)
-	offset := getIntArg(args, "offset", 0)
+		if params.ChunkSize > 0 {
+			return mcp.NewChunkedJSONResult(response, result.Data, params.ChunkSize)
+		}
+		response["data"] = result.Data
+		return mcp.NewJSONResult(response)
+	}
 
-	result, err := client.ListIncidents(ctx, query, limit, offset)
+	result, err := client.ListIncidents(ctx, params.Query, params.Limit, params.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list incidents: %w", err)
 	}
 
+	response := pageResult(result.Data, len(result.Data), result.Paging)
+	if result.TotalCount > 0 {
+		response["total"] = result.TotalCount
+	}
+	return mcp.NewJSONResult(response)
+}
+
+// OpsgenieListServicesTool creates the opsgenie_list_services tool
+func OpsgenieListServicesTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_list_services",
+		"List Opsgenie services (business services that incidents can impact) with pagination. Use the service IDs as impacted_services when creating incidents.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"limit": mcp.NewIntegerProperty("Maximum number of services to return (default 20, max 100)").
+					WithMinimum(1).WithMaximum(100).
+					WithDefault(20),
+				"offset": mcp.NewIntegerProperty("Number of services to skip for pagination (default 0)").
+					WithDefault(0),
+			},
+		),
+		opsgenieListServicesHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieListServicesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	var params struct {
+		Limit  int `arg:"limit" default:"20"`
+		Offset int `arg:"offset" default:"0"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	result, err := client.ListServices(ctx, params.Limit, params.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
 	return mcp.NewJSONResult(result)
 }
 
+// OpsgenieGetServiceTool creates the opsgenie_get_service tool
+func OpsgenieGetServiceTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_get_service",
+		"Get details of an Opsgenie service by ID, including its owning team.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"id": mcp.NewStringProperty("Service ID (required)"),
+			},
+			"id",
+		),
+		opsgenieGetServiceHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieGetServiceHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		ID string `arg:"id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	service, err := client.GetService(ctx, params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service: %w", err)
+	}
+
+	return mcp.NewJSONResult(service)
+}
+
 // OpsgenieGetScheduleTool creates the opsgenie_get_schedule tool
 func OpsgenieGetScheduleTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
@@ -259,9 +636,11 @@ func OpsgenieGetScheduleTool() *mcp.ToolDefinition {
 }
 
 func opsgenieGetScheduleHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		ID string `arg:"id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -269,7 +648,7 @@ func opsgenieGetScheduleHandler(ctx context.Context, args map[string]interface{}
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	schedule, err := client.GetSchedule(ctx, id)
+	schedule, err := client.GetSchedule(ctx, params.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get schedule: %w", err)
 	}
@@ -308,12 +687,12 @@ func opsgenieListSchedulesHandler(ctx context.Context, args map[string]interface
 func OpsgenieGetScheduleTimelineTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"opsgenie_get_schedule_timeline",
-		"Get the timeline for an Opsgenie schedule within a specified time range. Shows who is on-call during each period. Dates must be in ISO 8601 format (e.g., '2024-01-15T00:00:00Z').",
+		"Get the timeline for an Opsgenie schedule within a specified time range. Shows who is on-call during each period. Dates are ISO 8601 (e.g., '2024-01-15T00:00:00Z') or expressions such as 'start-of-week' or 'now+7d'.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"id":   mcp.NewStringProperty("Schedule ID to retrieve timeline for"),
-				"from": mcp.NewStringProperty("Start date in ISO 8601 format (e.g., '2024-01-15T00:00:00Z')"),
-				"to":   mcp.NewStringProperty("End date in ISO 8601 format (e.g., '2024-01-22T00:00:00Z')"),
+				"from": mcp.NewStringProperty("Start date in ISO 8601 format (e.g., '2024-01-15T00:00:00Z') or an expression (e.g., 'start-of-week')"),
+				"to":   mcp.NewStringProperty("End date in ISO 8601 format (e.g., '2024-01-22T00:00:00Z') or an expression (e.g., 'end-of-week')"),
 			},
 			"id", "from", "to",
 		),
@@ -323,19 +702,13 @@ func OpsgenieGetScheduleTimelineTool() *mcp.ToolDefinition {
 }
 
 func opsgenieGetScheduleTimelineHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
-	}
-
-	fromStr, ok := args["from"].(string)
-	if !ok || fromStr == "" {
-		return nil, fmt.Errorf("from date is required")
+	var params struct {
+		ID   string `arg:"id,trim" validate:"required"`
+		From string `arg:"from,trim" validate:"required"`
+		To   string `arg:"to,trim" validate:"required"`
 	}
-
-	toStr, ok := args["to"].(string)
-	if !ok || toStr == "" {
-		return nil, fmt.Errorf("to date is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -343,21 +716,21 @@ func opsgenieGetScheduleTimelineHandler(ctx context.Context, args map[string]int
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	// Parse ISO 8601 dates
-	from, err := parseISO8601(fromStr)
+	from, err := display.ParseTime(ctx, params.From)
 	if err != nil {
-		return nil, fmt.Errorf("invalid from date format (use ISO 8601, e.g., '2024-01-15T00:00:00Z'): %w", err)
+		return nil, fmt.Errorf("invalid from date: %w", err)
 	}
 
-	to, err := parseISO8601(toStr)
+	to, err := display.ParseTime(ctx, params.To)
 	if err != nil {
-		return nil, fmt.Errorf("invalid to date format (use ISO 8601, e.g., '2024-01-22T00:00:00Z'): %w", err)
+		return nil, fmt.Errorf("invalid to date: %w", err)
 	}
 
-	timeline, err := client.GetScheduleTimeline(ctx, id, from, to)
+	timeline, err := client.GetScheduleTimeline(ctx, params.ID, from, to)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get schedule timeline: %w", err)
 	}
+	timeline.Localize(display.Now(ctx))
 
 	return mcp.NewJSONResult(timeline)
 }
@@ -366,10 +739,13 @@ func opsgenieGetScheduleTimelineHandler(ctx context.Context, args map[string]int
 func OpsgenieGetOnCallsTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"opsgenie_get_on_calls",
-		"Get current on-call users. If schedule ID is provided, returns on-calls for that schedule only. If no schedule is provided, returns on-calls for all schedules.",
+		"Get on-call users, now or at a specific point in time (e.g., who was on call last Tuesday at 02:00). If schedule ID is provided, returns on-calls for that schedule only. If no schedule is provided, returns on-calls for all schedules.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"schedule": mcp.NewStringProperty("Optional schedule ID to filter on-call users by specific schedule. Leave empty to get on-calls for all schedules."),
+				"date":     mcp.NewStringProperty("Optional point in time in ISO 8601 format (e.g., '2024-05-07T02:00:00Z') or an expression (e.g., 'yesterday 02:00'). Defaults to now."),
+				"flat": mcp.NewBooleanProperty("Resolve escalations and teams to the individual on-call users (default false)").
+					WithDefault(false),
 			},
 		),
 		opsgenieGetOnCallsHandler,
@@ -378,20 +754,39 @@ func OpsgenieGetOnCallsTool() *mcp.ToolDefinition {
 }
 
 func opsgenieGetOnCallsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Schedule string `arg:"schedule,trim"`
+		Date     string `arg:"date,trim"`
+		Flat     bool   `arg:"flat"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
 	client := GetOpsgenieClient(ctx)
 	if client == nil {
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	schedule := ""
-	if s, ok := args["schedule"].(string); ok {
-		schedule = s
+	opts := &opsgenie.OnCallOptions{Flat: params.Flat}
+	if params.Date != "" {
+		date, err := display.ParseTime(ctx, params.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date: %w", err)
+		}
+		opts.Date = date
 	}
 
-	onCalls, err := client.GetOnCalls(ctx, schedule)
+	onCalls, err := client.GetOnCallsWithOptions(ctx, params.Schedule, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get on-calls: %w", err)
 	}
+	for i := range onCalls {
+		if onCalls[i].Date != nil {
+			date := onCalls[i].Date.In(display.Location(ctx))
+			onCalls[i].Date = &date
+		}
+	}
 
 	return mcp.NewJSONResult(onCalls)
 }
@@ -413,9 +808,11 @@ func OpsgenieGetTeamTool() *mcp.ToolDefinition {
 }
 
 func opsgenieGetTeamHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		ID string `arg:"id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -423,7 +820,7 @@ func opsgenieGetTeamHandler(ctx context.Context, args map[string]interface{}) (*
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	team, err := client.GetTeam(ctx, id)
+	team, err := client.GetTeam(ctx, params.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get team: %w", err)
 	}
@@ -458,6 +855,311 @@ func opsgenieListTeamsHandler(ctx context.Context, args map[string]interface{})
 	return mcp.NewJSONResult(teams)
 }
 
+// OpsgenieListTeamMembersTool creates the opsgenie_list_team_members tool
+func OpsgenieListTeamMembersTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_list_team_members",
+		"List the members of an Opsgenie team with their roles (admin or user).",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"team": mcp.NewStringProperty("Team ID or name (required)"),
+			},
+			"team",
+		),
+		opsgenieListTeamMembersHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieListTeamMembersHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Team string `arg:"team,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	members, err := client.ListTeamMembers(ctx, params.Team)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team members: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"members": members,
+		"total":   len(members),
+	})
+}
+
+// OpsgenieListTeamRoutingRulesTool creates the opsgenie_list_team_routing_rules tool
+func OpsgenieListTeamRoutingRulesTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_list_team_routing_rules",
+		"List the routing rules of an Opsgenie team in evaluation order. Each rule shows the alert criteria, time restrictions, and which schedule or escalation is notified.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"team": mcp.NewStringProperty("Team ID (required)"),
+			},
+			"team",
+		),
+		opsgenieListTeamRoutingRulesHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieListTeamRoutingRulesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Team string `arg:"team,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	rules, err := client.ListTeamRoutingRules(ctx, params.Team)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team routing rules: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"routing_rules": rules,
+		"total":         len(rules),
+	})
+}
+
+// OpsgenieListIntegrationsTool creates the opsgenie_list_integrations tool
+func OpsgenieListIntegrationsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_list_integrations",
+		"List Opsgenie integrations with their type, enabled state, and owner team. An alert's integration field holds the ID of the integration that created it.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"type": mcp.NewStringProperty("Only integrations of this type (e.g., API, Prometheus, Email)"),
+				"team": mcp.NewStringProperty("Only integrations owned by this team ID"),
+			},
+		),
+		opsgenieListIntegrationsHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieListIntegrationsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Type string `arg:"type,trim"`
+		Team string `arg:"team,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	integrations, err := client.ListIntegrations(ctx, params.Type, params.Team)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list integrations: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"integrations": integrations,
+		"total":        len(integrations),
+	})
+}
+
+// OpsgenieGetIntegrationTool creates the opsgenie_get_integration tool
+func OpsgenieGetIntegrationTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_get_integration",
+		"Get an Opsgenie integration by ID, including its type-specific settings, owner team, and recipients.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"integration_id": mcp.NewStringProperty("Integration ID (required)"),
+			},
+			"integration_id",
+		),
+		opsgenieGetIntegrationHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieGetIntegrationHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		IntegrationID string `arg:"integration_id,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	integration, err := client.GetIntegration(ctx, params.IntegrationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get integration: %w", err)
+	}
+
+	return mcp.NewJSONResult(integration)
+}
+
+// OpsgenieListPoliciesTool creates the opsgenie_list_policies tool
+func OpsgenieListPoliciesTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_list_policies",
+		"List Opsgenie alert or notification policies in evaluation order with their enabled state. Alert policies modify alerts as they are created; notification policies suppress, delay, or deduplicate a team's notifications. Use opsgenie_get_policy for the filter and time restrictions.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"type": mcp.NewEnumProperty("Policy type (default alert)", "alert", "notification").WithDefault("alert"),
+				"team": mcp.NewStringProperty("Team ID (required for notification policies; omit for global alert policies)"),
+			},
+		),
+		opsgenieListPoliciesHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieListPoliciesHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Type string `arg:"type,trim" default:"alert" validate:"oneof=alert notification"`
+		Team string `arg:"team,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	policies, err := client.ListPolicies(ctx, opsgenie.PolicyType(params.Type), params.Team)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"policies": policies,
+		"total":    len(policies),
+	})
+}
+
+// OpsgenieGetPolicyTool creates the opsgenie_get_policy tool
+func OpsgenieGetPolicyTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_get_policy",
+		"Get an Opsgenie alert or notification policy by ID: the alert filter it matches, when it applies (time restrictions), and what it does (e.g., suppress notifications, change priority).",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"policy_id": mcp.NewStringProperty("Policy ID (required)"),
+				"team":      mcp.NewStringProperty("Team ID of the policy (required for team policies)"),
+			},
+			"policy_id",
+		),
+		opsgenieGetPolicyHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieGetPolicyHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		PolicyID string `arg:"policy_id,trim" validate:"required"`
+		Team     string `arg:"team,trim"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	policy, err := client.GetPolicy(ctx, params.PolicyID, params.Team)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policy: %w", err)
+	}
+
+	return mcp.NewJSONResult(policy)
+}
+
+// OpsgenieListHeartbeatsTool creates the opsgenie_list_heartbeats tool
+func OpsgenieListHeartbeatsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_list_heartbeats",
+		"List all Opsgenie heartbeats with their interval, enabled state, and whether they have expired (missed a ping).",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{},
+		),
+		opsgenieListHeartbeatsHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieListHeartbeatsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	heartbeats, err := client.ListHeartbeats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list heartbeats: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"heartbeats": heartbeats,
+		"total":      len(heartbeats),
+	})
+}
+
+// OpsgenieGetHeartbeatTool creates the opsgenie_get_heartbeat tool
+func OpsgenieGetHeartbeatTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_get_heartbeat",
+		"Get an Opsgenie heartbeat by name, including whether it has expired (missed a ping).",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"name": mcp.NewStringProperty("Heartbeat name (required)"),
+			},
+			"name",
+		),
+		opsgenieGetHeartbeatHandler,
+		"opsgenie", "read",
+	)
+}
+
+func opsgenieGetHeartbeatHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Name string `arg:"name" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	heartbeat, err := client.GetHeartbeat(ctx, params.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get heartbeat: %w", err)
+	}
+
+	return mcp.NewJSONResult(heartbeat)
+}
+
 // OpsgenieGetUserTool creates the opsgenie_get_user tool
 func OpsgenieGetUserTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
@@ -475,9 +1177,11 @@ func OpsgenieGetUserTool() *mcp.ToolDefinition {
 }
 
 func opsgenieGetUserHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	identifier, ok := args["identifier"].(string)
-	if !ok || identifier == "" {
-		return nil, fmt.Errorf("identifier is required")
+	var params struct {
+		Identifier string `arg:"identifier,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -485,7 +1189,7 @@ func opsgenieGetUserHandler(ctx context.Context, args map[string]interface{}) (*
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	user, err := client.GetUser(ctx, identifier)
+	user, err := client.GetUser(ctx, params.Identifier)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
@@ -493,37 +1197,22 @@ func opsgenieGetUserHandler(ctx context.Context, args map[string]interface{}) (*
 	return mcp.NewJSONResult(user)
 }
 
-// Helper function to get integer argument with default
-func getIntArg(args map[string]interface{}, key string, defaultVal int) int {
-	if val, ok := args[key]; ok {
-		switch v := val.(type) {
-		case int:
-			return v
-		case int64:
-			return int(v)
-		case float64:
-			return int(v)
-		}
-	}
-	return defaultVal
-}
+// fetchAllLimit caps the number of alerts returned with fetch_all
+const fetchAllLimit = 1000
 
-// Helper function to parse ISO 8601 date string
-func parseISO8601(dateStr string) (time.Time, error) {
-	// Try common ISO 8601 formats
-	formats := []string{
-		time.RFC3339,
-		time.RFC3339Nano,
-		"2006-01-02T15:04:05Z",
-		"2006-01-02T15:04:05",
-		"2006-01-02",
+// pageResult formats a page of a list with the offsets of the next and
+// previous pages, read from the paging links
+func pageResult(data interface{}, count int, paging *opsgenie.Pagination) map[string]interface{} {
+	response := map[string]interface{}{
+		"data":   data,
+		"count":  count,
+		"paging": paging,
 	}
-
-	for _, format := range formats {
-		if t, err := time.Parse(format, dateStr); err == nil {
-			return t, nil
-		}
+	if offset, ok := paging.NextOffset(); ok {
+		response["next_offset"] = offset
 	}
-
-	return time.Time{}, fmt.Errorf("unable to parse date '%s' using ISO 8601 format", dateStr)
+	if offset, ok := paging.PrevOffset(); ok {
+		response["prev_offset"] = offset
+	}
+	return response
 }
@@ -3,10 +3,14 @@ package opsgenie
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/display"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
 	"github.com/codeownersnet/atlas/pkg/atlassian/opsgenie"
 )
 
@@ -14,15 +18,21 @@ import (
 func OpsgenieCreateAlertTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"opsgenie_create_alert",
-		"Create a new Opsgenie alert. Alerts are notifications for specific events or issues. Requires message, and can include description, priority, responders, and tags.",
+		"Create a new Opsgenie alert. Alerts are notifications for specific events or issues. Requires message, and can include description, priority, responders, tags, and details. Alerts with the alias of an open alert are deduplicated into it (its count increases) instead of creating a new alert; the result reports whether this happened. Telling the two apart requires waiting for the request to be processed, so this is done for an alias that matches an open alert even without wait.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"message":     mcp.NewStringProperty("Brief message describing the alert (required)"),
 				"description": mcp.NewStringProperty("Detailed description of the alert"),
 				"priority": mcp.NewStringProperty("Priority level (P1, P2, P3, P4, P5 - default P3)").
-					WithDefault("P3"),
-				"responders": mcp.NewStringProperty("JSON string of responders array. Each responder should have 'type' (user/team/escalation/schedule) and 'id'. Example: '[{\"type\":\"user\",\"id\":\"user-id\"},{\"type\":\"team\",\"id\":\"team-id\"}]'"),
+					WithEnum("P1", "P2", "P3", "P4", "P5").WithDefault("P3"),
+				"responders": respondersProperty("Responders to notify about the alert"),
 				"tags":       mcp.NewStringProperty("Comma-separated tags to categorize the alert"),
+				"alias":      mcp.NewStringProperty("Client-defined identifier used for deduplication (max 512 characters)"),
+				"source":     mcp.NewStringProperty("Source of the alert (e.g., monitoring tool or host name)"),
+				"entity":     mcp.NewStringProperty("Entity the alert relates to (e.g., service or host)"),
+				"details":    mcp.NewStringProperty("JSON object of custom key/value properties. Example: '{\"region\":\"eu-west-1\",\"runbook\":\"https://...\"}'"),
+				"note":       mcp.NewStringProperty("Note to add to the alert when it is created"),
+				"wait":       waitProperty(),
 			},
 			"message",
 		),
@@ -32,9 +42,24 @@ func OpsgenieCreateAlertTool() *mcp.ToolDefinition {
 }
 
 func opsgenieCreateAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	message, ok := args["message"].(string)
-	if !ok || message == "" {
-		return nil, fmt.Errorf("message is required")
+	var params struct {
+		waitArgs
+		Message     string               `arg:"message" validate:"required"`
+		Description string               `arg:"description"`
+		Priority    string               `arg:"priority,trim" default:"P3"`
+		Responders  []opsgenie.Responder `arg:"responders"`
+		Tags        []string             `arg:"tags"`
+		Alias       string               `arg:"alias,trim"`
+		Source      string               `arg:"source,trim"`
+		Entity      string               `arg:"entity,trim"`
+		Note        string               `arg:"note"`
+		Details     string               `arg:"details"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+	if err := checkResponders(params.Responders); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -42,60 +67,37 @@ func opsgenieCreateAlertHandler(ctx context.Context, args map[string]interface{}
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	// Build alert request
 	req := &opsgenie.AlertRequest{
-		Message: message,
-	}
-
-	// Add optional description
-	if desc, ok := args["description"].(string); ok && desc != "" {
-		req.Description = desc
-	}
-
-	// Add priority (default to P3)
-	priority := "P3"
-	if p, ok := args["priority"].(string); ok && p != "" {
-		priority = p
-	}
-	req.Priority = opsgenie.Priority(priority)
-
-	// Add responders (accept either JSON string or array)
-	if respondersStr, ok := args["responders"].(string); ok && respondersStr != "" {
-		var respondersList []map[string]interface{}
-		if err := json.Unmarshal([]byte(respondersStr), &respondersList); err == nil {
-			responders := make([]opsgenie.Responder, 0, len(respondersList))
-			for _, respMap := range respondersList {
-				responder := opsgenie.Responder{}
-				if rType, ok := respMap["type"].(string); ok {
-					responder.Type = opsgenie.ResponderType(rType)
-				}
-				if id, ok := respMap["id"].(string); ok {
-					responder.ID = id
-				}
-				if name, ok := respMap["name"].(string); ok {
-					responder.Name = name
-				}
-				if responder.Type != "" && responder.ID != "" {
-					responders = append(responders, responder)
-				}
-			}
-			if len(responders) > 0 {
-				req.Responders = responders
-			}
+		Message:     params.Message,
+		Description: params.Description,
+		Priority:    opsgenie.Priority(params.Priority),
+		Responders:  params.Responders,
+		Tags:        params.Tags,
+		Alias:       params.Alias,
+		Source:      params.Source,
+		Entity:      params.Entity,
+		Note:        params.Note,
+	}
+
+	if params.Details != "" {
+		details, err := parseDetails(params.Details)
+		if err != nil {
+			return nil, err
 		}
+		req.Details = details
 	}
 
-	// Add tags (accept comma-separated string)
-	if tagsStr, ok := args["tags"].(string); ok && tagsStr != "" {
-		tags := strings.Split(tagsStr, ",")
-		trimmedTags := make([]string, 0, len(tags))
-		for _, tag := range tags {
-			if trimmed := strings.TrimSpace(tag); trimmed != "" {
-				trimmedTags = append(trimmedTags, trimmed)
-			}
+	// An open alert with the same alias may absorb the new one. It can be
+	// closed before the request is processed, so whether it did is only
+	// known from the alert the processed request points to.
+	var existing *opsgenie.Alert
+	if req.Alias != "" {
+		alert, err := client.GetAlertByAlias(ctx, req.Alias)
+		if err != nil && !errors.Is(err, atlassian.ErrNotFound) {
+			return nil, fmt.Errorf("failed to check for an alert with alias %s: %w", req.Alias, err)
 		}
-		if len(trimmedTags) > 0 {
-			req.Tags = trimmedTags
+		if alert != nil && alert.Status == opsgenie.AlertStatusOpen {
+			existing = alert
 		}
 	}
 
@@ -105,7 +107,65 @@ func opsgenieCreateAlertHandler(ctx context.Context, args map[string]interface{}
 		return nil, fmt.Errorf("failed to create alert: %w", err)
 	}
 
-	return mcp.NewJSONResult(alert)
+	if existing == nil {
+		if params.Wait {
+			return alertActionResult(ctx, client, true, alert.RequestID, "Alert created successfully")
+		}
+		return mcp.NewJSONResult(alert)
+	}
+
+	status, err := client.WaitForRequest(ctx, alert.RequestID, opsgenie.DefaultWaitTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if status.AlertID != existing.ID {
+		return mcp.NewJSONResult(map[string]interface{}{
+			"success":      true,
+			"message":      "Alert created successfully",
+			"request_id":   alert.RequestID,
+			"status":       status.Status,
+			"alert_id":     status.AlertID,
+			"deduplicated": false,
+		})
+	}
+
+	result := map[string]interface{}{
+		"success":      true,
+		"message":      fmt.Sprintf("Deduplicated into open alert %s with alias %s", existing.ID, req.Alias),
+		"request_id":   alert.RequestID,
+		"status":       status.Status,
+		"alert_id":     existing.ID,
+		"deduplicated": true,
+	}
+	// The count is read back, since other alerts may have been deduplicated
+	// into the same alert meanwhile
+	if updated, err := client.GetAlert(ctx, existing.ID); err == nil {
+		result["count"] = updated.Count
+	}
+	return mcp.NewJSONResult(result)
+}
+
+// parseDetails parses a JSON object of alert details, stringifying
+// non-string values since Opsgenie stores strings
+func parseDetails(detailsStr string) (map[string]string, error) {
+	var rawDetails map[string]interface{}
+	if err := json.Unmarshal([]byte(detailsStr), &rawDetails); err != nil {
+		return nil, fmt.Errorf("details must be a JSON object: %w", err)
+	}
+	if len(rawDetails) == 0 {
+		return nil, fmt.Errorf("no details provided")
+	}
+
+	details := make(map[string]string, len(rawDetails))
+	for key, value := range rawDetails {
+		if str, ok := value.(string); ok {
+			details[key] = str
+		} else {
+			encoded, _ := json.Marshal(value)
+			details[key] = string(encoded)
+		}
+	}
+	return details, nil
 }
 
 // OpsgenieCloseAlertTool creates the opsgenie_close_alert tool
@@ -117,6 +177,7 @@ func OpsgenieCloseAlertTool() *mcp.ToolDefinition {
 			map[string]mcp.Property{
 				"id":   mcp.NewStringProperty("Alert ID to close (required)"),
 				"note": mcp.NewStringProperty("Optional note explaining the closure reason"),
+				"wait": waitProperty(),
 			},
 			"id",
 		),
@@ -126,9 +187,13 @@ func OpsgenieCloseAlertTool() *mcp.ToolDefinition {
 }
 
 func opsgenieCloseAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		waitArgs
+		ID   string `arg:"id,trim" validate:"required"`
+		Note string `arg:"note"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -136,20 +201,12 @@ func opsgenieCloseAlertHandler(ctx context.Context, args map[string]interface{})
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	note := ""
-	if n, ok := args["note"].(string); ok {
-		note = n
-	}
-
-	err := client.CloseAlert(ctx, id, note)
+	requestID, err := client.CloseAlert(ctx, params.ID, params.Note)
 	if err != nil {
 		return nil, fmt.Errorf("failed to close alert: %w", err)
 	}
 
-	return mcp.NewJSONResult(map[string]interface{}{
-		"success": true,
-		"message": fmt.Sprintf("Alert %s closed successfully", id),
-	})
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Alert %s closed successfully", params.ID))
 }
 
 // OpsgenieAcknowledgeAlertTool creates the opsgenie_acknowledge_alert tool
@@ -161,6 +218,7 @@ func OpsgenieAcknowledgeAlertTool() *mcp.ToolDefinition {
 			map[string]mcp.Property{
 				"id":   mcp.NewStringProperty("Alert ID to acknowledge (required)"),
 				"note": mcp.NewStringProperty("Optional note explaining the acknowledgment"),
+				"wait": waitProperty(),
 			},
 			"id",
 		),
@@ -170,9 +228,13 @@ func OpsgenieAcknowledgeAlertTool() *mcp.ToolDefinition {
 }
 
 func opsgenieAcknowledgeAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		waitArgs
+		ID   string `arg:"id,trim" validate:"required"`
+		Note string `arg:"note"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -180,20 +242,53 @@ func opsgenieAcknowledgeAlertHandler(ctx context.Context, args map[string]interf
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	note := ""
-	if n, ok := args["note"].(string); ok {
-		note = n
+	requestID, err := client.AcknowledgeAlert(ctx, params.ID, params.Note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acknowledge alert: %w", err)
+	}
+
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Alert %s acknowledged successfully", params.ID))
+}
+
+// OpsgenieUnacknowledgeAlertTool creates the opsgenie_unacknowledge_alert tool
+func OpsgenieUnacknowledgeAlertTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_unacknowledge_alert",
+		"Unacknowledge an Opsgenie alert by ID, reverting an accidental or premature acknowledgment so notifications resume. Optionally add a note explaining why.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"id":   mcp.NewStringProperty("Alert ID to unacknowledge (required)"),
+				"note": mcp.NewStringProperty("Optional note explaining the unacknowledgment"),
+				"wait": waitProperty(),
+			},
+			"id",
+		),
+		opsgenieUnacknowledgeAlertHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieUnacknowledgeAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		waitArgs
+		ID   string `arg:"id,trim" validate:"required"`
+		Note string `arg:"note"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	err := client.AcknowledgeAlert(ctx, id, note)
+	requestID, err := client.UnacknowledgeAlert(ctx, params.ID, params.Note)
 	if err != nil {
-		return nil, fmt.Errorf("failed to acknowledge alert: %w", err)
+		return nil, fmt.Errorf("failed to unacknowledge alert: %w", err)
 	}
 
-	return mcp.NewJSONResult(map[string]interface{}{
-		"success": true,
-		"message": fmt.Sprintf("Alert %s acknowledged successfully", id),
-	})
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Alert %s unacknowledged successfully", params.ID))
 }
 
 // OpsgenieSnoozeAlertTool creates the opsgenie_snooze_alert tool
@@ -204,8 +299,9 @@ func OpsgenieSnoozeAlertTool() *mcp.ToolDefinition {
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"id":       mcp.NewStringProperty("Alert ID to snooze (required)"),
-				"end_time": mcp.NewStringProperty("End time for snooze in ISO 8601 format (e.g., 2024-01-01T12:00:00Z) (required)"),
+				"end_time": mcp.NewStringProperty("End time for snooze in ISO 8601 format (e.g., 2024-01-01T12:00:00Z) or an expression such as 'now+2h' or 'tomorrow 09:00' (required)"),
 				"note":     mcp.NewStringProperty("Optional note explaining the snooze reason"),
+				"wait":     waitProperty(),
 			},
 			"id", "end_time",
 		),
@@ -215,14 +311,14 @@ func OpsgenieSnoozeAlertTool() *mcp.ToolDefinition {
 }
 
 func opsgenieSnoozeAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		waitArgs
+		ID      string `arg:"id,trim" validate:"required"`
+		EndTime string `arg:"end_time,trim" validate:"required"`
+		Note    string `arg:"note"`
 	}
-
-	endTime, ok := args["end_time"].(string)
-	if !ok || endTime == "" {
-		return nil, fmt.Errorf("end_time is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -230,20 +326,18 @@ func opsgenieSnoozeAlertHandler(ctx context.Context, args map[string]interface{}
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	note := ""
-	if n, ok := args["note"].(string); ok {
-		note = n
+	endTime, err := display.ParseTime(ctx, params.EndTime)
+	if err != nil {
+		return nil, &mcp.ArgsError{Problems: []mcp.ArgProblem{{Arg: "end_time", Message: err.Error()}}}
 	}
+	until := endTime.Format(time.RFC3339)
 
-	err := client.SnoozeAlert(ctx, id, endTime, note)
+	requestID, err := client.SnoozeAlert(ctx, params.ID, until, params.Note)
 	if err != nil {
 		return nil, fmt.Errorf("failed to snooze alert: %w", err)
 	}
 
-	return mcp.NewJSONResult(map[string]interface{}{
-		"success": true,
-		"message": fmt.Sprintf("Alert %s snoozed successfully until %s", id, endTime),
-	})
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Alert %s snoozed successfully until %s", params.ID, until))
 }
 
 // OpsgenieEscalateAlertTool creates the opsgenie_escalate_alert tool
@@ -258,6 +352,7 @@ func OpsgenieEscalateAlertTool() *mcp.ToolDefinition {
 				"responder_id":   mcp.NewStringProperty("Responder ID (required)"),
 				"responder_name": mcp.NewStringProperty("Responder name (optional)"),
 				"note":           mcp.NewStringProperty("Optional note explaining the escalation reason"),
+				"wait":           waitProperty(),
 			},
 			"id", "responder_type", "responder_id",
 		),
@@ -267,19 +362,16 @@ func OpsgenieEscalateAlertTool() *mcp.ToolDefinition {
 }
 
 func opsgenieEscalateAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		waitArgs
+		ID            string `arg:"id,trim" validate:"required"`
+		ResponderType string `arg:"responder_type,trim" validate:"required"`
+		ResponderID   string `arg:"responder_id,trim" validate:"required"`
+		Note          string `arg:"note"`
+		ResponderName string `arg:"responder_name,trim"`
 	}
-
-	responderType, ok := args["responder_type"].(string)
-	if !ok || responderType == "" {
-		return nil, fmt.Errorf("responder_type is required")
-	}
-
-	responderID, ok := args["responder_id"].(string)
-	if !ok || responderID == "" {
-		return nil, fmt.Errorf("responder_id is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -289,28 +381,17 @@ func opsgenieEscalateAlertHandler(ctx context.Context, args map[string]interface
 
 	// Build responder object
 	responder := &opsgenie.Responder{
-		Type: opsgenie.ResponderType(responderType),
-		ID:   responderID,
+		Type: opsgenie.ResponderType(params.ResponderType),
+		ID:   params.ResponderID,
+		Name: params.ResponderName,
 	}
 
-	if name, ok := args["responder_name"].(string); ok && name != "" {
-		responder.Name = name
-	}
-
-	note := ""
-	if n, ok := args["note"].(string); ok {
-		note = n
-	}
-
-	err := client.EscalateAlert(ctx, id, responder, note)
+	requestID, err := client.EscalateAlert(ctx, params.ID, responder, params.Note)
 	if err != nil {
 		return nil, fmt.Errorf("failed to escalate alert: %w", err)
 	}
 
-	return mcp.NewJSONResult(map[string]interface{}{
-		"success": true,
-		"message": fmt.Sprintf("Alert %s escalated successfully", id),
-	})
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Alert %s escalated successfully", params.ID))
 }
 
 // OpsgenieAssignAlertTool creates the opsgenie_assign_alert tool
@@ -325,6 +406,7 @@ func OpsgenieAssignAlertTool() *mcp.ToolDefinition {
 				"responder_id":   mcp.NewStringProperty("Responder ID (required)"),
 				"responder_name": mcp.NewStringProperty("Responder name (optional)"),
 				"note":           mcp.NewStringProperty("Optional note explaining the assignment"),
+				"wait":           waitProperty(),
 			},
 			"id", "responder_type", "responder_id",
 		),
@@ -334,19 +416,16 @@ func OpsgenieAssignAlertTool() *mcp.ToolDefinition {
 }
 
 func opsgenieAssignAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
-	}
-
-	responderType, ok := args["responder_type"].(string)
-	if !ok || responderType == "" {
-		return nil, fmt.Errorf("responder_type is required")
+	var params struct {
+		waitArgs
+		ID            string `arg:"id,trim" validate:"required"`
+		ResponderType string `arg:"responder_type,trim" validate:"required"`
+		ResponderID   string `arg:"responder_id,trim" validate:"required"`
+		Note          string `arg:"note"`
+		ResponderName string `arg:"responder_name,trim"`
 	}
-
-	responderID, ok := args["responder_id"].(string)
-	if !ok || responderID == "" {
-		return nil, fmt.Errorf("responder_id is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -356,28 +435,60 @@ func opsgenieAssignAlertHandler(ctx context.Context, args map[string]interface{}
 
 	// Build responder object
 	responder := &opsgenie.Responder{
-		Type: opsgenie.ResponderType(responderType),
-		ID:   responderID,
+		Type: opsgenie.ResponderType(params.ResponderType),
+		ID:   params.ResponderID,
+		Name: params.ResponderName,
 	}
 
-	if name, ok := args["responder_name"].(string); ok && name != "" {
-		responder.Name = name
+	requestID, err := client.AssignAlert(ctx, params.ID, responder, params.Note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign alert: %w", err)
 	}
 
-	note := ""
-	if n, ok := args["note"].(string); ok {
-		note = n
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Alert %s assigned successfully", params.ID))
+}
+
+// OpsgenieExecuteCustomActionTool creates the opsgenie_execute_custom_action tool
+func OpsgenieExecuteCustomActionTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_execute_custom_action",
+		"Execute a custom action on an Opsgenie alert, such as a runbook step defined on the alert's integration. The alert's actions field lists the available actions.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"id":     mcp.NewStringProperty("Alert ID (required)"),
+				"action": mcp.NewStringProperty("Name of the custom action, exactly as listed in the alert's actions (required)"),
+				"note":   mcp.NewStringProperty("Optional note to add to the alert"),
+				"wait":   waitProperty(),
+			},
+			"id", "action",
+		),
+		opsgenieExecuteCustomActionHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieExecuteCustomActionHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		waitArgs
+		ID     string `arg:"id,trim" validate:"required"`
+		Action string `arg:"action,trim" validate:"required"`
+		Note   string `arg:"note"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	err := client.AssignAlert(ctx, id, responder, note)
+	requestID, err := client.ExecuteCustomAction(ctx, params.ID, params.Action, params.Note)
 	if err != nil {
-		return nil, fmt.Errorf("failed to assign alert: %w", err)
+		return nil, fmt.Errorf("failed to execute custom action: %w", err)
 	}
 
-	return mcp.NewJSONResult(map[string]interface{}{
-		"success": true,
-		"message": fmt.Sprintf("Alert %s assigned successfully", id),
-	})
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Custom action %q executed on alert %s", params.Action, params.ID))
 }
 
 // OpsgenieAddNoteToAlertTool creates the opsgenie_add_note_to_alert tool
@@ -389,6 +500,7 @@ func OpsgenieAddNoteToAlertTool() *mcp.ToolDefinition {
 			map[string]mcp.Property{
 				"id":   mcp.NewStringProperty("Alert ID to add note to (required)"),
 				"note": mcp.NewStringProperty("Note text to add to the alert (required)"),
+				"wait": waitProperty(),
 			},
 			"id", "note",
 		),
@@ -398,14 +510,13 @@ func OpsgenieAddNoteToAlertTool() *mcp.ToolDefinition {
 }
 
 func opsgenieAddNoteToAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		waitArgs
+		ID   string `arg:"id,trim" validate:"required"`
+		Note string `arg:"note" validate:"required"`
 	}
-
-	note, ok := args["note"].(string)
-	if !ok || note == "" {
-		return nil, fmt.Errorf("note is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -413,15 +524,12 @@ func opsgenieAddNoteToAlertHandler(ctx context.Context, args map[string]interfac
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	err := client.AddNoteToAlert(ctx, id, note)
+	requestID, err := client.AddNoteToAlert(ctx, params.ID, params.Note)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add note to alert: %w", err)
 	}
 
-	return mcp.NewJSONResult(map[string]interface{}{
-		"success": true,
-		"message": fmt.Sprintf("Note added to alert %s successfully", id),
-	})
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Note added to alert %s successfully", params.ID))
 }
 
 // OpsgenieAddTagsToAlertTool creates the opsgenie_add_tags_to_alert tool
@@ -434,6 +542,7 @@ func OpsgenieAddTagsToAlertTool() *mcp.ToolDefinition {
 				"id":   mcp.NewStringProperty("Alert ID to add tags to (required)"),
 				"tags": mcp.NewStringProperty("Comma-separated tags to add to the alert (required)"),
 				"note": mcp.NewStringProperty("Optional note explaining the tag addition"),
+				"wait": waitProperty(),
 			},
 			"id", "tags",
 		),
@@ -443,14 +552,70 @@ func OpsgenieAddTagsToAlertTool() *mcp.ToolDefinition {
 }
 
 func opsgenieAddTagsToAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		waitArgs
+		ID   string `arg:"id,trim" validate:"required"`
+		Tags string `arg:"tags" validate:"required"`
+		Note string `arg:"note"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	// Split tags by comma and trim whitespace
+	tags := strings.Split(params.Tags, ",")
+	trimmedTags := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if trimmed := strings.TrimSpace(tag); trimmed != "" {
+			trimmedTags = append(trimmedTags, trimmed)
+		}
 	}
 
-	tagsStr, ok := args["tags"].(string)
-	if !ok || tagsStr == "" {
-		return nil, fmt.Errorf("tags is required")
+	if len(trimmedTags) == 0 {
+		return nil, fmt.Errorf("no valid tags provided")
+	}
+
+	requestID, err := client.AddTagsToAlert(ctx, params.ID, trimmedTags, params.Note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add tags to alert: %w", err)
+	}
+
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Tags added to alert %s successfully", params.ID))
+}
+
+// OpsgenieRemoveTagsFromAlertTool creates the opsgenie_remove_tags_from_alert tool
+func OpsgenieRemoveTagsFromAlertTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_remove_tags_from_alert",
+		"Remove tags from an existing Opsgenie alert by ID. Provide tags as a comma-separated string.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"id":   mcp.NewStringProperty("Alert ID to remove tags from (required)"),
+				"tags": mcp.NewStringProperty("Comma-separated tags to remove from the alert (required)"),
+				"note": mcp.NewStringProperty("Optional note explaining the tag removal"),
+				"wait": waitProperty(),
+			},
+			"id", "tags",
+		),
+		opsgenieRemoveTagsFromAlertHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieRemoveTagsFromAlertHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		waitArgs
+		ID   string `arg:"id,trim" validate:"required"`
+		Tags string `arg:"tags" validate:"required"`
+		Note string `arg:"note"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -459,7 +624,7 @@ func opsgenieAddTagsToAlertHandler(ctx context.Context, args map[string]interfac
 	}
 
 	// Split tags by comma and trim whitespace
-	tags := strings.Split(tagsStr, ",")
+	tags := strings.Split(params.Tags, ",")
 	trimmedTags := make([]string, 0, len(tags))
 	for _, tag := range tags {
 		if trimmed := strings.TrimSpace(tag); trimmed != "" {
@@ -471,35 +636,402 @@ func opsgenieAddTagsToAlertHandler(ctx context.Context, args map[string]interfac
 		return nil, fmt.Errorf("no valid tags provided")
 	}
 
-	note := ""
-	if n, ok := args["note"].(string); ok {
-		note = n
+	requestID, err := client.RemoveTagsFromAlert(ctx, params.ID, trimmedTags, params.Note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove tags from alert: %w", err)
 	}
 
-	err := client.AddTagsToAlert(ctx, id, trimmedTags, note)
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Tags removed from alert %s successfully", params.ID))
+}
+
+// OpsgenieUpdateAlertPriorityTool creates the opsgenie_update_alert_priority tool
+func OpsgenieUpdateAlertPriorityTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_update_alert_priority",
+		"Change the priority of an existing Opsgenie alert by ID.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"id":       mcp.NewStringProperty("Alert ID to update (required)"),
+				"priority": mcp.NewEnumProperty("New priority level (required)", "P1", "P2", "P3", "P4", "P5"),
+				"wait":     waitProperty(),
+			},
+			"id", "priority",
+		),
+		opsgenieUpdateAlertPriorityHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieUpdateAlertPriorityHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		waitArgs
+		ID       string `arg:"id,trim" validate:"required"`
+		Priority string `arg:"priority,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	requestID, err := client.UpdateAlertPriority(ctx, params.ID, opsgenie.Priority(params.Priority))
 	if err != nil {
-		return nil, fmt.Errorf("failed to add tags to alert: %w", err)
+		return nil, fmt.Errorf("failed to update alert priority: %w", err)
+	}
+
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Alert %s priority updated to %s", params.ID, params.Priority))
+}
+
+// OpsgenieUpdateAlertMessageTool creates the opsgenie_update_alert_message tool
+func OpsgenieUpdateAlertMessageTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_update_alert_message",
+		"Change the message (title) of an existing Opsgenie alert by ID.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"id":      mcp.NewStringProperty("Alert ID to update (required)"),
+				"message": mcp.NewStringProperty("New alert message (required)"),
+				"wait":    waitProperty(),
+			},
+			"id", "message",
+		),
+		opsgenieUpdateAlertMessageHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieUpdateAlertMessageHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		waitArgs
+		ID      string `arg:"id,trim" validate:"required"`
+		Message string `arg:"message" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	requestID, err := client.UpdateAlertMessage(ctx, params.ID, params.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update alert message: %w", err)
+	}
+
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Alert %s message updated successfully", params.ID))
+}
+
+// OpsgenieUpdateAlertDescriptionTool creates the opsgenie_update_alert_description tool
+func OpsgenieUpdateAlertDescriptionTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_update_alert_description",
+		"Change the description of an existing Opsgenie alert by ID.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"id":          mcp.NewStringProperty("Alert ID to update (required)"),
+				"description": mcp.NewStringProperty("New alert description (required)"),
+				"wait":        waitProperty(),
+			},
+			"id", "description",
+		),
+		opsgenieUpdateAlertDescriptionHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieUpdateAlertDescriptionHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		waitArgs
+		ID          string `arg:"id,trim" validate:"required"`
+		Description string `arg:"description" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	requestID, err := client.UpdateAlertDescription(ctx, params.ID, params.Description)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update alert description: %w", err)
+	}
+
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Alert %s description updated successfully", params.ID))
+}
+
+// OpsgenieAddDetailsTool creates the opsgenie_add_details tool
+func OpsgenieAddDetailsTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_add_details",
+		"Add custom key/value properties (details) to an existing Opsgenie alert by ID. Existing keys are overwritten.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"id":      mcp.NewStringProperty("Alert ID to add details to (required)"),
+				"details": mcp.NewStringProperty("JSON object of details to add (required). Example: '{\"region\":\"eu-west-1\",\"runbook\":\"https://...\"}'"),
+				"note":    mcp.NewStringProperty("Optional note explaining the change"),
+				"wait":    waitProperty(),
+			},
+			"id", "details",
+		),
+		opsgenieAddDetailsHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieAddDetailsHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		waitArgs
+		ID      string `arg:"id,trim" validate:"required"`
+		Details string `arg:"details" validate:"required"`
+		Note    string `arg:"note"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	details, err := parseDetails(params.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	requestID, err := client.AddDetailsToAlert(ctx, params.ID, details, params.Note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add details to alert: %w", err)
+	}
+
+	return alertActionResult(ctx, client, params.Wait, requestID, fmt.Sprintf("Details added to alert %s successfully", params.ID))
+}
+
+// OpsgenieAddTeamMemberTool creates the opsgenie_add_team_member tool
+func OpsgenieAddTeamMemberTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_add_team_member",
+		"Add a user to an Opsgenie team with the given role.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"team": mcp.NewStringProperty("Team ID or name (required)"),
+				"user": mcp.NewStringProperty("User ID or username (email) to add (required)"),
+				"role": mcp.NewEnumProperty("Role of the user in the team (default user)", "user", "admin").
+					WithDefault("user"),
+			},
+			"team", "user",
+		),
+		opsgenieAddTeamMemberHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieAddTeamMemberHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Team string `arg:"team,trim" validate:"required"`
+		User string `arg:"user,trim" validate:"required"`
+		Role string `arg:"role,trim" default:"user"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	role := opsgenie.TeamMemberRole(params.Role)
+
+	err := client.AddTeamMember(ctx, params.Team, params.User, role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add team member: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("User %s added to team %s as %s", params.User, params.Team, role),
+	})
+}
+
+// OpsgenieRemoveTeamMemberTool creates the opsgenie_remove_team_member tool
+func OpsgenieRemoveTeamMemberTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_remove_team_member",
+		"Remove a user from an Opsgenie team.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"team": mcp.NewStringProperty("Team ID or name (required)"),
+				"user": mcp.NewStringProperty("User ID or username (email) to remove (required)"),
+			},
+			"team", "user",
+		),
+		opsgenieRemoveTeamMemberHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieRemoveTeamMemberHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Team string `arg:"team,trim" validate:"required"`
+		User string `arg:"user,trim" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	err := client.RemoveTeamMember(ctx, params.Team, params.User)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove team member: %w", err)
+	}
+
+	return mcp.NewJSONResult(map[string]interface{}{
+		"success": true,
+		"message": fmt.Sprintf("User %s removed from team %s", params.User, params.Team),
+	})
+}
+
+// OpsgeniePingHeartbeatTool creates the opsgenie_ping_heartbeat tool
+func OpsgeniePingHeartbeatTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_ping_heartbeat",
+		"Ping an Opsgenie heartbeat by name, resetting its interval so it does not expire and create an alert.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"name": mcp.NewStringProperty("Heartbeat name (required)"),
+			},
+			"name",
+		),
+		opsgeniePingHeartbeatHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgeniePingHeartbeatHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Name string `arg:"name" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	err := client.PingHeartbeat(ctx, params.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ping heartbeat: %w", err)
 	}
 
 	return mcp.NewJSONResult(map[string]interface{}{
 		"success": true,
-		"message": fmt.Sprintf("Tags added to alert %s successfully", id),
+		"message": fmt.Sprintf("Heartbeat %s pinged successfully", params.Name),
 	})
 }
 
+// OpsgenieEnableHeartbeatTool creates the opsgenie_enable_heartbeat tool
+func OpsgenieEnableHeartbeatTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_enable_heartbeat",
+		"Enable an Opsgenie heartbeat by name so an alert is created when it expires.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"name": mcp.NewStringProperty("Heartbeat name (required)"),
+			},
+			"name",
+		),
+		opsgenieEnableHeartbeatHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieEnableHeartbeatHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Name string `arg:"name" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	heartbeat, err := client.EnableHeartbeat(ctx, params.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enable heartbeat: %w", err)
+	}
+
+	return mcp.NewJSONResult(heartbeat)
+}
+
+// OpsgenieDisableHeartbeatTool creates the opsgenie_disable_heartbeat tool
+func OpsgenieDisableHeartbeatTool() *mcp.ToolDefinition {
+	return mcp.NewTool(
+		"opsgenie_disable_heartbeat",
+		"Disable an Opsgenie heartbeat by name, e.g. during planned maintenance, so no alert is created when it expires.",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"name": mcp.NewStringProperty("Heartbeat name (required)"),
+			},
+			"name",
+		),
+		opsgenieDisableHeartbeatHandler,
+		"opsgenie", "write",
+	)
+}
+
+func opsgenieDisableHeartbeatHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	var params struct {
+		Name string `arg:"name" validate:"required"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+
+	client := GetOpsgenieClient(ctx)
+	if client == nil {
+		return nil, fmt.Errorf("Opsgenie client not available")
+	}
+
+	heartbeat, err := client.DisableHeartbeat(ctx, params.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to disable heartbeat: %w", err)
+	}
+
+	return mcp.NewJSONResult(heartbeat)
+}
+
 // OpsgenieCreateIncidentTool creates the opsgenie_create_incident tool
 func OpsgenieCreateIncidentTool() *mcp.ToolDefinition {
 	return mcp.NewTool(
 		"opsgenie_create_incident",
-		"Create a new Opsgenie incident. Incidents are major issues affecting multiple services or users. Requires message, description, priority, and can include responders (array of objects with type and id fields) and tags.",
+		"Create a new Opsgenie incident. Incidents are major issues affecting multiple services or users. Requires message, description, priority, and can include responders (array of objects with type and id fields), tags, and impacted services.",
 		mcp.NewInputSchema(
 			map[string]mcp.Property{
 				"message":     mcp.NewStringProperty("Brief message describing the incident (required)"),
 				"description": mcp.NewStringProperty("Detailed description of the incident"),
 				"priority": mcp.NewStringProperty("Priority level (P1, P2, P3, P4, P5 - default P3)").
-					WithDefault("P3"),
-				"responders": mcp.NewStringProperty("JSON string of responders array. Each responder should have 'type' (user/team/escalation/schedule) and 'id'. Example: '[{\"type\":\"user\",\"id\":\"user-id\"},{\"type\":\"team\",\"id\":\"team-id\"}]'"),
-				"tags":       mcp.NewStringProperty("Comma-separated tags to categorize the incident"),
+					WithEnum("P1", "P2", "P3", "P4", "P5").WithDefault("P3"),
+				"responders":        respondersProperty("Responders to notify about the incident"),
+				"tags":              mcp.NewStringProperty("Comma-separated tags to categorize the incident"),
+				"impacted_services": mcp.NewStringProperty("Comma-separated IDs of the services affected by the incident (see opsgenie_list_services)"),
 			},
 			"message",
 		),
@@ -509,9 +1041,19 @@ func OpsgenieCreateIncidentTool() *mcp.ToolDefinition {
 }
 
 func opsgenieCreateIncidentHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	message, ok := args["message"].(string)
-	if !ok || message == "" {
-		return nil, fmt.Errorf("message is required")
+	var params struct {
+		Message          string               `arg:"message" validate:"required"`
+		Description      string               `arg:"description"`
+		Priority         string               `arg:"priority,trim" default:"P3"`
+		Responders       []opsgenie.Responder `arg:"responders"`
+		Tags             []string             `arg:"tags"`
+		ImpactedServices []string             `arg:"impacted_services"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
+	}
+	if err := checkResponders(params.Responders); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -519,61 +1061,13 @@ func opsgenieCreateIncidentHandler(ctx context.Context, args map[string]interfac
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	// Build incident request
 	req := &opsgenie.IncidentRequest{
-		Message: message,
-	}
-
-	// Add optional description
-	if desc, ok := args["description"].(string); ok && desc != "" {
-		req.Description = desc
-	}
-
-	// Add priority (default to P3)
-	priority := "P3"
-	if p, ok := args["priority"].(string); ok && p != "" {
-		priority = p
-	}
-	req.Priority = opsgenie.Priority(priority)
-
-	// Add responders (accept either JSON string or array)
-	if respondersStr, ok := args["responders"].(string); ok && respondersStr != "" {
-		var respondersList []map[string]interface{}
-		if err := json.Unmarshal([]byte(respondersStr), &respondersList); err == nil {
-			responders := make([]opsgenie.Responder, 0, len(respondersList))
-			for _, respMap := range respondersList {
-				responder := opsgenie.Responder{}
-				if rType, ok := respMap["type"].(string); ok {
-					responder.Type = opsgenie.ResponderType(rType)
-				}
-				if id, ok := respMap["id"].(string); ok {
-					responder.ID = id
-				}
-				if name, ok := respMap["name"].(string); ok {
-					responder.Name = name
-				}
-				if responder.Type != "" && responder.ID != "" {
-					responders = append(responders, responder)
-				}
-			}
-			if len(responders) > 0 {
-				req.Responders = responders
-			}
-		}
-	}
-
-	// Add tags (accept comma-separated string)
-	if tagsStr, ok := args["tags"].(string); ok && tagsStr != "" {
-		tags := strings.Split(tagsStr, ",")
-		trimmedTags := make([]string, 0, len(tags))
-		for _, tag := range tags {
-			if trimmed := strings.TrimSpace(tag); trimmed != "" {
-				trimmedTags = append(trimmedTags, trimmed)
-			}
-		}
-		if len(trimmedTags) > 0 {
-			req.Tags = trimmedTags
-		}
+		Message:          params.Message,
+		Description:      params.Description,
+		Priority:         opsgenie.Priority(params.Priority),
+		Responders:       params.Responders,
+		Tags:             params.Tags,
+		ImpactedServices: params.ImpactedServices,
 	}
 
 	// Create incident
@@ -603,9 +1097,12 @@ func OpsgenieCloseIncidentTool() *mcp.ToolDefinition {
 }
 
 func opsgenieCloseIncidentHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		ID   string `arg:"id,trim" validate:"required"`
+		Note string `arg:"note"`
+	}
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -613,19 +1110,14 @@ func opsgenieCloseIncidentHandler(ctx context.Context, args map[string]interface
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	note := ""
-	if n, ok := args["note"].(string); ok {
-		note = n
-	}
-
-	err := client.CloseIncident(ctx, id, note)
+	err := client.CloseIncident(ctx, params.ID, params.Note)
 	if err != nil {
 		return nil, fmt.Errorf("failed to close incident: %w", err)
 	}
 
 	return mcp.NewJSONResult(map[string]interface{}{
 		"success": true,
-		"message": fmt.Sprintf("Incident %s closed successfully", id),
+		"message": fmt.Sprintf("Incident %s closed successfully", params.ID),
 	})
 }
 
@@ -647,14 +1139,12 @@ func OpsgenieAddNoteToIncidentTool() *mcp.ToolDefinition {
 }
 
 func opsgenieAddNoteToIncidentHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		ID   string `arg:"id,trim" validate:"required"`
+		Note string `arg:"note" validate:"required"`
 	}
-
-	note, ok := args["note"].(string)
-	if !ok || note == "" {
-		return nil, fmt.Errorf("note is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -662,14 +1152,14 @@ func opsgenieAddNoteToIncidentHandler(ctx context.Context, args map[string]inter
 		return nil, fmt.Errorf("Opsgenie client not available")
 	}
 
-	err := client.AddNoteToIncident(ctx, id, note)
+	err := client.AddNoteToIncident(ctx, params.ID, params.Note)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add note to incident: %w", err)
 	}
 
 	return mcp.NewJSONResult(map[string]interface{}{
 		"success": true,
-		"message": fmt.Sprintf("Note added to incident %s successfully", id),
+		"message": fmt.Sprintf("Note added to incident %s successfully", params.ID),
 	})
 }
 
@@ -693,19 +1183,14 @@ func OpsgenieAddResponderToIncidentTool() *mcp.ToolDefinition {
 }
 
 func opsgenieAddResponderToIncidentHandler(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
-	id, ok := args["id"].(string)
-	if !ok || id == "" {
-		return nil, fmt.Errorf("id is required")
+	var params struct {
+		ID            string `arg:"id,trim" validate:"required"`
+		ResponderType string `arg:"responder_type,trim" validate:"required"`
+		ResponderID   string `arg:"responder_id,trim" validate:"required"`
+		ResponderName string `arg:"responder_name,trim"`
 	}
-
-	responderType, ok := args["responder_type"].(string)
-	if !ok || responderType == "" {
-		return nil, fmt.Errorf("responder_type is required")
-	}
-
-	responderID, ok := args["responder_id"].(string)
-	if !ok || responderID == "" {
-		return nil, fmt.Errorf("responder_id is required")
+	if err := mcp.Bind(args, &params); err != nil {
+		return nil, err
 	}
 
 	client := GetOpsgenieClient(ctx)
@@ -715,21 +1200,75 @@ func opsgenieAddResponderToIncidentHandler(ctx context.Context, args map[string]
 
 	// Build responder object
 	responder := &opsgenie.Responder{
-		Type: opsgenie.ResponderType(responderType),
-		ID:   responderID,
+		Type: opsgenie.ResponderType(params.ResponderType),
+		ID:   params.ResponderID,
+		Name: params.ResponderName,
 	}
 
-	if name, ok := args["responder_name"].(string); ok && name != "" {
-		responder.Name = name
-	}
-
-	err := client.AddResponderToIncident(ctx, id, responder)
+	err := client.AddResponderToIncident(ctx, params.ID, responder)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add responder to incident: %w", err)
 	}
 
 	return mcp.NewJSONResult(map[string]interface{}{
 		"success": true,
-		"message": fmt.Sprintf("Responder added to incident %s successfully", id),
+		"message": fmt.Sprintf("Responder added to incident %s successfully", params.ID),
 	})
 }
+
+// waitProperty returns the "wait" input property of the alert write tools
+func waitProperty() mcp.Property {
+	return mcp.NewBooleanProperty("Wait until Opsgenie has processed the request and return its final status and alert ID (default false, returns the request ID immediately)").
+		WithDefault(false)
+}
+
+// waitArgs holds the "wait" argument of the alert write tools
+type waitArgs struct {
+	Wait bool `arg:"wait"`
+}
+
+// alertActionResult builds the result of an asynchronous alert action. With
+// wait set it polls the request status until the action has been processed.
+func alertActionResult(ctx context.Context, client *opsgenie.Client, wait bool, requestID, message string) (*mcp.CallToolResult, error) {
+	result := map[string]interface{}{
+		"success":    true,
+		"message":    message,
+		"request_id": requestID,
+	}
+
+	if wait && requestID != "" {
+		status, err := client.WaitForRequest(ctx, requestID, opsgenie.DefaultWaitTimeout)
+		if err != nil {
+			return nil, err
+		}
+		result["status"] = status.Status
+		result["alert_id"] = status.AlertID
+	}
+
+	return mcp.NewJSONResult(result)
+}
+
+// respondersProperty describes the responders argument of the create tools
+func respondersProperty(description string) mcp.Property {
+	return mcp.NewArrayProperty(description, mcp.NewObjectProperty(
+		"A responder, identified by id or name. Example: {\"type\": \"team\", \"name\": \"SRE\"}",
+		map[string]mcp.Property{
+			"type": mcp.NewEnumProperty("Responder type", "user", "team", "escalation", "schedule"),
+			"id":   mcp.NewStringProperty("Responder ID"),
+			"name": mcp.NewStringProperty("Team, escalation, or schedule name"),
+		},
+		"type",
+	))
+}
+
+// checkResponders reports responders given without an id or a name
+func checkResponders(responders []opsgenie.Responder) error {
+	for i, responder := range responders {
+		if responder.ID == "" && responder.Name == "" {
+			return &mcp.ArgsError{Problems: []mcp.ArgProblem{
+				{Arg: "responders", Message: fmt.Sprintf("item %d needs an id or a name", i)},
+			}}
+		}
+	}
+	return nil
+}
@@ -1,9 +1,12 @@
 package opsgenie
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/tools/raw"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
 )
 
 // RegisterOpsgenieTools registers all Opsgenie tools with the MCP server
@@ -12,30 +15,55 @@ func RegisterOpsgenieTools(server *mcp.Server) error {
 		name string
 		tool *mcp.ToolDefinition
 	}{
-		// Read operations (13 tools)
+		// Read operations (26 tools)
 		{"opsgenie_get_alert", OpsgenieGetAlertTool()},
 		{"opsgenie_list_alerts", OpsgenieListAlertsTool()},
 		{"opsgenie_count_alerts", OpsgenieCountAlertsTool()},
+		{"opsgenie_list_alert_notes", OpsgenieListAlertNotesTool()},
+		{"opsgenie_list_alert_logs", OpsgenieListAlertLogsTool()},
+		{"opsgenie_list_alert_recipients", OpsgenieListAlertRecipientsTool()},
 		{"opsgenie_get_request_status", OpsgenieGetRequestStatusTool()},
 		{"opsgenie_get_incident", OpsgenieGetIncidentTool()},
 		{"opsgenie_list_incidents", OpsgenieListIncidentsTool()},
+		{"opsgenie_list_services", OpsgenieListServicesTool()},
+		{"opsgenie_get_service", OpsgenieGetServiceTool()},
 		{"opsgenie_get_schedule", OpsgenieGetScheduleTool()},
 		{"opsgenie_list_schedules", OpsgenieListSchedulesTool()},
 		{"opsgenie_get_schedule_timeline", OpsgenieGetScheduleTimelineTool()},
 		{"opsgenie_get_on_calls", OpsgenieGetOnCallsTool()},
 		{"opsgenie_get_team", OpsgenieGetTeamTool()},
 		{"opsgenie_list_teams", OpsgenieListTeamsTool()},
+		{"opsgenie_list_team_members", OpsgenieListTeamMembersTool()},
+		{"opsgenie_list_team_routing_rules", OpsgenieListTeamRoutingRulesTool()},
+		{"opsgenie_list_integrations", OpsgenieListIntegrationsTool()},
+		{"opsgenie_get_integration", OpsgenieGetIntegrationTool()},
+		{"opsgenie_list_policies", OpsgenieListPoliciesTool()},
+		{"opsgenie_get_policy", OpsgenieGetPolicyTool()},
 		{"opsgenie_get_user", OpsgenieGetUserTool()},
+		{"opsgenie_list_heartbeats", OpsgenieListHeartbeatsTool()},
+		{"opsgenie_get_heartbeat", OpsgenieGetHeartbeatTool()},
 
-		// Write operations (12 tools)
+		// Write operations (24 tools)
 		{"opsgenie_create_alert", OpsgenieCreateAlertTool()},
 		{"opsgenie_close_alert", OpsgenieCloseAlertTool()},
 		{"opsgenie_acknowledge_alert", OpsgenieAcknowledgeAlertTool()},
+		{"opsgenie_unacknowledge_alert", OpsgenieUnacknowledgeAlertTool()},
 		{"opsgenie_snooze_alert", OpsgenieSnoozeAlertTool()},
 		{"opsgenie_escalate_alert", OpsgenieEscalateAlertTool()},
 		{"opsgenie_assign_alert", OpsgenieAssignAlertTool()},
+		{"opsgenie_execute_custom_action", OpsgenieExecuteCustomActionTool()},
 		{"opsgenie_add_note_to_alert", OpsgenieAddNoteToAlertTool()},
 		{"opsgenie_add_tags_to_alert", OpsgenieAddTagsToAlertTool()},
+		{"opsgenie_remove_tags_from_alert", OpsgenieRemoveTagsFromAlertTool()},
+		{"opsgenie_update_alert_priority", OpsgenieUpdateAlertPriorityTool()},
+		{"opsgenie_update_alert_message", OpsgenieUpdateAlertMessageTool()},
+		{"opsgenie_update_alert_description", OpsgenieUpdateAlertDescriptionTool()},
+		{"opsgenie_add_details", OpsgenieAddDetailsTool()},
+		{"opsgenie_add_team_member", OpsgenieAddTeamMemberTool()},
+		{"opsgenie_remove_team_member", OpsgenieRemoveTeamMemberTool()},
+		{"opsgenie_ping_heartbeat", OpsgeniePingHeartbeatTool()},
+		{"opsgenie_enable_heartbeat", OpsgenieEnableHeartbeatTool()},
+		{"opsgenie_disable_heartbeat", OpsgenieDisableHeartbeatTool()},
 		{"opsgenie_create_incident", OpsgenieCreateIncidentTool()},
 		{"opsgenie_close_incident", OpsgenieCloseIncidentTool()},
 		{"opsgenie_add_note_to_incident", OpsgenieAddNoteToIncidentTool()},
@@ -50,3 +78,17 @@ func RegisterOpsgenieTools(server *mcp.Server) error {
 
 	return nil
 }
+
+// RegisterOpsgenieRawRequestTool registers opsgenie_raw_request, which sends
+// a request to any Opsgenie API path. In read-only mode it only sends GET
+// requests.
+func RegisterOpsgenieRawRequestTool(server *mcp.Server, readOnly bool) error {
+	tool := raw.Tool("opsgenie", "Opsgenie", "/v1/maintenance", readOnly, func(ctx context.Context, method, path string, body []byte) (*atlassian.RawResponse, error) {
+		client := GetOpsgenieClient(ctx)
+		if client == nil {
+			return nil, fmt.Errorf("Opsgenie client not available")
+		}
+		return client.Raw(ctx, method, path, body)
+	})
+	return server.RegisterTool(tool)
+}
@@ -0,0 +1,100 @@
+// Package raw builds the raw request tools, which send a request to any API
+// path of a product for endpoints that have no dedicated tool.
+package raw
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+)
+
+// DoFunc sends a raw request with the product client of the context
+type DoFunc func(ctx context.Context, method, path string, body []byte) (*atlassian.RawResponse, error)
+
+// Tool creates the "<product>_raw_request" tool. In read-only mode the tool
+// is tagged read and only sends GET requests; otherwise it is tagged write,
+// so the audit log and confirmations apply to it.
+func Tool(product, title, examplePath string, readOnly bool, do DoFunc) *mcp.ToolDefinition {
+	methods := atlassian.RawMethods
+	access := "write"
+	description := fmt.Sprintf("Send a request to any %s REST API path, for endpoints that have no dedicated tool. Prefer dedicated tools when one exists. The path is relative to the configured site (e.g. '%s') and may include a query string.", title, examplePath)
+	if readOnly {
+		methods = []string{http.MethodGet}
+		access = "read"
+		description += " Read-only mode is enabled, so only GET requests are allowed."
+	}
+
+	return mcp.NewTool(
+		product+"_raw_request",
+		description,
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"method": mcp.NewEnumProperty("HTTP method (default GET)", methods...).WithDefault(http.MethodGet),
+				"path":   mcp.NewStringProperty(fmt.Sprintf("API path relative to the site, with an optional query string (e.g. '%s')", examplePath)),
+				"body":   mcp.NewStringProperty("JSON request body, as text (for POST, PUT, and PATCH)"),
+			},
+			"path",
+		),
+		handler(readOnly, do),
+		product, access,
+	)
+}
+
+func handler(readOnly bool, do DoFunc) mcp.ToolHandler {
+	return func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+		var params struct {
+			Method string `arg:"method,trim" default:"GET"`
+			Path   string `arg:"path,trim" validate:"required"`
+			Body   string `arg:"body"`
+		}
+		if err := mcp.Bind(args, &params); err != nil {
+			return nil, err
+		}
+		method := strings.ToUpper(params.Method)
+
+		argsErr := &mcp.ArgsError{}
+		if readOnly && method != http.MethodGet {
+			argsErr.Problems = append(argsErr.Problems, mcp.ArgProblem{Arg: "method", Message: "only GET requests are allowed in read-only mode"})
+		}
+		if err := atlassian.ValidateRawRequest(method, params.Path); err != nil {
+			argsErr.Problems = append(argsErr.Problems, mcp.ArgProblem{Arg: "path", Message: err.Error()})
+		}
+		var body []byte
+		if params.Body != "" {
+			if !json.Valid([]byte(params.Body)) {
+				argsErr.Problems = append(argsErr.Problems, mcp.ArgProblem{Arg: "body", Message: "must be valid JSON"})
+			}
+			body = []byte(params.Body)
+		}
+		if len(argsErr.Problems) > 0 {
+			return nil, argsErr
+		}
+
+		resp, err := do(ctx, method, params.Path, body)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewJSONResult(Result(resp))
+	}
+}
+
+// Result describes a raw response: its status and its body, decoded if it
+// is JSON
+func Result(resp *atlassian.RawResponse) map[string]interface{} {
+	result := map[string]interface{}{"status": resp.StatusCode}
+
+	var decoded interface{}
+	switch {
+	case len(resp.Body) == 0:
+	case json.Unmarshal(resp.Body, &decoded) == nil:
+		result["body"] = decoded
+	default:
+		result["body"] = string(resp.Body)
+	}
+	return result
+}
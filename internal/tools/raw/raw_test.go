@@ -0,0 +1,65 @@
+package raw
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+)
+
+func TestTool(t *testing.T) {
+	var sent []string
+	do := func(ctx context.Context, method, path string, body []byte) (*atlassian.RawResponse, error) {
+		sent = append(sent, method+" "+path+" "+string(body))
+		return &atlassian.RawResponse{StatusCode: 200, Body: []byte(`{"ok": true}`)}, nil
+	}
+
+	tool := Tool("jira", "Jira", "/rest/api/2/field", false, do)
+	if tool.Name != "jira_raw_request" || tool.Tags[1] != "write" {
+		t.Errorf("Tool() = %s %v", tool.Name, tool.Tags)
+	}
+
+	result, err := tool.Handler(context.Background(), map[string]interface{}{
+		"method": "POST", "path": "/rest/api/2/search", "body": `{"jql": "project = PROJ"}`,
+	})
+	if err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+	if want := "{\n  \"body\": {\n    \"ok\": true\n  },\n  \"status\": 200\n}"; result.Content[0].Text != want {
+		t.Errorf("result = %s", result.Content[0].Text)
+	}
+	if len(sent) != 1 || sent[0] != `POST /rest/api/2/search {"jql": "project = PROJ"}` {
+		t.Errorf("sent = %v", sent)
+	}
+
+	_, err = tool.Handler(context.Background(), map[string]interface{}{"path": "https://example.com", "body": "{"})
+	var argsErr *mcp.ArgsError
+	if !errors.As(err, &argsErr) || len(argsErr.Problems) != 2 {
+		t.Errorf("handler error = %v, want problems with path and body", err)
+	}
+}
+
+func TestToolReadOnly(t *testing.T) {
+	called := false
+	tool := Tool("opsgenie", "Opsgenie", "/v1/maintenance", true, func(ctx context.Context, method, path string, body []byte) (*atlassian.RawResponse, error) {
+		called = true
+		return &atlassian.RawResponse{StatusCode: 200}, nil
+	})
+	if tool.Tags[1] != "read" || len(tool.InputSchema.Properties["method"].Enum) != 1 {
+		t.Errorf("read-only tool = %v, methods %v", tool.Tags, tool.InputSchema.Properties["method"].Enum)
+	}
+
+	if _, err := tool.Handler(context.Background(), map[string]interface{}{"method": "DELETE", "path": "/v1/maintenance/m1"}); err == nil {
+		t.Error("handler should refuse DELETE in read-only mode")
+	}
+	if called {
+		t.Error("refused request was sent")
+	}
+
+	result, err := tool.Handler(context.Background(), map[string]interface{}{"path": "/v1/maintenance"})
+	if err != nil || result.Content[0].Text != "{\n  \"status\": 200\n}" {
+		t.Errorf("GET = %v, %v", result, err)
+	}
+}
@@ -0,0 +1,157 @@
+// Package session holds the defaults an agent sets for the session (default
+// Jira project, board, Confluence space) and fills them into tool arguments
+// that are omitted.
+package session
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+)
+
+// Default keys
+const (
+	JiraProject     = "jira_project"
+	JiraBoard       = "jira_board"
+	ConfluenceSpace = "confluence_space"
+)
+
+// Keys lists the default keys
+var Keys = []string{JiraProject, JiraBoard, ConfluenceSpace}
+
+// Arguments of each product's tools that fall back to a default, by argument
+// name
+var (
+	JiraArgs       = map[string]string{"project_key": JiraProject, "board_id": JiraBoard}
+	ConfluenceArgs = map[string]string{"space_key": ConfluenceSpace}
+)
+
+type contextKey string
+
+const defaultsKey contextKey = "session_defaults"
+
+// Defaults holds the session defaults. It is safe for concurrent use.
+type Defaults struct {
+	mu     sync.RWMutex
+	values map[string]string
+}
+
+// NewDefaults creates an empty set of session defaults
+func NewDefaults() *Defaults {
+	return &Defaults{values: make(map[string]string)}
+}
+
+// Set sets a default; an empty value removes it
+func (d *Defaults) Set(key, value string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if value == "" {
+		delete(d.values, key)
+		return
+	}
+	d.values[key] = value
+}
+
+// Get returns a default, or "" if it is not set
+func (d *Defaults) Get(key string) string {
+	if d == nil {
+		return ""
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.values[key]
+}
+
+// All returns a copy of the defaults that are set
+func (d *Defaults) All() map[string]string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	all := make(map[string]string, len(d.values))
+	for key, value := range d.values {
+		all[key] = value
+	}
+	return all
+}
+
+// Clear removes all defaults
+func (d *Defaults) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	clear(d.values)
+}
+
+// WithDefaults adds the session defaults to the context
+func WithDefaults(ctx context.Context, defaults *Defaults) context.Context {
+	return context.WithValue(ctx, defaultsKey, defaults)
+}
+
+// GetDefaults retrieves the session defaults from the context
+func GetDefaults(ctx context.Context) *Defaults {
+	defaults, ok := ctx.Value(defaultsKey).(*Defaults)
+	if !ok {
+		return nil
+	}
+	return defaults
+}
+
+// WithDefaultArgs makes the required arguments of a tool that appear in
+// fallbacks optional, and wraps its handler to fill them from the session
+// defaults when they are omitted. Optional arguments are left alone, so a
+// default never narrows a search the agent did not ask to narrow.
+func WithDefaultArgs(def *mcp.ToolDefinition, fallbacks map[string]string) {
+	filled := make(map[string]string)
+	for arg, key := range fallbacks {
+		if !slices.Contains(def.InputSchema.Required, arg) {
+			continue
+		}
+		filled[arg] = key
+
+		def.InputSchema.Required = slices.DeleteFunc(def.InputSchema.Required, func(name string) bool { return name == arg })
+		prop := def.InputSchema.Properties[arg]
+		prop.Description += " (defaults to the session context, see atlas_set_context)"
+		def.InputSchema.Properties[arg] = prop
+	}
+	if len(filled) == 0 {
+		return
+	}
+
+	handler := def.Handler
+	integer := func(arg string) bool { return def.InputSchema.Properties[arg].Type == "integer" }
+	def.Handler = func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+		defaults := GetDefaults(ctx)
+
+		withDefaults := make(map[string]interface{}, len(args)+len(filled))
+		for name, value := range args {
+			withDefaults[name] = value
+		}
+
+		for arg, key := range filled {
+			if value, ok := args[arg]; ok && value != "" {
+				continue
+			}
+
+			value := defaults.Get(key)
+			if value == "" {
+				return nil, fmt.Errorf("%s is required (or set a default with atlas_set_context)", arg)
+			}
+			if integer(arg) {
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid session default %s %q: %w", key, value, err)
+				}
+				withDefaults[arg] = float64(n)
+				continue
+			}
+			withDefaults[arg] = value
+		}
+
+		return handler(ctx, withDefaults)
+	}
+}
@@ -0,0 +1,108 @@
+package session
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/codeownersnet/atlas/internal/mcp"
+)
+
+func TestWithDefaultArgs(t *testing.T) {
+	var got map[string]interface{}
+	def := mcp.NewTool(
+		"test_tool",
+		"Test tool",
+		mcp.NewInputSchema(
+			map[string]mcp.Property{
+				"project_key": mcp.NewStringProperty("Project key"),
+				"board_id":    mcp.NewIntegerProperty("Board ID"),
+				"summary":     mcp.NewStringProperty("Summary"),
+			},
+			"project_key", "board_id", "summary",
+		),
+		func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+			got = args
+			return mcp.NewSuccessResult("ok"), nil
+		},
+	)
+
+	WithDefaultArgs(def, JiraArgs)
+
+	if !slices.Equal(def.InputSchema.Required, []string{"summary"}) {
+		t.Errorf("Required = %v, want [summary]", def.InputSchema.Required)
+	}
+
+	defaults := NewDefaults()
+	ctx := WithDefaults(context.Background(), defaults)
+
+	// Without defaults, the arguments are still required
+	if _, err := def.Handler(ctx, map[string]interface{}{"summary": "x"}); err == nil {
+		t.Error("Expected error without session defaults")
+	}
+
+	defaults.Set(JiraProject, "PROJ")
+	defaults.Set(JiraBoard, "42")
+
+	args := map[string]interface{}{"summary": "x"}
+	if _, err := def.Handler(ctx, args); err != nil {
+		t.Fatalf("Handler() error = %v", err)
+	}
+	if got["project_key"] != "PROJ" || got["board_id"] != float64(42) {
+		t.Errorf("args = %v, want session defaults", got)
+	}
+	if _, ok := args["project_key"]; ok {
+		t.Error("Handler() modified the caller's arguments")
+	}
+
+	// Explicit arguments win
+	if _, err := def.Handler(ctx, map[string]interface{}{"summary": "x", "project_key": "OPS", "board_id": float64(7)}); err != nil {
+		t.Fatalf("Handler() error = %v", err)
+	}
+	if got["project_key"] != "OPS" || got["board_id"] != float64(7) {
+		t.Errorf("args = %v, want explicit arguments", got)
+	}
+}
+
+func TestWithDefaultArgsSkipsOptionalArgs(t *testing.T) {
+	def := mcp.NewTool(
+		"test_search",
+		"Test search",
+		mcp.NewInputSchema(map[string]mcp.Property{"space_key": mcp.NewStringProperty("Space filter")}),
+		func(ctx context.Context, args map[string]interface{}) (*mcp.CallToolResult, error) {
+			if _, ok := args["space_key"]; ok {
+				t.Error("optional argument was filled from the session defaults")
+			}
+			return mcp.NewSuccessResult("ok"), nil
+		},
+	)
+
+	WithDefaultArgs(def, ConfluenceArgs)
+
+	defaults := NewDefaults()
+	defaults.Set(ConfluenceSpace, "DOCS")
+	if _, err := def.Handler(WithDefaults(context.Background(), defaults), map[string]interface{}{}); err != nil {
+		t.Fatalf("Handler() error = %v", err)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	defaults := NewDefaults()
+	defaults.Set(JiraProject, "PROJ")
+	defaults.Set(ConfluenceSpace, "DOCS")
+	defaults.Set(ConfluenceSpace, "")
+
+	if all := defaults.All(); len(all) != 1 || all[JiraProject] != "PROJ" {
+		t.Errorf("All() = %v, want only the Jira project", all)
+	}
+
+	defaults.Clear()
+	if defaults.Get(JiraProject) != "" {
+		t.Error("Clear() kept the Jira project")
+	}
+
+	var unset *Defaults
+	if unset.Get(JiraProject) != "" {
+		t.Error("Get() on nil defaults returned a value")
+	}
+}
@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/codeownersnet/atlas/internal/config"
+	"go.opentelemetry.io/otel"
+)
+
+func TestSetupDisabled(t *testing.T) {
+	provider := otel.GetTracerProvider()
+
+	shutdown, err := Setup(context.Background(), &config.TracingConfig{Endpoint: "http://localhost:4318"}, "1.0.0")
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown() error = %v", err)
+	}
+	if otel.GetTracerProvider() != provider {
+		t.Error("Setup() replaced the tracer provider while tracing is disabled")
+	}
+}
+
+func TestSetupExportsSpans(t *testing.T) {
+	tests := []struct {
+		name     string
+		scheme   string
+		insecure bool
+	}{
+		{name: "http endpoint", scheme: "http"},
+		// Insecure forces plain HTTP, whatever the endpoint's scheme
+		{name: "https endpoint with insecure", scheme: "https", insecure: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+			t.Cleanup(func() {
+				otel.SetTracerProvider(provider)
+				otel.SetTextMapPropagator(propagator)
+			})
+
+			exported := make(chan string, 10)
+			collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				exported <- r.Method + " " + r.URL.Path
+			}))
+			defer collector.Close()
+
+			cfg := &config.TracingConfig{
+				Enabled:     true,
+				Endpoint:    tt.scheme + strings.TrimPrefix(collector.URL, "http") + "/v1/traces",
+				Insecure:    tt.insecure,
+				ServiceName: "atlas-mcp",
+				SampleRatio: 1,
+			}
+			shutdown, err := Setup(context.Background(), cfg, "1.0.0")
+			if err != nil {
+				t.Fatalf("Setup() error = %v", err)
+			}
+
+			_, span := otel.Tracer("test").Start(context.Background(), "call")
+			span.End()
+			if err := shutdown(context.Background()); err != nil {
+				t.Fatalf("shutdown() error = %v", err)
+			}
+
+			select {
+			case got := <-exported:
+				if got != "POST /v1/traces" {
+					t.Errorf("collector received %s, want POST /v1/traces", got)
+				}
+			default:
+				t.Error("shutdown() did not flush the span to the collector")
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// jiraPayload holds the fields of a Jira webhook used to normalize it
+type jiraPayload struct {
+	Timestamp    int64  `json:"timestamp"`
+	WebhookEvent string `json:"webhookEvent"`
+	Issue        *struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Summary string `json:"summary"`
+		} `json:"fields"`
+	} `json:"issue"`
+	User    *user `json:"user"`
+	Comment *struct {
+		Author *user `json:"author"`
+	} `json:"comment"`
+	Changelog *struct {
+		Items []struct {
+			Field      string `json:"field"`
+			FromString string `json:"fromString"`
+			ToString   string `json:"toString"`
+		} `json:"items"`
+	} `json:"changelog"`
+}
+
+// confluencePayload holds the fields of a Confluence webhook used to
+// normalize it
+type confluencePayload struct {
+	Timestamp     int64                       `json:"timestamp"`
+	Event         string                      `json:"event"`
+	UserAccountID string                      `json:"userAccountId"`
+	UserKey       string                      `json:"userKey"`
+	Page          *confluenceContent          `json:"page"`
+	Blog          *confluenceContent          `json:"blog"`
+	Comment       *confluenceContent          `json:"comment"`
+	Space         *struct{ Key, Name string } `json:"space"`
+}
+
+type confluenceContent struct {
+	ID       json.Number `json:"id"`
+	Title    string      `json:"title"`
+	SpaceKey string      `json:"spaceKey"`
+}
+
+// opsgeniePayload holds the fields of an Opsgenie webhook used to normalize
+// it
+type opsgeniePayload struct {
+	Action string `json:"action"`
+	Alert  *struct {
+		AlertID   string `json:"alertId"`
+		TinyID    string `json:"tinyId"`
+		Message   string `json:"message"`
+		Username  string `json:"username"`
+		CreatedAt int64  `json:"createdAt"`
+		UpdatedAt int64  `json:"updatedAt"`
+	} `json:"alert"`
+}
+
+type user struct {
+	DisplayName  string `json:"displayName"`
+	Name         string `json:"name"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+func (u *user) String() string {
+	switch {
+	case u == nil:
+		return ""
+	case u.DisplayName != "":
+		return u.DisplayName
+	case u.Name != "":
+		return u.Name
+	default:
+		return u.EmailAddress
+	}
+}
+
+// normalize turns a product's webhook payload into an event. eventType is the
+// event name given in the webhook URL, for senders that leave it out of the
+// payload.
+func normalize(product string, body []byte, eventType string) (Event, error) {
+	event := Event{Product: product, Type: eventType, Payload: json.RawMessage(body)}
+
+	switch product {
+	case ProductJira:
+		var p jiraPayload
+		if err := json.Unmarshal(body, &p); err != nil {
+			return event, fmt.Errorf("invalid Jira webhook: %w", err)
+		}
+		if p.WebhookEvent != "" {
+			event.Type = p.WebhookEvent
+		}
+		if p.Issue != nil {
+			event.Key = p.Issue.Key
+			event.Title = p.Issue.Fields.Summary
+		}
+		event.Actor = p.User.String()
+		if event.Actor == "" && p.Comment != nil {
+			event.Actor = p.Comment.Author.String()
+		}
+		if p.Changelog != nil {
+			for _, item := range p.Changelog.Items {
+				event.Changes = append(event.Changes, Change{Field: item.Field, From: item.FromString, To: item.ToString})
+			}
+		}
+		event.Time = fromMillis(p.Timestamp)
+
+	case ProductConfluence:
+		var p confluencePayload
+		if err := json.Unmarshal(body, &p); err != nil {
+			return event, fmt.Errorf("invalid Confluence webhook: %w", err)
+		}
+		if p.Event != "" {
+			event.Type = p.Event
+		}
+		for _, content := range []*confluenceContent{p.Comment, p.Page, p.Blog} {
+			if content != nil {
+				event.Key = content.ID.String()
+				event.Title = content.Title
+				break
+			}
+		}
+		if event.Key == "" && p.Space != nil {
+			event.Key = p.Space.Key
+			event.Title = p.Space.Name
+		}
+		event.Actor = p.UserAccountID
+		if event.Actor == "" {
+			event.Actor = p.UserKey
+		}
+		event.Time = fromMillis(p.Timestamp)
+
+	case ProductOpsgenie:
+		var p opsgeniePayload
+		if err := json.Unmarshal(body, &p); err != nil {
+			return event, fmt.Errorf("invalid Opsgenie webhook: %w", err)
+		}
+		if p.Action != "" {
+			event.Type = "alert_" + snakeCase(p.Action)
+		}
+		if p.Alert != nil {
+			event.Key = p.Alert.TinyID
+			if event.Key == "" {
+				event.Key = p.Alert.AlertID
+			}
+			event.Title = p.Alert.Message
+			event.Actor = p.Alert.Username
+			event.Time = fromMillis(max(p.Alert.UpdatedAt, p.Alert.CreatedAt))
+		}
+
+	default:
+		return event, fmt.Errorf("unknown product: %s", product)
+	}
+
+	if event.Type == "" {
+		return event, fmt.Errorf("event type is missing: pass it in the webhook URL, e.g. ?event=page_created")
+	}
+	return event, nil
+}
+
+// fromMillis converts a Unix timestamp in milliseconds, or returns the zero
+// time for 0. Opsgenie reports some timestamps in nanoseconds, which are
+// detected by their size.
+func fromMillis(ts int64) time.Time {
+	switch {
+	case ts <= 0:
+		return time.Time{}
+	case ts > 1e15:
+		return time.Unix(0, ts).UTC()
+	default:
+		return time.UnixMilli(ts).UTC()
+	}
+}
+
+// snakeCase converts an Opsgenie action (e.g. AddNote, Acknowledge) to
+// snake case
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,264 @@
+// Package webhook receives Jira, Confluence, and Opsgenie webhooks over HTTP,
+// normalizes them into events, and keeps the most recent ones in memory for
+// agents to read.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Products that send webhooks
+const (
+	ProductJira       = "jira"
+	ProductConfluence = "confluence"
+	ProductOpsgenie   = "opsgenie"
+)
+
+// maxPayloadSize is the largest webhook body accepted
+const maxPayloadSize = 5 << 20
+
+// SecretHeader is the header carrying the shared secret, for senders that
+// cannot sign their payloads
+const SecretHeader = "X-Atlas-Webhook-Secret"
+
+// Event is a webhook normalized across products
+type Event struct {
+	ID       int64           `json:"id"` // Increasing sequence number, for polling with After
+	Product  string          `json:"product"`
+	Type     string          `json:"type"`            // e.g. jira:issue_updated, page_created, alert_acknowledge
+	Key      string          `json:"key,omitempty"`   // Issue key, page ID, or alert tiny ID
+	Title    string          `json:"title,omitempty"` // Issue summary, page title, or alert message
+	Actor    string          `json:"actor,omitempty"`
+	Changes  []Change        `json:"changes,omitempty"`
+	Time     time.Time       `json:"time"`
+	Received time.Time       `json:"received"`
+	Payload  json.RawMessage `json:"payload,omitempty"`
+}
+
+// Change is a field change carried by an event
+type Change struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+// Filter selects events
+type Filter struct {
+	After   int64  // Only events with a greater ID
+	Product string // Only events of this product
+	Type    string // Only events whose type contains this text
+	Key     string // Only events about this issue, page, or alert
+	Limit   int    // Maximum number of events, most recent kept (0 for all)
+}
+
+// Receiver receives webhooks and keeps the most recent events. It is safe
+// for concurrent use.
+type Receiver struct {
+	secret string
+	logger *zerolog.Logger
+
+	mu      sync.Mutex
+	events  []Event // Ring buffer, oldest first once wrapped at next
+	next    int
+	size    int
+	lastID  int64
+	onEvent func(Event)
+}
+
+// NewReceiver creates a receiver keeping up to size events. When secret is
+// not empty, webhooks must be signed with it or present it.
+func NewReceiver(size int, secret string, logger *zerolog.Logger) *Receiver {
+	if size <= 0 {
+		size = 1
+	}
+	return &Receiver{
+		secret: secret,
+		logger: logger,
+		events: make([]Event, 0, size),
+		size:   size,
+	}
+}
+
+// OnEvent sets a function called with each event received
+func (r *Receiver) OnEvent(fn func(Event)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onEvent = fn
+}
+
+// Handler returns the HTTP handler receiving webhooks at /webhooks/jira,
+// /webhooks/confluence, and /webhooks/opsgenie
+func (r *Receiver) Handler() http.Handler {
+	mux := http.NewServeMux()
+	for _, product := range []string{ProductJira, ProductConfluence, ProductOpsgenie} {
+		mux.HandleFunc("POST /webhooks/"+product, r.handle(product))
+	}
+	return mux
+}
+
+// Serve listens on addr until the context is done
+func (r *Receiver) Serve(ctx context.Context, addr string) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           r.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("webhook receiver: %w", err)
+	}
+	return nil
+}
+
+// Events returns the events matching the filter, oldest first
+func (r *Receiver) Events(filter Filter) []Event {
+	r.mu.Lock()
+	ordered := make([]Event, 0, len(r.events))
+	ordered = append(ordered, r.events[r.next:]...)
+	ordered = append(ordered, r.events[:r.next]...)
+	r.mu.Unlock()
+
+	events := make([]Event, 0, len(ordered))
+	for _, event := range ordered {
+		if event.ID <= filter.After {
+			continue
+		}
+		if filter.Product != "" && event.Product != filter.Product {
+			continue
+		}
+		if filter.Type != "" && !strings.Contains(event.Type, filter.Type) {
+			continue
+		}
+		if filter.Key != "" && !strings.EqualFold(event.Key, filter.Key) {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	if filter.Limit > 0 && len(events) > filter.Limit {
+		events = events[len(events)-filter.Limit:]
+	}
+	return events
+}
+
+// LastID returns the ID of the most recent event, or 0 if none was received
+func (r *Receiver) LastID() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lastID
+}
+
+// handle returns the handler for a product's webhooks
+func (r *Receiver) handle(product string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxPayloadSize))
+		if err != nil {
+			http.Error(w, "failed to read payload", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if !r.authorized(req, body) {
+			r.logWarn("rejected unauthorized webhook", product)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		event, err := normalize(product, body, req.URL.Query().Get("event"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		r.add(event)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// authorized reports whether a webhook carries the shared secret, either as
+// an HMAC-SHA256 signature of the body (X-Hub-Signature, as sent by Jira),
+// the secret header, or the secret query parameter
+func (r *Receiver) authorized(req *http.Request, body []byte) bool {
+	if r.secret == "" {
+		return true
+	}
+
+	if signature := req.Header.Get("X-Hub-Signature"); signature != "" {
+		mac := hmac.New(sha256.New, []byte(r.secret))
+		mac.Write(body)
+		expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		return hmac.Equal([]byte(signature), []byte(expected))
+	}
+
+	presented := req.Header.Get(SecretHeader)
+	if presented == "" {
+		presented = req.URL.Query().Get("secret")
+	}
+	return subtle.ConstantTimeCompare([]byte(presented), []byte(r.secret)) == 1
+}
+
+// add stores an event and notifies the OnEvent function
+func (r *Receiver) add(event Event) {
+	r.mu.Lock()
+	r.lastID++
+	event.ID = r.lastID
+	event.Received = time.Now().UTC()
+	if event.Time.IsZero() {
+		event.Time = event.Received
+	}
+
+	if len(r.events) < r.size {
+		r.events = append(r.events, event)
+	} else {
+		r.events[r.next] = event
+		r.next = (r.next + 1) % r.size
+	}
+	onEvent := r.onEvent
+	r.mu.Unlock()
+
+	r.logDebug(event)
+	if onEvent != nil {
+		onEvent(event)
+	}
+}
+
+// Logging helpers
+
+func (r *Receiver) logDebug(event Event) {
+	if r.logger == nil {
+		return
+	}
+	r.logger.Debug().
+		Int64("id", event.ID).
+		Str("product", event.Product).
+		Str("type", event.Type).
+		Str("key", event.Key).
+		Msg("received webhook")
+}
+
+func (r *Receiver) logWarn(msg, product string) {
+	if r.logger == nil {
+		return
+	}
+	r.logger.Warn().Str("product", product).Msg(msg)
+}
@@ -0,0 +1,153 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func post(t *testing.T, handler http.Handler, path, body string, header http.Header) int {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name      string
+		product   string
+		body      string
+		eventType string
+		want      Event
+		wantErr   bool
+	}{
+		{
+			name:    "jira issue updated",
+			product: ProductJira,
+			body: `{"timestamp": 1736935200000, "webhookEvent": "jira:issue_updated",
+				"user": {"displayName": "Jane Doe"},
+				"issue": {"key": "PROJ-1", "fields": {"summary": "Broken login"}},
+				"changelog": {"items": [{"field": "status", "fromString": "To Do", "toString": "Done"}]}}`,
+			want: Event{
+				Type:    "jira:issue_updated",
+				Key:     "PROJ-1",
+				Title:   "Broken login",
+				Actor:   "Jane Doe",
+				Changes: []Change{{Field: "status", From: "To Do", To: "Done"}},
+				Time:    time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:      "confluence page with event in URL",
+			product:   ProductConfluence,
+			body:      `{"userAccountId": "abc", "page": {"id": 123, "title": "Runbook", "spaceKey": "OPS"}}`,
+			eventType: "page_updated",
+			want:      Event{Type: "page_updated", Key: "123", Title: "Runbook", Actor: "abc"},
+		},
+		{
+			name:    "opsgenie note added",
+			product: ProductOpsgenie,
+			body:    `{"action": "AddNote", "alert": {"alertId": "a-1", "tinyId": "42", "message": "CPU high", "username": "jdoe"}}`,
+			want:    Event{Type: "alert_add_note", Key: "42", Title: "CPU high", Actor: "jdoe"},
+		},
+		{name: "confluence without event", product: ProductConfluence, body: `{"page": {"id": 1}}`, wantErr: true},
+		{name: "invalid JSON", product: ProductJira, body: `{`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalize(tt.product, []byte(tt.body), tt.eventType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Type != tt.want.Type || got.Key != tt.want.Key || got.Title != tt.want.Title || got.Actor != tt.want.Actor || !got.Time.Equal(tt.want.Time) {
+				t.Errorf("normalize() = %+v, want %+v", got, tt.want)
+			}
+			if len(got.Changes) != len(tt.want.Changes) || (len(got.Changes) > 0 && got.Changes[0] != tt.want.Changes[0]) {
+				t.Errorf("normalize() changes = %+v, want %+v", got.Changes, tt.want.Changes)
+			}
+		})
+	}
+}
+
+func TestReceiverBuffersEvents(t *testing.T) {
+	receiver := NewReceiver(2, "", nil)
+	handler := receiver.Handler()
+
+	var notified []int64
+	receiver.OnEvent(func(event Event) { notified = append(notified, event.ID) })
+
+	for _, key := range []string{"PROJ-1", "PROJ-2", "PROJ-3"} {
+		body := `{"webhookEvent": "jira:issue_created", "issue": {"key": "` + key + `"}}`
+		if code := post(t, handler, "/webhooks/jira", body, nil); code != http.StatusNoContent {
+			t.Fatalf("POST status = %d, want 204", code)
+		}
+	}
+
+	events := receiver.Events(Filter{})
+	if len(events) != 2 || events[0].Key != "PROJ-2" || events[1].Key != "PROJ-3" {
+		t.Fatalf("Events() = %+v, want the two most recent, oldest first", events)
+	}
+	if len(notified) != 3 || receiver.LastID() != 3 {
+		t.Errorf("notified = %v, LastID() = %d", notified, receiver.LastID())
+	}
+
+	if events := receiver.Events(Filter{After: 2}); len(events) != 1 || events[0].ID != 3 {
+		t.Errorf("Events(After: 2) = %+v, want only event 3", events)
+	}
+	if events := receiver.Events(Filter{Key: "proj-2"}); len(events) != 1 {
+		t.Errorf("Events(Key) = %+v, want one event", events)
+	}
+	if events := receiver.Events(Filter{Product: ProductOpsgenie}); len(events) != 0 {
+		t.Errorf("Events(Product) = %+v, want none", events)
+	}
+	if events := receiver.Events(Filter{Limit: 1}); len(events) != 1 || events[0].Key != "PROJ-3" {
+		t.Errorf("Events(Limit: 1) = %+v, want the most recent", events)
+	}
+}
+
+func TestReceiverSecret(t *testing.T) {
+	receiver := NewReceiver(10, "s3cret", nil)
+	handler := receiver.Handler()
+	body := `{"webhookEvent": "jira:issue_deleted", "issue": {"key": "PROJ-1"}}`
+
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write([]byte(body))
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name   string
+		path   string
+		header http.Header
+		want   int
+	}{
+		{name: "missing secret", path: "/webhooks/jira", want: http.StatusUnauthorized},
+		{name: "wrong secret", path: "/webhooks/jira", header: http.Header{SecretHeader: {"nope"}}, want: http.StatusUnauthorized},
+		{name: "bad signature", path: "/webhooks/jira", header: http.Header{"X-Hub-Signature": {"sha256=00"}}, want: http.StatusUnauthorized},
+		{name: "secret header", path: "/webhooks/jira", header: http.Header{SecretHeader: {"s3cret"}}, want: http.StatusNoContent},
+		{name: "secret query", path: "/webhooks/jira?secret=s3cret", want: http.StatusNoContent},
+		{name: "signature", path: "/webhooks/jira", header: http.Header{"X-Hub-Signature": {signature}}, want: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := post(t, handler, tt.path, body, tt.header); code != tt.want {
+				t.Errorf("POST status = %d, want %d", code, tt.want)
+			}
+		})
+	}
+}
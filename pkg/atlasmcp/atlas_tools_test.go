@@ -0,0 +1,305 @@
+package atlasmcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/codeownersnet/atlas/internal/config"
+	"github.com/codeownersnet/atlas/pkg/atlassian/atlassiantest"
+	"github.com/codeownersnet/atlas/pkg/atlassian/confluence"
+	"github.com/rs/zerolog"
+)
+
+// newFakeServer creates a server with all products pointed at the fake
+func newFakeServer(t *testing.T, fake *atlassiantest.Server) *Server {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	cfg := testConfig()
+	cfg.Jira.URL = fake.URL
+	cfg.Confluence = &config.ConfluenceConfig{URL: fake.URL, AuthMethod: config.AuthMethodPAT, PersonalToken: "token", SSLVerify: true}
+	cfg.Opsgenie = &config.OpsgenieConfig{URL: fake.URL, APIKey: "key", SSLVerify: true}
+
+	srv, err := New(context.Background(), cfg, WithLogger(&logger))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return srv
+}
+
+// callJSON calls a tool and decodes its JSON result into v
+func callJSON(t *testing.T, srv *Server, tool string, args map[string]interface{}, v interface{}) {
+	t.Helper()
+
+	result, err := srv.CallTool(context.Background(), tool, args)
+	if err != nil {
+		t.Fatalf("CallTool(%s) error = %v", tool, err)
+	}
+	if result.IsError {
+		t.Fatalf("CallTool(%s) = %s", tool, result.Content[0].Text)
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].Text), v); err != nil {
+		t.Fatalf("CallTool(%s) returned invalid JSON %s: %v", tool, result.Content[0].Text, err)
+	}
+}
+
+// requestBody returns the decoded body of the last request with the given
+// method and path
+func requestBody(t *testing.T, fake *atlassiantest.Server, method, path string) map[string]interface{} {
+	t.Helper()
+
+	requests := fake.Requests()
+	for i := len(requests) - 1; i >= 0; i-- {
+		if requests[i].Method == method && requests[i].Path == path {
+			var body map[string]interface{}
+			if err := json.Unmarshal(requests[i].Body, &body); err != nil {
+				t.Fatalf("%s %s body is not JSON: %v", method, path, err)
+			}
+			return body
+		}
+	}
+	t.Fatalf("fake received no %s %s", method, path)
+	return nil
+}
+
+func TestAlertToIssue(t *testing.T) {
+	fake := atlassiantest.NewServer()
+	defer fake.Close()
+	fake.HandleJSON(http.MethodPost, "/rest/api/2/issue", http.StatusCreated, `{"id": "10010", "key": "OPS-7"}`)
+	fake.HandleJSON(http.MethodPost, "/rest/api/2/issue/OPS-7/remotelink", http.StatusCreated, `{"id": 1}`)
+	fake.HandleJSON(http.MethodPost, "/v2/alerts/"+atlassiantest.AlertID+"/notes", http.StatusAccepted, `{"result": "Request will be processed", "requestId": "r1"}`)
+	srv := newFakeServer(t, fake)
+
+	var result struct {
+		Key      string   `json:"key"`
+		Warnings []string `json:"warnings"`
+	}
+	callJSON(t, srv, "atlas_alert_to_issue", map[string]interface{}{
+		"alert_id":     atlassiantest.AlertID,
+		"project_key":  "OPS",
+		"priority_map": `{"p2": "Critical"}`,
+		"labels":       "incident review",
+	}, &result)
+	if result.Key != "OPS-7" || len(result.Warnings) != 0 {
+		t.Errorf("atlas_alert_to_issue = %+v, want OPS-7 without warnings", result)
+	}
+
+	fields := requestBody(t, fake, http.MethodPost, "/rest/api/2/issue")["fields"].(map[string]interface{})
+	if fields["summary"] != "Login latency above 2s" {
+		t.Errorf("summary = %v, want the alert message", fields["summary"])
+	}
+	if priority := fields["priority"].(map[string]interface{}); priority["name"] != "Critical" {
+		t.Errorf("priority = %v, want the mapped Critical", priority)
+	}
+	if labels, _ := json.Marshal(fields["labels"]); string(labels) != `["login","latency","incident-review"]` {
+		t.Errorf("labels = %s, want the alert tags and extra labels", labels)
+	}
+
+	link := requestBody(t, fake, http.MethodPost, "/rest/api/2/issue/OPS-7/remotelink")
+	if link["globalId"] != "opsgenie-alert="+atlassiantest.AlertID {
+		t.Errorf("remote link globalId = %v, want the alert", link["globalId"])
+	}
+	note := requestBody(t, fake, http.MethodPost, "/v2/alerts/"+atlassiantest.AlertID+"/notes")
+	if text, _ := note["note"].(string); !strings.Contains(text, fake.URL+"/browse/OPS-7") {
+		t.Errorf("alert note = %q, want the issue URL", text)
+	}
+}
+
+func TestAlertToIssueWarnings(t *testing.T) {
+	fake := atlassiantest.NewServer()
+	defer fake.Close()
+	fake.HandleJSON(http.MethodPost, "/rest/api/2/issue", http.StatusCreated, `{"id": "10010", "key": "OPS-7"}`)
+	fake.HandleJSON(http.MethodPost, "/rest/api/2/issue/OPS-7/remotelink", http.StatusForbidden, `{"errorMessages": ["Remote links are disabled"]}`)
+	fake.HandleJSON(http.MethodPost, "/v2/alerts/"+atlassiantest.AlertID+"/notes", http.StatusUnprocessableEntity, `{"message": "Alert is closed"}`)
+	srv := newFakeServer(t, fake)
+
+	// The issue exists, so failing to cross-reference it only warns
+	var result struct {
+		Key      string   `json:"key"`
+		Warnings []string `json:"warnings"`
+	}
+	callJSON(t, srv, "atlas_alert_to_issue", map[string]interface{}{
+		"alert_id":    atlassiantest.AlertID,
+		"project_key": "OPS",
+	}, &result)
+	if result.Key != "OPS-7" {
+		t.Errorf("key = %q, want OPS-7", result.Key)
+	}
+	if len(result.Warnings) != 2 ||
+		!strings.Contains(result.Warnings[0], "failed to link alert to issue") ||
+		!strings.Contains(result.Warnings[1], "failed to add note to alert") {
+		t.Errorf("warnings = %q, want the link and note failures", result.Warnings)
+	}
+}
+
+func TestLinkIssueToPage(t *testing.T) {
+	fake := atlassiantest.NewServer()
+	defer fake.Close()
+	fake.HandleJSON(http.MethodPost, "/rest/api/2/issue/"+atlassiantest.IssueKey+"/remotelink", http.StatusCreated, `{"id": 5}`)
+	fake.HandleJSON(http.MethodPut, "/rest/api/content/"+atlassiantest.PageID, http.StatusOK, `{"id": "123", "title": "Runbook: Login service"}`)
+	srv := newFakeServer(t, fake)
+
+	var result struct {
+		PageUpdated bool `json:"page_updated"`
+	}
+	args := map[string]interface{}{"issue_key": atlassiantest.IssueKey, "page_id": atlassiantest.PageID}
+	callJSON(t, srv, "atlas_link_issue_to_page", args, &result)
+	if !result.PageUpdated {
+		t.Error("page_updated = false, want the page to reference the issue")
+	}
+
+	link := requestBody(t, fake, http.MethodPost, "/rest/api/2/issue/"+atlassiantest.IssueKey+"/remotelink")
+	if link["globalId"] != "confluence-page="+atlassiantest.PageID {
+		t.Errorf("remote link globalId = %v, want the page", link["globalId"])
+	}
+	update := requestBody(t, fake, http.MethodPut, "/rest/api/content/"+atlassiantest.PageID)
+	body := update["body"].(map[string]interface{})["storage"].(map[string]interface{})["value"].(string)
+	if !strings.Contains(body, confluence.JiraIssueMacro(atlassiantest.IssueKey)) {
+		t.Errorf("page update body = %s, want the Jira issue macro", body)
+	}
+	if version := update["version"].(map[string]interface{}); version["number"] != float64(5) {
+		t.Errorf("page update version = %v, want 5", version["number"])
+	}
+
+	// A page that already references the issue is left alone
+	fake.HandleJSON(http.MethodGet, "/rest/api/content/"+atlassiantest.PageID, http.StatusOK, map[string]interface{}{
+		"id":      atlassiantest.PageID,
+		"title":   "Runbook: Login service",
+		"version": map[string]interface{}{"number": 5},
+		"body":    map[string]interface{}{"storage": map[string]interface{}{"value": "<p>" + confluence.JiraIssueMacro(atlassiantest.IssueKey) + "</p>"}},
+	})
+	callJSON(t, srv, "atlas_link_issue_to_page", args, &result)
+	if result.PageUpdated {
+		t.Error("page_updated = true for a page that already references the issue")
+	}
+}
+
+func TestGeneratePostmortem(t *testing.T) {
+	fake := atlassiantest.NewServer()
+	defer fake.Close()
+	fake.HandleJSON(http.MethodGet, "/v2/incidents/inc-1", http.StatusOK, `{"data": {
+		"id": "inc-1", "tinyId": "42", "message": "Login outage", "status": "resolved", "priority": "P1",
+		"tags": ["login", "say \"hi\""], "impactedServices": ["login"], "createdAt": "2024-03-04T16:00:00Z"}}`)
+	fake.HandleJSON(http.MethodGet, "/v1/incidents/inc-1/associated-alert-ids", http.StatusOK, `{"data": ["`+atlassiantest.AlertID+`"]}`)
+	fake.HandleJSON(http.MethodGet, "/v2/alerts/"+atlassiantest.AlertID+"/logs", http.StatusOK, `{"data": [
+		{"log": "Alert acknowledged", "owner": "jdoe", "createdAt": "2024-03-04T16:35:00Z"},
+		{"log": "Alert created", "owner": "System", "createdAt": "2024-03-04T16:30:00Z"}]}`)
+	fake.HandleJSON(http.MethodPost, "/rest/api/content", http.StatusOK, `{"id": "900", "title": "Postmortem: Login outage"}`)
+	srv := newFakeServer(t, fake)
+
+	var result struct {
+		TimelineCount int    `json:"timeline_count"`
+		IssueCount    int    `json:"issue_count"`
+		JQL           string `json:"jql"`
+	}
+	callJSON(t, srv, "atlas_generate_postmortem", map[string]interface{}{
+		"incident_id": "inc-1",
+		"space_key":   atlassiantest.SpaceKey,
+		"project_key": "PROJ",
+	}, &result)
+
+	// Tags become labels, quoted as JQL strings
+	if want := `project = "PROJ" AND labels in ("login", "say-\"hi\"") ORDER BY created DESC`; result.JQL != want {
+		t.Errorf("jql = %s, want %s", result.JQL, want)
+	}
+	if search := requestBody(t, fake, http.MethodPost, "/rest/api/2/search"); search["jql"] != result.JQL {
+		t.Errorf("searched %v, want %s", search["jql"], result.JQL)
+	}
+	if result.TimelineCount != 3 || result.IssueCount != 2 {
+		t.Errorf("timeline_count = %d, issue_count = %d, want 3 and 2", result.TimelineCount, result.IssueCount)
+	}
+
+	page := requestBody(t, fake, http.MethodPost, "/rest/api/content")
+	if page["title"] != "Postmortem: Login outage" {
+		t.Errorf("page title = %v", page["title"])
+	}
+	body := page["body"].(map[string]interface{})["storage"].(map[string]interface{})["value"].(string)
+	created := strings.Index(body, "Alert created")
+	acknowledged := strings.Index(body, "Alert acknowledged (jdoe)")
+	if created < 0 || acknowledged < created {
+		t.Errorf("page body timeline is not in time order:\n%s", body)
+	}
+	for _, want := range []string{"Incident #42 opened: Login outage", "PROJ-1", "Login times out after 30 seconds", "Root cause", "Action items"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("page body is missing %q:\n%s", want, body)
+		}
+	}
+}
+
+func TestCreateIssuesFromPage(t *testing.T) {
+	const storage = "<p>Notes</p><p>TODO: Fix login</p><p>Discussed the rollout</p><p>Action: Break the build</p><p>AI: Update docs</p>"
+
+	fake := atlassiantest.NewServer()
+	defer fake.Close()
+	fake.HandleJSON(http.MethodGet, "/rest/api/content/456", http.StatusOK, map[string]interface{}{
+		"id":    "456",
+		"title": "Retro",
+		"body":  map[string]interface{}{"storage": map[string]interface{}{"value": storage}},
+	})
+	keys := map[string]string{"Fix login": "PROJ-10", "Update docs": "PROJ-11"}
+	fake.Handle(http.MethodPost, "/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Fields struct {
+				Summary string `json:"summary"`
+			} `json:"fields"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		key, ok := keys[req.Fields.Summary]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors": {"summary": "rejected"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"key": key})
+	})
+	fake.HandleJSON(http.MethodPost, "/rest/api/2/issue/PROJ-10/remotelink", http.StatusCreated, `{"id": 1}`)
+	fake.HandleJSON(http.MethodPost, "/rest/api/2/issue/PROJ-11/remotelink", http.StatusForbidden, `{"errorMessages": ["Remote links are disabled"]}`)
+	srv := newFakeServer(t, fake)
+
+	var result struct {
+		Created []struct {
+			Line    int    `json:"line"`
+			Source  string `json:"source"`
+			Key     string `json:"key"`
+			Warning string `json:"warning"`
+		} `json:"created"`
+		Succeeded int      `json:"succeeded"`
+		Failed    int      `json:"failed"`
+		Errors    []string `json:"errors"`
+	}
+	callJSON(t, srv, "atlas_create_issues_from_page", map[string]interface{}{
+		"page_id":     "456",
+		"project_key": "PROJ",
+		"source":      "pattern",
+		"concurrency": 1,
+	}, &result)
+
+	items, err := confluence.ExtractPattern(storage, confluence.DefaultActionItemPattern)
+	if err != nil || len(items) != 3 {
+		t.Fatalf("ExtractPattern() = %+v, %v, want 3 items", items, err)
+	}
+	if result.Succeeded != 2 || result.Failed != 1 || len(result.Created) != 2 {
+		t.Fatalf("atlas_create_issues_from_page = %+v, want 2 created and 1 failed", result)
+	}
+
+	// Every issue is mapped back to the line it was created from
+	for i, item := range []confluence.ExtractedItem{items[0], items[2]} {
+		created := result.Created[i]
+		if created.Line != item.Line || created.Source != item.Source || created.Key != keys[item.Summary] {
+			t.Errorf("created[%d] = %+v, want line %d (%s) mapped to %s", i, created, item.Line, item.Source, keys[item.Summary])
+		}
+	}
+	if result.Created[0].Warning != "" || !strings.Contains(result.Created[1].Warning, "failed to link page to issue") {
+		t.Errorf("warnings = %q, %q, want only the second link to fail", result.Created[0].Warning, result.Created[1].Warning)
+	}
+	if want := fmt.Sprintf("line %d", items[1].Line); len(result.Errors) != 1 || !strings.Contains(result.Errors[0], want) {
+		t.Errorf("errors = %q, want the failure of %s", result.Errors, want)
+	}
+}
@@ -0,0 +1,105 @@
+// Package atlasmcp embeds the Atlassian MCP server in other Go programs.
+//
+// New creates a server with a client and the tools for every product in the
+// configuration, and options add custom tools and middleware:
+//
+//	cfg, err := atlasmcp.LoadConfig("")
+//	if err != nil {
+//		return err
+//	}
+//	srv, err := atlasmcp.New(ctx, cfg,
+//		atlasmcp.WithTools(deployTool),
+//		atlasmcp.WithMiddleware(metrics),
+//	)
+//	if err != nil {
+//		return err
+//	}
+//	return srv.ServeStdio(ctx)
+package atlasmcp
+
+import (
+	"os"
+	"time"
+
+	"github.com/codeownersnet/atlas/internal/config"
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/rs/zerolog"
+)
+
+// Configuration types
+type (
+	Config           = config.Config
+	JiraConfig       = config.JiraConfig
+	ConfluenceConfig = config.ConfluenceConfig
+	OpsgenieConfig   = config.OpsgenieConfig
+	HTTPConfig       = config.HTTPConfig
+	LoggingConfig    = config.LoggingConfig
+)
+
+// Tool types, for defining custom tools and middleware
+type (
+	Tool           = mcp.ToolDefinition
+	ToolInfo       = mcp.Tool
+	ToolHandler    = mcp.ToolHandler
+	InputSchema    = mcp.InputSchema
+	Property       = mcp.Property
+	CallToolResult = mcp.CallToolResult
+	Middleware     = mcp.Middleware
+	AuditFunc      = mcp.AuditFunc
+)
+
+// Helpers for defining custom tools, see the internal mcp package
+var (
+	NewTool            = mcp.NewTool
+	NewInputSchema     = mcp.NewInputSchema
+	NewStringProperty  = mcp.NewStringProperty
+	NewIntegerProperty = mcp.NewIntegerProperty
+	NewBooleanProperty = mcp.NewBooleanProperty
+	NewEnumProperty    = mcp.NewEnumProperty
+	NewArrayProperty   = mcp.NewArrayProperty
+	NewObjectProperty  = mcp.NewObjectProperty
+	NewJSONResult      = mcp.NewJSONResult
+	NewSuccessResult   = mcp.NewSuccessResult
+	Bind               = mcp.Bind
+	Chain              = mcp.Chain
+	OnlyTagged         = mcp.OnlyTagged
+)
+
+// LoadConfig loads the configuration from the environment and an optional
+// config file (.env, .yaml, .yml, .toml, or .json), like the atlas-mcp binary
+func LoadConfig(configFile string) (*Config, error) {
+	return config.Load(configFile)
+}
+
+// NewLogger creates the logger the server uses when none is given, writing to
+// stderr (or stdout) at the configured verbosity
+func NewLogger(cfg *LoggingConfig) zerolog.Logger {
+	// Determine log level
+	// Default to ErrorLevel to avoid cluttering MCP client logs
+	level := zerolog.ErrorLevel
+	if cfg.VeryVerbose {
+		level = zerolog.DebugLevel
+	} else if cfg.Verbose {
+		level = zerolog.InfoLevel
+	}
+
+	// Determine output stream
+	output := os.Stderr
+	if cfg.LogToStdout {
+		output = os.Stdout
+	}
+
+	// Disable colors for MCP servers since logs are captured by clients
+	// and ANSI escape codes appear as raw text
+	logger := zerolog.New(zerolog.ConsoleWriter{
+		Out:        output,
+		TimeFormat: time.RFC3339,
+		NoColor:    true,
+	}).
+		Level(level).
+		With().
+		Timestamp().
+		Logger()
+
+	return logger
+}
@@ -0,0 +1,274 @@
+package atlasmcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/codeownersnet/atlas/internal/auth"
+	"github.com/codeownersnet/atlas/internal/client"
+	"github.com/codeownersnet/atlas/internal/config"
+	"github.com/codeownersnet/atlas/pkg/atlassian/confluence"
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+	"github.com/codeownersnet/atlas/pkg/atlassian/opsgenie"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/rs/zerolog"
+	"go.yaml.in/yaml/v3"
+)
+
+// NewJiraClient creates a Jira client for an instance configuration, with the
+// shared HTTP settings
+func NewJiraClient(cfg *JiraConfig, httpCfg *HTTPConfig, logger *zerolog.Logger) (*jira.Client, error) {
+	return newJiraClient(cfg, &httpSettings{HTTPConfig: httpCfg}, logger)
+}
+
+// NewConfluenceClient creates a Confluence client for an instance
+// configuration, with the shared HTTP settings
+func NewConfluenceClient(cfg *ConfluenceConfig, httpCfg *HTTPConfig, logger *zerolog.Logger) (*confluence.Client, error) {
+	return newConfluenceClient(cfg, &httpSettings{HTTPConfig: httpCfg}, logger)
+}
+
+// NewOpsgenieClient creates an Opsgenie client with the shared HTTP settings
+func NewOpsgenieClient(cfg *OpsgenieConfig, httpCfg *HTTPConfig, logger *zerolog.Logger) (*opsgenie.Client, error) {
+	return newOpsgenieClient(cfg, &httpSettings{HTTPConfig: httpCfg}, logger)
+}
+
+// httpSettings holds the HTTP client settings shared by the clients of all
+// products
+type httpSettings struct {
+	*config.HTTPConfig
+	capture  *client.Capture  // Set when debug capture is enabled
+	cassette *client.Cassette // Set when a cassette is configured
+}
+
+// newJiraClient creates a Jira client with the appropriate authentication
+func newJiraClient(cfg *config.JiraConfig, httpCfg *httpSettings, logger *zerolog.Logger) (*jira.Client, error) {
+	authProvider, err := createJiraAuthProvider(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create auth provider: %w", err)
+	}
+
+	logger.Debug().
+		Str("auth_type", authProvider.Type()).
+		Str("auth_masked", authProvider.Mask()).
+		Msg("created Jira auth provider")
+
+	templates, err := loadIssueTemplates(cfg.IssueTemplatesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	jiraClient, err := jira.NewClient(&jira.Config{
+		BaseURL:       cfg.URL,
+		Auth:          authProvider,
+		CustomHeaders: cfg.CustomHeaders,
+		SSLVerify:     cfg.SSLVerify,
+		HTTPProxy:     cfg.HTTPProxy,
+		HTTPSProxy:    cfg.HTTPSProxy,
+		SOCKSProxy:    cfg.SOCKSProxy,
+		NoProxy:       cfg.NoProxy,
+
+		CACertFile:     cfg.CACert,
+		ClientCertFile: cfg.ClientCert,
+		ClientKeyFile:  cfg.ClientKey,
+
+		MaxResponseSize: httpCfg.MaxResponseSize(),
+
+		MaxIdleConns:        httpCfg.MaxIdleConns,
+		MaxConnsPerHost:     httpCfg.MaxConnsPerHost,
+		IdleConnTimeout:     time.Duration(httpCfg.IdleConnTimeoutSeconds) * time.Second,
+		TLSHandshakeTimeout: time.Duration(httpCfg.TLSHandshakeTimeoutSeconds) * time.Second,
+
+		Capture:  httpCfg.capture,
+		Cassette: httpCfg.cassette,
+
+		AutomationWebhooks: automationWebhooks(cfg),
+		MetadataTTL:        time.Duration(cfg.MetadataCacheTTL) * time.Second,
+		IssueTemplates:     templates,
+		HoursPerDay:        cfg.HoursPerDay,
+		DaysPerWeek:        cfg.DaysPerWeek,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Jira client: %w", err)
+	}
+
+	return jiraClient, nil
+}
+
+// automationWebhooks returns the configured Jira Automation webhooks with
+// their secrets
+func automationWebhooks(cfg *config.JiraConfig) map[string]jira.AutomationWebhook {
+	webhooks := make(map[string]jira.AutomationWebhook, len(cfg.AutomationWebhooks))
+	for name, url := range cfg.AutomationWebhooks {
+		webhooks[name] = jira.AutomationWebhook{URL: url, Token: cfg.AutomationWebhookTokens[name]}
+	}
+	return webhooks
+}
+
+// loadIssueTemplates reads the Jira issue templates of a YAML, TOML, or JSON
+// file with a top-level "templates" map. An empty path loads none. The file
+// is decoded directly rather than through viper, which lowercases map keys
+// and would change field names and values such as {"accountId": "..."}.
+func loadIssueTemplates(path string) (map[string]jira.IssueTemplate, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read issue templates file %s: %w", path, err)
+	}
+
+	var file struct {
+		Templates map[string]jira.IssueTemplate `json:"templates" yaml:"templates" toml:"templates"`
+	}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &file)
+	case ".toml":
+		err = toml.Unmarshal(data, &file)
+	case ".json":
+		err = json.Unmarshal(data, &file)
+	default:
+		return nil, fmt.Errorf("unsupported issue templates file %s: use a .yaml, .toml, or .json file", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid issue templates file %s: %w", path, err)
+	}
+	return file.Templates, nil
+}
+
+// createJiraAuthProvider creates the appropriate auth provider for Jira
+func createJiraAuthProvider(cfg *config.JiraConfig) (auth.Provider, error) {
+	switch cfg.AuthMethod {
+	case config.AuthMethodBasic:
+		return auth.NewBasicAuth(cfg.Username, cfg.APIToken)
+	case config.AuthMethodPAT:
+		return auth.NewPATAuth(cfg.PersonalToken)
+	case config.AuthMethodOAuth:
+		// BYO (Bring Your Own) OAuth token
+		if cfg.OAuthAccessToken == "" {
+			return nil, fmt.Errorf("ATLASSIAN_OAUTH_ACCESS_TOKEN is required for OAuth authentication")
+		}
+		return auth.NewOAuthAuth(cfg.OAuthAccessToken, cfg.OAuthCloudID)
+	default:
+		prefix := cfg.EnvPrefix()
+		return nil, fmt.Errorf("no authentication configured - set %s_USERNAME+%s_API_TOKEN or %s_PERSONAL_TOKEN or ATLASSIAN_OAUTH_ACCESS_TOKEN", prefix, prefix, prefix)
+	}
+}
+
+// newConfluenceClient creates a Confluence client with the appropriate authentication
+func newConfluenceClient(cfg *config.ConfluenceConfig, httpCfg *httpSettings, logger *zerolog.Logger) (*confluence.Client, error) {
+	authProvider, err := createConfluenceAuthProvider(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create auth provider: %w", err)
+	}
+
+	logger.Debug().
+		Str("auth_type", authProvider.Type()).
+		Str("auth_masked", authProvider.Mask()).
+		Msg("created Confluence auth provider")
+
+	confluenceClient, err := confluence.NewClient(&confluence.Config{
+		BaseURL:       cfg.URL,
+		Auth:          authProvider,
+		CustomHeaders: cfg.CustomHeaders,
+		SSLVerify:     cfg.SSLVerify,
+		HTTPProxy:     cfg.HTTPProxy,
+		HTTPSProxy:    cfg.HTTPSProxy,
+		SOCKSProxy:    cfg.SOCKSProxy,
+		NoProxy:       cfg.NoProxy,
+
+		CACertFile:     cfg.CACert,
+		ClientCertFile: cfg.ClientCert,
+		ClientKeyFile:  cfg.ClientKey,
+
+		MaxResponseSize: httpCfg.MaxResponseSize(),
+
+		MaxIdleConns:        httpCfg.MaxIdleConns,
+		MaxConnsPerHost:     httpCfg.MaxConnsPerHost,
+		IdleConnTimeout:     time.Duration(httpCfg.IdleConnTimeoutSeconds) * time.Second,
+		TLSHandshakeTimeout: time.Duration(httpCfg.TLSHandshakeTimeoutSeconds) * time.Second,
+
+		Capture:  httpCfg.capture,
+		Cassette: httpCfg.cassette,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Confluence client: %w", err)
+	}
+
+	return confluenceClient, nil
+}
+
+// createConfluenceAuthProvider creates the appropriate auth provider for Confluence
+func createConfluenceAuthProvider(cfg *config.ConfluenceConfig) (auth.Provider, error) {
+	switch cfg.AuthMethod {
+	case config.AuthMethodBasic:
+		return auth.NewBasicAuth(cfg.Username, cfg.APIToken)
+	case config.AuthMethodPAT:
+		return auth.NewPATAuth(cfg.PersonalToken)
+	case config.AuthMethodOAuth:
+		// BYO (Bring Your Own) OAuth token
+		if cfg.OAuthAccessToken == "" {
+			return nil, fmt.Errorf("ATLASSIAN_OAUTH_ACCESS_TOKEN is required for OAuth authentication")
+		}
+		return auth.NewOAuthAuth(cfg.OAuthAccessToken, cfg.OAuthCloudID)
+	default:
+		prefix := cfg.EnvPrefix()
+		return nil, fmt.Errorf("no authentication configured - set %s_USERNAME+%s_API_TOKEN or %s_PERSONAL_TOKEN or ATLASSIAN_OAUTH_ACCESS_TOKEN", prefix, prefix, prefix)
+	}
+}
+
+// newOpsgenieClient creates an Opsgenie client with the appropriate authentication
+func newOpsgenieClient(cfg *config.OpsgenieConfig, httpCfg *httpSettings, logger *zerolog.Logger) (*opsgenie.Client, error) {
+	authProvider, err := createOpsgenieAuthProvider(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create auth provider: %w", err)
+	}
+
+	logger.Debug().
+		Str("auth_type", authProvider.Type()).
+		Str("auth_masked", authProvider.Mask()).
+		Msg("created Opsgenie auth provider")
+
+	opsgenieClient, err := opsgenie.NewClient(&opsgenie.Config{
+		BaseURL:       cfg.URL,
+		Auth:          authProvider,
+		CustomHeaders: cfg.CustomHeaders,
+		SSLVerify:     cfg.SSLVerify,
+		HTTPProxy:     cfg.HTTPProxy,
+		HTTPSProxy:    cfg.HTTPSProxy,
+		SOCKSProxy:    cfg.SOCKSProxy,
+		NoProxy:       cfg.NoProxy,
+
+		CACertFile:     cfg.CACert,
+		ClientCertFile: cfg.ClientCert,
+		ClientKeyFile:  cfg.ClientKey,
+
+		MaxResponseSize: httpCfg.MaxResponseSize(),
+
+		MaxIdleConns:        httpCfg.MaxIdleConns,
+		MaxConnsPerHost:     httpCfg.MaxConnsPerHost,
+		IdleConnTimeout:     time.Duration(httpCfg.IdleConnTimeoutSeconds) * time.Second,
+		TLSHandshakeTimeout: time.Duration(httpCfg.TLSHandshakeTimeoutSeconds) * time.Second,
+
+		Capture:  httpCfg.capture,
+		Cassette: httpCfg.cassette,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Opsgenie client: %w", err)
+	}
+
+	return opsgenieClient, nil
+}
+
+// createOpsgenieAuthProvider creates the appropriate auth provider for Opsgenie
+func createOpsgenieAuthProvider(cfg *config.OpsgenieConfig) (auth.Provider, error) {
+	if cfg.APIKey == "" {
+		return nil, fmt.Errorf("OPSGENIE_API_KEY is required for Opsgenie authentication")
+	}
+	return auth.NewAPIKeyAuth(cfg.APIKey)
+}
@@ -0,0 +1,81 @@
+package atlasmcp
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadIssueTemplates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "templates.yaml")
+	data := `templates:
+  bug-report:
+    description: |
+      ## Steps to reproduce
+
+      ## Expected result
+    fields:
+      priority:
+        name: High
+      assignee:
+        accountId: 5b10a2844c20165700ede21g
+    labels: [bug, triage]
+    components: [Backend]
+`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	templates, err := loadIssueTemplates(path)
+	if err != nil {
+		t.Fatalf("loadIssueTemplates() error = %v", err)
+	}
+	bug, ok := templates["bug-report"]
+	if !ok {
+		t.Fatalf("templates = %+v", templates)
+	}
+	if bug.Description != "## Steps to reproduce\n\n## Expected result\n" {
+		t.Errorf("Description = %q", bug.Description)
+	}
+	if !reflect.DeepEqual(bug.Labels, []string{"bug", "triage"}) || !reflect.DeepEqual(bug.Components, []string{"Backend"}) {
+		t.Errorf("Labels = %v, Components = %v", bug.Labels, bug.Components)
+	}
+	if priority, ok := bug.Fields["priority"].(map[string]interface{}); !ok || priority["name"] != "High" {
+		t.Errorf("Fields = %#v", bug.Fields)
+	}
+	if assignee, ok := bug.Fields["assignee"].(map[string]interface{}); !ok || assignee["accountId"] != "5b10a2844c20165700ede21g" {
+		t.Errorf("Fields = %#v, the case of keys should be kept", bug.Fields)
+	}
+
+	for name, data := range map[string]string{
+		"templates.json": `{"templates": {"Incident": {"fields": {"customfield_10010": {"requestTypeId": "12"}}}}}`,
+		"templates.toml": "[templates.Incident.fields.customfield_10010]\nrequestTypeId = \"12\"\n",
+	} {
+		path := filepath.Join(t.TempDir(), name)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		templates, err := loadIssueTemplates(path)
+		if err != nil {
+			t.Fatalf("loadIssueTemplates(%s) error = %v", name, err)
+		}
+		if field, ok := templates["Incident"].Fields["customfield_10010"].(map[string]interface{}); !ok || field["requestTypeId"] != "12" {
+			t.Errorf("loadIssueTemplates(%s) = %#v", name, templates)
+		}
+	}
+
+	if templates, err := loadIssueTemplates(""); err != nil || templates != nil {
+		t.Errorf("loadIssueTemplates(\"\") = %v, %v", templates, err)
+	}
+	if _, err := loadIssueTemplates(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("loadIssueTemplates() with a missing file should return an error")
+	}
+	unsupported := filepath.Join(t.TempDir(), "templates.ini")
+	if err := os.WriteFile(unsupported, []byte("[templates]"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadIssueTemplates(unsupported); err == nil {
+		t.Error("loadIssueTemplates() with an unsupported format should return an error")
+	}
+}
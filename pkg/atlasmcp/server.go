@@ -0,0 +1,505 @@
+package atlasmcp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/codeownersnet/atlas/internal/client"
+	"github.com/codeownersnet/atlas/internal/mcp"
+	"github.com/codeownersnet/atlas/internal/store"
+	atlastools "github.com/codeownersnet/atlas/internal/tools/atlas"
+	"github.com/codeownersnet/atlas/internal/tools/batch"
+	"github.com/codeownersnet/atlas/internal/tools/command"
+	confluencetools "github.com/codeownersnet/atlas/internal/tools/confluence"
+	"github.com/codeownersnet/atlas/internal/tools/display"
+	jiratools "github.com/codeownersnet/atlas/internal/tools/jira"
+	opsgenietools "github.com/codeownersnet/atlas/internal/tools/opsgenie"
+	"github.com/codeownersnet/atlas/internal/tools/session"
+	"github.com/codeownersnet/atlas/internal/webhook"
+	"github.com/codeownersnet/atlas/pkg/atlassian/confluence"
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+	"github.com/rs/zerolog"
+)
+
+// Server is an MCP server with the tools of the configured Atlassian products
+type Server struct {
+	ctx         context.Context // Carries the clients and state used by the tools
+	mcp         *mcp.Server
+	logger      *zerolog.Logger
+	webhookAddr string // Set when the webhook receiver is enabled
+}
+
+// Option configures a server created with New
+type Option func(*options)
+
+type options struct {
+	logger     *zerolog.Logger
+	tools      []*Tool
+	middleware []Middleware
+}
+
+// WithLogger sets the logger of the server and its clients (defaults to
+// NewLogger with the logging configuration)
+func WithLogger(logger *zerolog.Logger) Option {
+	return func(o *options) { o.logger = logger }
+}
+
+// WithTools registers custom tools next to the Atlassian tools. Tag them
+// "write" if they change anything, so read-only mode and the audit log apply.
+func WithTools(tools ...*Tool) Option {
+	return func(o *options) { o.tools = append(o.tools, tools...) }
+}
+
+// WithMiddleware wraps the handlers of all tools, including custom ones.
+// Middleware given first runs outermost.
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(o *options) { o.middleware = append(o.middleware, middleware...) }
+}
+
+// New creates the MCP server, initializes a client for each configured
+// product, and registers its tools
+func New(ctx context.Context, cfg *Config, opts ...Option) (*Server, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	logger := o.logger
+	if logger == nil {
+		defaultLogger := NewLogger(cfg.Logging)
+		logger = &defaultLogger
+	}
+
+	// Open the state store if persistence is enabled
+	var state *store.Store
+	var audit mcp.AuditFunc
+	if cfg.Server.DataDir != "" {
+		var err error
+		state, err = store.Open(cfg.Server.DataDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open data directory: %w", err)
+		}
+		ctx = store.WithStore(ctx, state)
+		audit = auditToStore(state, logger)
+
+		logger.Info().Str("dir", cfg.Server.DataDir).Msg("persisting state")
+	}
+
+	// Create MCP server
+	mcpServer := mcp.NewServer(&mcp.ServerConfig{
+		Logger:        logger,
+		ReadOnlyMode:  cfg.Security.ReadOnlyMode,
+		EnabledTools:  cfg.Security.EnabledTools,
+		ConfirmTools:  cfg.Security.ConfirmTools,
+		ElicitTimeout: time.Duration(cfg.Security.ConfirmTimeoutSeconds) * time.Second,
+		Audit:         audit,
+		Middleware:    append([]mcp.Middleware{mcp.LoggingMiddleware(logger)}, o.middleware...),
+		OutputLimits: mcp.OutputLimits{
+			MaxChars:      cfg.Output.MaxChars,
+			MaxFieldChars: cfg.Output.MaxFieldChars,
+		},
+	})
+
+	// Store batch tool concurrency in context
+	ctx = batch.WithConcurrency(ctx, cfg.Server.BatchConcurrency)
+
+	// Session defaults set with atlas_set_context
+	ctx = session.WithDefaults(ctx, session.NewDefaults())
+
+	// Timezone of times in summarized output
+	displayLocation, err := cfg.Output.DisplayLocation()
+	if err != nil {
+		return nil, err
+	}
+	ctx = display.WithLocation(ctx, displayLocation)
+
+	// Capture HTTP exchanges of all products if debug capture is enabled
+	httpCfg := &httpSettings{HTTPConfig: cfg.HTTP}
+	if cfg.Logging.DebugCaptureEnabled() {
+		capture, err := client.NewCapture(cfg.Logging.DebugCaptureSize, cfg.Logging.DebugCaptureDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to enable debug capture: %w", err)
+		}
+		httpCfg.capture = capture
+		ctx = atlastools.WithCapture(ctx, capture)
+
+		logger.Warn().
+			Str("dir", cfg.Logging.DebugCaptureDir).
+			Msg("debug capture enabled: HTTP requests and responses are recorded")
+	}
+
+	// Record API interactions to a cassette, or replay them offline
+	if cfg.HTTP.Cassette != "" {
+		cassette, err := client.NewCassette(cfg.HTTP.Cassette, client.CassetteMode(cfg.HTTP.CassetteMode))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open cassette: %w", err)
+		}
+		httpCfg.cassette = cassette
+
+		logger.Warn().
+			Str("file", cfg.HTTP.Cassette).
+			Str("mode", cfg.HTTP.CassetteMode).
+			Msg("cassette enabled: API interactions are recorded or replayed")
+	}
+
+	// The tools to register, once the clients they use are in the context
+	set := toolSet{
+		jira:        cfg.IsJiraConfigured(),
+		confluence:  cfg.IsConfluenceConfigured(),
+		opsgenie:    cfg.IsOpsgenieConfigured(),
+		rawRequests: cfg.Security.RawRequestTools,
+		readOnly:    cfg.Security.ReadOnlyMode,
+		diagnostics: httpCfg.capture != nil,
+		events:      cfg.Webhook.Enabled,
+	}
+
+	// Initialize Jira client if configured
+	if set.jira {
+		logger.Info().
+			Str("url", cfg.Jira.URL).
+			Str("auth_method", cfg.Jira.AuthMethod.String()).
+			Msg("initializing Jira client")
+
+		jiraClient, err := newJiraClient(cfg.Jira, httpCfg, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Jira client: %w", err)
+		}
+
+		// Store Jira client in context
+		ctx = jiratools.WithJiraClient(ctx, jiraClient)
+
+		// Create named Jira instances, if any
+		jiraInstances := make(map[string]*jira.Client, len(cfg.JiraInstances))
+		for name, instanceCfg := range cfg.JiraInstances {
+			logger.Info().
+				Str("instance", name).
+				Str("url", instanceCfg.URL).
+				Str("auth_method", instanceCfg.AuthMethod.String()).
+				Msg("initializing Jira instance")
+
+			instanceClient, err := newJiraClient(instanceCfg, httpCfg, logger)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Jira instance %q: %w", name, err)
+			}
+			jiraInstances[name] = instanceClient
+			set.jiraInstances = append(set.jiraInstances, name)
+		}
+		sort.Strings(set.jiraInstances)
+		ctx = jiratools.WithJiraInstances(ctx, jiraInstances)
+
+		// Store field profiles in context
+		ctx, err = jiratools.WithFieldProfiles(ctx, cfg.Output.FieldProfiles, cfg.Output.ToolFieldProfiles)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Jira field profiles: %w", err)
+		}
+	}
+
+	// Initialize Confluence client if configured
+	if set.confluence {
+		logger.Info().
+			Str("url", cfg.Confluence.URL).
+			Str("auth_method", cfg.Confluence.AuthMethod.String()).
+			Msg("initializing Confluence client")
+
+		confluenceClient, err := newConfluenceClient(cfg.Confluence, httpCfg, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Confluence client: %w", err)
+		}
+
+		// Store Confluence client in context
+		ctx = confluencetools.WithConfluenceClient(ctx, confluenceClient)
+
+		// Create named Confluence instances, if any
+		confluenceInstances := make(map[string]*confluence.Client, len(cfg.ConfluenceInstances))
+		for name, instanceCfg := range cfg.ConfluenceInstances {
+			logger.Info().
+				Str("instance", name).
+				Str("url", instanceCfg.URL).
+				Str("auth_method", instanceCfg.AuthMethod.String()).
+				Msg("initializing Confluence instance")
+
+			instanceClient, err := newConfluenceClient(instanceCfg, httpCfg, logger)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Confluence instance %q: %w", name, err)
+			}
+			confluenceInstances[name] = instanceClient
+			set.confluenceInstances = append(set.confluenceInstances, name)
+		}
+		sort.Strings(set.confluenceInstances)
+		ctx = confluencetools.WithConfluenceInstances(ctx, confluenceInstances)
+	}
+
+	// Initialize Opsgenie client if configured
+	if set.opsgenie {
+		logger.Info().
+			Str("url", cfg.Opsgenie.URL).
+			Msg("initializing Opsgenie client")
+
+		opsgenieClient, err := newOpsgenieClient(cfg.Opsgenie, httpCfg, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Opsgenie client: %w", err)
+		}
+
+		// Store Opsgenie client in context
+		ctx = opsgenietools.WithOpsgenieClient(ctx, opsgenieClient)
+	}
+
+	// Keep webhook events for agents if the receiver is enabled; runServer
+	// starts listening
+	if cfg.Webhook.Enabled {
+		receiver := webhook.NewReceiver(cfg.Webhook.BufferSize, cfg.Webhook.Secret, logger)
+		receiver.OnEvent(func(event webhook.Event) {
+			event.Payload = nil
+			if err := mcpServer.Log(mcp.LogInfo, "webhook", event); err != nil {
+				logger.Error().Err(err).Msg("failed to notify webhook event")
+			}
+		})
+		ctx = atlastools.WithEvents(ctx, receiver)
+	}
+
+	if err := registerTools(mcpServer, set, logger); err != nil {
+		return nil, err
+	}
+
+	if cfg.Security.RawRequestTools {
+		logger.Warn().
+			Bool("read_only", cfg.Security.ReadOnlyMode).
+			Msg("raw request tools enabled: agents can call any API path of the configured products")
+	}
+
+	// Register the tools that run local commands
+	if cfg.Server.CommandToolsFile != "" {
+		defs, err := command.Load(cfg.Server.CommandToolsFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := command.RegisterCommandTools(mcpServer, defs); err != nil {
+			return nil, err
+		}
+		logger.Info().Int("count", len(defs)).Str("file", cfg.Server.CommandToolsFile).Msg("registered command tools")
+	}
+
+	// Register the embedding program's tools
+	for _, tool := range o.tools {
+		if err := mcpServer.RegisterTool(tool); err != nil {
+			return nil, fmt.Errorf("failed to register tool: %w", err)
+		}
+	}
+
+	server := &Server{ctx: ctx, mcp: mcpServer, logger: logger}
+	if cfg.Webhook.Enabled {
+		server.webhookAddr = cfg.Webhook.Addr
+	}
+	return server, nil
+}
+
+// AllTools returns every built-in tool, whatever the configuration: the tools
+// of all products, the cross-product tools, and the raw request, diagnostic,
+// and event tools. Registering tools needs no clients, so nothing is
+// configured or contacted.
+func AllTools() ([]ToolInfo, error) {
+	logger := zerolog.Nop()
+	server := mcp.NewServer(&mcp.ServerConfig{Logger: &logger})
+	set := toolSet{
+		jira:        true,
+		confluence:  true,
+		opsgenie:    true,
+		rawRequests: true,
+		diagnostics: true,
+		events:      true,
+	}
+	if err := registerTools(server, set, &logger); err != nil {
+		return nil, err
+	}
+	return server.ListTools(), nil
+}
+
+// toolSet selects the built-in tools registerTools registers
+type toolSet struct {
+	jira, confluence, opsgenie bool
+	jiraInstances              []string // Names of the named Jira instances
+	confluenceInstances        []string // Names of the named Confluence instances
+	rawRequests                bool     // Raw request tools of the products
+	readOnly                   bool     // Raw request tools only allow GET
+	diagnostics                bool     // Debug capture tools
+	events                     bool     // Webhook event tools
+}
+
+// registerTools registers the built-in tools of a tool set: the tools of the
+// selected products, the cross-product tools those products allow, and the
+// optional tool groups
+func registerTools(server *mcp.Server, set toolSet, logger *zerolog.Logger) error {
+	var products []string
+
+	if set.jira {
+		if err := jiratools.RegisterJiraTools(server, set.jiraInstances...); err != nil {
+			return fmt.Errorf("failed to register Jira tools: %w", err)
+		}
+		if set.rawRequests {
+			if err := jiratools.RegisterJiraRawRequestTool(server, set.readOnly, set.jiraInstances...); err != nil {
+				return fmt.Errorf("failed to register Jira raw request tool: %w", err)
+			}
+		}
+		products = append(products, atlastools.ProductJira)
+		logger.Info().Msg("registered Jira tools")
+	} else {
+		logger.Info().Msg("Jira not configured, skipping Jira tools")
+	}
+
+	if set.confluence {
+		if err := confluencetools.RegisterConfluenceTools(server, set.confluenceInstances...); err != nil {
+			return fmt.Errorf("failed to register Confluence tools: %w", err)
+		}
+		if set.rawRequests {
+			if err := confluencetools.RegisterConfluenceRawRequestTool(server, set.readOnly, set.confluenceInstances...); err != nil {
+				return fmt.Errorf("failed to register Confluence raw request tool: %w", err)
+			}
+		}
+		products = append(products, atlastools.ProductConfluence)
+		logger.Info().Msg("registered Confluence tools")
+	} else {
+		logger.Info().Msg("Confluence not configured, skipping Confluence tools")
+	}
+
+	if set.opsgenie {
+		if err := opsgenietools.RegisterOpsgenieTools(server); err != nil {
+			return fmt.Errorf("failed to register Opsgenie tools: %w", err)
+		}
+		if set.rawRequests {
+			if err := opsgenietools.RegisterOpsgenieRawRequestTool(server, set.readOnly); err != nil {
+				return fmt.Errorf("failed to register Opsgenie raw request tool: %w", err)
+			}
+		}
+		products = append(products, atlastools.ProductOpsgenie)
+		logger.Info().Msg("registered Opsgenie tools")
+	} else {
+		logger.Info().Msg("Opsgenie not configured, skipping Opsgenie tools")
+	}
+
+	// Cross-product tools whose products are all selected
+	count, err := atlastools.RegisterAtlasTools(server, products...)
+	if err != nil {
+		return fmt.Errorf("failed to register cross-product tools: %w", err)
+	}
+	logger.Info().Int("count", count).Msg("registered cross-product tools")
+
+	if set.diagnostics {
+		if err := atlastools.RegisterDiagnosticTools(server); err != nil {
+			return fmt.Errorf("failed to register diagnostic tools: %w", err)
+		}
+	}
+	if set.events {
+		if err := atlastools.RegisterEventTools(server); err != nil {
+			return fmt.Errorf("failed to register event tools: %w", err)
+		}
+	}
+	return nil
+}
+
+// Context returns a context carrying the clients and state of the server.
+// Tool handlers called outside of the server need it.
+func (s *Server) Context() context.Context {
+	return s.ctx
+}
+
+// RegisterTool registers a custom tool after the server was created
+func (s *Server) RegisterTool(tool *Tool) error {
+	return s.mcp.RegisterTool(tool)
+}
+
+// Use adds middleware around the handlers of all tools
+func (s *Server) Use(middleware ...Middleware) {
+	s.mcp.Use(middleware...)
+}
+
+// ListTools returns the tools exposed to clients, honoring the enabled tools
+// list and read-only mode
+func (s *Server) ListTools() []ToolInfo {
+	return s.mcp.ListTools()
+}
+
+// CallTool runs a tool with the same checks as an MCP tools/call request
+func (s *Server) CallTool(ctx context.Context, name string, arguments map[string]interface{}) (*CallToolResult, error) {
+	return s.mcp.CallTool(s.withClients(ctx), name, arguments)
+}
+
+// HandleMessage handles one JSON-RPC message, for programs that bring their
+// own transport. It returns the response to send, if any.
+func (s *Server) HandleMessage(ctx context.Context, data []byte) ([]byte, error) {
+	return s.mcp.HandleMessage(s.withClients(ctx), data)
+}
+
+// ServeStdio serves MCP over stdin and stdout until ctx is canceled, and
+// receives webhooks in the background if the receiver is enabled
+func (s *Server) ServeStdio(ctx context.Context) error {
+	ctx = s.withClients(ctx)
+
+	if receiver := atlastools.GetEvents(ctx); receiver != nil {
+		s.logger.Info().Str("addr", s.webhookAddr).Msg("starting webhook receiver")
+		go func() {
+			if err := receiver.Serve(ctx, s.webhookAddr); err != nil {
+				s.logger.Error().Err(err).Msg("webhook receiver stopped")
+			}
+		}()
+	}
+
+	s.logger.Info().Msg("starting stdio transport")
+
+	transport := mcp.NewStdioTransport(s.mcp, s.logger)
+
+	if err := transport.Start(ctx); err != nil {
+		if err == context.Canceled {
+			s.logger.Info().Msg("stdio transport stopped gracefully")
+			return nil
+		}
+		return fmt.Errorf("stdio transport error: %w", err)
+	}
+
+	return nil
+}
+
+// withClients returns a context with the deadline and cancellation of ctx and
+// the values of the server context, such as the clients
+func (s *Server) withClients(ctx context.Context) context.Context {
+	if ctx == nil {
+		return s.ctx
+	}
+	return valuesContext{Context: ctx, values: s.ctx}
+}
+
+// valuesContext looks up values in its own context first, then in values
+type valuesContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c valuesContext) Value(key interface{}) interface{} {
+	if value := c.Context.Value(key); value != nil {
+		return value
+	}
+	return c.values.Value(key)
+}
+
+// auditToStore returns the audit function recording write tool calls in the
+// store's audit log
+func auditToStore(state *store.Store, logger *zerolog.Logger) mcp.AuditFunc {
+	return func(name string, arguments map[string]interface{}, result *mcp.CallToolResult, err error) {
+		entry := store.AuditEntry{
+			Time:      time.Now().UTC(),
+			Tool:      name,
+			Arguments: arguments,
+		}
+		switch {
+		case err != nil:
+			entry.Error = err.Error()
+		case result != nil && result.IsError && len(result.Content) > 0:
+			entry.Error = result.Content[0].Text
+		}
+
+		if err := state.Audit(entry); err != nil {
+			logger.Error().Err(err).Str("tool", name).Msg("failed to write audit log")
+		}
+	}
+}
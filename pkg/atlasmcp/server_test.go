@@ -0,0 +1,234 @@
+package atlasmcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/codeownersnet/atlas/internal/config"
+	"github.com/codeownersnet/atlas/internal/mcp"
+	jiratools "github.com/codeownersnet/atlas/internal/tools/jira"
+	"github.com/codeownersnet/atlas/pkg/atlassian/atlassiantest"
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+	"github.com/rs/zerolog"
+)
+
+// testConfig returns a configuration with only Jira configured
+func testConfig() *Config {
+	return &Config{
+		Jira: &config.JiraConfig{
+			URL:           "https://example.atlassian.net",
+			AuthMethod:    config.AuthMethodPAT,
+			PersonalToken: "token",
+			SSLVerify:     true,
+		},
+		Server:   &config.ServerConfig{Transport: "stdio", BatchConcurrency: 4},
+		Security: &config.SecurityConfig{},
+		Output:   &config.OutputConfig{},
+		Logging:  &config.LoggingConfig{},
+		HTTP:     &config.HTTPConfig{},
+		Webhook:  &config.WebhookConfig{},
+	}
+}
+
+func TestNew(t *testing.T) {
+	logger := zerolog.Nop()
+
+	// The custom tool reaches the Jira client through the server context
+	deployStatus := NewTool("deploy_status", "Report the deployment status",
+		NewInputSchema(map[string]Property{"service": NewStringProperty("Service name")}, "service"),
+		func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+			return NewJSONResult(map[string]interface{}{
+				"service":    args["service"],
+				"has_client": jiratools.GetJiraClient(ctx) != nil,
+			})
+		},
+		"deploy", "read",
+	)
+
+	var called []string
+	recordCalls := func(tool *Tool, next ToolHandler) ToolHandler {
+		return func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+			called = append(called, tool.Name)
+			return next(ctx, args)
+		}
+	}
+
+	srv, err := New(context.Background(), testConfig(),
+		WithLogger(&logger),
+		WithTools(deployStatus),
+		WithMiddleware(recordCalls),
+	)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	names := make(map[string]bool)
+	for _, tool := range srv.ListTools() {
+		names[tool.Name] = true
+	}
+	for _, name := range []string{"deploy_status", "jira_get_issue", "atlas_set_context"} {
+		if !names[name] {
+			t.Errorf("ListTools() is missing %s", name)
+		}
+	}
+	if names["confluence_search"] || names["opsgenie_list_alerts"] {
+		t.Error("ListTools() includes tools of products that are not configured")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result, err := srv.CallTool(ctx, "deploy_status", map[string]interface{}{"service": "api"})
+	if err != nil {
+		t.Fatalf("CallTool() error = %v", err)
+	}
+	if want := "{\n  \"has_client\": true,\n  \"service\": \"api\"\n}"; result.Content[0].Text != want {
+		t.Errorf("CallTool() = %s, want %s", result.Content[0].Text, want)
+	}
+	if len(called) != 1 || called[0] != "deploy_status" {
+		t.Errorf("middleware saw calls %v, want [deploy_status]", called)
+	}
+}
+
+func TestNewRejectsDuplicateTools(t *testing.T) {
+	logger := zerolog.Nop()
+	handler := func(ctx context.Context, args map[string]interface{}) (*CallToolResult, error) {
+		return NewSuccessResult("ok"), nil
+	}
+
+	_, err := New(context.Background(), testConfig(),
+		WithLogger(&logger),
+		WithTools(NewTool("jira_get_issue", "Shadows a Jira tool", NewInputSchema(nil), handler)),
+	)
+	if err == nil {
+		t.Error("New() should fail when a custom tool reuses the name of a built-in tool")
+	}
+}
+
+func TestNewRawRequestTools(t *testing.T) {
+	logger := zerolog.Nop()
+
+	hasRawTool := func(cfg *Config) bool {
+		srv, err := New(context.Background(), cfg, WithLogger(&logger))
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		for _, tool := range srv.ListTools() {
+			if tool.Name == "jira_raw_request" {
+				return true
+			}
+		}
+		return false
+	}
+
+	cfg := testConfig()
+	if hasRawTool(cfg) {
+		t.Error("jira_raw_request should be disabled by default")
+	}
+
+	cfg.Security.RawRequestTools = true
+	if !hasRawTool(cfg) {
+		t.Error("jira_raw_request should be registered when enabled")
+	}
+
+	// In read-only mode the tool stays available for GET requests
+	cfg.Security.ReadOnlyMode = true
+	if !hasRawTool(cfg) {
+		t.Error("jira_raw_request should be available in read-only mode")
+	}
+}
+
+func TestAllTools(t *testing.T) {
+	tools, err := AllTools()
+	if err != nil {
+		t.Fatalf("AllTools() error = %v", err)
+	}
+
+	names := make(map[string]bool, len(tools))
+	for _, tool := range tools {
+		names[tool.Name] = true
+	}
+	for _, name := range []string{
+		"jira_search", "confluence_get_page", "opsgenie_list_alerts",
+		"atlas_alert_to_issue", "atlas_set_context", "atlas_get_recent_activity",
+		"jira_raw_request", "atlas_get_recent_events", "atlas_debug_captures",
+	} {
+		if !names[name] {
+			t.Errorf("AllTools() is missing %s", name)
+		}
+	}
+}
+
+func TestToolsAgainstFakeServer(t *testing.T) {
+	fake := atlassiantest.NewServer()
+	defer fake.Close()
+	srv := newFakeServer(t, fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	calls := []struct {
+		tool string
+		args map[string]interface{}
+		want string
+	}{
+		{"jira_get_issue", map[string]interface{}{"issue_key": atlassiantest.IssueKey}, atlassiantest.IssueKey},
+		{"confluence_get_page", map[string]interface{}{"page_id": atlassiantest.PageID}, atlassiantest.SpaceKey},
+		{"opsgenie_get_alert", map[string]interface{}{"id": atlassiantest.AlertID}, atlassiantest.AlertID},
+	}
+	for _, call := range calls {
+		result, err := srv.CallTool(ctx, call.tool, call.args)
+		if err != nil {
+			t.Errorf("CallTool(%s) error = %v", call.tool, err)
+			continue
+		}
+		if result.IsError || !strings.Contains(result.Content[0].Text, call.want) {
+			t.Errorf("CallTool(%s) = %s, want a result mentioning %s", call.tool, result.Content[0].Text, call.want)
+		}
+	}
+
+	if requests := fake.Requests(); len(requests) < len(calls) {
+		t.Errorf("fake received %d requests, want at least %d", len(requests), len(calls))
+	}
+}
+
+func TestJiraSearchCloudPages(t *testing.T) {
+	fake := atlassiantest.NewServer()
+	defer fake.Close()
+	fake.Handle(http.MethodPost, "/rest/api/3/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		if body["nextPageToken"] == "page-2" {
+			w.Write([]byte(`{"issues": [{"key": "PROJ-3"}]}`))
+			return
+		}
+		w.Write([]byte(`{"issues": [{"key": "PROJ-1"}, {"key": "PROJ-2"}], "nextPageToken": "page-2"}`))
+	})
+
+	client, err := jira.NewClient(&jira.Config{BaseURL: "https://example.atlassian.net", HTTPClient: fake.Doer()})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := jiratools.WithJiraClient(context.Background(), client)
+	search := jiratools.JiraSearchTool().Handler
+
+	result, err := search(ctx, map[string]interface{}{"jql": "project = PROJ", "max_results": 2})
+	if err != nil || !strings.Contains(result.Content[0].Text, `"nextPageToken": "page-2"`) {
+		t.Fatalf("jira_search = %v, %v, want the next page token", result, err)
+	}
+
+	result, err = search(ctx, map[string]interface{}{"jql": "project = PROJ", "max_results": 2, "next_page_token": "page-2"})
+	if err != nil || !strings.Contains(result.Content[0].Text, "PROJ-3") || strings.Contains(result.Content[0].Text, "PROJ-1") {
+		t.Fatalf("jira_search page 2 = %v, %v", result, err)
+	}
+
+	// An offset would return the first page again, so it is rejected
+	var argsErr *mcp.ArgsError
+	if _, err := search(ctx, map[string]interface{}{"jql": "project = PROJ", "start_at": 2}); !errors.As(err, &argsErr) {
+		t.Errorf("jira_search with start_at on Cloud error = %v, want an argument error", err)
+	}
+}
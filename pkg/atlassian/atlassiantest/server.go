@@ -0,0 +1,179 @@
+// Package atlassiantest provides a fake Jira, Confluence, and Opsgenie API for
+// tests. The fake answers from recorded fixtures and from routes the test
+// adds, and records every request, so clients and tool handlers can be
+// tested without live credentials.
+//
+// Point a product client at the fake with its base URL and Doer:
+//
+//	srv := atlassiantest.NewServer()
+//	defer srv.Close()
+//	client, err := jira.NewClient(&jira.Config{BaseURL: srv.URL, HTTPClient: srv.Doer()})
+//
+// The fake's URL is not an Atlassian Cloud URL, so the clients use the
+// Server/Data Center APIs.
+package atlassiantest
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+)
+
+//go:embed fixtures
+var fixtures embed.FS
+
+// Fixture IDs: the issue, page, space, and alert the fixtures describe
+const (
+	IssueKey = "PROJ-1"
+	PageID   = "123"
+	SpaceKey = "DOCS"
+	AlertID  = "70413a06-38d6-4c85-92b8-5ebc900d42e2"
+)
+
+// defaultRoutes map requests to the fixtures answering them
+var defaultRoutes = []struct {
+	method, path, fixture string
+}{
+	// Jira Server/Data Center
+	{http.MethodGet, "/rest/api/2/myself", "jira/myself.json"},
+	{http.MethodGet, "/rest/api/2/issue/" + IssueKey, "jira/issue.json"},
+	{http.MethodPost, "/rest/api/2/search", "jira/search.json"},
+	{http.MethodGet, "/rest/api/2/search", "jira/search.json"},
+	{http.MethodGet, "/rest/api/2/project", "jira/projects.json"},
+
+	// Confluence Server/Data Center
+	{http.MethodGet, "/rest/api/user/current", "confluence/current_user.json"},
+	{http.MethodGet, "/rest/api/content/" + PageID, "confluence/page.json"},
+	{http.MethodGet, "/rest/api/space/" + SpaceKey, "confluence/space.json"},
+	{http.MethodGet, "/rest/api/content/search", "confluence/search.json"},
+
+	// Opsgenie
+	{http.MethodGet, "/v2/account", "opsgenie/account.json"},
+	{http.MethodGet, "/v2/alerts", "opsgenie/alerts.json"},
+	{http.MethodGet, "/v2/alerts/" + AlertID, "opsgenie/alert.json"},
+}
+
+// Request is a request the fake received
+type Request struct {
+	Method string
+	Path   string
+	Query  string // Raw query string
+	Body   []byte
+}
+
+// Server is a fake Atlassian API served over HTTP. Routes match the method
+// and path of a request; the query string is ignored. Requests without a
+// route get a 404 error response.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	routes   map[string]http.HandlerFunc
+	requests []Request
+}
+
+// NewServer starts a fake answering from the recorded fixtures. Close it
+// when done.
+func NewServer() *Server {
+	s := &Server{routes: make(map[string]http.HandlerFunc)}
+	for _, r := range defaultRoutes {
+		body, err := fixtures.ReadFile("fixtures/" + r.fixture)
+		if err != nil {
+			panic(fmt.Sprintf("atlassiantest: missing fixture %s: %v", r.fixture, err))
+		}
+		s.Handle(r.method, r.path, respond(http.StatusOK, body))
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Handle routes requests with the given method and path to handler,
+// replacing any fixture or earlier route
+func (s *Server) Handle(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[method+" "+path] = handler
+}
+
+// HandleJSON answers requests with the given method and path with a status
+// and a JSON body: a string or []byte is sent as-is, anything else is
+// marshaled
+func (s *Server) HandleJSON(method, path string, status int, body interface{}) {
+	var data []byte
+	switch b := body.(type) {
+	case string:
+		data = []byte(b)
+	case []byte:
+		data = b
+	default:
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			panic(fmt.Sprintf("atlassiantest: failed to marshal response of %s %s: %v", method, path, err))
+		}
+	}
+	s.Handle(method, path, respond(status, data))
+}
+
+// Requests returns the requests received so far, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Doer returns a client sending requests to the fake, for the HTTPClient of
+// a product client's configuration. It sends no credentials, so the product
+// configuration needs no authentication.
+func (s *Server) Doer() atlassian.ClientDoer {
+	return doer{s}
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery, Body: body})
+	handler := s.routes[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+
+	if handler == nil {
+		// Shaped like a Jira error, which the other products' clients also
+		// report by status
+		message := fmt.Sprintf("atlassiantest: no route for %s %s", r.Method, r.URL.Path)
+		respond(http.StatusNotFound, []byte(fmt.Sprintf(`{"errorMessages": [%q], "message": %q}`, message, message)))(w, r)
+		return
+	}
+	handler(w, r)
+}
+
+func respond(status int, body []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write(body)
+	}
+}
+
+// doer sends requests to the fake with the headers of the product clients
+type doer struct {
+	s *Server
+}
+
+func (d doer) Do(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, d.s.URL+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Accept", "application/json")
+	return d.s.Client().Do(req)
+}
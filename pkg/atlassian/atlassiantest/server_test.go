@@ -0,0 +1,90 @@
+package atlassiantest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian/atlassiantest"
+	"github.com/codeownersnet/atlas/pkg/atlassian/confluence"
+	"github.com/codeownersnet/atlas/pkg/atlassian/jira"
+	"github.com/codeownersnet/atlas/pkg/atlassian/opsgenie"
+)
+
+func TestServerFixtures(t *testing.T) {
+	srv := atlassiantest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	jiraClient, err := jira.NewClient(&jira.Config{BaseURL: srv.URL, HTTPClient: srv.Doer()})
+	if err != nil {
+		t.Fatalf("Failed to create Jira client: %v", err)
+	}
+	issue, err := jiraClient.GetIssue(ctx, atlassiantest.IssueKey, nil)
+	if err != nil || issue.Key != atlassiantest.IssueKey {
+		t.Errorf("GetIssue() = %+v, %v", issue, err)
+	}
+	result, err := jiraClient.SearchIssues(ctx, "project = PROJ", nil)
+	if err != nil || len(result.Issues) == 0 {
+		t.Errorf("SearchIssues() = %+v, %v", result, err)
+	}
+
+	confluenceClient, err := confluence.NewClient(&confluence.Config{BaseURL: srv.URL, HTTPClient: srv.Doer()})
+	if err != nil {
+		t.Fatalf("Failed to create Confluence client: %v", err)
+	}
+	page, err := confluenceClient.GetPage(ctx, atlassiantest.PageID, nil)
+	if err != nil || page.ID != atlassiantest.PageID {
+		t.Errorf("GetPage() = %+v, %v", page, err)
+	}
+	space, err := confluenceClient.GetSpace(ctx, atlassiantest.SpaceKey, nil)
+	if err != nil || space.Key != atlassiantest.SpaceKey {
+		t.Errorf("GetSpace() = %+v, %v", space, err)
+	}
+
+	opsgenieClient, err := opsgenie.NewClient(&opsgenie.Config{BaseURL: srv.URL, HTTPClient: srv.Doer()})
+	if err != nil {
+		t.Fatalf("Failed to create Opsgenie client: %v", err)
+	}
+	alert, err := opsgenieClient.GetAlert(ctx, atlassiantest.AlertID)
+	if err != nil || alert.ID != atlassiantest.AlertID {
+		t.Errorf("GetAlert() = %+v, %v", alert, err)
+	}
+}
+
+func TestServerRoutes(t *testing.T) {
+	srv := atlassiantest.NewServer()
+	defer srv.Close()
+	ctx := context.Background()
+
+	client, err := jira.NewClient(&jira.Config{BaseURL: srv.URL, HTTPClient: srv.Doer()})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	// Routes replace fixtures
+	srv.HandleJSON(http.MethodGet, "/rest/api/2/issue/"+atlassiantest.IssueKey, http.StatusOK, map[string]interface{}{
+		"id": "1", "key": atlassiantest.IssueKey, "fields": map[string]interface{}{"summary": "Replaced"},
+	})
+	issue, err := client.GetIssue(ctx, atlassiantest.IssueKey, nil)
+	if err != nil || issue.Fields.Summary != "Replaced" {
+		t.Errorf("GetIssue() = %+v, %v, want the replaced issue", issue, err)
+	}
+
+	srv.HandleJSON(http.MethodGet, "/rest/api/2/issue/PROJ-2", http.StatusForbidden, `{"errorMessages": ["No permission"]}`)
+	if _, err := client.GetIssue(ctx, "PROJ-2", nil); err == nil {
+		t.Error("GetIssue() should fail with the route's status")
+	}
+
+	if _, err := client.GetIssue(ctx, "PROJ-3", nil); err == nil {
+		t.Error("GetIssue() of an issue without a route should fail")
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 3 {
+		t.Fatalf("Requests() = %+v, want 3 requests", requests)
+	}
+	if requests[2].Method != http.MethodGet || requests[2].Path != "/rest/api/2/issue/PROJ-3" {
+		t.Errorf("requests[2] = %+v", requests[2])
+	}
+}
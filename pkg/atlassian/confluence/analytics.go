@@ -0,0 +1,49 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PageViews holds the view statistics of a page since a date
+type PageViews struct {
+	PageID  string `json:"pageId"`
+	Views   int    `json:"views"`   // Total number of views
+	Viewers int    `json:"viewers"` // Number of distinct users who viewed the page
+}
+
+// GetPageViews returns the number of views and distinct viewers of a page
+// from a day until now (all time if since is zero). Page analytics are only available on
+// Confluence Cloud.
+func (c *Client) GetPageViews(ctx context.Context, pageID string, since time.Time) (*PageViews, error) {
+	if !c.IsCloud() {
+		return nil, fmt.Errorf("page analytics are only available on Confluence Cloud")
+	}
+
+	params := make(map[string]string)
+	if !since.IsZero() {
+		params["fromDate"] = since.Format("2006-01-02")
+	}
+
+	views := &PageViews{PageID: pageID}
+	for _, metric := range []struct {
+		name  string
+		count *int
+	}{
+		{"views", &views.Views},
+		{"viewers", &views.Viewers},
+	} {
+		path := buildURL(fmt.Sprintf("%s/analytics/content/%s/%s", c.getAPIPath(), pageID, metric.name), params)
+
+		var response struct {
+			Count int `json:"count"`
+		}
+		if err := c.doRequest(ctx, "GET", path, nil, &response); err != nil {
+			return nil, fmt.Errorf("failed to get %s of page %s: %w", metric.name, pageID, err)
+		}
+		*metric.count = response.Count
+	}
+
+	return views, nil
+}
@@ -0,0 +1,93 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// blogPostDateLayout is the date format accepted for blog post date ranges
+const blogPostDateLayout = "2006-01-02"
+
+// ListBlogPostsOptions represents options for listing blog posts
+type ListBlogPostsOptions struct {
+	SpaceKey string // Restrict to a single space
+	From     string // Created on or after this date (YYYY-MM-DD)
+	To       string // Created on or before this date (YYYY-MM-DD)
+	Expand   []string
+	Start    int
+	Limit    int
+	Cursor   string
+}
+
+// CreateBlogPost creates a new blog post in a space
+func (c *Client) CreateBlogPost(ctx context.Context, spaceKey, title, body string) (*Content, error) {
+	req := &CreateContentRequest{
+		Type:  ContentTypeBlogPost,
+		Title: title,
+		Space: &SpaceRef{Key: spaceKey},
+		Body: &Body{
+			Storage: &BodyContent{
+				Value:          body,
+				Representation: FormatStorage,
+			},
+		},
+		Status: ContentStatusCurrent,
+	}
+
+	return c.CreateContent(ctx, req)
+}
+
+// ListBlogPosts lists blog posts, newest first, optionally filtered by space
+// and creation date range
+func (c *Client) ListBlogPosts(ctx context.Context, opts *ListBlogPostsOptions) (*SearchResult, error) {
+	if opts == nil {
+		opts = &ListBlogPostsOptions{}
+	}
+
+	cql, err := blogPostCQL(opts.SpaceKey, opts.From, opts.To)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.SearchCQL(ctx, cql, &SearchOptions{
+		Expand: opts.Expand,
+		Start:  opts.Start,
+		Limit:  opts.Limit,
+		Cursor: opts.Cursor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blog posts: %w", err)
+	}
+
+	return result, nil
+}
+
+// blogPostCQL builds the CQL query for listing blog posts. The upper date
+// bound is inclusive, so it is compared against the start of the next day.
+func blogPostCQL(spaceKey, from, to string) (string, error) {
+	clauses := []string{"type = blogpost"}
+
+	if spaceKey != "" {
+		clauses = append(clauses, "space = "+QuoteCQL(spaceKey))
+	}
+
+	if from != "" {
+		start, err := time.Parse(blogPostDateLayout, from)
+		if err != nil {
+			return "", fmt.Errorf("invalid from date %q: expected YYYY-MM-DD", from)
+		}
+		clauses = append(clauses, fmt.Sprintf("created >= \"%s\"", start.Format(blogPostDateLayout)))
+	}
+
+	if to != "" {
+		end, err := time.Parse(blogPostDateLayout, to)
+		if err != nil {
+			return "", fmt.Errorf("invalid to date %q: expected YYYY-MM-DD", to)
+		}
+		clauses = append(clauses, fmt.Sprintf("created < \"%s\"", end.AddDate(0, 0, 1).Format(blogPostDateLayout)))
+	}
+
+	return strings.Join(clauses, " AND ") + " ORDER BY created DESC", nil
+}
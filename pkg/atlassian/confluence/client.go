@@ -1,26 +1,31 @@
 package confluence
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/codeownersnet/atlas/internal/auth"
 	"github.com/codeownersnet/atlas/internal/client"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
 )
 
 const (
 	// API paths
-	apiPath = "/rest/api"
+	apiPath   = "/rest/api"
+	apiV2Path = "/api/v2" // Cloud only
 )
 
 // Client is a Confluence API client
 type Client struct {
-	httpClient     *client.Client
+	httpClient     atlassian.ClientDoer
 	baseURL        string
 	deploymentType DeploymentType
 }
@@ -35,6 +40,26 @@ type Config struct {
 	HTTPSProxy    string
 	SOCKSProxy    string
 	NoProxy       string
+
+	// TLS files (PEM): extra CA bundle, and client certificate and key for mTLS
+	CACertFile     string
+	ClientCertFile string
+	ClientKeyFile  string
+
+	MaxResponseSize int64 // Maximum response body size in bytes (0 = unlimited)
+
+	// Connection pooling (zero values use the HTTP client defaults)
+	MaxIdleConns        int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+	TLSHandshakeTimeout time.Duration
+
+	Capture  *client.Capture  // Records requests and responses for debugging (optional)
+	Cassette *client.Cassette // Records or replays API interactions (optional)
+
+	// HTTPClient sends the API requests instead of an HTTP client built from
+	// this configuration (optional, e.g. a fake in tests)
+	HTTPClient atlassian.ClientDoer
 }
 
 // NewClient creates a new Confluence client
@@ -43,26 +68,44 @@ func NewClient(cfg *Config) (*Client, error) {
 		return nil, fmt.Errorf("base URL is required")
 	}
 
-	if cfg.Auth == nil {
+	if cfg.Auth == nil && cfg.HTTPClient == nil {
 		return nil, fmt.Errorf("auth provider is required")
 	}
 
 	// Detect deployment type from URL
 	deploymentType := detectDeploymentType(cfg.BaseURL)
 
-	// Create HTTP client
-	httpClient, err := client.NewClient(&client.Config{
-		BaseURL:       cfg.BaseURL,
-		Auth:          cfg.Auth,
-		CustomHeaders: cfg.CustomHeaders,
-		SSLVerify:     cfg.SSLVerify,
-		HTTPProxy:     cfg.HTTPProxy,
-		HTTPSProxy:    cfg.HTTPSProxy,
-		SOCKSProxy:    cfg.SOCKSProxy,
-		NoProxy:       cfg.NoProxy,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+	// Create HTTP client, unless one is given
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		defaultClient, err := client.NewClient(&client.Config{
+			BaseURL:       cfg.BaseURL,
+			Auth:          cfg.Auth,
+			CustomHeaders: cfg.CustomHeaders,
+			SSLVerify:     cfg.SSLVerify,
+			HTTPProxy:     cfg.HTTPProxy,
+			HTTPSProxy:    cfg.HTTPSProxy,
+			SOCKSProxy:    cfg.SOCKSProxy,
+			NoProxy:       cfg.NoProxy,
+
+			CACertFile:     cfg.CACertFile,
+			ClientCertFile: cfg.ClientCertFile,
+			ClientKeyFile:  cfg.ClientKeyFile,
+
+			MaxResponseSize: cfg.MaxResponseSize,
+
+			MaxIdleConns:        cfg.MaxIdleConns,
+			MaxConnsPerHost:     cfg.MaxConnsPerHost,
+			IdleConnTimeout:     cfg.IdleConnTimeout,
+			TLSHandshakeTimeout: cfg.TLSHandshakeTimeout,
+
+			Capture:  cfg.Capture,
+			Cassette: cfg.Cassette,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+		}
+		httpClient = defaultClient
 	}
 
 	return &Client{
@@ -95,24 +138,32 @@ func (c *Client) GetDeploymentType() DeploymentType {
 	return c.deploymentType
 }
 
+// PageURL returns the web URL of a page. The URL from the content's links is
+// preferred; without it, the viewpage URL is built from the content ID.
+func (c *Client) PageURL(content *Content) string {
+	if content.Links != nil && content.Links.WebUI != "" {
+		base := content.Links.Base
+		if base == "" {
+			base = c.baseURL
+		}
+		return base + content.Links.WebUI
+	}
+	return fmt.Sprintf("%s/pages/viewpage.action?pageId=%s", c.baseURL, content.ID)
+}
+
 // doRequest performs an HTTP request and decodes the response
 func (c *Client) doRequest(ctx context.Context, method, path string, body []byte, result interface{}) error {
-	var resp *http.Response
-	var err error
-
 	switch method {
-	case http.MethodGet:
-		resp, err = c.httpClient.Get(ctx, path)
-	case http.MethodPost:
-		resp, err = c.httpClient.Post(ctx, path, body)
-	case http.MethodPut:
-		resp, err = c.httpClient.Put(ctx, path, body)
-	case http.MethodDelete:
-		resp, err = c.httpClient.Delete(ctx, path)
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete:
 	default:
 		return fmt.Errorf("unsupported HTTP method: %s", method)
 	}
 
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	resp, err := c.httpClient.Do(ctx, method, path, reader)
 	if err != nil {
 		return err
 	}
@@ -139,24 +190,31 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body []byte
 	return nil
 }
 
+// Raw sends a request to any API path of the site, for endpoints without a
+// dedicated method. The path is relative to the base URL; error statuses are
+// returned as errors.
+func (c *Client) Raw(ctx context.Context, method, path string, body []byte) (*atlassian.RawResponse, error) {
+	return atlassian.DoRaw(ctx, c.httpClient, method, path, body, c.parseError)
+}
+
 // parseError parses an error response from Confluence
 func (c *Client) parseError(statusCode int, body []byte) error {
 	var errResp ErrorResponse
 	if err := json.Unmarshal(body, &errResp); err != nil {
 		// If we can't parse the error, return the raw body
-		return fmt.Errorf("HTTP %d: %s", statusCode, string(body))
+		return atlassian.NewError(statusCode, string(body), nil)
 	}
 
 	// Build error message
 	if errResp.Message != "" {
-		return fmt.Errorf("HTTP %d: %s", statusCode, errResp.Message)
+		return atlassian.NewError(statusCode, errResp.Message, nil)
 	}
 
 	if errResp.Reason != "" {
-		return fmt.Errorf("HTTP %d: %s", statusCode, errResp.Reason)
+		return atlassian.NewError(statusCode, errResp.Reason, nil)
 	}
 
-	return fmt.Errorf("HTTP %d: %s", statusCode, string(body))
+	return atlassian.NewError(statusCode, string(body), nil)
 }
 
 // buildURL builds a full URL with query parameters
@@ -165,9 +223,16 @@ func buildURL(base string, params map[string]string) string {
 		return base
 	}
 
+	// Parameters are sorted by name, so the same parameters give the same URL
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	values := make([]string, 0, len(params))
-	for k, v := range params {
-		if v != "" {
+	for _, k := range keys {
+		if v := params[k]; v != "" {
 			values = append(values, fmt.Sprintf("%s=%s", k, url.QueryEscape(v)))
 		}
 	}
@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 // mockAuth is a mock authentication provider for testing
@@ -195,6 +198,213 @@ func TestSearchCQL(t *testing.T) {
 	}
 }
 
+func TestGetPageTree(t *testing.T) {
+	children := map[string][]Content{
+		"1": {{ID: "2", Title: "Child A"}, {ID: "3", Title: "Child B"}, {ID: "4", Title: "Child C"}},
+		"2": {{ID: "5", Title: "Grandchild"}},
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/rest/api/content/"), "/")
+		if len(parts) == 1 {
+			json.NewEncoder(w).Encode(Content{ID: parts[0], Title: "Root"})
+			return
+		}
+
+		results := children[parts[0]]
+		if limit, _ := strconv.Atoi(r.URL.Query().Get("limit")); limit > 0 && len(results) > limit {
+			results = results[:limit]
+		}
+		json.NewEncoder(w).Encode(ContentArray{Results: results, Size: len(results)})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &mockAuth{},
+		SSLVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	tree, err := client.GetPageTree(context.Background(), "1", 2, 2, 100)
+	if err != nil {
+		t.Fatalf("GetPageTree() error = %v", err)
+	}
+
+	if tree.Title != "Root" {
+		t.Errorf("Expected root title 'Root', got %s", tree.Title)
+	}
+	if !tree.HasMoreChildren {
+		t.Error("Expected root to have more children than the limit")
+	}
+	if len(tree.Children) != 2 {
+		t.Fatalf("Expected 2 children, got %d", len(tree.Children))
+	}
+	if len(tree.Children[0].Children) != 1 || tree.Children[0].Children[0].ID != "5" {
+		t.Errorf("Expected grandchild 5 under child 2, got %+v", tree.Children[0].Children)
+	}
+	if tree.Children[1].HasMoreChildren || len(tree.Children[1].Children) != 0 {
+		t.Errorf("Expected child 3 to have no children, got %+v", tree.Children[1])
+	}
+
+	// The page cap stops the tree, and so the requests, early
+	requests = 0
+	tree, err = client.GetPageTree(context.Background(), "1", 2, 2, 1)
+	if err != nil {
+		t.Fatalf("GetPageTree() error = %v", err)
+	}
+	if len(tree.Children) != 1 || !tree.HasMoreChildren || !tree.Children[0].Unexplored {
+		t.Errorf("Expected one unexplored child, got %+v", tree.Children)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests with a cap of one page, got %d", requests)
+	}
+}
+
+func TestGetPageComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("location") != "inline" {
+			t.Errorf("Expected location=inline, got %q", query.Get("location"))
+		}
+		if query.Get("depth") != "all" {
+			t.Errorf("Expected depth=all, got %q", query.Get("depth"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": [{"id": "10", "type": "comment", "ancestors": [{"id": "9", "type": "comment"}],
+			"extensions": {"location": "inline", "inlineProperties": {"originalSelection": "rollout plan"}}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &mockAuth{},
+		SSLVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	comments, err := client.GetPageComments(context.Background(), "1", &GetCommentsOptions{
+		Location:       CommentLocationInline,
+		IncludeReplies: true,
+	})
+	if err != nil {
+		t.Fatalf("GetPageComments() error = %v", err)
+	}
+
+	if len(comments) != 1 {
+		t.Fatalf("Expected 1 comment, got %d", len(comments))
+	}
+	if len(comments[0].Ancestors) != 1 || comments[0].Ancestors[0].ID != "9" {
+		t.Errorf("Expected parent comment 9, got %+v", comments[0].Ancestors)
+	}
+	if comments[0].Extensions == nil || comments[0].Extensions.InlineProperties.OriginalSelection != "rollout plan" {
+		t.Errorf("Expected inline selection 'rollout plan', got %+v", comments[0].Extensions)
+	}
+}
+
+func TestReplyToCommentServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/content" {
+			t.Errorf("Expected POST /rest/api/content, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req CreateCommentRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if len(req.Ancestors) != 1 || req.Ancestors[0].ID != "9" {
+			t.Errorf("Expected ancestor 9, got %+v", req.Ancestors)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Comment{ID: "11", Type: "comment"})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &mockAuth{},
+		SSLVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	comment, err := client.ReplyToComment(context.Background(), "1", "9", "<p>Agreed</p>")
+	if err != nil {
+		t.Fatalf("ReplyToComment() error = %v", err)
+	}
+	if comment.ID != "11" {
+		t.Errorf("Expected comment ID 11, got %s", comment.ID)
+	}
+
+	if _, err := client.AddInlineComment(context.Background(), &InlineCommentOptions{PageID: "1", Body: "x", Selection: "y"}); err == nil {
+		t.Error("AddInlineComment() should fail on Server/Data Center")
+	}
+}
+
+func TestAddInlineCommentCloud(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/rest/api/content/1":
+			json.NewEncoder(w).Encode(Content{ID: "1", Body: &Body{Storage: &BodyContent{
+				Value: "<p>Deploy on <strong>Monday</strong>.</p><p>Review on Monday.</p>",
+			}}})
+		case "/api/v2/inline-comments":
+			var req CreateCommentV2Request
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("Failed to decode request: %v", err)
+			}
+			props := req.InlineCommentProperties
+			if props == nil || props.TextSelection != "Monday" || props.TextSelectionMatchCount != 2 || props.TextSelectionMatchIndex != 1 {
+				t.Errorf("Unexpected inline properties %+v", props)
+			}
+			w.Write([]byte(`{"id": "12", "status": "current", "pageId": "1", "properties": {"inlineOriginalSelection": "Monday"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &mockAuth{},
+		SSLVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.deploymentType = DeploymentCloud
+
+	comment, err := client.AddInlineComment(context.Background(), &InlineCommentOptions{
+		PageID:     "1",
+		Body:       "<p>Which Monday?</p>",
+		Selection:  "Monday",
+		MatchIndex: 1,
+	})
+	if err != nil {
+		t.Fatalf("AddInlineComment() error = %v", err)
+	}
+	if comment.ID != "12" || comment.Extensions.Location != CommentLocationInline {
+		t.Errorf("Unexpected comment %+v", comment)
+	}
+
+	if _, err := client.AddInlineComment(context.Background(), &InlineCommentOptions{PageID: "1", Body: "x", Selection: "Friday"}); err == nil {
+		t.Error("AddInlineComment() should fail when the selection is not on the page")
+	}
+}
+
 func TestGetSpaces(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/rest/api/space" {
@@ -305,3 +515,555 @@ func TestIsCQL(t *testing.T) {
 		})
 	}
 }
+
+func TestWithSearchFilters(t *testing.T) {
+	tests := []struct {
+		name      string
+		cql       string
+		spaceKeys []string
+		types     []string
+		want      string
+	}{
+		{
+			name: "No filters",
+			cql:  `text ~ "deploy"`,
+			want: `text ~ "deploy"`,
+		},
+		{
+			name:      "Space and type",
+			cql:       `text ~ "deploy"`,
+			spaceKeys: []string{"OPS", "DEV"},
+			types:     []string{"page"},
+			want:      `(text ~ "deploy") AND space in ("OPS","DEV") AND type in ("page")`,
+		},
+		{
+			name:      "Order by kept last",
+			cql:       "label = runbook order by lastmodified desc",
+			spaceKeys: []string{"OPS"},
+			want:      `(label = runbook) AND space in ("OPS") order by lastmodified desc`,
+		},
+		{
+			name:  "Filters only",
+			types: []string{"blogpost"},
+			want:  `type in ("blogpost")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := withSearchFilters(tt.cql, tt.spaceKeys, tt.types); got != tt.want {
+				t.Errorf("withSearchFilters() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuoteCQL(t *testing.T) {
+	tests := map[string]string{
+		"OPS":        `"OPS"`,
+		`say "hi"`:   `"say \"hi\""`,
+		`C:\temp`:    `"C:\\temp"`,
+		`x" OR "a=a`: `"x\" OR \"a=a"`,
+	}
+	for value, want := range tests {
+		if got := QuoteCQL(value); got != want {
+			t.Errorf("QuoteCQL(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
+
+func TestSearchCursor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") != "abc" {
+			t.Errorf("Expected cursor=abc, got %q", r.URL.Query().Get("cursor"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": [], "_links": {"next": "/rest/api/content/search?cql=type%3Dpage&cursor=def&limit=25"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &mockAuth{},
+		SSLVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	result, err := client.SearchCQL(context.Background(), "type=page", &SearchOptions{Cursor: "abc"})
+	if err != nil {
+		t.Fatalf("SearchCQL() error = %v", err)
+	}
+	if result.NextCursor != "def" {
+		t.Errorf("Expected next cursor def, got %q", result.NextCursor)
+	}
+}
+
+func TestSetRestrictions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/rest/api/content/1/restriction" {
+			t.Errorf("Expected PUT /rest/api/content/1/restriction, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var updates []ContentRestrictionUpdate
+		if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if len(updates) != 1 || updates[0].Operation != RestrictionOperationRead {
+			t.Fatalf("Unexpected updates %+v", updates)
+		}
+		if users := updates[0].Restrictions.User; len(users) != 1 || users[0].Username != "jdoe" || users[0].AccountID != "" {
+			t.Errorf("Expected Server user reference by username, got %+v", users)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": [{"operation": "read", "restrictions": {
+			"user": {"results": [{"username": "jdoe", "displayName": "Jane Doe"}]},
+			"group": {"results": [{"name": "admins"}]}}}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &mockAuth{},
+		SSLVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	update := client.NewRestrictionUpdate(RestrictionOperationRead, []string{"jdoe"}, []string{"admins"})
+	restrictions, err := client.SetRestrictions(context.Background(), "1", []ContentRestrictionUpdate{update})
+	if err != nil {
+		t.Fatalf("SetRestrictions() error = %v", err)
+	}
+
+	if len(restrictions) != 1 || restrictions[0].Restrictions.Group.Results[0].Name != "admins" {
+		t.Errorf("Unexpected restrictions %+v", restrictions)
+	}
+}
+
+func TestSetContentProperty(t *testing.T) {
+	tests := []struct {
+		name        string
+		exists      bool
+		wantMethod  string
+		wantVersion int
+	}{
+		{"create new property", false, http.MethodPost, 0},
+		{"update existing property", true, http.MethodPut, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				if r.Method == http.MethodGet {
+					if !tt.exists {
+						w.WriteHeader(http.StatusNotFound)
+						w.Write([]byte(`{"statusCode": 404, "message": "not found"}`))
+						return
+					}
+					w.Write([]byte(`{"key": "owner", "value": "team-a", "version": {"number": 3}}`))
+					return
+				}
+
+				if r.Method != tt.wantMethod {
+					t.Errorf("Expected %s, got %s", tt.wantMethod, r.Method)
+				}
+
+				var req ContentPropertyRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Fatalf("Failed to decode request: %v", err)
+				}
+				gotVersion := 0
+				if req.Version != nil {
+					gotVersion = req.Version.Number
+				}
+				if gotVersion != tt.wantVersion {
+					t.Errorf("Expected version %d, got %d", tt.wantVersion, gotVersion)
+				}
+
+				w.Write([]byte(`{"key": "owner", "value": {"team": "b"}}`))
+			}))
+			defer server.Close()
+
+			client, err := NewClient(&Config{
+				BaseURL:   server.URL,
+				Auth:      &mockAuth{},
+				SSLVerify: true,
+			})
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			property, err := client.SetContentProperty(context.Background(), "1", "owner", map[string]string{"team": "b"})
+			if err != nil {
+				t.Fatalf("SetContentProperty() error = %v", err)
+			}
+			if string(property.Value) != `{"team": "b"}` {
+				t.Errorf("Unexpected value %s", property.Value)
+			}
+		})
+	}
+}
+
+func TestBlogPostCQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		spaceKey string
+		from     string
+		to       string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name: "No filters",
+			want: "type = blogpost ORDER BY created DESC",
+		},
+		{
+			name:     "Space and date range",
+			spaceKey: "ENG",
+			from:     "2024-03-01",
+			to:       "2024-03-31",
+			want:     `type = blogpost AND space = "ENG" AND created >= "2024-03-01" AND created < "2024-04-01" ORDER BY created DESC`,
+		},
+		{
+			name:     "Quoted space key",
+			spaceKey: `ENG" OR space = "HR`,
+			want:     `type = blogpost AND space = "ENG\" OR space = \"HR" ORDER BY created DESC`,
+		},
+		{
+			name:    "Invalid date",
+			from:    "March 1st",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := blogPostCQL(tt.spaceKey, tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("blogPostCQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("blogPostCQL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreatePageFromTemplate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/template/42":
+			w.Write([]byte(`{"templateId": "42", "name": "Retro", "body": {"storage": {"representation": "storage",
+				"value": "<at:declarations><at:string at:name=\"team\" /></at:declarations><h1><at:var at:name=\"team\" /> retro</h1><p>Owner: <at:var at:name=\"owner\"/></p>"}}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/content":
+			var req CreateContentRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("Failed to decode request: %v", err)
+			}
+			want := "<h1>Platform &amp; Infra retro</h1><p>Owner: </p>"
+			if req.Body.Storage.Value != want {
+				t.Errorf("Expected body %q, got %q", want, req.Body.Storage.Value)
+			}
+			if len(req.Ancestors) != 1 || req.Ancestors[0].ID != "7" {
+				t.Errorf("Expected parent 7, got %+v", req.Ancestors)
+			}
+			w.Write([]byte(`{"id": "100", "type": "page", "title": "Sprint 12 retro"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &mockAuth{},
+		SSLVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	page, missing, err := client.CreatePageFromTemplate(context.Background(), "42", "ENG", "Sprint 12 retro", "7",
+		map[string]string{"team": "Platform & Infra"})
+	if err != nil {
+		t.Fatalf("CreatePageFromTemplate() error = %v", err)
+	}
+	if page.ID != "100" {
+		t.Errorf("Expected page 100, got %s", page.ID)
+	}
+	if len(missing) != 1 || missing[0] != "owner" {
+		t.Errorf("Expected missing [owner], got %v", missing)
+	}
+}
+
+func TestSearchAll(t *testing.T) {
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		w.Header().Set("Content-Type", "application/json")
+		if cursor == "" {
+			w.Write([]byte(`{"results": [{"id": "1", "title": "One"}, {"id": "2", "title": "Two"}], "size": 2, "totalSize": 3, "_links": {"next": "/rest/api/content/search?cql=type%3Dpage&cursor=page2"}}`))
+			return
+		}
+		w.Write([]byte(`{"results": [{"id": "3", "title": "Three"}], "size": 1, "totalSize": 3, "_links": {}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &mockAuth{},
+		SSLVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	result, err := client.SearchAll(context.Background(), "type=page", nil, 1000)
+	if err != nil {
+		t.Fatalf("SearchAll() error = %v", err)
+	}
+	if len(result.Results) != 3 || result.Size != 3 || result.TotalSize != 3 {
+		t.Errorf("Expected 3 results of 3, got %d (size %d, total %d)", len(result.Results), result.Size, result.TotalSize)
+	}
+	if len(cursors) != 2 || cursors[1] != "page2" {
+		t.Errorf("Expected requests with cursors [\"\" page2], got %q", cursors)
+	}
+	if result.HasMore() {
+		t.Errorf("Expected HasMore() = false after the last page")
+	}
+}
+
+func TestResolveContentURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/content/search") {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		if cql := r.URL.Query().Get("cql"); cql != `type=page and space="OPS" and title="Deploy Runbook"` {
+			t.Errorf("unexpected CQL %q", cql)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SearchResult{Size: 1, Results: []Content{{ID: "123456", Type: ContentTypePage, Title: "Deploy Runbook"}}})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &mockAuth{},
+		SSLVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ref, content, err := client.ResolveContentURL(context.Background(), server.URL+"/display/OPS/Deploy+Runbook", nil)
+	if err != nil {
+		t.Fatalf("ResolveContentURL() error = %v", err)
+	}
+	if ref.Title != "Deploy Runbook" || content.ID != "123456" {
+		t.Errorf("ResolveContentURL() = %+v, %+v; want page 123456", ref, content)
+	}
+}
+
+func TestWatchPage(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.String())
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"watching": true}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	ctx := context.Background()
+
+	if err := client.WatchPage(ctx, "1", ""); err != nil {
+		t.Fatalf("WatchPage() error = %v", err)
+	}
+	if err := client.UnwatchPage(ctx, "1", "jdoe"); err != nil {
+		t.Fatalf("UnwatchPage() error = %v", err)
+	}
+	client.deploymentType = DeploymentCloud
+	watching, err := client.IsWatchingPage(ctx, "1", "abc123")
+	if err != nil || !watching {
+		t.Errorf("IsWatchingPage() = %v, %v", watching, err)
+	}
+
+	want := []string{
+		"POST /rest/api/user/watch/content/1",
+		"DELETE /rest/api/user/watch/content/1?username=jdoe",
+		"GET /rest/api/user/watch/content/1?accountId=abc123",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests =\n%s\nwant\n%s", strings.Join(requests, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestUpdatePageMinorEdit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateContentRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if req.Version == nil || req.Version.Number != 3 || !req.Version.MinorEdit {
+			t.Errorf("Expected a minor edit to version 3, got %+v", req.Version)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "1", "title": "Page", "version": {"number": 3, "minorEdit": true}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.UpdatePageWithOptions(context.Background(), "1", "Page", "<p>x</p>", 3, &UpdatePageOptions{MinorEdit: true}); err != nil {
+		t.Fatalf("UpdatePageWithOptions() error = %v", err)
+	}
+}
+
+func TestGetPageViews(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.String())
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/viewers") {
+			w.Write([]byte(`{"id": 1, "count": 3}`))
+			return
+		}
+		w.Write([]byte(`{"id": 1, "count": 42}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	ctx := context.Background()
+	since := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := client.GetPageViews(ctx, "1", since); err == nil {
+		t.Error("GetPageViews() should fail on Server")
+	}
+
+	client.deploymentType = DeploymentCloud
+	views, err := client.GetPageViews(ctx, "1", since)
+	if err != nil {
+		t.Fatalf("GetPageViews() error = %v", err)
+	}
+	if views.Views != 42 || views.Viewers != 3 {
+		t.Errorf("GetPageViews() = %+v", views)
+	}
+
+	want := []string{
+		"/rest/api/analytics/content/1/views?fromDate=2024-03-01",
+		"/rest/api/analytics/content/1/viewers?fromDate=2024-03-01",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests =\n%s\nwant\n%s", strings.Join(requests, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestGetSpacePermissions(t *testing.T) {
+	t.Run("server", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/rest/api/space/DOCS" || r.URL.Query().Get("expand") != "permissions" {
+				t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"key": "DOCS", "permissions": [
+				{"operation": {"operation": "read", "targetType": "space"}, "subjects": {"group": {"results": [{"name": "staff"}]}}},
+				{"operation": {"operation": "read", "targetType": "space"}, "subjects": {"user": {"results": [{"username": "jdoe", "displayName": "Jane Doe"}]}}},
+				{"operation": {"operation": "read", "targetType": "space"}, "subjects": {"user": {"results": [{"username": "jdoe", "displayName": "Jane Doe"}]}}},
+				{"operation": {"operation": "read", "targetType": "space"}, "anonymousAccess": true},
+				{"operation": {"operation": "administer", "targetType": "space"}, "subjects": {"user": {"results": [{"username": "admin"}]}}},
+				{"operation": {"operation": "create", "targetType": "page"}, "subjects": {"group": {"results": [{"name": "staff"}]}}}
+			]}`))
+		}))
+		defer server.Close()
+
+		client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+
+		perms, err := client.GetSpacePermissions(context.Background(), "DOCS")
+		if err != nil {
+			t.Fatalf("GetSpacePermissions() error = %v", err)
+		}
+		var keys []string
+		for _, p := range perms {
+			keys = append(keys, p.Key())
+		}
+		if strings.Join(keys, " ") != "create:page administer:space read:space" {
+			t.Fatalf("permissions = %v", keys)
+		}
+		read := perms[2]
+		if len(read.Users) != 1 || read.Users[0].Name != "Jane Doe" || len(read.Groups) != 1 || !read.Anonymous {
+			t.Errorf("read:space = %+v", read)
+		}
+	})
+
+	t.Run("cloud", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.URL.Path == "/rest/api/space/DOCS":
+				w.Write([]byte(`{"id": 98304, "key": "DOCS"}`))
+			case r.URL.Path == "/api/v2/spaces/98304/permissions" && r.URL.Query().Get("cursor") == "":
+				w.Write([]byte(`{"results": [
+					{"principal": {"type": "user", "id": "abc"}, "operation": {"key": "read", "targetType": "space"}},
+					{"principal": {"type": "group", "id": "g1"}, "operation": {"key": "read", "targetType": "space"}}
+				], "_links": {"next": "/wiki/api/v2/spaces/98304/permissions?cursor=next"}}`))
+			case r.URL.Path == "/api/v2/spaces/98304/permissions":
+				w.Write([]byte(`{"results": [
+					{"principal": {"type": "user", "id": "abc"}, "operation": {"key": "administer", "targetType": "space"}}
+				], "_links": {}}`))
+			case r.URL.Path == "/rest/api/user":
+				w.Write([]byte(`{"accountId": "abc", "displayName": "Jane Doe"}`))
+			case r.URL.Path == "/rest/api/group/by-id":
+				w.Write([]byte(`{"id": "g1", "name": "confluence-users"}`))
+			default:
+				t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		client.deploymentType = DeploymentCloud
+
+		perms, err := client.GetSpacePermissions(context.Background(), "DOCS")
+		if err != nil {
+			t.Fatalf("GetSpacePermissions() error = %v", err)
+		}
+		if len(perms) != 2 || perms[0].Key() != "administer:space" || perms[0].Users[0].Name != "Jane Doe" {
+			t.Fatalf("permissions = %+v", perms)
+		}
+		if read := perms[1]; len(read.Users) != 1 || len(read.Groups) != 1 || read.Groups[0].Name != "confluence-users" {
+			t.Errorf("read:space = %+v", read)
+		}
+	})
+}
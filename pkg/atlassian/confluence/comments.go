@@ -4,18 +4,40 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
+// GetCommentsOptions contains options for listing page comments
+type GetCommentsOptions struct {
+	Expand         []string        // Resources to expand (e.g., "body.storage", "extensions.inlineProperties")
+	Limit          int             // Maximum number of comments
+	Location       CommentLocation // Only return footer, inline, or resolved comments
+	IncludeReplies bool            // Return replies as well as top-level comments
+}
+
 // GetComments retrieves comments for a page
 func (c *Client) GetComments(ctx context.Context, pageID string, expand []string, limit int) ([]Comment, error) {
+	return c.GetPageComments(ctx, pageID, &GetCommentsOptions{Expand: expand, Limit: limit})
+}
+
+// GetPageComments retrieves comments for a page with location and reply filtering
+func (c *Client) GetPageComments(ctx context.Context, pageID string, opts *GetCommentsOptions) ([]Comment, error) {
 	path := fmt.Sprintf("%s/content/%s/child/comment", c.getAPIPath(), pageID)
 
 	params := make(map[string]string)
-	if len(expand) > 0 {
-		params["expand"] = expandFields(expand)
-	}
-	if limit > 0 {
-		params["limit"] = fmt.Sprintf("%d", limit)
+	if opts != nil {
+		if len(opts.Expand) > 0 {
+			params["expand"] = expandFields(opts.Expand)
+		}
+		if opts.Limit > 0 {
+			params["limit"] = fmt.Sprintf("%d", opts.Limit)
+		}
+		if opts.Location != "" {
+			params["location"] = string(opts.Location)
+		}
+		if opts.IncludeReplies {
+			params["depth"] = "all"
+		}
 	}
 
 	path = buildURL(path, params)
@@ -55,6 +77,86 @@ func (c *Client) GetComment(ctx context.Context, commentID string, expand []stri
 
 // AddComment adds a comment to a page
 func (c *Client) AddComment(ctx context.Context, pageID string, body string) (*Comment, error) {
+	return c.createComment(ctx, pageID, body, nil)
+}
+
+// ReplyToComment adds a reply to an existing footer or inline comment.
+// On Cloud the reply is created with the v2 API, which threads replies to both
+// footer and inline comments; Server/Data Center uses comment ancestors.
+func (c *Client) ReplyToComment(ctx context.Context, pageID, parentCommentID, body string) (*Comment, error) {
+	if !c.IsCloud() {
+		return c.createComment(ctx, pageID, body, []ContentRef{{ID: parentCommentID, Type: "comment"}})
+	}
+
+	parent, err := c.GetComment(ctx, parentCommentID, []string{"extensions.location"})
+	if err != nil {
+		return nil, err
+	}
+
+	location := CommentLocationFooter
+	if parent.Extensions != nil && parent.Extensions.Location == CommentLocationInline {
+		location = CommentLocationInline
+	}
+
+	return c.createCommentV2(ctx, location, &CreateCommentV2Request{
+		ParentCommentID: parentCommentID,
+		Body:            &BodyContent{Value: body, Representation: FormatStorage},
+	})
+}
+
+// InlineCommentOptions contains options for creating an inline comment
+type InlineCommentOptions struct {
+	PageID     string // Page to comment on
+	Body       string // Comment body in storage format
+	Selection  string // Page text the comment is anchored to
+	MatchIndex int    // Which occurrence of Selection to anchor to (0-based)
+}
+
+// AddInlineComment adds a comment anchored to a text selection on a page.
+// Inline comments can only be created through the Cloud v2 API.
+func (c *Client) AddInlineComment(ctx context.Context, opts *InlineCommentOptions) (*Comment, error) {
+	if !c.IsCloud() {
+		return nil, fmt.Errorf("inline comments can only be created on Confluence Cloud")
+	}
+	if opts.Selection == "" {
+		return nil, fmt.Errorf("text selection is required for inline comments")
+	}
+
+	// The API requires the number of occurrences of the selection on the page
+	page, err := c.GetPage(ctx, opts.PageID, []string{"body.storage"})
+	if err != nil {
+		return nil, err
+	}
+
+	matches := 0
+	if page.Body != nil && page.Body.Storage != nil {
+		text, err := storageText(page.Body.Storage.Value)
+		if err != nil {
+			return nil, err
+		}
+		matches = strings.Count(text, opts.Selection)
+	}
+	if matches == 0 {
+		return nil, fmt.Errorf("text %q was not found on page %s", opts.Selection, opts.PageID)
+	}
+	if opts.MatchIndex < 0 || opts.MatchIndex >= matches {
+		return nil, fmt.Errorf("match index %d is out of range: text %q occurs %d time(s) on page %s",
+			opts.MatchIndex, opts.Selection, matches, opts.PageID)
+	}
+
+	return c.createCommentV2(ctx, CommentLocationInline, &CreateCommentV2Request{
+		PageID: opts.PageID,
+		Body:   &BodyContent{Value: opts.Body, Representation: FormatStorage},
+		InlineCommentProperties: &InlineCommentProperties{
+			TextSelection:           opts.Selection,
+			TextSelectionMatchCount: matches,
+			TextSelectionMatchIndex: opts.MatchIndex,
+		},
+	})
+}
+
+// createComment creates a footer comment, optionally as a reply to ancestors
+func (c *Client) createComment(ctx context.Context, pageID string, body string, ancestors []ContentRef) (*Comment, error) {
 	path := fmt.Sprintf("%s/content", c.getAPIPath())
 
 	req := CreateCommentRequest{
@@ -69,6 +171,7 @@ func (c *Client) AddComment(ctx context.Context, pageID string, body string) (*C
 				Representation: FormatStorage,
 			},
 		},
+		Ancestors: ancestors,
 	}
 
 	reqBody, err := json.Marshal(req)
@@ -84,6 +187,49 @@ func (c *Client) AddComment(ctx context.Context, pageID string, body string) (*C
 	return &comment, nil
 }
 
+// createCommentV2 creates a footer or inline comment with the Cloud v2 API
+func (c *Client) createCommentV2(ctx context.Context, location CommentLocation, req *CreateCommentV2Request) (*Comment, error) {
+	path := fmt.Sprintf("%s/%s-comments", apiV2Path, location)
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal comment request: %w", err)
+	}
+
+	var created CommentV2
+	if err := c.doRequest(ctx, "POST", path, reqBody, &created); err != nil {
+		return nil, fmt.Errorf("failed to create %s comment: %w", location, err)
+	}
+
+	comment := &Comment{
+		ID:     created.ID,
+		Type:   string(ContentTypeComment),
+		Status: created.Status,
+		Title:  created.Title,
+		Links:  created.Links,
+		Extensions: &CommentExtensions{
+			Location: location,
+		},
+	}
+	if created.PageID != "" {
+		comment.Container = &Container{ID: created.PageID, Type: "page"}
+	}
+	if created.ParentCommentID != "" {
+		comment.Ancestors = []ContentRef{{ID: created.ParentCommentID, Type: "comment"}}
+	}
+	if created.Version != nil {
+		comment.Version = &Version{Number: created.Version.Number}
+	}
+	if created.Properties != nil {
+		comment.Extensions.InlineProperties = &CommentInlineProperties{
+			OriginalSelection: created.Properties.InlineOriginalSelection,
+			MarkerRef:         created.Properties.InlineMarkerRef,
+		}
+	}
+
+	return comment, nil
+}
+
 // UpdateComment updates an existing comment
 func (c *Client) UpdateComment(ctx context.Context, commentID string, body string, version int) (*Comment, error) {
 	path := fmt.Sprintf("%s/content/%s", c.getAPIPath(), commentID)
@@ -0,0 +1,84 @@
+package confluence
+
+import (
+	"regexp"
+	"strings"
+)
+
+// DefaultActionItemPattern matches action item lines such as "TODO: ...",
+// "Action: ...", or "AI - ...", capturing the item text
+var DefaultActionItemPattern = regexp.MustCompile(`(?i)^(?:[-*+]\s+|\d+[.)]\s+)?(?:\[[ x]\]\s+)?(?:TODO|ACTION(?: ITEM)?|AI)\s*[:\-]\s*(.+)$`)
+
+// taskLinePattern matches a task list item of the Markdown rendering
+var taskLinePattern = regexp.MustCompile(`^\s*- \[([ x])\] (.*)$`)
+
+// ExtractedItem is a task-like item found in a page
+type ExtractedItem struct {
+	Line    int    `json:"line"`   // 1-based line of the page's Markdown rendering
+	Source  string `json:"source"` // The line the item was found on
+	Summary string `json:"summary"`
+	Done    bool   `json:"done,omitempty"` // The task is checked off
+}
+
+// ExtractTasks returns the items of the task lists (action items) in a
+// storage document. Completed tasks are only included if includeCompleted is
+// set.
+func ExtractTasks(storage string, includeCompleted bool) ([]ExtractedItem, error) {
+	lines, err := markdownLines(storage)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []ExtractedItem
+	for i, line := range lines {
+		m := taskLinePattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		done := m[1] == "x"
+		summary := strings.TrimSpace(m[2])
+		if summary == "" || (done && !includeCompleted) {
+			continue
+		}
+		items = append(items, ExtractedItem{Line: i + 1, Source: strings.TrimSpace(line), Summary: summary, Done: done})
+	}
+	return items, nil
+}
+
+// ExtractPattern returns the lines of a storage document matching pattern.
+// The first capture group, if any, is the item summary; otherwise the whole
+// line is.
+func ExtractPattern(storage string, pattern *regexp.Regexp) ([]ExtractedItem, error) {
+	lines, err := markdownLines(storage)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []ExtractedItem
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		m := pattern.FindStringSubmatch(trimmed)
+		if m == nil {
+			continue
+		}
+		summary := trimmed
+		if len(m) > 1 {
+			summary = strings.TrimSpace(m[1])
+		}
+		if summary == "" {
+			continue
+		}
+		items = append(items, ExtractedItem{Line: i + 1, Source: trimmed, Summary: summary})
+	}
+	return items, nil
+}
+
+// markdownLines renders a storage document as Markdown and splits it into
+// lines
+func markdownLines(storage string) ([]string, error) {
+	markdown, err := StorageToMarkdown(storage)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(markdown, "\n"), nil
+}
@@ -0,0 +1,57 @@
+package confluence
+
+import (
+	"regexp"
+	"testing"
+)
+
+const meetingNotes = `<h2>Action items</h2>
+<ac:task-list>
+<ac:task><ac:task-id>1</ac:task-id><ac:task-status>incomplete</ac:task-status><ac:task-body>Fix the <strong>login</strong> timeout</ac:task-body></ac:task>
+<ac:task><ac:task-id>2</ac:task-id><ac:task-status>complete</ac:task-status><ac:task-body>Book the room</ac:task-body></ac:task>
+</ac:task-list>
+<p>TODO: update the runbook</p>
+<ul><li>Action: rotate the API keys</li><li>Not an action</li></ul>`
+
+func TestExtractTasks(t *testing.T) {
+	items, err := ExtractTasks(meetingNotes, false)
+	if err != nil {
+		t.Fatalf("ExtractTasks() error = %v", err)
+	}
+	if len(items) != 1 || items[0].Summary != "Fix the **login** timeout" || items[0].Done {
+		t.Fatalf("ExtractTasks() = %+v, want the open task", items)
+	}
+	if items[0].Line == 0 || items[0].Source != "- [ ] Fix the **login** timeout" {
+		t.Errorf("ExtractTasks() source = %d %q", items[0].Line, items[0].Source)
+	}
+
+	items, err = ExtractTasks(meetingNotes, true)
+	if err != nil {
+		t.Fatalf("ExtractTasks() error = %v", err)
+	}
+	if len(items) != 2 || !items[1].Done || items[1].Summary != "Book the room" {
+		t.Errorf("ExtractTasks(includeCompleted) = %+v, want both tasks", items)
+	}
+}
+
+func TestExtractPattern(t *testing.T) {
+	items, err := ExtractPattern(meetingNotes, DefaultActionItemPattern)
+	if err != nil {
+		t.Fatalf("ExtractPattern() error = %v", err)
+	}
+	if len(items) != 2 || items[0].Summary != "update the runbook" || items[1].Summary != "rotate the API keys" {
+		t.Fatalf("ExtractPattern() = %+v, want the TODO and Action lines", items)
+	}
+	if items[1].Source != "- Action: rotate the API keys" || items[1].Line <= items[0].Line {
+		t.Errorf("ExtractPattern() source = %+v", items[1])
+	}
+
+	// Without a capture group, the whole line is the summary
+	items, err = ExtractPattern(meetingNotes, regexp.MustCompile(`runbook`))
+	if err != nil {
+		t.Fatalf("ExtractPattern() error = %v", err)
+	}
+	if len(items) != 1 || items[0].Summary != "TODO: update the runbook" {
+		t.Errorf("ExtractPattern() = %+v", items)
+	}
+}
@@ -0,0 +1,46 @@
+package confluence
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// jiraMacroPattern matches Jira issue macros in a storage body
+	jiraMacroPattern = regexp.MustCompile(`(?s)<ac:structured-macro[^>]*ac:name="jira"[^>]*>(.*?)</ac:structured-macro>`)
+
+	// jiraMacroKeyPattern matches the key parameter of a Jira issue macro
+	jiraMacroKeyPattern = regexp.MustCompile(`(?s)<ac:parameter ac:name="key">(.*?)</ac:parameter>`)
+)
+
+// JiraIssueMacro returns the storage format of a Jira issue macro, which
+// Confluence renders as the issue's key, summary, and status
+func JiraIssueMacro(issueKey string) string {
+	return fmt.Sprintf(
+		`<ac:structured-macro ac:name="jira" ac:schema-version="1"><ac:parameter ac:name="key">%s</ac:parameter></ac:structured-macro>`,
+		html.EscapeString(issueKey),
+	)
+}
+
+// JiraFilterMacro returns the storage format of a Jira issues macro for a JQL
+// query, which Confluence renders as a live table of the matching issues
+func JiraFilterMacro(jql string) string {
+	return fmt.Sprintf(
+		`<ac:structured-macro ac:name="jira" ac:schema-version="1"><ac:parameter ac:name="jqlQuery">%s</ac:parameter><ac:parameter ac:name="maximumIssues">20</ac:parameter></ac:structured-macro>`,
+		html.EscapeString(jql),
+	)
+}
+
+// HasJiraIssueMacro reports whether a storage body contains a Jira issue
+// macro for the given issue key
+func HasJiraIssueMacro(storage, issueKey string) bool {
+	for _, macro := range jiraMacroPattern.FindAllStringSubmatch(storage, -1) {
+		key := jiraMacroKeyPattern.FindStringSubmatch(macro[1])
+		if key != nil && strings.EqualFold(strings.TrimSpace(html.UnescapeString(key[1])), issueKey) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package confluence
+
+import "testing"
+
+func TestHasJiraIssueMacro(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage string
+		key     string
+		want    bool
+	}{
+		{
+			name:    "generated macro",
+			storage: "<p>Intro</p><p>" + JiraIssueMacro("PROJ-1") + "</p>",
+			key:     "PROJ-1",
+			want:    true,
+		},
+		{
+			name:    "macro with server parameters",
+			storage: `<ac:structured-macro ac:name="jira" ac:macro-id="x"><ac:parameter ac:name="server">Jira</ac:parameter><ac:parameter ac:name="key">PROJ-1</ac:parameter></ac:structured-macro>`,
+			key:     "proj-1",
+			want:    true,
+		},
+		{
+			name:    "different key",
+			storage: JiraIssueMacro("PROJ-12"),
+			key:     "PROJ-1",
+			want:    false,
+		},
+		{
+			name:    "key outside a jira macro",
+			storage: `<ac:structured-macro ac:name="jira"><ac:parameter ac:name="jqlQuery">project = PROJ</ac:parameter></ac:structured-macro><ac:structured-macro ac:name="info"><ac:parameter ac:name="key">PROJ-1</ac:parameter></ac:structured-macro>`,
+			key:     "PROJ-1",
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasJiraIssueMacro(tt.storage, tt.key); got != tt.want {
+				t.Errorf("HasJiraIssueMacro() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
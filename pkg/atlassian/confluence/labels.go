@@ -79,7 +79,11 @@ func (c *Client) AddLabels(ctx context.Context, contentID string, labels []Creat
 
 // RemoveLabel removes a label from content
 func (c *Client) RemoveLabel(ctx context.Context, contentID string, labelName string) error {
-	path := fmt.Sprintf("%s/content/%s/label/%s", c.getAPIPath(), contentID, labelName)
+	// The name query parameter also works for labels containing characters
+	// that are not allowed in a path segment
+	path := buildURL(fmt.Sprintf("%s/content/%s/label", c.getAPIPath(), contentID), map[string]string{
+		"name": labelName,
+	})
 
 	if err := c.doRequest(ctx, "DELETE", path, nil, nil); err != nil {
 		return fmt.Errorf("failed to remove label %s from content %s: %w", labelName, contentID, err)
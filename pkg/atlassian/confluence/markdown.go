@@ -0,0 +1,610 @@
+package confluence
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	mdHeadingPattern    = regexp.MustCompile(`^ {0,3}(#{1,6})(?:\s+(.*?))?(?:\s+#+)?\s*$`)
+	mdRulePattern       = regexp.MustCompile(`^ {0,3}([-*_])(?:\s*[-*_]){2,}\s*$`)
+	mdFencePattern      = regexp.MustCompile("^(\\s*)(`{3,}|~{3,})\\s*([^`\\s]*)")
+	mdListItemPattern   = regexp.MustCompile(`^(\s*)([-*+]|\d{1,9}[.)])(\s+(.*))?$`)
+	mdTableSepPattern   = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	mdAdmonitionPattern = regexp.MustCompile(`^\[!(\w+)\]\s*(.*)$`)
+	mdAutolinkPattern   = regexp.MustCompile(`^<((?:https?|mailto):[^\s<>]+)>`)
+	mdJiraIssuePattern  = regexp.MustCompile(`^jira:([A-Z][A-Z0-9_]*-[0-9]+)\b`)
+	mdJiraTablePattern  = regexp.MustCompile(`^ {0,3}jira-table:\s*(\S.*?)\s*$`)
+)
+
+// admonitionMacros maps GitHub-style alert types ("> [!NOTE]") to Confluence panel macros
+var admonitionMacros = map[string]string{
+	"NOTE":      "info",
+	"INFO":      "info",
+	"TIP":       "tip",
+	"SUCCESS":   "tip",
+	"IMPORTANT": "note",
+	"WARNING":   "note",
+	"CAUTION":   "warning",
+	"DANGER":    "warning",
+	"ERROR":     "warning",
+}
+
+// codeLanguageAliases maps common fenced code block languages to the names
+// understood by the Confluence code macro
+var codeLanguageAliases = map[string]string{
+	"sh":     "bash",
+	"shell":  "bash",
+	"zsh":    "bash",
+	"js":     "javascript",
+	"ts":     "typescript",
+	"py":     "python",
+	"rb":     "ruby",
+	"yml":    "yaml",
+	"golang": "go",
+	"cs":     "csharp",
+	"c#":     "csharp",
+	"c++":    "cpp",
+	"html":   "xml",
+	"ps1":    "powershell",
+}
+
+// MarkdownToStorage converts Markdown to Confluence storage format (XHTML).
+// Supported syntax: headings, paragraphs, emphasis, strikethrough, inline code,
+// links, images, ordered/unordered lists, block quotes, horizontal rules,
+// pipe tables, fenced code blocks (rendered as the code macro) and GitHub-style
+// alerts such as "> [!WARNING]" (rendered as info/tip/note/warning panels).
+// Jira issues are shown live with "jira:PROJ-123" (the Jira issue macro), and
+// a line "jira-table: <JQL>" renders a table of the issues matching the query.
+func MarkdownToStorage(markdown string) string {
+	markdown = strings.ReplaceAll(markdown, "\r\n", "\n")
+	markdown = strings.ReplaceAll(markdown, "\t", "    ")
+	return renderMarkdownBlocks(strings.Split(markdown, "\n"), false)
+}
+
+// renderMarkdownBlocks converts block-level markdown. In tight mode (list items
+// without blank lines between them) paragraphs are not wrapped in <p>.
+func renderMarkdownBlocks(lines []string, tight bool) string {
+	var b strings.Builder
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			i++
+
+		case mdFencePattern.MatchString(line):
+			i = renderCodeBlock(&b, lines, i)
+
+		case mdHeadingPattern.MatchString(line):
+			m := mdHeadingPattern.FindStringSubmatch(line)
+			fmt.Fprintf(&b, "<h%d>%s</h%d>", len(m[1]), renderMarkdownInline(m[2]), len(m[1]))
+			i++
+
+		case mdRulePattern.MatchString(line):
+			b.WriteString("<hr />")
+			i++
+
+		case mdJiraTablePattern.MatchString(line):
+			b.WriteString(JiraFilterMacro(mdJiraTablePattern.FindStringSubmatch(line)[1]))
+			i++
+
+		case isBlockQuote(line):
+			i = renderBlockQuote(&b, lines, i)
+
+		case mdListItemPattern.MatchString(line):
+			i = renderList(&b, lines, i)
+
+		case isTableStart(lines, i):
+			i = renderTable(&b, lines, i)
+
+		default:
+			i = renderParagraph(&b, lines, i, tight)
+		}
+	}
+
+	return b.String()
+}
+
+// renderCodeBlock renders a fenced code block as a code macro
+func renderCodeBlock(b *strings.Builder, lines []string, start int) int {
+	m := mdFencePattern.FindStringSubmatch(lines[start])
+	indent, fence, lang := len(m[1]), m[2], strings.ToLower(m[3])
+
+	var code []string
+	i := start + 1
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			i++
+			break
+		}
+		code = append(code, trimLeadingSpaces(lines[i], indent))
+	}
+
+	if alias, ok := codeLanguageAliases[lang]; ok {
+		lang = alias
+	}
+
+	b.WriteString(`<ac:structured-macro ac:name="code">`)
+	if lang != "" {
+		fmt.Fprintf(b, `<ac:parameter ac:name="language">%s</ac:parameter>`, html.EscapeString(lang))
+	}
+	fmt.Fprintf(b, "<ac:plain-text-body><![CDATA[%s]]></ac:plain-text-body>", escapeCDATA(strings.Join(code, "\n")))
+	b.WriteString("</ac:structured-macro>")
+
+	return i
+}
+
+// renderBlockQuote renders a block quote, or a panel macro when the quote
+// starts with a GitHub-style alert marker
+func renderBlockQuote(b *strings.Builder, lines []string, start int) int {
+	var inner []string
+	i := start
+	for ; i < len(lines) && isBlockQuote(lines[i]); i++ {
+		line := strings.TrimLeft(lines[i], " ")[1:]
+		inner = append(inner, strings.TrimPrefix(line, " "))
+	}
+
+	if m := mdAdmonitionPattern.FindStringSubmatch(strings.TrimSpace(inner[0])); m != nil {
+		if macro, ok := admonitionMacros[strings.ToUpper(m[1])]; ok {
+			fmt.Fprintf(b, `<ac:structured-macro ac:name="%s">`, macro)
+			if title := strings.TrimSpace(m[2]); title != "" {
+				fmt.Fprintf(b, `<ac:parameter ac:name="title">%s</ac:parameter>`, html.EscapeString(title))
+			}
+			fmt.Fprintf(b, "<ac:rich-text-body>%s</ac:rich-text-body>", renderMarkdownBlocks(inner[1:], false))
+			b.WriteString("</ac:structured-macro>")
+			return i
+		}
+	}
+
+	fmt.Fprintf(b, "<blockquote>%s</blockquote>", renderMarkdownBlocks(inner, false))
+	return i
+}
+
+// renderList renders an ordered or unordered list, including nested lists
+func renderList(b *strings.Builder, lines []string, start int) int {
+	first := mdListItemPattern.FindStringSubmatch(lines[start])
+	baseIndent := len(first[1])
+	ordered := isOrderedMarker(first[2])
+
+	var items [][]string
+	loose := false
+	i := start
+
+	for i < len(lines) {
+		m := mdListItemPattern.FindStringSubmatch(lines[i])
+		if m == nil || len(m[1]) != baseIndent || isOrderedMarker(m[2]) != ordered {
+			break
+		}
+
+		contentIndent := len(m[1]) + len(m[2]) + 1
+		item := []string{m[4]}
+		i++
+
+		for i < len(lines) {
+			line := lines[i]
+			if strings.TrimSpace(line) == "" {
+				// A blank line continues the item only if indented content follows
+				next := i + 1
+				for next < len(lines) && strings.TrimSpace(lines[next]) == "" {
+					next++
+				}
+				if next < len(lines) && leadingSpaces(lines[next]) > baseIndent {
+					item = append(item, "")
+					loose = true
+					i++
+					continue
+				}
+				if next < len(lines) && isSiblingItem(lines[next], baseIndent, ordered) {
+					loose = true
+				}
+				i = next
+				break
+			}
+
+			indent := leadingSpaces(line)
+			if indent <= baseIndent {
+				break
+			}
+			item = append(item, trimLeadingSpaces(line, contentIndent))
+			i++
+		}
+
+		items = append(items, item)
+
+		if i >= len(lines) || !isSiblingItem(lines[i], baseIndent, ordered) {
+			break
+		}
+	}
+
+	tag := "ul"
+	if ordered {
+		tag = "ol"
+	}
+
+	b.WriteString("<" + tag)
+	if ordered {
+		if n, err := strconv.Atoi(strings.TrimRight(first[2], ".)")); err == nil && n != 1 {
+			fmt.Fprintf(b, ` start="%d"`, n)
+		}
+	}
+	b.WriteString(">")
+	for _, item := range items {
+		fmt.Fprintf(b, "<li>%s</li>", renderMarkdownBlocks(item, !loose))
+	}
+	b.WriteString("</" + tag + ">")
+
+	return i
+}
+
+// renderTable renders a pipe table with a header row
+func renderTable(b *strings.Builder, lines []string, start int) int {
+	header := splitTableRow(lines[start])
+	aligns := tableAlignments(splitTableRow(lines[start+1]))
+
+	b.WriteString("<table><tbody><tr>")
+	for col, cell := range header {
+		fmt.Fprintf(b, "<th%s>%s</th>", alignStyle(aligns, col), renderMarkdownInline(cell))
+	}
+	b.WriteString("</tr>")
+
+	i := start + 2
+	for ; i < len(lines) && strings.Contains(lines[i], "|") && strings.TrimSpace(lines[i]) != ""; i++ {
+		cells := splitTableRow(lines[i])
+		b.WriteString("<tr>")
+		for col := range header {
+			cell := ""
+			if col < len(cells) {
+				cell = cells[col]
+			}
+			fmt.Fprintf(b, "<td%s>%s</td>", alignStyle(aligns, col), renderMarkdownInline(cell))
+		}
+		b.WriteString("</tr>")
+	}
+
+	b.WriteString("</tbody></table>")
+	return i
+}
+
+// renderParagraph renders consecutive text lines as a paragraph
+func renderParagraph(b *strings.Builder, lines []string, start int, tight bool) int {
+	var text strings.Builder
+	i := start
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if i > start && (strings.TrimSpace(line) == "" || interruptsParagraph(lines, i)) {
+			break
+		}
+
+		hardBreak := strings.HasSuffix(line, "  ") || strings.HasSuffix(line, "\\")
+		line = strings.TrimSpace(line)
+		if strings.HasSuffix(line, "\\") {
+			line = strings.TrimSuffix(line, "\\")
+		}
+
+		if i > start {
+			text.WriteString("\n")
+		}
+		text.WriteString(line)
+		if hardBreak && i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != "" {
+			text.WriteString("\x00")
+		}
+	}
+
+	content := renderMarkdownInline(text.String())
+	content = strings.ReplaceAll(content, "\x00\n", "<br />")
+	content = strings.ReplaceAll(content, "\x00", "")
+	content = strings.ReplaceAll(content, "\n", " ")
+
+	if tight {
+		b.WriteString(content)
+	} else {
+		fmt.Fprintf(b, "<p>%s</p>", content)
+	}
+	return i
+}
+
+// renderMarkdownInline converts inline markdown (emphasis, code, links, images)
+// and escapes everything else
+func renderMarkdownInline(text string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(text); {
+		c := text[i]
+
+		switch {
+		case c == '\\' && i+1 < len(text) && strings.ContainsRune("\\`*_{}[]()#+-.!|~<>", rune(text[i+1])):
+			b.WriteString(html.EscapeString(text[i+1 : i+2]))
+			i += 2
+			continue
+
+		case c == '`':
+			if code, n, ok := parseCodeSpan(text[i:]); ok {
+				fmt.Fprintf(&b, "<code>%s</code>", html.EscapeString(code))
+				i += n
+				continue
+			}
+
+		case c == '!' && strings.HasPrefix(text[i+1:], "["):
+			if label, target, n, ok := parseLink(text[i+1:]); ok {
+				fmt.Fprintf(&b, `<ac:image ac:alt="%s"><ri:url ri:value="%s" /></ac:image>`,
+					html.EscapeString(label), html.EscapeString(target))
+				i += n + 1
+				continue
+			}
+
+		case c == '[':
+			if label, target, n, ok := parseLink(text[i:]); ok {
+				fmt.Fprintf(&b, `<a href="%s">%s</a>`, html.EscapeString(target), renderMarkdownInline(label))
+				i += n
+				continue
+			}
+
+		case c == '<':
+			if m := mdAutolinkPattern.FindStringSubmatch(text[i:]); m != nil {
+				fmt.Fprintf(&b, `<a href="%s">%s</a>`, html.EscapeString(m[1]), html.EscapeString(m[1]))
+				i += len(m[0])
+				continue
+			}
+
+		case c == 'j' && (i == 0 || !isWordChar(text[i-1])):
+			if m := mdJiraIssuePattern.FindStringSubmatch(text[i:]); m != nil {
+				b.WriteString(JiraIssueMacro(m[1]))
+				i += len(m[0])
+				continue
+			}
+
+		case c == '*' || c == '_' || c == '~':
+			if out, n, ok := parseEmphasis(text, i); ok {
+				b.WriteString(out)
+				i += n
+				continue
+			}
+		}
+
+		b.WriteString(html.EscapeString(text[i : i+1]))
+		i++
+	}
+
+	return b.String()
+}
+
+// parseCodeSpan parses a backtick code span at the start of text
+func parseCodeSpan(text string) (string, int, bool) {
+	ticks := len(text) - len(strings.TrimLeft(text, "`"))
+	fence := text[:ticks]
+
+	end := strings.Index(text[ticks:], fence)
+	if end < 0 {
+		return "", 0, false
+	}
+
+	code := text[ticks : ticks+end]
+	if len(code) > 1 && strings.HasPrefix(code, " ") && strings.HasSuffix(code, " ") {
+		code = code[1 : len(code)-1]
+	}
+	return code, ticks + end + ticks, true
+}
+
+// parseLink parses "[label](target)" at the start of text
+func parseLink(text string) (string, string, int, bool) {
+	depth := 0
+	closeLabel := -1
+	for i := 0; i < len(text) && closeLabel < 0; i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				closeLabel = i
+			}
+		}
+	}
+	if closeLabel < 0 || closeLabel+1 >= len(text) || text[closeLabel+1] != '(' {
+		return "", "", 0, false
+	}
+
+	depth = 0
+	for i := closeLabel + 1; i < len(text); i++ {
+		switch text[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				target := strings.TrimSpace(text[closeLabel+2 : i])
+				// Drop an optional title: [label](url "title")
+				if sp := strings.IndexAny(target, " \t"); sp >= 0 {
+					target = target[:sp]
+				}
+				target = strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+				return text[1:closeLabel], target, i + 1, true
+			}
+		}
+	}
+
+	return "", "", 0, false
+}
+
+// parseEmphasis parses bold, italic, or strikethrough starting at text[i]
+func parseEmphasis(text string, i int) (string, int, bool) {
+	c := text[i]
+
+	// Underscores inside words (snake_case) are not emphasis
+	if c == '_' && i > 0 && isWordChar(text[i-1]) {
+		return "", 0, false
+	}
+
+	markers := []struct {
+		delim string
+		tag   string
+	}{
+		{"**", "strong"},
+		{"__", "strong"},
+		{"~~", "del"},
+		{"*", "em"},
+		{"_", "em"},
+	}
+
+	for _, m := range markers {
+		if !strings.HasPrefix(text[i:], m.delim) {
+			continue
+		}
+
+		rest := text[i+len(m.delim):]
+		if rest == "" || rest[0] == ' ' || strings.HasPrefix(rest, m.delim[:1]) && len(m.delim) == 1 {
+			continue
+		}
+
+		end := findClosingDelimiter(rest, m.delim)
+		if end <= 0 {
+			continue
+		}
+
+		inner := rest[:end]
+		return fmt.Sprintf("<%s>%s</%s>", m.tag, renderMarkdownInline(inner), m.tag), len(m.delim)*2 + end, true
+	}
+
+	return "", 0, false
+}
+
+// findClosingDelimiter finds the closing emphasis delimiter, skipping code spans
+func findClosingDelimiter(text, delim string) int {
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '\\':
+			i++
+		case text[i] == '`':
+			if _, n, ok := parseCodeSpan(text[i:]); ok {
+				i += n - 1
+			}
+		case strings.HasPrefix(text[i:], delim):
+			if i == 0 || text[i-1] == ' ' {
+				continue
+			}
+			after := i + len(delim)
+			// A single delimiter must not be part of a double one (e.g. "*a **b** c*")
+			if len(delim) == 1 && after < len(text) && text[after] == delim[0] {
+				i++
+				continue
+			}
+			if delim[0] == '_' && after < len(text) && isWordChar(text[after]) {
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// interruptsParagraph reports whether the line at index i starts a new block
+func interruptsParagraph(lines []string, i int) bool {
+	line := lines[i]
+	return mdFencePattern.MatchString(line) ||
+		mdHeadingPattern.MatchString(line) ||
+		mdRulePattern.MatchString(line) ||
+		mdJiraTablePattern.MatchString(line) ||
+		isBlockQuote(line) ||
+		mdListItemPattern.MatchString(line) ||
+		isTableStart(lines, i)
+}
+
+// isBlockQuote reports whether the line is part of a block quote
+func isBlockQuote(line string) bool {
+	return strings.HasPrefix(strings.TrimLeft(line, " "), ">")
+}
+
+// isTableStart reports whether a pipe table starts at index i
+func isTableStart(lines []string, i int) bool {
+	return i+1 < len(lines) &&
+		strings.Contains(lines[i], "|") &&
+		strings.Contains(lines[i+1], "|") &&
+		mdTableSepPattern.MatchString(lines[i+1])
+}
+
+// isSiblingItem reports whether the line is a list item of the same list
+func isSiblingItem(line string, indent int, ordered bool) bool {
+	m := mdListItemPattern.FindStringSubmatch(line)
+	return m != nil && len(m[1]) == indent && isOrderedMarker(m[2]) == ordered
+}
+
+func isOrderedMarker(marker string) bool {
+	return marker[0] >= '0' && marker[0] <= '9'
+}
+
+// splitTableRow splits a table row into trimmed cells, honoring escaped pipes
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, "\\|") {
+		line = line[:len(line)-1]
+	}
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// tableAlignments reads column alignments from the separator row
+func tableAlignments(cells []string) []string {
+	aligns := make([]string, len(cells))
+	for i, cell := range cells {
+		left := strings.HasPrefix(cell, ":")
+		right := strings.HasSuffix(cell, ":")
+		switch {
+		case left && right:
+			aligns[i] = "center"
+		case right:
+			aligns[i] = "right"
+		}
+	}
+	return aligns
+}
+
+func alignStyle(aligns []string, col int) string {
+	if col >= len(aligns) || aligns[col] == "" {
+		return ""
+	}
+	return fmt.Sprintf(` style="text-align: %s;"`, aligns[col])
+}
+
+// escapeCDATA splits CDATA terminators so the content cannot close the section
+func escapeCDATA(text string) string {
+	return strings.ReplaceAll(text, "]]>", "]]]]><![CDATA[>")
+}
+
+func leadingSpaces(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// trimLeadingSpaces removes up to n leading spaces
+func trimLeadingSpaces(line string, n int) string {
+	if spaces := leadingSpaces(line); spaces < n {
+		n = spaces
+	}
+	return line[n:]
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,179 @@
+package confluence
+
+import "testing"
+
+func TestMarkdownToStorage(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "Headings and paragraphs",
+			markdown: "# Title\n\nFirst line\nsecond line\n\n### Sub ###",
+			want:     "<h1>Title</h1><p>First line second line</p><h3>Sub</h3>",
+		},
+		{
+			name:     "Inline formatting",
+			markdown: "**bold** *em* _em_ ~~del~~ `a<b>` snake_case_name",
+			want:     "<p><strong>bold</strong> <em>em</em> <em>em</em> <del>del</del> <code>a&lt;b&gt;</code> snake_case_name</p>",
+		},
+		{
+			name:     "Escaping",
+			markdown: `Tom & Jerry <tag> \*not em\*`,
+			want:     "<p>Tom &amp; Jerry &lt;tag&gt; *not em*</p>",
+		},
+		{
+			name:     "Links and images",
+			markdown: `[docs](https://example.com/a?b=1&c=2 "Docs") ![logo](https://example.com/logo.png) <https://example.com>`,
+			want:     `<p><a href="https://example.com/a?b=1&amp;c=2">docs</a> <ac:image ac:alt="logo"><ri:url ri:value="https://example.com/logo.png" /></ac:image> <a href="https://example.com">https://example.com</a></p>`,
+		},
+		{
+			name:     "Hard line break",
+			markdown: "line one  \nline two",
+			want:     "<p>line one<br />line two</p>",
+		},
+		{
+			name:     "Nested unordered list",
+			markdown: "- a\n- b\n  - c\n- d",
+			want:     "<ul><li>a</li><li>b<ul><li>c</li></ul></li><li>d</li></ul>",
+		},
+		{
+			name:     "Ordered list with start",
+			markdown: "3. three\n4. four",
+			want:     `<ol start="3"><li>three</li><li>four</li></ol>`,
+		},
+		{
+			name:     "Loose list",
+			markdown: "- a\n\n- b",
+			want:     "<ul><li><p>a</p></li><li><p>b</p></li></ul>",
+		},
+		{
+			name:     "Table with alignment",
+			markdown: "| Name | Count |\n|------|------:|\n| a \\| b | 1 |\n| c |",
+			want:     `<table><tbody><tr><th>Name</th><th style="text-align: right;">Count</th></tr><tr><td>a | b</td><td style="text-align: right;">1</td></tr><tr><td>c</td><td style="text-align: right;"></td></tr></tbody></table>`,
+		},
+		{
+			name:     "Code block",
+			markdown: "```sh\necho \"<hi>\"\n```",
+			want:     `<ac:structured-macro ac:name="code"><ac:parameter ac:name="language">bash</ac:parameter><ac:plain-text-body><![CDATA[echo "<hi>"]]></ac:plain-text-body></ac:structured-macro>`,
+		},
+		{
+			name:     "Code block containing CDATA terminator",
+			markdown: "```\na]]>b\n```",
+			want:     `<ac:structured-macro ac:name="code"><ac:plain-text-body><![CDATA[a]]]]><![CDATA[>b]]></ac:plain-text-body></ac:structured-macro>`,
+		},
+		{
+			name:     "Info panel",
+			markdown: "> [!NOTE]\n> Read **this**",
+			want:     `<ac:structured-macro ac:name="info"><ac:rich-text-body><p>Read <strong>this</strong></p></ac:rich-text-body></ac:structured-macro>`,
+		},
+		{
+			name:     "Warning panel with title",
+			markdown: "> [!CAUTION] Danger zone\n> Irreversible",
+			want:     `<ac:structured-macro ac:name="warning"><ac:parameter ac:name="title">Danger zone</ac:parameter><ac:rich-text-body><p>Irreversible</p></ac:rich-text-body></ac:structured-macro>`,
+		},
+		{
+			name:     "Block quote and rule",
+			markdown: "> quoted\n\n---",
+			want:     "<blockquote><p>quoted</p></blockquote><hr />",
+		},
+		{
+			name:     "Jira issue shortcode",
+			markdown: "Fixed in jira:PROJ-123, not xjira:PROJ-1 or `jira:PROJ-2`",
+			want:     `<p>Fixed in <ac:structured-macro ac:name="jira" ac:schema-version="1"><ac:parameter ac:name="key">PROJ-123</ac:parameter></ac:structured-macro>, not xjira:PROJ-1 or <code>jira:PROJ-2</code></p>`,
+		},
+		{
+			name:     "Jira table shortcode",
+			markdown: "Open bugs:\njira-table: project = PROJ AND type = Bug & status != Done",
+			want:     `<p>Open bugs:</p><ac:structured-macro ac:name="jira" ac:schema-version="1"><ac:parameter ac:name="jqlQuery">project = PROJ AND type = Bug &amp; status != Done</ac:parameter><ac:parameter ac:name="maximumIssues">20</ac:parameter></ac:structured-macro>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MarkdownToStorage(tt.markdown)
+			if got != tt.want {
+				t.Errorf("MarkdownToStorage() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStorageToMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage string
+		want    string
+	}{
+		{
+			name:    "Headings and inline formatting",
+			storage: "<h2>Title</h2><p>Some <strong>bold</strong>, <em>em</em>, <del>gone</del> and <code>x &lt; y</code>&nbsp;text</p>",
+			want:    "## Title\n\nSome **bold**, *em*, ~~gone~~ and `x < y` text",
+		},
+		{
+			name:    "Links",
+			storage: `<p><a href="https://example.com">site</a> <ac:link><ri:page ri:content-title="Runbook" /></ac:link> <ac:link><ri:user ri:username="jdoe" /></ac:link></p>`,
+			want:    "[site](https://example.com) Runbook @jdoe",
+		},
+		{
+			name:    "Nested lists",
+			storage: "<ul><li>a</li><li>b<ul><li>c</li></ul></li></ul><ol><li>one</li><li>two</li></ol>",
+			want:    "- a\n- b\n  - c\n\n1. one\n2. two",
+		},
+		{
+			name:    "Table",
+			storage: "<table><tbody><tr><th>Name</th><th>Value</th></tr><tr><td><p>a|b</p></td><td>1</td></tr></tbody></table>",
+			want:    "| Name | Value |\n| --- | --- |\n| a\\|b | 1 |",
+		},
+		{
+			name:    "Code macro",
+			storage: `<ac:structured-macro ac:name="code"><ac:parameter ac:name="language">go</ac:parameter><ac:plain-text-body><![CDATA[if a < b {}]]></ac:plain-text-body></ac:structured-macro>`,
+			want:    "```go\nif a < b {}\n```",
+		},
+		{
+			name:    "Info panel with title",
+			storage: `<ac:structured-macro ac:name="warning"><ac:parameter ac:name="title">Careful</ac:parameter><ac:rich-text-body><p>Do not run in prod</p></ac:rich-text-body></ac:structured-macro>`,
+			want:    "> [!CAUTION] Careful\n> Do not run in prod",
+		},
+		{
+			name:    "Inline macros and skipped toc",
+			storage: `<ac:structured-macro ac:name="toc" /><p>Status <ac:structured-macro ac:name="status"><ac:parameter ac:name="title">DONE</ac:parameter></ac:structured-macro> for <ac:structured-macro ac:name="jira"><ac:parameter ac:name="key">PROJ-1</ac:parameter></ac:structured-macro></p>`,
+			want:    "Status [DONE] for PROJ-1",
+		},
+		{
+			name:    "Task list",
+			storage: "<ac:task-list><ac:task><ac:task-status>complete</ac:task-status><ac:task-body>Ship it</ac:task-body></ac:task><ac:task><ac:task-status>incomplete</ac:task-status><ac:task-body>Write docs</ac:task-body></ac:task></ac:task-list>",
+			want:    "- [x] Ship it\n- [ ] Write docs",
+		},
+		{
+			name:    "View HTML with void elements",
+			storage: "<p>line one<br>line two</p><hr><pre>raw\n  text</pre>",
+			want:    "line one  \nline two\n\n---\n\n```\nraw\n  text\n```",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StorageToMarkdown(tt.storage)
+			if err != nil {
+				t.Fatalf("StorageToMarkdown() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("StorageToMarkdown() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStorageMarkdownRoundTrip(t *testing.T) {
+	markdown := "# Title\n\nSome **bold** text\n\n- a\n- b\n\n| A | B |\n| --- | --- |\n| 1 | 2 |\n\n```go\nfmt.Println()\n```\n\n> [!TIP]\n> Use it"
+
+	got, err := StorageToMarkdown(MarkdownToStorage(markdown))
+	if err != nil {
+		t.Fatalf("StorageToMarkdown() error = %v", err)
+	}
+	if got != markdown {
+		t.Errorf("round trip =\n%q\nwant\n%q", got, markdown)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 // GetContentOptions contains options for getting content
@@ -124,10 +125,25 @@ func (c *Client) UpdateContent(ctx context.Context, contentID string, req *Updat
 	return &content, nil
 }
 
+// UpdatePageOptions contains options for updating a page
+type UpdatePageOptions struct {
+	MinorEdit bool // Don't notify watchers of the change
+}
+
 // UpdatePage updates an existing page
 func (c *Client) UpdatePage(ctx context.Context, pageID string, title, body string, version int) (*Content, error) {
+	return c.UpdatePageWithOptions(ctx, pageID, title, body, version, nil)
+}
+
+// UpdatePageWithOptions updates an existing page. A minor edit is recorded in
+// the page history without notifying the page's watchers.
+func (c *Client) UpdatePageWithOptions(ctx context.Context, pageID string, title, body string, version int, opts *UpdatePageOptions) (*Content, error) {
+	if opts == nil {
+		opts = &UpdatePageOptions{}
+	}
+
 	req := &UpdateContentRequest{
-		Version: &Version{Number: version},
+		Version: &Version{Number: version, MinorEdit: opts.MinorEdit},
 		Title:   title,
 		Type:    ContentTypePage,
 		Body: &Body{
@@ -159,20 +175,119 @@ func (c *Client) DeletePage(ctx context.Context, pageID string) error {
 
 // SearchOptions contains options for searching
 type SearchOptions struct {
-	Expand []string
-	Start  int
-	Limit  int
+	Expand    []string
+	Start     int
+	Limit     int
+	Cursor    string   // Cursor from a previous result's NextCursor (Cloud)
+	Excerpt   string   // Excerpt strategy for SiteSearch (e.g., "highlight", "indexed", "none")
+	SpaceKeys []string // Restrict results to these spaces
+	Types     []string // Restrict results to these content types (e.g., "page", "blogpost")
 }
 
 // SearchCQL searches content using CQL (Confluence Query Language)
 func (c *Client) SearchCQL(ctx context.Context, cql string, opts *SearchOptions) (*SearchResult, error) {
 	path := fmt.Sprintf("%s/content/search", c.getAPIPath())
+	path = buildURL(path, searchParams(cql, opts))
 
-	params := map[string]string{
-		"cql": cql,
+	var result SearchResult
+	if err := c.doRequest(ctx, "GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to search with CQL: %w", err)
 	}
 
+	result.NextCursor = nextCursor(result.Links)
+
+	return &result, nil
+}
+
+// Search searches content using text or CQL
+func (c *Client) Search(ctx context.Context, query string, opts *SearchOptions) (*SearchResult, error) {
+	return c.SearchCQL(ctx, queryToCQL(query), opts)
+}
+
+// maxSearchPageSize is the page size used when following search pagination
+const maxSearchPageSize = 100
+
+// SearchAll searches content using text or CQL and follows pagination until
+// every matching result, or limit results, has been retrieved. The options'
+// Limit is ignored. Use HasMore on the result to tell whether the limit cut
+// the results short.
+func (c *Client) SearchAll(ctx context.Context, query string, opts *SearchOptions, limit int) (*SearchResult, error) {
+	pageOpts := SearchOptions{}
 	if opts != nil {
+		pageOpts = *opts
+	}
+
+	combined := &SearchResult{Start: pageOpts.Start}
+	for len(combined.Results) < limit {
+		pageOpts.Limit = min(maxSearchPageSize, limit-len(combined.Results))
+
+		page, err := c.Search(ctx, query, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		combined.Results = append(combined.Results, page.Results...)
+		combined.TotalSize = page.TotalSize
+		combined.CqlQuery = page.CqlQuery
+		combined.NextCursor = page.NextCursor
+		combined.Links = page.Links
+
+		if len(page.Results) == 0 || !page.HasMore() {
+			break
+		}
+		if page.NextCursor != "" {
+			pageOpts.Cursor = page.NextCursor
+		} else {
+			pageOpts.Start += len(page.Results)
+		}
+	}
+	combined.Size = len(combined.Results)
+	combined.Limit = combined.Size
+
+	return combined, nil
+}
+
+// HasMore reports whether the search has a next page of results
+func (r *SearchResult) HasMore() bool {
+	return r.Links != nil && r.Links.Next != ""
+}
+
+// SiteSearch searches using the site search API, which supports excerpts.
+// The query may be CQL or plain text.
+func (c *Client) SiteSearch(ctx context.Context, query string, opts *SearchOptions) (*SiteSearchResult, error) {
+	path := fmt.Sprintf("%s/search", c.getAPIPath())
+
+	params := searchParams(queryToCQL(query), opts)
+	if opts != nil && opts.Excerpt != "" {
+		params["excerpt"] = opts.Excerpt
+	}
+	path = buildURL(path, params)
+
+	var result SiteSearchResult
+	if err := c.doRequest(ctx, "GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+
+	result.NextCursor = nextCursor(result.Links)
+
+	return &result, nil
+}
+
+// queryToCQL returns CQL queries unchanged and converts plain text to a text search
+func queryToCQL(query string) string {
+	// Auto-detect if it's CQL or simple text search
+	if strings.TrimSpace(query) == "" || isCQL(query) {
+		return query
+	}
+	return fmt.Sprintf("text ~ \"%s\"", strings.ReplaceAll(query, `"`, `\"`))
+}
+
+// searchParams builds the query parameters shared by the search endpoints
+func searchParams(cql string, opts *SearchOptions) map[string]string {
+	params := make(map[string]string)
+
+	if opts != nil {
+		cql = withSearchFilters(cql, opts.SpaceKeys, opts.Types)
 		if len(opts.Expand) > 0 {
 			params["expand"] = expandFields(opts.Expand)
 		}
@@ -182,28 +297,66 @@ func (c *Client) SearchCQL(ctx context.Context, cql string, opts *SearchOptions)
 		if opts.Limit > 0 {
 			params["limit"] = fmt.Sprintf("%d", opts.Limit)
 		}
+		if opts.Cursor != "" {
+			params["cursor"] = opts.Cursor
+		}
 	}
 
-	path = buildURL(path, params)
+	params["cql"] = cql
+	return params
+}
 
-	var result SearchResult
-	if err := c.doRequest(ctx, "GET", path, nil, &result); err != nil {
-		return nil, fmt.Errorf("failed to search with CQL: %w", err)
+// withSearchFilters adds space and type restrictions to a CQL query, keeping
+// any ORDER BY clause at the end
+func withSearchFilters(cql string, spaceKeys, types []string) string {
+	var filters []string
+	if len(spaceKeys) > 0 {
+		filters = append(filters, fmt.Sprintf("space in (%s)", quoteCQLList(spaceKeys)))
+	}
+	if len(types) > 0 {
+		filters = append(filters, fmt.Sprintf("type in (%s)", quoteCQLList(types)))
+	}
+	if len(filters) == 0 {
+		return cql
 	}
 
-	return &result, nil
+	orderBy := ""
+	if i := strings.Index(strings.ToLower(cql), "order by"); i >= 0 {
+		cql, orderBy = strings.TrimSpace(cql[:i]), " "+cql[i:]
+	}
+
+	if cql != "" {
+		filters = append([]string{"(" + cql + ")"}, filters...)
+	}
+	return strings.Join(filters, " AND ") + orderBy
 }
 
-// Search searches content using text or CQL
-func (c *Client) Search(ctx context.Context, query string, opts *SearchOptions) (*SearchResult, error) {
-	// Auto-detect if it's CQL or simple text search
-	if isCQL(query) {
-		return c.SearchCQL(ctx, query, opts)
+// QuoteCQL quotes a value for CQL, escaping backslashes and double quotes
+func QuoteCQL(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
+
+// quoteCQLList quotes values for a CQL "in (...)" clause
+func quoteCQLList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = QuoteCQL(strings.TrimSpace(v))
 	}
+	return strings.Join(quoted, ",")
+}
 
-	// Convert simple text to CQL
-	cql := fmt.Sprintf("text ~ \"%s\"", query)
-	return c.SearchCQL(ctx, cql, opts)
+// nextCursor extracts the cursor parameter from a _links.next URL
+func nextCursor(links *Links) string {
+	if links == nil || links.Next == "" {
+		return ""
+	}
+	next, err := url.Parse(links.Next)
+	if err != nil {
+		return ""
+	}
+	return next.Query().Get("cursor")
 }
 
 // isCQL checks if a query string is CQL
@@ -263,6 +416,62 @@ func (c *Client) GetPageAncestors(ctx context.Context, pageID string) ([]Content
 	return page.Ancestors, nil
 }
 
+// GetPageTree retrieves a page and its descendants down to the given depth,
+// breadth first. At most limit children are fetched for each page; pages with
+// more children than that are marked with HasMoreChildren. The tree holds at
+// most maxPages pages besides the root, which also bounds the requests to one
+// per page; once it is full, pages whose children were not fetched are
+// marked with Unexplored.
+func (c *Client) GetPageTree(ctx context.Context, pageID string, depth, limit, maxPages int) (*PageTreeNode, error) {
+	page, err := c.GetPage(ctx, pageID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &PageTreeNode{ID: page.ID, Title: page.Title}
+
+	type level struct {
+		node  *PageTreeNode
+		depth int
+	}
+	queue := []level{{root, depth}}
+	pages := 0
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		if next.depth <= 0 {
+			continue
+		}
+		if pages >= maxPages {
+			next.node.Unexplored = true
+			continue
+		}
+
+		// Request one extra child to detect truncation
+		children, err := c.GetPageChildren(ctx, next.node.ID, nil, limit+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(children) > limit {
+			children = children[:limit]
+			next.node.HasMoreChildren = true
+		}
+		if room := maxPages - pages; len(children) > room {
+			children = children[:room]
+			next.node.HasMoreChildren = true
+		}
+
+		for _, child := range children {
+			childNode := &PageTreeNode{ID: child.ID, Title: child.Title}
+			next.node.Children = append(next.node.Children, childNode)
+			queue = append(queue, level{childNode, next.depth - 1})
+		}
+		pages += len(children)
+	}
+
+	return root, nil
+}
+
 // GetPageHistory retrieves the history of a page
 func (c *Client) GetPageHistory(ctx context.Context, pageID string) (*History, error) {
 	page, err := c.GetPage(ctx, pageID, []string{"history"})
@@ -273,12 +482,10 @@ func (c *Client) GetPageHistory(ctx context.Context, pageID string) (*History, e
 	return page.History, nil
 }
 
-// ConvertMarkdownToStorage converts Markdown to Confluence storage format
-// This is a stub - actual implementation would use a proper converter
+// ConvertMarkdownToStorage converts Markdown to Confluence storage format.
+// See MarkdownToStorage for the supported syntax.
 func (c *Client) ConvertMarkdownToStorage(ctx context.Context, markdown string) (string, error) {
-	// TODO: Implement proper Markdown to Confluence storage format conversion
-	// For now, return a basic HTML-like structure
-	return fmt.Sprintf("<p>%s</p>", url.QueryEscape(markdown)), nil
+	return MarkdownToStorage(markdown), nil
 }
 
 // ConvertWikiToStorage converts Wiki markup to Confluence storage format
@@ -0,0 +1,196 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SpacePermission lists who holds one permission of a space, such as
+// "read" on the space or "create" on pages
+type SpacePermission struct {
+	Operation string              `json:"operation"` // e.g. "read", "create", "delete", "administer"
+	Target    string              `json:"target"`    // e.g. "space", "page", "blogpost", "comment"
+	Users     []PermissionSubject `json:"users,omitempty"`
+	Groups    []PermissionSubject `json:"groups,omitempty"`
+	Anonymous bool                `json:"anonymous,omitempty"` // Granted to anonymous users
+}
+
+// Key returns the permission as "operation:target", e.g. "create:page"
+func (p *SpacePermission) Key() string {
+	return p.Operation + ":" + p.Target
+}
+
+// PermissionSubject is a user or group holding a permission. ID is an
+// account ID or group ID on Cloud and a username or group name on Server/DC.
+type PermissionSubject struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// GetSpacePermissions returns the permissions of a space with the users and
+// groups holding each, sorted by target and operation. Cloud permissions are
+// read with the v2 API and their subjects' names are looked up.
+func (c *Client) GetSpacePermissions(ctx context.Context, spaceKey string) ([]SpacePermission, error) {
+	if c.IsCloud() {
+		return c.getSpacePermissionsV2(ctx, spaceKey)
+	}
+
+	var space struct {
+		Permissions []struct {
+			Operation struct {
+				Operation  string `json:"operation"`
+				TargetType string `json:"targetType"`
+			} `json:"operation"`
+			Subjects struct {
+				User struct {
+					Results []User `json:"results"`
+				} `json:"user"`
+				Group struct {
+					Results []Group `json:"results"`
+				} `json:"group"`
+			} `json:"subjects"`
+			AnonymousAccess bool `json:"anonymousAccess"`
+		} `json:"permissions"`
+	}
+	path := buildURL(fmt.Sprintf("%s/space/%s", c.getAPIPath(), spaceKey), map[string]string{"expand": "permissions"})
+	if err := c.doRequest(ctx, "GET", path, nil, &space); err != nil {
+		return nil, fmt.Errorf("failed to get permissions of space %s: %w", spaceKey, err)
+	}
+
+	perms := newSpacePermissionSet()
+	for _, p := range space.Permissions {
+		perm := perms.get(p.Operation.Operation, p.Operation.TargetType)
+		for _, user := range p.Subjects.User.Results {
+			perm.Users = append(perm.Users, PermissionSubject{ID: user.Username, Name: user.DisplayName})
+		}
+		for _, group := range p.Subjects.Group.Results {
+			perm.Groups = append(perm.Groups, PermissionSubject{ID: group.Name, Name: group.Name})
+		}
+		perm.Anonymous = perm.Anonymous || p.AnonymousAccess
+	}
+	return perms.list(), nil
+}
+
+// getSpacePermissionsV2 reads the permissions of a space with the Cloud v2
+// API, which addresses spaces by ID and subjects by ID only
+func (c *Client) getSpacePermissionsV2(ctx context.Context, spaceKey string) ([]SpacePermission, error) {
+	space, err := c.GetSpace(ctx, spaceKey, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	perms := newSpacePermissionSet()
+	names := make(map[string]string)
+	path := fmt.Sprintf("%s/spaces/%s/permissions", apiV2Path, space.GetID())
+	for path != "" {
+		var response struct {
+			Results []struct {
+				Principal struct {
+					Type string `json:"type"`
+					ID   string `json:"id"`
+				} `json:"principal"`
+				Operation struct {
+					Key        string `json:"key"`
+					TargetType string `json:"targetType"`
+				} `json:"operation"`
+			} `json:"results"`
+			Links struct {
+				Next string `json:"next"`
+			} `json:"_links"`
+		}
+		if err := c.doRequest(ctx, "GET", path, nil, &response); err != nil {
+			return nil, fmt.Errorf("failed to get permissions of space %s: %w", spaceKey, err)
+		}
+
+		for _, p := range response.Results {
+			perm := perms.get(p.Operation.Key, p.Operation.TargetType)
+			switch p.Principal.Type {
+			case "user":
+				perm.Users = append(perm.Users, PermissionSubject{ID: p.Principal.ID, Name: c.subjectName(ctx, names, "user", p.Principal.ID)})
+			case "group":
+				perm.Groups = append(perm.Groups, PermissionSubject{ID: p.Principal.ID, Name: c.subjectName(ctx, names, "group", p.Principal.ID)})
+			default:
+				// Anonymous access is granted to a role rather than a user or group
+				perm.Anonymous = true
+			}
+		}
+		// The next link is relative to the site, including the /wiki context
+		path = strings.TrimPrefix(response.Links.Next, "/wiki")
+	}
+	return perms.list(), nil
+}
+
+// subjectName looks up the display name of a user or the name of a group by
+// ID, caching names in names. Names are best effort: an empty name is
+// returned if the lookup fails.
+func (c *Client) subjectName(ctx context.Context, names map[string]string, kind, id string) string {
+	key := kind + ":" + id
+	if name, ok := names[key]; ok {
+		return name
+	}
+
+	name := ""
+	if kind == "user" {
+		if user, err := c.GetUser(ctx, id); err == nil {
+			name = user.DisplayName
+		}
+	} else {
+		var group Group
+		path := buildURL(fmt.Sprintf("%s/group/by-id", c.getAPIPath()), map[string]string{"id": id})
+		if err := c.doRequest(ctx, "GET", path, nil, &group); err == nil {
+			name = group.Name
+		}
+	}
+	names[key] = name
+	return name
+}
+
+// spacePermissionSet merges the grants of a space into one entry per
+// permission
+type spacePermissionSet map[string]*SpacePermission
+
+func newSpacePermissionSet() spacePermissionSet {
+	return make(spacePermissionSet)
+}
+
+func (s spacePermissionSet) get(operation, target string) *SpacePermission {
+	perm := &SpacePermission{Operation: operation, Target: target}
+	if existing, ok := s[perm.Key()]; ok {
+		return existing
+	}
+	s[perm.Key()] = perm
+	return perm
+}
+
+func (s spacePermissionSet) list() []SpacePermission {
+	perms := make([]SpacePermission, 0, len(s))
+	for _, perm := range s {
+		perm.Users = uniqueSubjects(perm.Users)
+		perm.Groups = uniqueSubjects(perm.Groups)
+		perms = append(perms, *perm)
+	}
+	sort.Slice(perms, func(i, j int) bool {
+		if perms[i].Target != perms[j].Target {
+			return perms[i].Target < perms[j].Target
+		}
+		return perms[i].Operation < perms[j].Operation
+	})
+	return perms
+}
+
+// uniqueSubjects sorts subjects by ID, dropping subjects granted a
+// permission more than once
+func uniqueSubjects(subjects []PermissionSubject) []PermissionSubject {
+	sort.Slice(subjects, func(i, j int) bool {
+		return subjects[i].ID < subjects[j].ID
+	})
+	unique := subjects[:0]
+	for i, subject := range subjects {
+		if i == 0 || subject.ID != subjects[i-1].ID {
+			unique = append(unique, subject)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,99 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+)
+
+// GetContentProperties retrieves the properties stored on content
+func (c *Client) GetContentProperties(ctx context.Context, contentID string, limit int) ([]ContentProperty, error) {
+	path := fmt.Sprintf("%s/content/%s/property", c.getAPIPath(), contentID)
+
+	params := make(map[string]string)
+	if limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", limit)
+	}
+
+	path = buildURL(path, params)
+
+	var response struct {
+		Results []ContentProperty `json:"results"`
+		Start   int               `json:"start"`
+		Limit   int               `json:"limit"`
+		Size    int               `json:"size"`
+	}
+
+	if err := c.doRequest(ctx, "GET", path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get properties for content %s: %w", contentID, err)
+	}
+
+	return response.Results, nil
+}
+
+// GetContentProperty retrieves a single content property by key
+func (c *Client) GetContentProperty(ctx context.Context, contentID, key string) (*ContentProperty, error) {
+	var property ContentProperty
+	if err := c.doRequest(ctx, "GET", c.contentPropertyPath(contentID, key), nil, &property); err != nil {
+		return nil, fmt.Errorf("failed to get property %s for content %s: %w", key, contentID, err)
+	}
+
+	return &property, nil
+}
+
+// SetContentProperty creates a content property or updates it to the next version.
+// The value can be any JSON-serializable value.
+func (c *Client) SetContentProperty(ctx context.Context, contentID, key string, value interface{}) (*ContentProperty, error) {
+	req := ContentPropertyRequest{
+		Key:   key,
+		Value: value,
+	}
+
+	var existing ContentProperty
+	err := c.doRequest(ctx, "GET", c.contentPropertyPath(contentID, key), nil, &existing)
+	switch {
+	case err == nil:
+		version := 1
+		if existing.Version != nil {
+			version = existing.Version.Number + 1
+		}
+		req.Version = &Version{Number: version}
+	case !errors.Is(err, atlassian.ErrNotFound):
+		return nil, fmt.Errorf("failed to get property %s for content %s: %w", key, contentID, err)
+	}
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal property request: %w", err)
+	}
+
+	var property ContentProperty
+	if req.Version == nil {
+		path := fmt.Sprintf("%s/content/%s/property", c.getAPIPath(), contentID)
+		err = c.doRequest(ctx, "POST", path, reqBody, &property)
+	} else {
+		err = c.doRequest(ctx, "PUT", c.contentPropertyPath(contentID, key), reqBody, &property)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set property %s for content %s: %w", key, contentID, err)
+	}
+
+	return &property, nil
+}
+
+// DeleteContentProperty deletes a content property
+func (c *Client) DeleteContentProperty(ctx context.Context, contentID, key string) error {
+	if err := c.doRequest(ctx, "DELETE", c.contentPropertyPath(contentID, key), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete property %s for content %s: %w", key, contentID, err)
+	}
+
+	return nil
+}
+
+func (c *Client) contentPropertyPath(contentID, key string) string {
+	return fmt.Sprintf("%s/content/%s/property/%s", c.getAPIPath(), contentID, url.PathEscape(key))
+}
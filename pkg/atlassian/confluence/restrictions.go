@@ -0,0 +1,76 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Restriction operations
+const (
+	RestrictionOperationRead   = "read"
+	RestrictionOperationUpdate = "update"
+)
+
+// GetRestrictions retrieves the read and update restrictions of content
+func (c *Client) GetRestrictions(ctx context.Context, contentID string) ([]ContentRestriction, error) {
+	path := fmt.Sprintf("%s/content/%s/restriction", c.getAPIPath(), contentID)
+	path = buildURL(path, map[string]string{
+		"expand": expandFields([]string{"restrictions.user", "restrictions.group"}),
+	})
+
+	var response ContentRestrictionArray
+	if err := c.doRequest(ctx, "GET", path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get restrictions for content %s: %w", contentID, err)
+	}
+
+	return response.Results, nil
+}
+
+// SetRestrictions replaces the restrictions of content. Operations that are not
+// included are left without restrictions.
+func (c *Client) SetRestrictions(ctx context.Context, contentID string, restrictions []ContentRestrictionUpdate) ([]ContentRestriction, error) {
+	path := fmt.Sprintf("%s/content/%s/restriction", c.getAPIPath(), contentID)
+	path = buildURL(path, map[string]string{
+		"expand": expandFields([]string{"restrictions.user", "restrictions.group"}),
+	})
+
+	reqBody, err := json.Marshal(restrictions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal restrictions request: %w", err)
+	}
+
+	var response ContentRestrictionArray
+	if err := c.doRequest(ctx, "PUT", path, reqBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to set restrictions for content %s: %w", contentID, err)
+	}
+
+	return response.Results, nil
+}
+
+// NewRestrictionUpdate builds a restriction update for an operation. Users are
+// account IDs on Cloud and usernames on Server/Data Center.
+func (c *Client) NewRestrictionUpdate(operation string, users, groups []string) ContentRestrictionUpdate {
+	update := ContentRestrictionUpdate{
+		Operation: operation,
+		Restrictions: RestrictionUpdateSubjects{
+			User:  make([]RestrictionUserRef, 0, len(users)),
+			Group: make([]RestrictionGroupRef, 0, len(groups)),
+		},
+	}
+
+	for _, user := range users {
+		ref := RestrictionUserRef{Type: "known"}
+		if c.IsCloud() {
+			ref.AccountID = user
+		} else {
+			ref.Username = user
+		}
+		update.Restrictions.User = append(update.Restrictions.User, ref)
+	}
+	for _, group := range groups {
+		update.Restrictions.Group = append(update.Restrictions.Group, RestrictionGroupRef{Type: "group", Name: group})
+	}
+
+	return update
+}
@@ -0,0 +1,140 @@
+package confluence
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Markers the site search API puts around matched terms in excerpts
+const (
+	highlightStart = "@@@hl@@@"
+	highlightEnd   = "@@@endhl@@@"
+)
+
+// maxSnippetLength caps snippets built from content bodies, in characters
+const maxSnippetLength = 300
+
+var excerptTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// ExcerptFormat selects how CleanExcerpt renders an excerpt
+type ExcerptFormat string
+
+const (
+	ExcerptFormatMarkdown ExcerptFormat = "markdown" // Matched terms in bold
+	ExcerptFormatText     ExcerptFormat = "text"     // Plain text
+	ExcerptFormatRaw      ExcerptFormat = "raw"      // As returned by Confluence
+)
+
+// CleanExcerpt converts a search excerpt, which may hold XHTML tags, HTML
+// entities, and highlight markers, to a single line of plain text or
+// Markdown. Raw excerpts are returned unchanged.
+func CleanExcerpt(excerpt string, format ExcerptFormat) string {
+	if format == ExcerptFormatRaw {
+		return excerpt
+	}
+
+	emphasis := ""
+	if format == ExcerptFormatMarkdown {
+		emphasis = "**"
+	}
+	excerpt = strings.NewReplacer(highlightStart, emphasis, highlightEnd, emphasis).Replace(excerpt)
+	excerpt = excerptTagPattern.ReplaceAllString(excerpt, " ")
+	excerpt = strings.ReplaceAll(html.UnescapeString(excerpt), "\u00a0", " ")
+	excerpt = mdWhitespacePattern.ReplaceAllString(excerpt, " ")
+
+	return strings.TrimSpace(excerpt)
+}
+
+// SearchSnippet is a compact search result
+type SearchSnippet struct {
+	ID           string `json:"id,omitempty"`
+	Title        string `json:"title"`
+	Type         string `json:"type,omitempty"`
+	Space        string `json:"space,omitempty"` // Space key
+	URL          string `json:"url,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	Snippet      string `json:"snippet,omitempty"`
+}
+
+// SiteSearchSnippets returns compact views of site search results, with
+// their excerpts cleaned up in format
+func (c *Client) SiteSearchSnippets(result *SiteSearchResult, format ExcerptFormat) []SearchSnippet {
+	base := c.baseURL
+	if result.Links != nil && result.Links.Base != "" {
+		base = result.Links.Base
+	}
+
+	snippets := make([]SearchSnippet, 0, len(result.Results))
+	for _, item := range result.Results {
+		snippet := SearchSnippet{
+			Title:        item.Title,
+			Type:         item.EntityType,
+			LastModified: item.LastModified,
+			Snippet:      CleanExcerpt(item.Excerpt, format),
+		}
+		if item.URL != "" {
+			snippet.URL = base + item.URL
+		}
+		if content := item.Content; content != nil {
+			snippet.ID = content.ID
+			snippet.Type = string(content.Type)
+			if content.Space != nil {
+				snippet.Space = content.Space.Key
+			}
+			if snippet.URL == "" {
+				snippet.URL = c.PageURL(content)
+			}
+		}
+		snippet.Title = CleanExcerpt(snippet.Title, ExcerptFormatText)
+		snippets = append(snippets, snippet)
+	}
+	return snippets
+}
+
+// ContentSnippets returns compact views of content search results. The
+// snippet is the start of the body, when the body was expanded.
+func (c *Client) ContentSnippets(results []Content) []SearchSnippet {
+	snippets := make([]SearchSnippet, 0, len(results))
+	for i := range results {
+		content := &results[i]
+		snippet := SearchSnippet{
+			ID:    content.ID,
+			Title: content.Title,
+			Type:  string(content.Type),
+			URL:   c.PageURL(content),
+		}
+		if content.Space != nil {
+			snippet.Space = content.Space.Key
+		}
+		switch {
+		case content.Version != nil && content.Version.When != "":
+			snippet.LastModified = content.Version.When
+		case content.History != nil && content.History.LastUpdated != nil:
+			snippet.LastModified = content.History.LastUpdated.When
+		}
+		snippet.Snippet = bodySnippet(content.Body)
+		snippets = append(snippets, snippet)
+	}
+	return snippets
+}
+
+// bodySnippet returns the start of a body as plain text
+func bodySnippet(body *Body) string {
+	if body == nil {
+		return ""
+	}
+
+	var text string
+	for _, source := range []*BodyContent{body.Storage, body.View, body.ExportView, body.StyledView, body.Markdown} {
+		if source != nil {
+			text = CleanExcerpt(source.Value, ExcerptFormatText)
+			break
+		}
+	}
+
+	if runes := []rune(text); len(runes) > maxSnippetLength {
+		text = strings.TrimSpace(string(runes[:maxSnippetLength])) + "…"
+	}
+	return text
+}
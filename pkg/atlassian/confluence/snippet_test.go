@@ -0,0 +1,79 @@
+package confluence
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCleanExcerpt(t *testing.T) {
+	excerpt := "Restart the <p>@@@hl@@@deploy@@@endhl@@@ \n\n pipeline</p> &amp; check&nbsp;logs&hellip;"
+
+	tests := []struct {
+		format ExcerptFormat
+		want   string
+	}{
+		{ExcerptFormatMarkdown, "Restart the **deploy** pipeline & check logs…"},
+		{ExcerptFormatText, "Restart the deploy pipeline & check logs…"},
+		{ExcerptFormatRaw, excerpt},
+	}
+
+	for _, tt := range tests {
+		if got := CleanExcerpt(excerpt, tt.format); got != tt.want {
+			t.Errorf("CleanExcerpt(%s) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestSiteSearchSnippets(t *testing.T) {
+	client := &Client{baseURL: "https://example.atlassian.net/wiki"}
+	result := &SiteSearchResult{
+		Results: []SiteSearchItem{{
+			Title:        "@@@hl@@@Deploy@@@endhl@@@ runbook",
+			Excerpt:      "<b>Step</b> 1",
+			URL:          "/spaces/OPS/pages/123/Deploy+runbook",
+			LastModified: "2024-05-07T02:00:00.000Z",
+			Content:      &Content{ID: "123", Type: ContentTypePage, Space: &Space{Key: "OPS"}},
+		}},
+	}
+
+	snippets := client.SiteSearchSnippets(result, ExcerptFormatText)
+	if len(snippets) != 1 {
+		t.Fatalf("SiteSearchSnippets() returned %d results, want 1", len(snippets))
+	}
+	want := SearchSnippet{
+		ID:           "123",
+		Title:        "Deploy runbook",
+		Type:         "page",
+		Space:        "OPS",
+		URL:          "https://example.atlassian.net/wiki/spaces/OPS/pages/123/Deploy+runbook",
+		LastModified: "2024-05-07T02:00:00.000Z",
+		Snippet:      "Step 1",
+	}
+	if snippets[0] != want {
+		t.Errorf("SiteSearchSnippets() = %+v, want %+v", snippets[0], want)
+	}
+}
+
+func TestContentSnippets(t *testing.T) {
+	client := &Client{baseURL: "https://wiki.example.com"}
+	results := []Content{{
+		ID:      "42",
+		Type:    ContentTypePage,
+		Title:   "Architecture",
+		Space:   &Space{Key: "ENG"},
+		Version: &Version{When: "2024-05-07T02:00:00.000Z", Number: 3},
+		Body:    &Body{Storage: &BodyContent{Value: "<p>" + strings.Repeat("word ", 100) + "</p>"}},
+	}}
+
+	snippets := client.ContentSnippets(results)
+	if len(snippets) != 1 {
+		t.Fatalf("ContentSnippets() returned %d results, want 1", len(snippets))
+	}
+	got := snippets[0]
+	if got.URL != "https://wiki.example.com/pages/viewpage.action?pageId=42" || got.Space != "ENG" || got.LastModified == "" {
+		t.Errorf("ContentSnippets() = %+v", got)
+	}
+	if !strings.HasPrefix(got.Snippet, "word word") || !strings.HasSuffix(got.Snippet, "…") || len([]rune(got.Snippet)) > maxSnippetLength+1 {
+		t.Errorf("ContentSnippets() snippet = %q, want the truncated body", got.Snippet)
+	}
+}
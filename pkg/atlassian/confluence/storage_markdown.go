@@ -0,0 +1,585 @@
+package confluence
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var mdWhitespacePattern = regexp.MustCompile(`\s+`)
+
+// panelAlerts maps Confluence panel macros to GitHub-style alert types, the
+// reverse of admonitionMacros
+var panelAlerts = map[string]string{
+	"info":    "NOTE",
+	"tip":     "TIP",
+	"note":    "WARNING",
+	"warning": "CAUTION",
+}
+
+// inlineMacros are macros rendered inside running text
+var inlineMacros = map[string]bool{
+	"status": true,
+	"jira":   true,
+	"anchor": true,
+}
+
+// skippedMacros are navigation macros with no meaningful text content
+var skippedMacros = map[string]bool{
+	"toc":                    true,
+	"children":               true,
+	"pagetree":               true,
+	"recently-updated":       true,
+	"contentbylabel":         true,
+	"livesearch":             true,
+	"create-from-template":   true,
+	"content-report-table":   true,
+	"page-properties-report": true,
+}
+
+// storageAutoClose lists the HTML void elements that may appear unclosed in
+// view HTML. "link" is excluded because auto-closing matches local names and
+// would otherwise close <ac:link>.
+var storageAutoClose = func() []string {
+	var names []string
+	for _, name := range xml.HTMLAutoClose {
+		if name != "link" {
+			names = append(names, name)
+		}
+	}
+	return names
+}()
+
+// storageNode is an element or text node of a parsed storage/view document
+type storageNode struct {
+	name     string
+	attrs    map[string]string
+	children []*storageNode
+	text     string
+	isText   bool
+}
+
+// StorageToMarkdown converts Confluence storage format or view HTML to Markdown.
+// Code, panel (info/tip/note/warning), expand, status, and Jira macros are
+// converted; navigation macros such as the table of contents are dropped.
+func StorageToMarkdown(storage string) (string, error) {
+	root, err := parseStorage(storage)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(renderStorageBlocks(root.children, "\n\n")), nil
+}
+
+// ConvertBodyToMarkdown replaces the content body with a Markdown rendering of
+// its storage (or view) representation. Content without a body is unchanged.
+func (c *Content) ConvertBodyToMarkdown() error {
+	if c.Body == nil {
+		return nil
+	}
+
+	var source *BodyContent
+	for _, body := range []*BodyContent{c.Body.Storage, c.Body.View, c.Body.ExportView, c.Body.StyledView} {
+		if body != nil {
+			source = body
+			break
+		}
+	}
+	if source == nil {
+		return nil
+	}
+
+	markdown, err := StorageToMarkdown(source.Value)
+	if err != nil {
+		return fmt.Errorf("failed to convert content %s to markdown: %w", c.ID, err)
+	}
+
+	c.Body = &Body{
+		Markdown: &BodyContent{Value: markdown, Representation: FormatMarkdown},
+	}
+	return nil
+}
+
+// storageText returns the plain text of a storage document, as shown to readers
+func storageText(storage string) (string, error) {
+	root, err := parseStorage(storage)
+	if err != nil {
+		return "", err
+	}
+	return root.textContent(), nil
+}
+
+// parseStorage parses a storage fragment leniently as XHTML
+func parseStorage(storage string) (*storageNode, error) {
+	// Wrap the fragment so documents with several top-level elements parse
+	decoder := xml.NewDecoder(strings.NewReader("<root>" + storage + "</root>"))
+	decoder.Strict = false
+	decoder.AutoClose = storageAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	document := &storageNode{name: "document"}
+	stack := []*storageNode{document}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse storage format: %w", err)
+		}
+
+		parent := stack[len(stack)-1]
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &storageNode{name: qualifiedName(t.Name), attrs: make(map[string]string, len(t.Attr))}
+			for _, attr := range t.Attr {
+				node.attrs[qualifiedName(attr.Name)] = attr.Value
+			}
+			parent.children = append(parent.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			parent.children = append(parent.children, &storageNode{text: string(t), isText: true})
+		}
+	}
+
+	if root := document.child("root"); root != nil {
+		return root, nil
+	}
+	return document, nil
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return strings.ToLower(name.Local)
+	}
+	return strings.ToLower(name.Space + ":" + name.Local)
+}
+
+// child returns the first child element with the given name
+func (n *storageNode) child(name string) *storageNode {
+	for _, c := range n.children {
+		if !c.isText && c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// textContent returns the concatenated text of the node and its descendants
+func (n *storageNode) textContent() string {
+	if n.isText {
+		return n.text
+	}
+	var b strings.Builder
+	for _, c := range n.children {
+		b.WriteString(c.textContent())
+	}
+	return b.String()
+}
+
+// macroParams returns the ac:parameter values of a macro
+func (n *storageNode) macroParams() map[string]string {
+	params := make(map[string]string)
+	for _, c := range n.children {
+		if !c.isText && c.name == "ac:parameter" {
+			params[c.attrs["ac:name"]] = strings.TrimSpace(c.textContent())
+		}
+	}
+	return params
+}
+
+func isStorageBlock(n *storageNode) bool {
+	if n.isText {
+		return false
+	}
+	switch n.name {
+	case "p", "h1", "h2", "h3", "h4", "h5", "h6", "ul", "ol", "table", "pre", "blockquote", "hr",
+		"div", "section", "ac:layout", "ac:layout-section", "ac:layout-cell", "ac:task-list":
+		return true
+	case "ac:structured-macro", "ac:macro":
+		return !inlineMacros[n.attrs["ac:name"]]
+	}
+	return false
+}
+
+// renderStorageBlocks renders nodes as blocks separated by sep. Runs of inline
+// nodes between blocks are rendered as paragraphs.
+func renderStorageBlocks(nodes []*storageNode, sep string) string {
+	var blocks []string
+	var inline []*storageNode
+
+	flush := func() {
+		if text := strings.TrimSpace(renderStorageInline(inline)); text != "" {
+			blocks = append(blocks, text)
+		}
+		inline = nil
+	}
+
+	for _, n := range nodes {
+		if !isStorageBlock(n) {
+			inline = append(inline, n)
+			continue
+		}
+		flush()
+		if block := strings.TrimRight(renderStorageBlock(n), " \n"); strings.TrimSpace(block) != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	flush()
+
+	return strings.Join(blocks, sep)
+}
+
+func renderStorageBlock(n *storageNode) string {
+	switch n.name {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level := int(n.name[1] - '0')
+		return strings.Repeat("#", level) + " " + strings.TrimSpace(renderStorageInline(n.children))
+
+	case "p":
+		return strings.TrimSpace(renderStorageInline(n.children))
+
+	case "ul", "ol":
+		return renderStorageList(n)
+
+	case "table":
+		return renderStorageTable(n)
+
+	case "pre":
+		return fenceCode(n.textContent(), "")
+
+	case "blockquote":
+		return prefixLines(renderStorageBlocks(n.children, "\n\n"), "> ")
+
+	case "hr":
+		return "---"
+
+	case "ac:task-list":
+		return renderStorageTasks(n)
+
+	case "ac:structured-macro", "ac:macro":
+		return renderStorageMacro(n)
+
+	default:
+		return renderStorageBlocks(n.children, "\n\n")
+	}
+}
+
+func renderStorageList(n *storageNode) string {
+	var items []string
+	number := 1
+	for _, li := range n.children {
+		if li.isText || li.name != "li" {
+			continue
+		}
+
+		marker := "- "
+		if n.name == "ol" {
+			marker = fmt.Sprintf("%d. ", number)
+			number++
+		}
+
+		content := renderStorageBlocks(li.children, "\n")
+		indent := strings.Repeat(" ", len(marker))
+		lines := strings.Split(content, "\n")
+		for i := range lines {
+			if i == 0 {
+				lines[i] = marker + lines[i]
+			} else if lines[i] != "" {
+				lines[i] = indent + lines[i]
+			}
+		}
+		items = append(items, strings.Join(lines, "\n"))
+	}
+	return strings.Join(items, "\n")
+}
+
+func renderStorageTable(n *storageNode) string {
+	var rows [][]string
+	var collect func(node *storageNode)
+	collect = func(node *storageNode) {
+		for _, c := range node.children {
+			switch {
+			case c.isText:
+			case c.name == "tr":
+				var cells []string
+				for _, cell := range c.children {
+					if !cell.isText && (cell.name == "td" || cell.name == "th") {
+						text := mdWhitespacePattern.ReplaceAllString(renderStorageBlocks(cell.children, " "), " ")
+						cells = append(cells, strings.ReplaceAll(strings.TrimSpace(text), "|", "\\|"))
+					}
+				}
+				rows = append(rows, cells)
+			case c.name == "thead" || c.name == "tbody" || c.name == "tfoot":
+				collect(c)
+			}
+		}
+	}
+	collect(n)
+
+	if len(rows) == 0 {
+		return ""
+	}
+
+	columns := 0
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	var b strings.Builder
+	writeRow := func(row []string) {
+		b.WriteString("|")
+		for col := 0; col < columns; col++ {
+			cell := ""
+			if col < len(row) {
+				cell = row[col]
+			}
+			b.WriteString(" " + cell + " |")
+		}
+		b.WriteString("\n")
+	}
+
+	writeRow(rows[0])
+	b.WriteString("|" + strings.Repeat(" --- |", columns) + "\n")
+	for _, row := range rows[1:] {
+		writeRow(row)
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func renderStorageTasks(n *storageNode) string {
+	var tasks []string
+	for _, task := range n.children {
+		if task.isText || task.name != "ac:task" {
+			continue
+		}
+
+		check := "[ ]"
+		if status := task.child("ac:task-status"); status != nil && strings.TrimSpace(status.textContent()) == "complete" {
+			check = "[x]"
+		}
+
+		body := ""
+		if b := task.child("ac:task-body"); b != nil {
+			body = strings.TrimSpace(renderStorageInline(b.children))
+		}
+		tasks = append(tasks, fmt.Sprintf("- %s %s", check, body))
+	}
+	return strings.Join(tasks, "\n")
+}
+
+func renderStorageMacro(n *storageNode) string {
+	name := n.attrs["ac:name"]
+	params := n.macroParams()
+
+	if skippedMacros[name] {
+		return ""
+	}
+
+	switch name {
+	case "code", "noformat":
+		body := ""
+		if b := n.child("ac:plain-text-body"); b != nil {
+			body = b.textContent()
+		}
+		return fenceCode(body, params["language"])
+
+	case "info", "tip", "note", "warning":
+		header := "[!" + panelAlerts[name] + "]"
+		if title := params["title"]; title != "" {
+			header += " " + title
+		}
+		return prefixLines(header+"\n"+renderMacroBody(n), "> ")
+
+	case "panel":
+		return prefixLines(renderMacroBody(n), "> ")
+
+	case "expand":
+		title := params["title"]
+		if title == "" {
+			title = "Expand"
+		}
+		return "**" + title + "**\n\n" + renderMacroBody(n)
+	}
+
+	return renderMacroBody(n)
+}
+
+// renderMacroBody renders the rich text or plain text body of a macro
+func renderMacroBody(n *storageNode) string {
+	if body := n.child("ac:rich-text-body"); body != nil {
+		return renderStorageBlocks(body.children, "\n\n")
+	}
+	if body := n.child("ac:plain-text-body"); body != nil {
+		return strings.TrimSpace(body.textContent())
+	}
+	return ""
+}
+
+func renderStorageInline(nodes []*storageNode) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		b.WriteString(renderStorageInlineNode(n))
+	}
+	return b.String()
+}
+
+func renderStorageInlineNode(n *storageNode) string {
+	if n.isText {
+		return mdWhitespacePattern.ReplaceAllString(n.text, " ")
+	}
+
+	inner := func() string { return renderStorageInline(n.children) }
+
+	switch n.name {
+	case "strong", "b":
+		return wrapInline(inner(), "**")
+	case "em", "i":
+		return wrapInline(inner(), "*")
+	case "del", "s", "strike":
+		return wrapInline(inner(), "~~")
+	case "code", "tt":
+		return wrapInline(n.textContent(), "`")
+	case "br":
+		return "  \n"
+	case "a":
+		text := strings.TrimSpace(inner())
+		href := n.attrs["href"]
+		if href == "" {
+			return text
+		}
+		if text == "" {
+			text = href
+		}
+		return fmt.Sprintf("[%s](%s)", text, href)
+	case "img":
+		return fmt.Sprintf("![%s](%s)", n.attrs["alt"], n.attrs["src"])
+	case "time":
+		return n.attrs["datetime"]
+	case "ac:link":
+		return renderStorageLink(n)
+	case "ac:image":
+		return renderStorageImage(n)
+	case "ac:emoticon":
+		if fallback := n.attrs["ac:emoji-fallback"]; fallback != "" {
+			return fallback
+		}
+		return ":" + n.attrs["ac:name"] + ":"
+	case "ac:structured-macro", "ac:macro":
+		params := n.macroParams()
+		switch n.attrs["ac:name"] {
+		case "status":
+			return "[" + params["title"] + "]"
+		case "jira":
+			return params["key"]
+		case "anchor":
+			return ""
+		}
+		return renderMacroBody(n)
+	case "ac:parameter", "ac:placeholder":
+		return ""
+	}
+
+	if isStorageBlock(n) {
+		return " " + renderStorageBlocks(n.children, " ") + " "
+	}
+	return inner()
+}
+
+// renderStorageLink renders an ac:link to a page, attachment, user, or URL
+func renderStorageLink(n *storageNode) string {
+	text := ""
+	if body := n.child("ac:plain-text-link-body"); body != nil {
+		text = strings.TrimSpace(body.textContent())
+	} else if body := n.child("ac:link-body"); body != nil {
+		text = strings.TrimSpace(renderStorageInline(body.children))
+	}
+
+	for _, c := range n.children {
+		if c.isText {
+			continue
+		}
+		switch c.name {
+		case "ri:url":
+			if text == "" {
+				text = c.attrs["ri:value"]
+			}
+			return fmt.Sprintf("[%s](%s)", text, c.attrs["ri:value"])
+		case "ri:page", "ri:blog-post":
+			if text == "" {
+				text = c.attrs["ri:content-title"]
+			}
+		case "ri:attachment":
+			if text == "" {
+				text = c.attrs["ri:filename"]
+			}
+		case "ri:user":
+			if text == "" {
+				for _, key := range []string{"ri:username", "ri:account-id", "ri:userkey"} {
+					if c.attrs[key] != "" {
+						text = "@" + c.attrs[key]
+						break
+					}
+				}
+			}
+		}
+	}
+
+	if text == "" {
+		text = n.attrs["ac:anchor"]
+	}
+	return text
+}
+
+// renderStorageImage renders an ac:image from a URL or attachment
+func renderStorageImage(n *storageNode) string {
+	src := ""
+	if url := n.child("ri:url"); url != nil {
+		src = url.attrs["ri:value"]
+	} else if attachment := n.child("ri:attachment"); attachment != nil {
+		src = attachment.attrs["ri:filename"]
+	}
+	return fmt.Sprintf("![%s](%s)", n.attrs["ac:alt"], src)
+}
+
+// wrapInline wraps text in a markdown delimiter, keeping surrounding spaces
+// outside the delimiters
+func wrapInline(text, delim string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	leading := text[:strings.Index(text, trimmed)]
+	trailing := text[len(leading)+len(trimmed):]
+	return leading + delim + trimmed + delim + trailing
+}
+
+// fenceCode renders a fenced code block, choosing a fence that does not occur
+// in the code
+func fenceCode(code, language string) string {
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	return fence + language + "\n" + strings.Trim(code, "\n") + "\n" + fence
+}
+
+// prefixLines prefixes every line of text, e.g. with "> " for block quotes
+func prefixLines(text, prefix string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(prefix+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
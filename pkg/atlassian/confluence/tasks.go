@@ -0,0 +1,332 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// TaskStatus is the status of an inline task
+type TaskStatus string
+
+const (
+	TaskStatusIncomplete TaskStatus = "incomplete"
+	TaskStatusComplete   TaskStatus = "complete"
+)
+
+// Task is an inline task (action item) of a page or blog post
+type Task struct {
+	ID          string     `json:"id"`
+	LocalID     string     `json:"localId,omitempty"` // ID of the task within its page
+	SpaceID     string     `json:"spaceId,omitempty"`
+	PageID      string     `json:"pageId,omitempty"`
+	BlogPostID  string     `json:"blogPostId,omitempty"`
+	Status      TaskStatus `json:"status"`
+	Body        *Body      `json:"body,omitempty"`
+	Text        string     `json:"text,omitempty"`       // Plain text of the task body
+	CreatedBy   string     `json:"createdBy,omitempty"`  // Cloud only
+	AssignedTo  string     `json:"assignedTo,omitempty"` // Account ID on Cloud, user key on Server/DC
+	CompletedBy string     `json:"completedBy,omitempty"`
+	CreatedAt   string     `json:"createdAt,omitempty"`
+	UpdatedAt   string     `json:"updatedAt,omitempty"`
+	DueAt       string     `json:"dueAt,omitempty"`
+	CompletedAt string     `json:"completedAt,omitempty"`
+}
+
+// GetTasksOptions contains options for listing inline tasks
+type GetTasksOptions struct {
+	PageID   string     // Only tasks of this page (required on Server/DC)
+	SpaceID  string     // Only tasks of this space (Cloud only)
+	Assignee string     // Account ID on Cloud, username on Server/DC
+	Status   TaskStatus // Only tasks with this status
+	Limit    int        // Maximum number of tasks
+}
+
+// GetTasks lists inline tasks. On Cloud tasks are searched with the v2 tasks
+// API; Server/DC has no task search API, so the tasks are read from the
+// storage format of a single page.
+func (c *Client) GetTasks(ctx context.Context, opts *GetTasksOptions) ([]Task, error) {
+	if opts == nil {
+		opts = &GetTasksOptions{}
+	}
+	if c.IsCloud() {
+		return c.getTasksV2(ctx, opts)
+	}
+	if opts.PageID == "" {
+		return nil, fmt.Errorf("a page ID is required to list tasks on Confluence Server/Data Center")
+	}
+	if opts.SpaceID != "" {
+		return nil, fmt.Errorf("tasks can only be listed by space on Confluence Cloud")
+	}
+
+	page, err := c.GetPage(ctx, opts.PageID, []string{"body.storage"})
+	if err != nil {
+		return nil, err
+	}
+	storage := ""
+	if page.Body != nil && page.Body.Storage != nil {
+		storage = page.Body.Storage.Value
+	}
+	tasks, err := storageTasks(storage)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignees []string
+	if opts.Assignee != "" {
+		// Task bodies mention users by user key, so match that as well
+		assignees = []string{opts.Assignee}
+		if user, err := c.GetUser(ctx, opts.Assignee); err == nil && user.UserKey != "" {
+			assignees = append(assignees, user.UserKey)
+		}
+	}
+
+	var filtered []Task
+	for _, task := range tasks {
+		if opts.Status != "" && task.Status != opts.Status {
+			continue
+		}
+		if len(assignees) > 0 && !containsString(assignees, task.AssignedTo) {
+			continue
+		}
+		task.PageID = opts.PageID
+		filtered = append(filtered, task)
+		if opts.Limit > 0 && len(filtered) >= opts.Limit {
+			break
+		}
+	}
+	return filtered, nil
+}
+
+// getTasksV2 searches tasks with the Cloud v2 API, following the result
+// cursor until the limit is reached
+func (c *Client) getTasksV2(ctx context.Context, opts *GetTasksOptions) ([]Task, error) {
+	params := map[string]string{"body-format": "storage"}
+	if opts.PageID != "" {
+		params["page-id"] = opts.PageID
+	}
+	if opts.SpaceID != "" {
+		params["space-id"] = opts.SpaceID
+	}
+	if opts.Assignee != "" {
+		params["assigned-to"] = opts.Assignee
+	}
+	if opts.Status != "" {
+		params["status"] = string(opts.Status)
+	}
+	if opts.Limit > 0 && opts.Limit < 250 {
+		params["limit"] = fmt.Sprintf("%d", opts.Limit)
+	}
+
+	var tasks []Task
+	path := buildURL(apiV2Path+"/tasks", params)
+	for path != "" {
+		var response struct {
+			Results []Task `json:"results"`
+			Links   struct {
+				Next string `json:"next"`
+			} `json:"_links"`
+		}
+		if err := c.doRequest(ctx, "GET", path, nil, &response); err != nil {
+			return nil, fmt.Errorf("failed to get tasks: %w", err)
+		}
+
+		for _, task := range response.Results {
+			task.fillText()
+			tasks = append(tasks, task)
+			if opts.Limit > 0 && len(tasks) >= opts.Limit {
+				return tasks, nil
+			}
+		}
+		// The next link is relative to the site, including the /wiki context
+		path = strings.TrimPrefix(response.Links.Next, "/wiki")
+	}
+	return tasks, nil
+}
+
+// CompleteTask marks an inline task as complete. On Cloud taskID is the
+// global task ID; on Server/DC it is the task's ID within the page, and the
+// page is updated as a minor edit.
+func (c *Client) CompleteTask(ctx context.Context, pageID, taskID string) (*Task, error) {
+	if c.IsCloud() {
+		return c.completeTaskV2(ctx, taskID)
+	}
+	if pageID == "" {
+		return nil, fmt.Errorf("a page ID is required to complete tasks on Confluence Server/Data Center")
+	}
+
+	page, err := c.GetPage(ctx, pageID, []string{"body.storage", "version"})
+	if err != nil {
+		return nil, err
+	}
+	if page.Body == nil || page.Body.Storage == nil || page.Version == nil {
+		return nil, fmt.Errorf("page %s has no content", pageID)
+	}
+
+	storage, ok := setStorageTaskStatus(page.Body.Storage.Value, taskID, TaskStatusComplete)
+	if !ok {
+		return nil, fmt.Errorf("task %s was not found on page %s", taskID, pageID)
+	}
+	if _, err := c.UpdatePageWithOptions(ctx, pageID, page.Title, storage, page.Version.Number+1, &UpdatePageOptions{MinorEdit: true}); err != nil {
+		return nil, fmt.Errorf("failed to complete task %s: %w", taskID, err)
+	}
+
+	tasks, err := storageTasks(storage)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		if task.ID == taskID {
+			task.PageID = pageID
+			return &task, nil
+		}
+	}
+	return nil, fmt.Errorf("task %s was not found on page %s", taskID, pageID)
+}
+
+// completeTaskV2 completes a task with the Cloud v2 API, which replaces the
+// whole task, so the current task is fetched first
+func (c *Client) completeTaskV2(ctx context.Context, taskID string) (*Task, error) {
+	path := fmt.Sprintf("%s/tasks/%s", apiV2Path, taskID)
+
+	var task Task
+	if err := c.doRequest(ctx, "GET", path, nil, &task); err != nil {
+		return nil, fmt.Errorf("failed to get task %s: %w", taskID, err)
+	}
+
+	task.Status = TaskStatusComplete
+	task.Body = nil
+	task.Text = ""
+	reqBody, err := json.Marshal(task)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task: %w", err)
+	}
+
+	var updated Task
+	if err := c.doRequest(ctx, "PUT", buildURL(path, map[string]string{"body-format": "storage"}), reqBody, &updated); err != nil {
+		return nil, fmt.Errorf("failed to complete task %s: %w", taskID, err)
+	}
+	updated.fillText()
+	return &updated, nil
+}
+
+// fillText sets the plain text of a task from its storage body
+func (t *Task) fillText() {
+	if t.Body == nil || t.Body.Storage == nil {
+		return
+	}
+	if text, err := storageText(t.Body.Storage.Value); err == nil {
+		t.Text = strings.TrimSpace(text)
+	}
+}
+
+// storageTasks returns the inline tasks of a storage document, including
+// tasks nested in other tasks
+func storageTasks(storage string) ([]Task, error) {
+	root, err := parseStorage(storage)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []Task
+	var walk func(n *storageNode)
+	walk = func(n *storageNode) {
+		for _, c := range n.children {
+			if c.isText {
+				continue
+			}
+			if c.name == "ac:task" {
+				tasks = append(tasks, storageTask(c))
+			}
+			walk(c)
+		}
+	}
+	walk(root)
+	return tasks, nil
+}
+
+func storageTask(n *storageNode) Task {
+	task := Task{Status: TaskStatusIncomplete}
+	if id := n.child("ac:task-id"); id != nil {
+		task.ID = strings.TrimSpace(id.textContent())
+		task.LocalID = task.ID
+	}
+	if status := n.child("ac:task-status"); status != nil && strings.TrimSpace(status.textContent()) == string(TaskStatusComplete) {
+		task.Status = TaskStatusComplete
+	}
+
+	body := n.child("ac:task-body")
+	if body == nil {
+		return task
+	}
+	// Leave out nested task lists, which are tasks of their own
+	var text strings.Builder
+	for _, c := range body.children {
+		if !c.isText && c.name == "ac:task-list" {
+			continue
+		}
+		text.WriteString(c.textContent())
+	}
+	task.Text = strings.TrimSpace(text.String())
+
+	// The first mentioned user is the assignee and the first date the due date
+	var find func(n *storageNode)
+	find = func(n *storageNode) {
+		for _, c := range n.children {
+			if c.isText || c.name == "ac:task-list" {
+				continue
+			}
+			switch c.name {
+			case "ri:user":
+				if task.AssignedTo == "" {
+					for _, key := range []string{"ri:userkey", "ri:account-id", "ri:username"} {
+						if c.attrs[key] != "" {
+							task.AssignedTo = c.attrs[key]
+							break
+						}
+					}
+				}
+			case "time":
+				if task.DueAt == "" {
+					task.DueAt = c.attrs["datetime"]
+				}
+			}
+			find(c)
+		}
+	}
+	find(body)
+	return task
+}
+
+var storageTaskStatusPattern = regexp.MustCompile(`<ac:task-status>[^<]*</ac:task-status>`)
+
+// setStorageTaskStatus sets the status of the task with the given ID in a
+// storage document, reporting whether the task was found
+func setStorageTaskStatus(storage, taskID string, status TaskStatus) (string, bool) {
+	idPattern := regexp.MustCompile(`<ac:task-id>\s*` + regexp.QuoteMeta(taskID) + `\s*</ac:task-id>`)
+	loc := idPattern.FindStringIndex(storage)
+	if loc == nil {
+		return storage, false
+	}
+
+	rest := storage[loc[1]:]
+	statusLoc := storageTaskStatusPattern.FindStringIndex(rest)
+	// The status follows the ID of the same task, before any other task starts
+	if statusLoc == nil || strings.Contains(rest[:statusLoc[0]], "<ac:task>") {
+		return storage, false
+	}
+
+	replacement := "<ac:task-status>" + string(status) + "</ac:task-status>"
+	return storage[:loc[1]] + rest[:statusLoc[0]] + replacement + rest[statusLoc[1]:], true
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
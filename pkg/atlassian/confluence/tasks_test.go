@@ -0,0 +1,200 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const assignedTasks = `<ac:task-list>
+<ac:task><ac:task-id>1</ac:task-id><ac:task-status>incomplete</ac:task-status><ac:task-body><ac:link><ri:user ri:userkey="key1" /></ac:link> fix the build by <time datetime="2024-05-01" /></ac:task-body></ac:task>
+<ac:task><ac:task-id>2</ac:task-id><ac:task-status>complete</ac:task-status><ac:task-body>Book the room
+<ac:task-list><ac:task><ac:task-id>3</ac:task-id><ac:task-status>incomplete</ac:task-status><ac:task-body>Order <ac:link><ri:user ri:userkey="key2" /></ac:link> lunch</ac:task-body></ac:task></ac:task-list>
+</ac:task-body></ac:task>
+</ac:task-list>`
+
+func TestStorageTasks(t *testing.T) {
+	tasks, err := storageTasks(assignedTasks)
+	if err != nil {
+		t.Fatalf("storageTasks() error = %v", err)
+	}
+	if len(tasks) != 3 {
+		t.Fatalf("storageTasks() = %+v, want 3 tasks", tasks)
+	}
+
+	if tasks[0].ID != "1" || tasks[0].Status != TaskStatusIncomplete || tasks[0].AssignedTo != "key1" || tasks[0].DueAt != "2024-05-01" {
+		t.Errorf("tasks[0] = %+v", tasks[0])
+	}
+	if tasks[1].Status != TaskStatusComplete || tasks[1].Text != "Book the room" || tasks[1].AssignedTo != "" {
+		t.Errorf("nested tasks should not belong to their parent, got %+v", tasks[1])
+	}
+	if tasks[2].ID != "3" || tasks[2].AssignedTo != "key2" {
+		t.Errorf("tasks[2] = %+v", tasks[2])
+	}
+}
+
+func TestSetStorageTaskStatus(t *testing.T) {
+	got, ok := setStorageTaskStatus(assignedTasks, "3", TaskStatusComplete)
+	if !ok {
+		t.Fatal("setStorageTaskStatus() did not find task 3")
+	}
+	want := strings.Replace(assignedTasks, "<ac:task-id>3</ac:task-id><ac:task-status>incomplete", "<ac:task-id>3</ac:task-id><ac:task-status>complete", 1)
+	if got != want {
+		t.Errorf("setStorageTaskStatus() =\n%s\nwant\n%s", got, want)
+	}
+
+	if _, ok := setStorageTaskStatus(assignedTasks, "4", TaskStatusComplete); ok {
+		t.Error("setStorageTaskStatus() found a missing task")
+	}
+}
+
+func TestGetTasksCloud(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/tasks" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			w.Write([]byte(`{"results": [{"id": "10", "pageId": "1", "status": "incomplete", "assignedTo": "abc",
+				"body": {"storage": {"value": "<p>Fix <strong>it</strong></p>", "representation": "storage"}}}],
+				"_links": {"next": "/wiki/api/v2/tasks?cursor=next"}}`))
+			return
+		}
+		w.Write([]byte(`{"results": [{"id": "11", "pageId": "2", "status": "incomplete", "assignedTo": "abc"}], "_links": {}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.deploymentType = DeploymentCloud
+
+	tasks, err := client.GetTasks(context.Background(), &GetTasksOptions{Assignee: "abc", Status: TaskStatusIncomplete})
+	if err != nil {
+		t.Fatalf("GetTasks() error = %v", err)
+	}
+	if len(tasks) != 2 || tasks[0].Text != "Fix it" || tasks[1].ID != "11" {
+		t.Errorf("GetTasks() = %+v", tasks)
+	}
+	if len(queries) != 2 || !strings.Contains(queries[0], "assigned-to=abc") || !strings.Contains(queries[0], "status=incomplete") {
+		t.Errorf("queries = %v", queries)
+	}
+}
+
+func TestGetTasksServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/content/1":
+			body, _ := json.Marshal(map[string]interface{}{
+				"id": "1", "title": "Notes",
+				"body": map[string]interface{}{"storage": map[string]string{"value": assignedTasks, "representation": "storage"}},
+			})
+			w.Write(body)
+		case "/rest/api/user":
+			w.Write([]byte(`{"username": "jdoe", "userKey": "key2"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := client.GetTasks(ctx, &GetTasksOptions{Assignee: "jdoe"}); err == nil {
+		t.Error("GetTasks() without a page should fail on Server")
+	}
+
+	tasks, err := client.GetTasks(ctx, &GetTasksOptions{PageID: "1", Assignee: "jdoe", Status: TaskStatusIncomplete})
+	if err != nil {
+		t.Fatalf("GetTasks() error = %v", err)
+	}
+	if len(tasks) != 1 || tasks[0].ID != "3" || tasks[0].PageID != "1" {
+		t.Errorf("GetTasks() = %+v, want task 3 of jdoe", tasks)
+	}
+}
+
+func TestCompleteTask(t *testing.T) {
+	t.Run("cloud", func(t *testing.T) {
+		var sent map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.Method {
+			case http.MethodGet:
+				w.Write([]byte(`{"id": "10", "localId": "1", "pageId": "1", "status": "incomplete", "assignedTo": "abc"}`))
+			case http.MethodPut:
+				data, _ := io.ReadAll(r.Body)
+				json.Unmarshal(data, &sent)
+				w.Write([]byte(`{"id": "10", "pageId": "1", "status": "complete", "completedBy": "abc"}`))
+			}
+		}))
+		defer server.Close()
+
+		client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		client.deploymentType = DeploymentCloud
+
+		task, err := client.CompleteTask(context.Background(), "", "10")
+		if err != nil || task.Status != TaskStatusComplete {
+			t.Fatalf("CompleteTask() = %+v, %v", task, err)
+		}
+		if sent["status"] != "complete" || sent["assignedTo"] != "abc" || sent["localId"] != "1" {
+			t.Errorf("update should keep the task and change its status, got %v", sent)
+		}
+	})
+
+	t.Run("server", func(t *testing.T) {
+		var sent UpdateContentRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.Method {
+			case http.MethodGet:
+				body, _ := json.Marshal(map[string]interface{}{
+					"id": "1", "title": "Notes", "version": map[string]int{"number": 4},
+					"body": map[string]interface{}{"storage": map[string]string{"value": assignedTasks, "representation": "storage"}},
+				})
+				w.Write(body)
+			case http.MethodPut:
+				json.NewDecoder(r.Body).Decode(&sent)
+				w.Write([]byte(`{"id": "1", "title": "Notes", "version": {"number": 5}}`))
+			}
+		}))
+		defer server.Close()
+
+		client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+
+		task, err := client.CompleteTask(context.Background(), "1", "1")
+		if err != nil || task.Status != TaskStatusComplete || task.AssignedTo != "key1" {
+			t.Fatalf("CompleteTask() = %+v, %v", task, err)
+		}
+		if sent.Version == nil || sent.Version.Number != 5 || !sent.Version.MinorEdit {
+			t.Errorf("Expected a minor edit to version 5, got %+v", sent.Version)
+		}
+		if sent.Title != "Notes" || !strings.Contains(sent.Body.Storage.Value, "<ac:task-id>1</ac:task-id><ac:task-status>complete") {
+			t.Errorf("update = %+v", sent.Body.Storage)
+		}
+
+		if _, err := client.CompleteTask(context.Background(), "1", "9"); err == nil {
+			t.Error("CompleteTask() of a missing task should fail")
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+)
+
+var (
+	// templateVarPattern matches template variables such as <at:var at:name="owner" />
+	templateVarPattern = regexp.MustCompile(`<at:var\s+at:name="([^"]*)"[^>]*?(?:/>|>\s*</at:var>)`)
+
+	// templateDeclarationsPattern matches the variable declarations block of a template
+	templateDeclarationsPattern = regexp.MustCompile(`(?s)<at:declarations>.*?</at:declarations>`)
+)
+
+// GetPageTemplates retrieves page templates. With a space key, the space's
+// templates are returned; without one, the global templates are returned.
+func (c *Client) GetPageTemplates(ctx context.Context, spaceKey string, start, limit int) (*ContentTemplateArray, error) {
+	path := fmt.Sprintf("%s/template/page", c.getAPIPath())
+
+	params := make(map[string]string)
+	if spaceKey != "" {
+		params["spaceKey"] = spaceKey
+	}
+	if start > 0 {
+		params["start"] = fmt.Sprintf("%d", start)
+	}
+	if limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", limit)
+	}
+	path = buildURL(path, params)
+
+	var result ContentTemplateArray
+	if err := c.doRequest(ctx, "GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get page templates: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetContentTemplate retrieves a template by ID, including its body
+func (c *Client) GetContentTemplate(ctx context.Context, templateID string) (*ContentTemplate, error) {
+	path := fmt.Sprintf("%s/template/%s", c.getAPIPath(), templateID)
+	path = buildURL(path, map[string]string{"expand": "body"})
+
+	var template ContentTemplate
+	if err := c.doRequest(ctx, "GET", path, nil, &template); err != nil {
+		return nil, fmt.Errorf("failed to get template %s: %w", templateID, err)
+	}
+
+	return &template, nil
+}
+
+// CreatePageFromTemplate creates a page from a template, substituting the
+// template's variables. It returns the created page and the names of any
+// variables that had no value (those are left empty in the page).
+func (c *Client) CreatePageFromTemplate(ctx context.Context, templateID, spaceKey, title, parentID string, vars map[string]string) (*Content, []string, error) {
+	template, err := c.GetContentTemplate(ctx, templateID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if template.Body == nil || template.Body.Storage == nil {
+		return nil, nil, fmt.Errorf("template %s has no storage body", templateID)
+	}
+
+	body, missing := ApplyTemplateVariables(template.Body.Storage.Value, vars)
+
+	page, err := c.CreatePage(ctx, spaceKey, title, body, parentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return page, missing, nil
+}
+
+// ApplyTemplateVariables replaces the <at:var> variables in a template body
+// with the given values and strips the variable declarations. Values are
+// inserted as escaped text. The sorted names of variables without a value are
+// returned.
+func ApplyTemplateVariables(storage string, vars map[string]string) (string, []string) {
+	missingSet := make(map[string]bool)
+
+	body := templateDeclarationsPattern.ReplaceAllString(storage, "")
+	body = templateVarPattern.ReplaceAllStringFunc(body, func(match string) string {
+		name := html.UnescapeString(templateVarPattern.FindStringSubmatch(match)[1])
+		value, ok := vars[name]
+		if !ok {
+			missingSet[name] = true
+			return ""
+		}
+		return html.EscapeString(value)
+	})
+
+	var missing []string
+	for name := range missingSet {
+		missing = append(missing, name)
+	}
+	sort.Strings(missing)
+
+	return body, missing
+}
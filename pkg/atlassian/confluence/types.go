@@ -1,6 +1,9 @@
 package confluence
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // DeploymentType represents the Confluence deployment type
 type DeploymentType string
@@ -34,11 +37,12 @@ const (
 type ContentFormat string
 
 const (
-	FormatStorage ContentFormat = "storage" // Confluence storage format (XHTML)
-	FormatView    ContentFormat = "view"    // HTML view format
-	FormatExport  ContentFormat = "export_view"
-	FormatEditor  ContentFormat = "editor"
-	FormatWiki    ContentFormat = "wiki" // Wiki markup (legacy)
+	FormatStorage  ContentFormat = "storage" // Confluence storage format (XHTML)
+	FormatView     ContentFormat = "view"    // HTML view format
+	FormatExport   ContentFormat = "export_view"
+	FormatEditor   ContentFormat = "editor"
+	FormatWiki     ContentFormat = "wiki"     // Wiki markup (legacy)
+	FormatMarkdown ContentFormat = "markdown" // Markdown (converted locally, not an API representation)
 )
 
 // Content represents a piece of Confluence content (page, blogpost, comment, etc.)
@@ -116,6 +120,7 @@ type Body struct {
 	Editor2             *BodyContent `json:"editor2,omitempty"`
 	AnonymousExportView *BodyContent `json:"anonymous_export_view,omitempty"`
 	Wiki                *BodyContent `json:"wiki,omitempty"`
+	Markdown            *BodyContent `json:"markdown,omitempty"` // Set by Content.ConvertBodyToMarkdown
 }
 
 // BodyContent represents the actual content in a specific format
@@ -206,15 +211,45 @@ type Label struct {
 
 // Comment represents a comment
 type Comment struct {
-	ID         string      `json:"id"`
-	Type       string      `json:"type"`
-	Status     string      `json:"status,omitempty"`
-	Title      string      `json:"title,omitempty"`
-	Body       *Body       `json:"body,omitempty"`
-	Version    *Version    `json:"version,omitempty"`
-	Container  *Container  `json:"container,omitempty"`
-	Links      *Links      `json:"_links,omitempty"`
-	Expandable *Expandable `json:"_expandable,omitempty"`
+	ID         string             `json:"id"`
+	Type       string             `json:"type"`
+	Status     string             `json:"status,omitempty"`
+	Title      string             `json:"title,omitempty"`
+	Body       *Body              `json:"body,omitempty"`
+	Version    *Version           `json:"version,omitempty"`
+	Container  *Container         `json:"container,omitempty"`
+	Ancestors  []ContentRef       `json:"ancestors,omitempty"` // Parent comments of a reply, outermost first
+	Extensions *CommentExtensions `json:"extensions,omitempty"`
+	Links      *Links             `json:"_links,omitempty"`
+	Expandable *Expandable        `json:"_expandable,omitempty"`
+}
+
+// CommentLocation identifies where a comment is displayed on a page
+type CommentLocation string
+
+const (
+	CommentLocationFooter   CommentLocation = "footer"
+	CommentLocationInline   CommentLocation = "inline"
+	CommentLocationResolved CommentLocation = "resolved"
+)
+
+// CommentExtensions holds the location and inline anchor of a comment
+type CommentExtensions struct {
+	Location         CommentLocation          `json:"location,omitempty"`
+	InlineProperties *CommentInlineProperties `json:"inlineProperties,omitempty"`
+	Resolution       *CommentResolution       `json:"resolution,omitempty"`
+}
+
+// CommentInlineProperties describes the text an inline comment is anchored to
+type CommentInlineProperties struct {
+	OriginalSelection string `json:"originalSelection,omitempty"`
+	MarkerRef         string `json:"markerRef,omitempty"`
+}
+
+// CommentResolution represents the resolution state of an inline comment
+type CommentResolution struct {
+	Status           string `json:"status,omitempty"`
+	LastModifiedDate string `json:"lastModifiedDate,omitempty"`
 }
 
 // Children represents child content
@@ -297,6 +332,7 @@ type Links struct {
 	TinyUI     string `json:"tinyui,omitempty"`
 	Collection string `json:"collection,omitempty"`
 	Download   string `json:"download,omitempty"`
+	Next       string `json:"next,omitempty"`
 }
 
 // Expandable represents expandable fields
@@ -314,6 +350,15 @@ type Expandable struct {
 	Space        string `json:"space,omitempty"`
 }
 
+// PageTreeNode represents a page and its descendants
+type PageTreeNode struct {
+	ID              string          `json:"id"`
+	Title           string          `json:"title"`
+	Children        []*PageTreeNode `json:"children,omitempty"`
+	HasMoreChildren bool            `json:"hasMoreChildren,omitempty"`
+	Unexplored      bool            `json:"unexplored,omitempty"` // Children not fetched: the tree reached its page cap
+}
+
 // SearchResult represents search results
 type SearchResult struct {
 	Results        []Content `json:"results"`
@@ -323,9 +368,35 @@ type SearchResult struct {
 	TotalSize      int       `json:"totalSize,omitempty"`
 	CqlQuery       string    `json:"cqlQuery,omitempty"`
 	SearchDuration int       `json:"searchDuration,omitempty"`
+	NextCursor     string    `json:"nextCursor,omitempty"` // Cursor for the next page, parsed from _links.next
 	Links          *Links    `json:"_links,omitempty"`
 }
 
+// SiteSearchResult represents results of the site search API, which returns
+// excerpts alongside the matching content
+type SiteSearchResult struct {
+	Results        []SiteSearchItem `json:"results"`
+	Start          int              `json:"start"`
+	Limit          int              `json:"limit"`
+	Size           int              `json:"size"`
+	TotalSize      int              `json:"totalSize,omitempty"`
+	CqlQuery       string           `json:"cqlQuery,omitempty"`
+	SearchDuration int              `json:"searchDuration,omitempty"`
+	NextCursor     string           `json:"nextCursor,omitempty"` // Cursor for the next page, parsed from _links.next
+	Links          *Links           `json:"_links,omitempty"`
+}
+
+// SiteSearchItem represents a single site search result
+type SiteSearchItem struct {
+	Content              *Content `json:"content,omitempty"`
+	Title                string   `json:"title"`
+	Excerpt              string   `json:"excerpt,omitempty"`
+	URL                  string   `json:"url,omitempty"`
+	EntityType           string   `json:"entityType,omitempty"`
+	LastModified         string   `json:"lastModified,omitempty"`
+	FriendlyLastModified string   `json:"friendlyLastModified,omitempty"`
+}
+
 // CreateContentRequest represents a request to create content
 type CreateContentRequest struct {
 	Type      ContentType   `json:"type"`
@@ -370,6 +441,143 @@ type CreateCommentRequest struct {
 	Ancestors []ContentRef `json:"ancestors,omitempty"`
 }
 
+// CreateCommentV2Request represents a request to create a footer or inline
+// comment with the Cloud v2 API
+type CreateCommentV2Request struct {
+	PageID                  string                   `json:"pageId,omitempty"`
+	ParentCommentID         string                   `json:"parentCommentId,omitempty"`
+	Body                    *BodyContent             `json:"body"`
+	InlineCommentProperties *InlineCommentProperties `json:"inlineCommentProperties,omitempty"`
+}
+
+// InlineCommentProperties selects the text an inline comment is anchored to.
+// MatchIndex picks one occurrence when the selection appears MatchCount times.
+type InlineCommentProperties struct {
+	TextSelection           string `json:"textSelection"`
+	TextSelectionMatchCount int    `json:"textSelectionMatchCount"`
+	TextSelectionMatchIndex int    `json:"textSelectionMatchIndex"`
+}
+
+// CommentV2 represents a comment returned by the Cloud v2 API
+type CommentV2 struct {
+	ID              string `json:"id"`
+	Status          string `json:"status,omitempty"`
+	Title           string `json:"title,omitempty"`
+	PageID          string `json:"pageId,omitempty"`
+	ParentCommentID string `json:"parentCommentId,omitempty"`
+	Version         *struct {
+		Number int `json:"number"`
+	} `json:"version,omitempty"`
+	Properties *struct {
+		InlineMarkerRef         string `json:"inlineMarkerRef,omitempty"`
+		InlineOriginalSelection string `json:"inlineOriginalSelection,omitempty"`
+	} `json:"properties,omitempty"`
+	Links *Links `json:"_links,omitempty"`
+}
+
+// ContentRestrictionArray represents a list of content restrictions
+type ContentRestrictionArray struct {
+	Results []ContentRestriction `json:"results"`
+	Start   int                  `json:"start,omitempty"`
+	Limit   int                  `json:"limit,omitempty"`
+	Size    int                  `json:"size,omitempty"`
+}
+
+// ContentRestriction represents the users and groups allowed to perform an
+// operation (read or update) on content
+type ContentRestriction struct {
+	Operation    string              `json:"operation"`
+	Restrictions *RestrictionSubject `json:"restrictions,omitempty"`
+}
+
+// RestrictionSubject lists the users and groups of a restriction
+type RestrictionSubject struct {
+	User  *RestrictionUsers  `json:"user,omitempty"`
+	Group *RestrictionGroups `json:"group,omitempty"`
+}
+
+// RestrictionUsers represents the users of a restriction
+type RestrictionUsers struct {
+	Results []User `json:"results"`
+	Size    int    `json:"size,omitempty"`
+}
+
+// RestrictionGroups represents the groups of a restriction
+type RestrictionGroups struct {
+	Results []Group `json:"results"`
+	Size    int     `json:"size,omitempty"`
+}
+
+// Group represents a Confluence group
+type Group struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name"`
+	ID   string `json:"id,omitempty"`
+}
+
+// ContentRestrictionUpdate represents a request to set the restrictions of an operation
+type ContentRestrictionUpdate struct {
+	Operation    string                    `json:"operation"`
+	Restrictions RestrictionUpdateSubjects `json:"restrictions"`
+}
+
+// RestrictionUpdateSubjects lists the users and groups to restrict an operation to
+type RestrictionUpdateSubjects struct {
+	User  []RestrictionUserRef  `json:"user"`
+	Group []RestrictionGroupRef `json:"group"`
+}
+
+// RestrictionUserRef references a user by account ID (Cloud) or username (Server/DC)
+type RestrictionUserRef struct {
+	Type      string `json:"type"`
+	AccountID string `json:"accountId,omitempty"`
+	Username  string `json:"username,omitempty"`
+}
+
+// RestrictionGroupRef references a group by name
+type RestrictionGroupRef struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// ContentProperty represents a JSON property stored on content
+type ContentProperty struct {
+	ID      string          `json:"id,omitempty"`
+	Key     string          `json:"key"`
+	Value   json.RawMessage `json:"value"`
+	Version *Version        `json:"version,omitempty"`
+	Links   *Links          `json:"_links,omitempty"`
+}
+
+// ContentPropertyRequest represents a request to create or update a content property
+type ContentPropertyRequest struct {
+	Key     string      `json:"key"`
+	Value   interface{} `json:"value"`
+	Version *Version    `json:"version,omitempty"`
+}
+
+// ContentTemplate represents a page or blueprint template
+type ContentTemplate struct {
+	TemplateID    string  `json:"templateId"`
+	Name          string  `json:"name"`
+	Description   string  `json:"description,omitempty"`
+	TemplateType  string  `json:"templateType,omitempty"`
+	EditorVersion string  `json:"editorVersion,omitempty"`
+	Labels        []Label `json:"labels,omitempty"`
+	Space         *Space  `json:"space,omitempty"`
+	Body          *Body   `json:"body,omitempty"`
+	Links         *Links  `json:"_links,omitempty"`
+}
+
+// ContentTemplateArray represents a paginated list of templates
+type ContentTemplateArray struct {
+	Results []ContentTemplate `json:"results"`
+	Start   int               `json:"start"`
+	Limit   int               `json:"limit"`
+	Size    int               `json:"size"`
+	Links   *Links            `json:"_links,omitempty"`
+}
+
 // ErrorResponse represents a Confluence error response
 type ErrorResponse struct {
 	StatusCode int        `json:"statusCode,omitempty"`
@@ -0,0 +1,129 @@
+package confluence
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// URLTarget is the content a URL points to, by ID or by space key and title
+type URLTarget struct {
+	ID       string      `json:"id,omitempty"`
+	SpaceKey string      `json:"space_key,omitempty"`
+	Title    string      `json:"title,omitempty"`
+	Type     ContentType `json:"type,omitempty"`
+	TinyID   string      `json:"tiny_id,omitempty"` // Tiny link ID the content ID was decoded from
+}
+
+var (
+	// /spaces/KEY/pages/123/Title and /spaces/KEY/pages/edit-v2/123 (Cloud)
+	prettyPagePattern = regexp.MustCompile(`/spaces/([^/]+)/pages/(?:[a-z0-9-]+/)?(\d+)`)
+	// /spaces/KEY/blog/2024/05/07/123/Title (Cloud)
+	prettyBlogPattern = regexp.MustCompile(`/spaces/([^/]+)/blog/(?:\d{4}/\d{2}/\d{2}/)?(\d+)`)
+	// /display/KEY/2024/05/07/Title (Server/DC blog posts)
+	displayBlogPattern = regexp.MustCompile(`/display/([^/]+)/\d{4}/\d{2}/\d{2}/([^/]+)`)
+	// /display/KEY/Title (Server/DC pages)
+	displayPagePattern = regexp.MustCompile(`/display/([^/]+)/([^/]+)`)
+	// /x/AbCd (tiny links)
+	tinyLinkPattern = regexp.MustCompile(`/x/([A-Za-z0-9_-]+)`)
+)
+
+// ParseContentURL extracts the content a Confluence URL points to. Pretty
+// URLs (/spaces/KEY/pages/123/Title), viewpage.action?pageId=123, display
+// URLs (/display/KEY/Title), and tiny links (/x/AbCd) are understood. Display
+// URLs carry no ID, so they resolve to a space key and title.
+func ParseContentURL(raw string) (*URLTarget, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %q: %w", raw, err)
+	}
+
+	if id := u.Query().Get("pageId"); id != "" {
+		return &URLTarget{ID: id}, nil
+	}
+
+	path := u.EscapedPath()
+	if m := prettyPagePattern.FindStringSubmatch(path); m != nil {
+		return &URLTarget{ID: m[2], SpaceKey: unescapeURLPart(m[1]), Type: ContentTypePage}, nil
+	}
+	if m := prettyBlogPattern.FindStringSubmatch(path); m != nil {
+		return &URLTarget{ID: m[2], SpaceKey: unescapeURLPart(m[1]), Type: ContentTypeBlogPost}, nil
+	}
+	if m := tinyLinkPattern.FindStringSubmatch(path); m != nil {
+		id, err := DecodeTinyID(m[1])
+		if err != nil {
+			return nil, err
+		}
+		return &URLTarget{ID: id, TinyID: m[1]}, nil
+	}
+	if m := displayBlogPattern.FindStringSubmatch(path); m != nil {
+		return &URLTarget{SpaceKey: unescapeURLPart(m[1]), Title: unescapeURLPart(m[2]), Type: ContentTypeBlogPost}, nil
+	}
+	if m := displayPagePattern.FindStringSubmatch(path); m != nil {
+		return &URLTarget{SpaceKey: unescapeURLPart(m[1]), Title: unescapeURLPart(m[2]), Type: ContentTypePage}, nil
+	}
+
+	return nil, fmt.Errorf("not a recognized Confluence content URL: %s", raw)
+}
+
+// unescapeURLPart decodes a path segment, where spaces may be written as "+".
+// The "+" are replaced first, so an escaped plus sign ("%2B") is kept.
+func unescapeURLPart(part string) string {
+	part = strings.ReplaceAll(part, "+", " ")
+	if unescaped, err := url.PathUnescape(part); err == nil {
+		part = unescaped
+	}
+	return part
+}
+
+// DecodeTinyID decodes the ID of a tiny link (/x/AbCd) to a content ID. Tiny
+// IDs are the little-endian bytes of the content ID in base64 with "-" for "/"
+// and "_" for "+", without the trailing zero bytes and padding.
+func DecodeTinyID(tinyID string) (string, error) {
+	if tinyID == "" || len(tinyID) > 11 {
+		return "", fmt.Errorf("invalid tiny link ID %q", tinyID)
+	}
+
+	encoded := strings.NewReplacer("-", "/", "_", "+").Replace(tinyID) + strings.Repeat("A", 12-len(tinyID))
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("invalid tiny link ID %q: %w", tinyID, err)
+	}
+
+	id := binary.LittleEndian.Uint64(data[:8])
+	if id == 0 {
+		return "", fmt.Errorf("invalid tiny link ID %q", tinyID)
+	}
+	return strconv.FormatUint(id, 10), nil
+}
+
+// ResolveContentURL retrieves the content a Confluence URL points to
+func (c *Client) ResolveContentURL(ctx context.Context, raw string, expand []string) (*URLTarget, *Content, error) {
+	ref, err := ParseContentURL(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if ref.ID != "" {
+		content, err := c.GetContent(ctx, ref.ID, &GetContentOptions{Expand: expand})
+		if err != nil {
+			return ref, nil, err
+		}
+		return ref, content, nil
+	}
+
+	cql := fmt.Sprintf("type=%s and space=%s and title=%s", ref.Type, quoteCQLList([]string{ref.SpaceKey}), quoteCQLList([]string{ref.Title}))
+	results, err := c.SearchCQL(ctx, cql, &SearchOptions{Expand: expand, Limit: 1})
+	if err != nil {
+		return ref, nil, err
+	}
+	if len(results.Results) == 0 {
+		return ref, nil, fmt.Errorf("%s not found: %s in space %s", ref.Type, ref.Title, ref.SpaceKey)
+	}
+	return ref, &results.Results[0], nil
+}
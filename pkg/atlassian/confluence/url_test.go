@@ -0,0 +1,66 @@
+package confluence
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseContentURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want URLTarget
+	}{
+		{"Cloud page", "https://example.atlassian.net/wiki/spaces/OPS/pages/123456/Deploy+Runbook", URLTarget{ID: "123456", SpaceKey: "OPS", Type: ContentTypePage}},
+		{"Cloud page editor", "https://example.atlassian.net/wiki/spaces/OPS/pages/edit-v2/123456", URLTarget{ID: "123456", SpaceKey: "OPS", Type: ContentTypePage}},
+		{"Cloud blog post", "https://example.atlassian.net/wiki/spaces/OPS/blog/2024/05/07/98765/Release+Notes", URLTarget{ID: "98765", SpaceKey: "OPS", Type: ContentTypeBlogPost}},
+		{"Personal space", "https://example.atlassian.net/wiki/spaces/~5b1c2d/pages/42", URLTarget{ID: "42", SpaceKey: "~5b1c2d", Type: ContentTypePage}},
+		{"viewpage", "https://wiki.example.com/pages/viewpage.action?pageId=123456", URLTarget{ID: "123456"}},
+		{"tiny link", "https://example.atlassian.net/wiki/x/AgAB", URLTarget{ID: "65538", TinyID: "AgAB"}},
+		{"display page", "https://wiki.example.com/display/OPS/Deploy+Runbook%3A+Steps", URLTarget{SpaceKey: "OPS", Title: "Deploy Runbook: Steps", Type: ContentTypePage}},
+		{"display page with a plus sign", "https://wiki.example.com/display/DEV/C%2B%2B+Style+Guide", URLTarget{SpaceKey: "DEV", Title: "C++ Style Guide", Type: ContentTypePage}},
+		{"display blog post", "https://wiki.example.com/display/OPS/2024/05/07/Release+Notes", URLTarget{SpaceKey: "OPS", Title: "Release Notes", Type: ContentTypeBlogPost}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseContentURL(tt.url)
+			if err != nil {
+				t.Fatalf("ParseContentURL(%s) error = %v", tt.url, err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("ParseContentURL(%s) = %+v, want %+v", tt.url, *got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseContentURL("https://example.atlassian.net/wiki/home"); err == nil {
+		t.Error("ParseContentURL() of a non-content URL should fail")
+	}
+}
+
+func TestDecodeTinyID(t *testing.T) {
+	tests := map[string]string{
+		"AgAB":   "65538",
+		"Fc1bBw": "123456789",
+		"-----w": "4294967295",
+		"--9-Pg": "1048575999",
+	}
+
+	for tinyID, want := range tests {
+		got, err := DecodeTinyID(tinyID)
+		if err != nil {
+			t.Errorf("DecodeTinyID(%s) error = %v", tinyID, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("DecodeTinyID(%s) = %s, want %s", tinyID, got, want)
+		}
+	}
+
+	for _, invalid := range []string{"", "AAAA", "AAAAAAAAAAAAA"} {
+		if _, err := DecodeTinyID(invalid); err == nil {
+			t.Errorf("DecodeTinyID(%q) should fail", invalid)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+)
+
+// watchPath returns the path of the watch endpoint of a content item for a
+// user: an account ID on Cloud, a username on Server/DC. An empty user is
+// the authenticated user.
+func (c *Client) watchPath(contentID, user string) string {
+	path := fmt.Sprintf("%s/user/watch/content/%s", c.getAPIPath(), contentID)
+	if user == "" {
+		return path
+	}
+	if c.IsCloud() {
+		return buildURL(path, map[string]string{"accountId": user})
+	}
+	return buildURL(path, map[string]string{"username": user})
+}
+
+// WatchPage makes a user watch a page, so they are notified when it changes.
+// An empty user is the authenticated user.
+func (c *Client) WatchPage(ctx context.Context, pageID, user string) error {
+	if err := c.doRequest(ctx, "POST", c.watchPath(pageID, user), nil, nil); err != nil {
+		return fmt.Errorf("failed to watch page %s: %w", pageID, err)
+	}
+	return nil
+}
+
+// UnwatchPage stops a user watching a page. An empty user is the
+// authenticated user.
+func (c *Client) UnwatchPage(ctx context.Context, pageID, user string) error {
+	if err := c.doRequest(ctx, "DELETE", c.watchPath(pageID, user), nil, nil); err != nil {
+		return fmt.Errorf("failed to unwatch page %s: %w", pageID, err)
+	}
+	return nil
+}
+
+// IsWatchingPage reports whether a user watches a page. An empty user is the
+// authenticated user.
+func (c *Client) IsWatchingPage(ctx context.Context, pageID, user string) (bool, error) {
+	var result struct {
+		Watching bool `json:"watching"`
+	}
+	if err := c.doRequest(ctx, "GET", c.watchPath(pageID, user), nil, &result); err != nil {
+		return false, fmt.Errorf("failed to get watch status of page %s: %w", pageID, err)
+	}
+	return result.Watching, nil
+}
@@ -0,0 +1,15 @@
+package atlassian
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// ClientDoer sends an HTTP request to a path relative to a product's base URL
+// and returns the response, whatever its status. The product clients send
+// all API requests through one, so tests and embedding programs can replace
+// the default HTTP client (see the atlassiantest package).
+type ClientDoer interface {
+	Do(ctx context.Context, method, path string, body io.Reader) (*http.Response, error)
+}
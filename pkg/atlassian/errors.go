@@ -0,0 +1,98 @@
+// Package atlassian holds the types shared by the Jira, Confluence, and
+// Opsgenie clients.
+package atlassian
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Error kinds. API errors wrap the kind matching their status code, so callers
+// can test for them with errors.Is.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("authentication failed")
+	ErrPermission   = errors.New("permission denied")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrValidation   = errors.New("validation failed")
+	ErrConflict     = errors.New("conflict")
+)
+
+// Machine-readable error codes
+const (
+	CodeNotFound     = "not_found"
+	CodeUnauthorized = "unauthorized"
+	CodePermission   = "permission_denied"
+	CodeRateLimited  = "rate_limited"
+	CodeValidation   = "validation_failed"
+	CodeConflict     = "conflict"
+	CodeAPIError     = "api_error"
+)
+
+// Error is an error response from an Atlassian or Opsgenie API
+type Error struct {
+	StatusCode int
+	Message    string
+	Fields     map[string]string // Per-field messages of validation errors
+}
+
+// NewError creates an API error for an error response
+func NewError(statusCode int, message string, fields map[string]string) *Error {
+	return &Error{StatusCode: statusCode, Message: message, Fields: fields}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
+}
+
+// Unwrap returns the error kind of the status code, or nil
+func (e *Error) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrPermission
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	case http.StatusConflict:
+		return ErrConflict
+	default:
+		return nil
+	}
+}
+
+// ErrorCode returns the machine-readable code of the error
+func (e *Error) ErrorCode() string {
+	switch e.Unwrap() {
+	case ErrNotFound:
+		return CodeNotFound
+	case ErrUnauthorized:
+		return CodeUnauthorized
+	case ErrPermission:
+		return CodePermission
+	case ErrRateLimited:
+		return CodeRateLimited
+	case ErrValidation:
+		return CodeValidation
+	case ErrConflict:
+		return CodeConflict
+	default:
+		return CodeAPIError
+	}
+}
+
+// ErrorDetails returns the structured details of the error
+func (e *Error) ErrorDetails() map[string]interface{} {
+	details := map[string]interface{}{
+		"status": e.StatusCode,
+	}
+	if len(e.Fields) > 0 {
+		details["fields"] = e.Fields
+	}
+	return details
+}
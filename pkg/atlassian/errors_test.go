@@ -0,0 +1,60 @@
+package atlassian
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		status   int
+		wantKind error
+		wantCode string
+	}{
+		{404, ErrNotFound, CodeNotFound},
+		{401, ErrUnauthorized, CodeUnauthorized},
+		{403, ErrPermission, CodePermission},
+		{429, ErrRateLimited, CodeRateLimited},
+		{400, ErrValidation, CodeValidation},
+		{409, ErrConflict, CodeConflict},
+		{500, nil, CodeAPIError},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.status), func(t *testing.T) {
+			err := fmt.Errorf("failed to get issue: %w", NewError(tt.status, "message", nil))
+
+			if tt.wantKind != nil && !errors.Is(err, tt.wantKind) {
+				t.Errorf("errors.Is(%v) = false, want true", tt.wantKind)
+			}
+			var apiErr *Error
+			if !errors.As(err, &apiErr) {
+				t.Fatal("errors.As(*Error) = false")
+			}
+			if apiErr.ErrorCode() != tt.wantCode {
+				t.Errorf("ErrorCode() = %s, want %s", apiErr.ErrorCode(), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestErrorDetails(t *testing.T) {
+	err := NewError(400, "summary: required", map[string]string{"summary": "required"})
+
+	if got := err.Error(); got != "HTTP 400: summary: required" {
+		t.Errorf("Error() = %q", got)
+	}
+
+	details := err.ErrorDetails()
+	if details["status"] != 400 {
+		t.Errorf("details status = %v, want 400", details["status"])
+	}
+	if fields, ok := details["fields"].(map[string]string); !ok || fields["summary"] != "required" {
+		t.Errorf("details fields = %v, want summary", details["fields"])
+	}
+
+	if _, ok := NewError(404, "missing", nil).ErrorDetails()["fields"]; ok {
+		t.Error("details without field errors should omit fields")
+	}
+}
@@ -0,0 +1,33 @@
+package atlassian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2024, 5, 7, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{"seconds", now.Add(-30 * time.Second), "just now"},
+		{"one minute", now.Add(-time.Minute), "1 minute ago"},
+		{"minutes", now.Add(-45 * time.Minute), "45 minutes ago"},
+		{"hours", now.Add(-5*time.Hour - 59*time.Minute), "5 hours ago"},
+		{"days", now.AddDate(0, 0, -3), "3 days ago"},
+		{"months", now.AddDate(0, -2, 0), "2 months ago"},
+		{"years", now.AddDate(-2, 0, 0), "2 years ago"},
+		{"future", now.Add(2 * time.Hour), "in 2 hours"},
+		{"tomorrow", now.AddDate(0, 0, 1), "in 1 day"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RelativeTime(tt.t, now); got != tt.want {
+				t.Errorf("RelativeTime() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,8 +4,36 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
+// Board types
+const (
+	BoardTypeScrum  = "scrum"
+	BoardTypeKanban = "kanban"
+	BoardTypeSimple = "simple" // Team-managed project board
+)
+
+// HasSprints reports whether sprints can be planned on the board. Kanban
+// boards have no sprints.
+func (b *Board) HasSprints() bool {
+	return b.Type != BoardTypeKanban
+}
+
+// CreateBoardRequest represents a request to create a board
+type CreateBoardRequest struct {
+	Name     string               `json:"name"`
+	Type     string               `json:"type"`     // scrum or kanban
+	FilterID int                  `json:"filterId"` // Saved filter selecting the board's issues
+	Location *CreateBoardLocation `json:"location,omitempty"`
+}
+
+// CreateBoardLocation is the project a new board belongs to
+type CreateBoardLocation struct {
+	Type           string `json:"type"` // project
+	ProjectKeyOrID string `json:"projectKeyOrId"`
+}
+
 // GetBoardsOptions contains options for getting boards
 type GetBoardsOptions struct {
 	ProjectKeyOrID string
@@ -79,6 +107,9 @@ func (c *Client) GetBoardIssues(ctx context.Context, boardID int, opts *SearchOp
 		if opts.MaxResults > 0 {
 			params["maxResults"] = fmt.Sprintf("%d", opts.MaxResults)
 		}
+		if len(opts.Fields) > 0 {
+			params["fields"] = strings.Join(opts.Fields, ",")
+		}
 	}
 
 	path = buildURL(path, params)
@@ -91,6 +122,24 @@ func (c *Client) GetBoardIssues(ctx context.Context, boardID int, opts *SearchOp
 	return &result, nil
 }
 
+// CreateBoard creates a Scrum or Kanban board showing the issues of a saved
+// filter
+func (c *Client) CreateBoard(ctx context.Context, req *CreateBoardRequest) (*Board, error) {
+	path := fmt.Sprintf("%s/board", c.getAgileAPIPath())
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal board request: %w", err)
+	}
+
+	var board Board
+	if err := c.doRequest(ctx, "POST", path, reqBody, &board); err != nil {
+		return nil, fmt.Errorf("failed to create board: %w", err)
+	}
+
+	return &board, nil
+}
+
 // GetBoardSprints retrieves sprints for a board
 func (c *Client) GetBoardSprints(ctx context.Context, boardID int, state string) ([]Sprint, error) {
 	path := fmt.Sprintf("%s/board/%d/sprint", c.getAgileAPIPath(), boardID)
@@ -140,6 +189,9 @@ func (c *Client) GetSprintIssues(ctx context.Context, sprintID int, opts *Search
 		if opts.MaxResults > 0 {
 			params["maxResults"] = fmt.Sprintf("%d", opts.MaxResults)
 		}
+		if len(opts.Fields) > 0 {
+			params["fields"] = strings.Join(opts.Fields, ",")
+		}
 	}
 
 	path = buildURL(path, params)
@@ -245,6 +297,9 @@ func (c *Client) GetBacklogIssues(ctx context.Context, boardID int, opts *Search
 		if opts.MaxResults > 0 {
 			params["maxResults"] = fmt.Sprintf("%d", opts.MaxResults)
 		}
+		if len(opts.Fields) > 0 {
+			params["fields"] = strings.Join(opts.Fields, ",")
+		}
 	}
 
 	path = buildURL(path, params)
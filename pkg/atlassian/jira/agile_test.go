@@ -0,0 +1,90 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateBoard(t *testing.T) {
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		var body map[string]interface{}
+		json.Unmarshal(data, &body)
+		bodies = append(bodies, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /rest/api/2/filter":
+			w.Write([]byte(`{"id": "10100", "name": "Filter for Team board", "jql": "project = PROJ"}`))
+		case "POST /rest/agile/1.0/board":
+			w.Write([]byte(`{"id": 7, "name": "Team board", "type": "kanban"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	filter, err := client.CreateFilter(context.Background(), &CreateFilterRequest{Name: "Filter for Team board", JQL: "project = PROJ"})
+	if err != nil || filter.ID != "10100" {
+		t.Fatalf("CreateFilter() = %+v, %v", filter, err)
+	}
+
+	board, err := client.CreateBoard(context.Background(), &CreateBoardRequest{
+		Name:     "Team board",
+		Type:     BoardTypeKanban,
+		FilterID: 10100,
+		Location: &CreateBoardLocation{Type: "project", ProjectKeyOrID: "PROJ"},
+	})
+	if err != nil {
+		t.Fatalf("CreateBoard() error = %v", err)
+	}
+	if board.ID != 7 || board.HasSprints() {
+		t.Errorf("CreateBoard() = %+v, want Kanban board 7 without sprints", board)
+	}
+
+	if len(bodies) != 2 {
+		t.Fatalf("Expected two requests, got %d", len(bodies))
+	}
+	sent := bodies[1]
+	location, _ := sent["location"].(map[string]interface{})
+	if sent["type"] != "kanban" || sent["filterId"] != float64(10100) || location["projectKeyOrId"] != "PROJ" {
+		t.Errorf("board request = %v", sent)
+	}
+}
+
+func TestDeleteFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/rest/api/2/filter/10100" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if err := client.DeleteFilter(context.Background(), "10100"); err != nil {
+		t.Errorf("DeleteFilter() error = %v", err)
+	}
+}
+
+func TestBoardHasSprints(t *testing.T) {
+	for boardType, want := range map[string]bool{BoardTypeScrum: true, BoardTypeKanban: false, BoardTypeSimple: true} {
+		if got := (&Board{Type: boardType}).HasSprints(); got != want {
+			t.Errorf("HasSprints() of a %s board = %v, want %v", boardType, got, want)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// insightVersion is the API path of Assets (formerly Insight) on Server/DC
+	insightVersion = "/rest/insight/1.0"
+
+	// assetsGatewayPath is the API path of an Assets workspace on Cloud. The
+	// site's API gateway serves it, so the site credentials work unchanged.
+	assetsGatewayPath = "/gateway/api/jsm/assets/workspace/%s/v1"
+)
+
+// AssetID is the ID of an Assets object, object type, or attribute. Cloud
+// returns IDs as strings and Server/DC as numbers.
+type AssetID string
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (id *AssetID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = AssetID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("invalid Assets ID %s", data)
+	}
+	*id = AssetID(n.String())
+	return nil
+}
+
+// AssetObject represents an object in Assets (JSM's CMDB), such as a
+// server, service, or laptop
+type AssetObject struct {
+	ID         AssetID          `json:"id"`
+	Key        string           `json:"objectKey"`
+	Label      string           `json:"label"`
+	ObjectType *AssetObjectType `json:"objectType,omitempty"`
+	Created    string           `json:"created,omitempty"`
+	Updated    string           `json:"updated,omitempty"`
+	Attributes []AssetAttribute `json:"attributes,omitempty"`
+}
+
+// AssetObjectType represents the type of an Assets object
+type AssetObjectType struct {
+	ID   AssetID `json:"id"`
+	Name string  `json:"name"`
+}
+
+// AssetTypeAttribute represents an attribute defined by an object type
+type AssetTypeAttribute struct {
+	ID       AssetID `json:"id"`
+	Name     string  `json:"name"`
+	Editable bool    `json:"editable"`
+}
+
+// AssetAttribute represents the values of an attribute of an object
+type AssetAttribute struct {
+	ObjectTypeAttributeID AssetID               `json:"objectTypeAttributeId"`
+	ObjectTypeAttribute   *AssetTypeAttribute   `json:"objectTypeAttribute,omitempty"`
+	Values                []AssetAttributeValue `json:"objectAttributeValues"`
+}
+
+// AssetAttributeValue represents one value of an attribute. References to
+// other objects carry the referenced object.
+type AssetAttributeValue struct {
+	Value            interface{}  `json:"value,omitempty"`
+	DisplayValue     interface{}  `json:"displayValue,omitempty"`
+	ReferencedObject *AssetObject `json:"referencedObject,omitempty"`
+}
+
+// AttributeValues returns the display values of the object's attributes by
+// attribute name
+func (o *AssetObject) AttributeValues() map[string][]string {
+	values := make(map[string][]string, len(o.Attributes))
+	for _, attr := range o.Attributes {
+		name := string(attr.ObjectTypeAttributeID)
+		if attr.ObjectTypeAttribute != nil && attr.ObjectTypeAttribute.Name != "" {
+			name = attr.ObjectTypeAttribute.Name
+		}
+
+		var display []string
+		for _, v := range attr.Values {
+			switch {
+			case v.ReferencedObject != nil && v.ReferencedObject.Key != "":
+				display = append(display, fmt.Sprintf("%s (%s)", v.ReferencedObject.Label, v.ReferencedObject.Key))
+			case v.DisplayValue != nil:
+				display = append(display, fmt.Sprint(v.DisplayValue))
+			case v.Value != nil:
+				display = append(display, fmt.Sprint(v.Value))
+			}
+		}
+		values[name] = display
+	}
+	return values
+}
+
+// AssetSearchResult holds a page of objects matching an AQL query
+type AssetSearchResult struct {
+	Objects    []AssetObject `json:"objects"`
+	StartAt    int           `json:"start_at"`
+	MaxResults int           `json:"max_results"`
+	Total      int           `json:"total"`
+}
+
+// assetsPath returns the API path of Assets. On Cloud the workspace ID is
+// looked up once and cached for the life of the client.
+func (c *Client) assetsPath(ctx context.Context) (string, error) {
+	if !c.IsCloud() {
+		return insightVersion, nil
+	}
+
+	c.assetsMu.Lock()
+	defer c.assetsMu.Unlock()
+
+	if c.assetsWorkspaceID == "" {
+		var result struct {
+			Values []struct {
+				WorkspaceID string `json:"workspaceId"`
+			} `json:"values"`
+		}
+		if err := c.doRequest(ctx, "GET", "/rest/servicedeskapi/assets/workspace", nil, &result); err != nil {
+			return "", fmt.Errorf("failed to get Assets workspace: %w", err)
+		}
+		if len(result.Values) == 0 || result.Values[0].WorkspaceID == "" {
+			return "", fmt.Errorf("Assets is not available on this site")
+		}
+		c.assetsWorkspaceID = result.Values[0].WorkspaceID
+	}
+	return fmt.Sprintf(assetsGatewayPath, c.assetsWorkspaceID), nil
+}
+
+// SearchAssets returns the objects matching an AQL query (e.g.
+// objectType = "Server" AND Name like "db"), with their attributes
+func (c *Client) SearchAssets(ctx context.Context, aql string, startAt, maxResults int) (*AssetSearchResult, error) {
+	base, err := c.assetsPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if maxResults <= 0 {
+		maxResults = 50
+	}
+
+	var objects []AssetObject
+	var typeAttributes []AssetTypeAttribute
+	var total int
+
+	if c.IsCloud() {
+		path := buildURL(base+"/object/aql", map[string]string{
+			"startAt":           strconv.Itoa(startAt),
+			"maxResults":        strconv.Itoa(maxResults),
+			"includeAttributes": "true",
+		})
+		body, err := json.Marshal(map[string]string{"qlQuery": aql})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %w", err)
+		}
+
+		var result struct {
+			Values               []AssetObject        `json:"values"`
+			ObjectTypeAttributes []AssetTypeAttribute `json:"objectTypeAttributes"`
+			Total                int                  `json:"total"`
+		}
+		if err := c.doRequest(ctx, "POST", path, body, &result); err != nil {
+			return nil, fmt.Errorf("failed to search assets: %w", err)
+		}
+		objects, typeAttributes, total = result.Values, result.ObjectTypeAttributes, result.Total
+	} else {
+		// Server/DC pages by page number rather than offset
+		path := buildURL(base+"/aql/objects", map[string]string{
+			"qlQuery":           aql,
+			"page":              strconv.Itoa(startAt/maxResults + 1),
+			"resultPerPage":     strconv.Itoa(maxResults),
+			"includeAttributes": "true",
+		})
+
+		var result struct {
+			ObjectEntries        []AssetObject        `json:"objectEntries"`
+			ObjectTypeAttributes []AssetTypeAttribute `json:"objectTypeAttributes"`
+			TotalFilterCount     int                  `json:"totalFilterCount"`
+		}
+		if err := c.doRequest(ctx, "GET", path, nil, &result); err != nil {
+			return nil, fmt.Errorf("failed to search assets: %w", err)
+		}
+		objects, typeAttributes, total = result.ObjectEntries, result.ObjectTypeAttributes, result.TotalFilterCount
+		startAt = startAt / maxResults * maxResults
+	}
+
+	// Search results refer to attributes by ID only; name them from the
+	// attributes of the matched object types
+	byID := make(map[AssetID]*AssetTypeAttribute, len(typeAttributes))
+	for i := range typeAttributes {
+		byID[typeAttributes[i].ID] = &typeAttributes[i]
+	}
+	for i := range objects {
+		for j := range objects[i].Attributes {
+			attr := &objects[i].Attributes[j]
+			if attr.ObjectTypeAttribute == nil {
+				attr.ObjectTypeAttribute = byID[attr.ObjectTypeAttributeID]
+			}
+		}
+	}
+
+	return &AssetSearchResult{
+		Objects:    objects,
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Total:      total,
+	}, nil
+}
+
+// GetAsset returns an object by its numeric ID or its key (e.g. CMDB-42)
+func (c *Client) GetAsset(ctx context.Context, idOrKey string) (*AssetObject, error) {
+	idOrKey = strings.TrimSpace(idOrKey)
+	if idOrKey == "" {
+		return nil, fmt.Errorf("object ID or key is required")
+	}
+
+	if _, err := strconv.Atoi(idOrKey); err != nil {
+		result, err := c.SearchAssets(ctx, fmt.Sprintf("Key = %q", idOrKey), 0, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(result.Objects) == 0 {
+			return nil, fmt.Errorf("asset %s not found", idOrKey)
+		}
+		idOrKey = string(result.Objects[0].ID)
+	}
+
+	base, err := c.assetsPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var object AssetObject
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("%s/object/%s", base, idOrKey), nil, &object); err != nil {
+		return nil, fmt.Errorf("failed to get asset: %w", err)
+	}
+	return &object, nil
+}
+
+// GetAssetTypeAttributes returns the attributes defined by an object type
+func (c *Client) GetAssetTypeAttributes(ctx context.Context, objectTypeID string) ([]AssetTypeAttribute, error) {
+	base, err := c.assetsPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes []AssetTypeAttribute
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("%s/objecttype/%s/attributes", base, objectTypeID), nil, &attributes); err != nil {
+		return nil, fmt.Errorf("failed to get object type attributes: %w", err)
+	}
+	return attributes, nil
+}
+
+// UpdateAssetAttributes sets attributes of an object, given by name or ID, to
+// the given values. An empty list of values clears the attribute; references
+// to other objects take the referenced object's key. Other attributes are
+// left unchanged.
+func (c *Client) UpdateAssetAttributes(ctx context.Context, idOrKey string, values map[string][]string) (*AssetObject, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("at least one attribute is required")
+	}
+
+	object, err := c.GetAsset(ctx, idOrKey)
+	if err != nil {
+		return nil, err
+	}
+	if object.ObjectType == nil {
+		return nil, fmt.Errorf("asset %s has no object type", idOrKey)
+	}
+
+	typeAttributes, err := c.GetAssetTypeAttributes(ctx, string(object.ObjectType.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	type attributeValue struct {
+		Value string `json:"value"`
+	}
+	type attributeUpdate struct {
+		ObjectTypeAttributeID AssetID          `json:"objectTypeAttributeId"`
+		ObjectAttributeValues []attributeValue `json:"objectAttributeValues"`
+	}
+
+	updates := make([]attributeUpdate, 0, len(names))
+	for _, name := range names {
+		attr := findAssetTypeAttribute(typeAttributes, name)
+		if attr == nil {
+			available := make([]string, 0, len(typeAttributes))
+			for _, a := range typeAttributes {
+				available = append(available, a.Name)
+			}
+			return nil, fmt.Errorf("%s has no attribute %q (available: %s)", object.ObjectType.Name, name, strings.Join(available, ", "))
+		}
+
+		update := attributeUpdate{
+			ObjectTypeAttributeID: attr.ID,
+			ObjectAttributeValues: []attributeValue{},
+		}
+		for _, v := range values[name] {
+			update.ObjectAttributeValues = append(update.ObjectAttributeValues, attributeValue{Value: v})
+		}
+		updates = append(updates, update)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"objectTypeId": object.ObjectType.ID,
+		"attributes":   updates,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	base, err := c.assetsPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated AssetObject
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("%s/object/%s", base, object.ID), body, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update asset: %w", err)
+	}
+	return &updated, nil
+}
+
+// findAssetTypeAttribute finds an attribute by ID or case-insensitive name
+func findAssetTypeAttribute(attributes []AssetTypeAttribute, nameOrID string) *AssetTypeAttribute {
+	for i := range attributes {
+		if string(attributes[i].ID) == nameOrID || strings.EqualFold(attributes[i].Name, nameOrID) {
+			return &attributes[i]
+		}
+	}
+	return nil
+}
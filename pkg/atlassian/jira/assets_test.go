@@ -0,0 +1,156 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestSearchAssetsServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/insight/1.0/aql/objects" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		q := r.URL.Query()
+		if q.Get("qlQuery") != `objectType = "Server"` || q.Get("page") != "2" || q.Get("resultPerPage") != "10" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"objectEntries": [{
+				"id": 42, "objectKey": "CMDB-42", "label": "db-01",
+				"objectType": {"id": 7, "name": "Server"},
+				"attributes": [
+					{"objectTypeAttributeId": 70, "objectAttributeValues": [{"value": "10.0.0.5", "displayValue": "10.0.0.5"}]},
+					{"objectTypeAttributeId": 71, "objectAttributeValues": [{"referencedObject": {"id": 9, "objectKey": "CMDB-9", "label": "Payments"}}]}
+				]
+			}],
+			"objectTypeAttributes": [{"id": 70, "name": "IP Address"}, {"id": 71, "name": "Service"}],
+			"totalFilterCount": 11
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	result, err := client.SearchAssets(context.Background(), `objectType = "Server"`, 10, 10)
+	if err != nil {
+		t.Fatalf("SearchAssets() error = %v", err)
+	}
+	if result.Total != 11 || result.StartAt != 10 || len(result.Objects) != 1 {
+		t.Fatalf("SearchAssets() = %+v", result)
+	}
+
+	object := result.Objects[0]
+	if object.ID != "42" || object.ObjectType.ID != "7" {
+		t.Errorf("IDs = %q, %q, want 42, 7", object.ID, object.ObjectType.ID)
+	}
+	want := map[string][]string{
+		"IP Address": {"10.0.0.5"},
+		"Service":    {"Payments (CMDB-9)"},
+	}
+	if got := object.AttributeValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("AttributeValues() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchAssetsCloud(t *testing.T) {
+	var workspaceLookups int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/servicedeskapi/assets/workspace":
+			workspaceLookups++
+			w.Write([]byte(`{"values": [{"workspaceId": "ws-1"}]}`))
+		case "/gateway/api/jsm/assets/workspace/ws-1/v1/object/aql":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if r.Method != http.MethodPost || body["qlQuery"] != "Name like db" || r.URL.Query().Get("maxResults") != "5" {
+				t.Errorf("Unexpected search %s %s %v", r.Method, r.URL, body)
+			}
+			w.Write([]byte(`{"values": [{"id": "42", "objectKey": "CMDB-42", "label": "db-01"}], "total": 1}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.deploymentType = DeploymentCloud
+
+	for i := 0; i < 2; i++ {
+		result, err := client.SearchAssets(context.Background(), "Name like db", 0, 5)
+		if err != nil {
+			t.Fatalf("SearchAssets() error = %v", err)
+		}
+		if result.Total != 1 || result.Objects[0].Key != "CMDB-42" {
+			t.Errorf("SearchAssets() = %+v", result)
+		}
+	}
+	if workspaceLookups != 1 {
+		t.Errorf("workspace looked up %d times, want 1", workspaceLookups)
+	}
+}
+
+func TestUpdateAssetAttributes(t *testing.T) {
+	var update map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/rest/insight/1.0/aql/objects":
+			if r.URL.Query().Get("qlQuery") != `Key = "CMDB-42"` {
+				t.Errorf("Unexpected key lookup %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"objectEntries": [{"id": 42, "objectKey": "CMDB-42"}], "totalFilterCount": 1}`))
+		case r.URL.Path == "/rest/insight/1.0/object/42" && r.Method == http.MethodGet:
+			w.Write([]byte(`{"id": 42, "objectKey": "CMDB-42", "objectType": {"id": 7, "name": "Server"}}`))
+		case r.URL.Path == "/rest/insight/1.0/objecttype/7/attributes":
+			w.Write([]byte(`[{"id": 70, "name": "Status"}, {"id": 72, "name": "Tags"}]`))
+		case r.URL.Path == "/rest/insight/1.0/object/42" && r.Method == http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			json.Unmarshal(data, &update)
+			w.Write([]byte(`{"id": 42, "objectKey": "CMDB-42"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.UpdateAssetAttributes(context.Background(), "CMDB-42", map[string][]string{
+		"status": {"Retired"},
+		"Tags":   nil,
+	})
+	if err != nil {
+		t.Fatalf("UpdateAssetAttributes() error = %v", err)
+	}
+
+	want := map[string]interface{}{
+		"objectTypeId": "7",
+		"attributes": []interface{}{
+			map[string]interface{}{"objectTypeAttributeId": "72", "objectAttributeValues": []interface{}{}},
+			map[string]interface{}{"objectTypeAttributeId": "70", "objectAttributeValues": []interface{}{map[string]interface{}{"value": "Retired"}}},
+		},
+	}
+	if !reflect.DeepEqual(update, want) {
+		t.Errorf("update = %v, want %v", update, want)
+	}
+
+	if _, err := client.UpdateAssetAttributes(context.Background(), "42", map[string][]string{"Owner": {"x"}}); err == nil {
+		t.Error("UpdateAssetAttributes() with an unknown attribute should return an error")
+	}
+}
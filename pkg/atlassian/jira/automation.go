@@ -0,0 +1,119 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/codeownersnet/atlas/internal/client"
+)
+
+// automationTokenHeader carries the secret of an incoming webhook. Rules
+// created before webhook secrets existed embed the secret in the URL instead.
+const automationTokenHeader = "X-Automation-Webhook-Token"
+
+// AutomationWebhook is the incoming webhook trigger of a Jira Automation rule
+type AutomationWebhook struct {
+	URL   string
+	Token string // Secret sent in the X-Automation-Webhook-Token header (optional)
+}
+
+// AutomationTrigger holds what a rule receives when its webhook is called
+type AutomationTrigger struct {
+	IssueKeys []string               `json:"issues,omitempty"` // Issues the rule runs on, for rules that take issues from the webhook
+	Data      map[string]interface{} `json:"data,omitempty"`   // Available to the rule as {{webhookData.data}}
+}
+
+// newAutomationClients creates an HTTP client per webhook, sharing the proxy
+// and TLS settings of the Jira client. Webhooks authenticate with their own
+// secret, so the Jira credentials and custom headers are not sent.
+func newAutomationClients(httpCfg *client.Config, webhooks map[string]AutomationWebhook) (map[string]*client.Client, error) {
+	clients := make(map[string]*client.Client, len(webhooks))
+	for name, webhook := range webhooks {
+		cfg := *httpCfg
+		cfg.BaseURL = webhook.URL
+		cfg.Auth = &automationAuth{token: webhook.Token}
+		cfg.CustomHeaders = nil
+		// A retried trigger could run the rule twice
+		cfg.MaxRetries = -1
+
+		c, err := client.NewClient(&cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for automation webhook %s: %w", name, err)
+		}
+		clients[strings.ToLower(name)] = c
+	}
+	return clients, nil
+}
+
+// AutomationRules returns the names of the rules with a configured webhook
+func (c *Client) AutomationRules() []string {
+	names := make([]string, 0, len(c.automation))
+	for name := range c.automation {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TriggerAutomation calls the incoming webhook of a rule, which runs the rule
+// asynchronously. Rule names are case-insensitive.
+func (c *Client) TriggerAutomation(ctx context.Context, rule string, trigger *AutomationTrigger) error {
+	hook, ok := c.automation[strings.ToLower(strings.TrimSpace(rule))]
+	if !ok {
+		if len(c.automation) == 0 {
+			return fmt.Errorf("no automation webhooks are configured")
+		}
+		return fmt.Errorf("unknown automation rule %q (configured: %s)", rule, strings.Join(c.AutomationRules(), ", "))
+	}
+
+	if trigger == nil {
+		trigger = &AutomationTrigger{}
+	}
+	body, err := json.Marshal(trigger)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := hook.Post(ctx, "", body)
+	if err != nil {
+		return fmt.Errorf("failed to trigger automation rule %s: %w", rule, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to trigger automation rule %s: %w", rule, c.parseError(resp.StatusCode, respBody))
+	}
+	return nil
+}
+
+// automationAuth authenticates a webhook call with the webhook's secret
+type automationAuth struct {
+	token string
+}
+
+// Apply adds the webhook secret, if any, to the request
+func (a *automationAuth) Apply(req *http.Request) error {
+	if a.token != "" {
+		req.Header.Set(automationTokenHeader, a.token)
+	}
+	return nil
+}
+
+// Type returns the authentication type
+func (a *automationAuth) Type() string {
+	return "automation-webhook"
+}
+
+// Mask returns a masked version of the secret for logging
+func (a *automationAuth) Mask() string {
+	if a.token == "" {
+		return "none"
+	}
+	return "***"
+}
@@ -0,0 +1,95 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTriggerAutomation(t *testing.T) {
+	var requests int
+	var body map[string]interface{}
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != http.MethodPost || r.URL.Path != "/hooks/abc" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		if got := r.Header.Get("X-Automation-Webhook-Token"); got != "s3cret" {
+			t.Errorf("token header = %q, want s3cret", got)
+		}
+		if r.Header.Get("Authorization") != "" || r.Header.Get("X-Custom") != "" {
+			t.Error("Jira credentials and custom headers must not be sent to the webhook")
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer hook.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:       "https://jira.example.com",
+		Auth:          &mockAuth{},
+		CustomHeaders: map[string]string{"X-Custom": "1"},
+		AutomationWebhooks: map[string]AutomationWebhook{
+			"Escalate": {URL: hook.URL + "/hooks/abc", Token: "s3cret"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if got := client.AutomationRules(); !reflect.DeepEqual(got, []string{"escalate"}) {
+		t.Errorf("AutomationRules() = %v, want [escalate]", got)
+	}
+
+	err = client.TriggerAutomation(context.Background(), "ESCALATE", &AutomationTrigger{
+		IssueKeys: []string{"PROJ-1"},
+		Data:      map[string]interface{}{"severity": "high"},
+	})
+	if err != nil {
+		t.Fatalf("TriggerAutomation() error = %v", err)
+	}
+	want := map[string]interface{}{
+		"issues": []interface{}{"PROJ-1"},
+		"data":   map[string]interface{}{"severity": "high"},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("body = %v, want %v", body, want)
+	}
+
+	err = client.TriggerAutomation(context.Background(), "deploy", nil)
+	if err == nil || !strings.Contains(err.Error(), "configured: escalate") {
+		t.Errorf("TriggerAutomation() with an unknown rule error = %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("webhook called %d times, want 1", requests)
+	}
+}
+
+func TestTriggerAutomationFailureIsNotRetried(t *testing.T) {
+	var requests int
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer hook.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:            "https://jira.example.com",
+		Auth:               &mockAuth{},
+		AutomationWebhooks: map[string]AutomationWebhook{"escalate": {URL: hook.URL}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if err := client.TriggerAutomation(context.Background(), "escalate", nil); err == nil {
+		t.Error("TriggerAutomation() should fail when the webhook does")
+	}
+	if requests != 1 {
+		t.Errorf("webhook called %d times, want 1", requests)
+	}
+}
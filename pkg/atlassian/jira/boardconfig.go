@@ -0,0 +1,177 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BoardConfiguration is the setup of an agile board: its columns and the
+// statuses mapped to them, how issues are estimated, and its quick filters
+type BoardConfiguration struct {
+	ID             int              `json:"id"`
+	Name           string           `json:"name"`
+	Type           string           `json:"type,omitempty"`
+	FilterID       string           `json:"filterId,omitempty"`
+	Columns        []BoardColumn    `json:"columns"`
+	ConstraintType string           `json:"constraintType,omitempty"` // none, issueCount, or issueCountExclSubs
+	Estimation     *BoardEstimation `json:"estimation,omitempty"`
+	RankFieldID    string           `json:"rankFieldId,omitempty"`
+	QuickFilters   []QuickFilter    `json:"quickFilters"`
+}
+
+// BoardColumn is a column of a board and the statuses shown in it
+type BoardColumn struct {
+	Name     string   `json:"name"`
+	Statuses []Status `json:"statuses"`
+	Min      *int     `json:"min,omitempty"` // Column constraints, if set
+	Max      *int     `json:"max,omitempty"`
+}
+
+// BoardEstimation is how issues on a board are estimated
+type BoardEstimation struct {
+	Type      string `json:"type"` // none or field
+	FieldID   string `json:"fieldId,omitempty"`
+	FieldName string `json:"fieldName,omitempty"`
+}
+
+// QuickFilter is a saved JQL filter shown on a board
+type QuickFilter struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	JQL         string `json:"jql"`
+	Description string `json:"description,omitempty"`
+	Position    int    `json:"position"`
+}
+
+// Column returns the column of a name (case-insensitive), or nil
+func (b *BoardConfiguration) Column(name string) *BoardColumn {
+	for i := range b.Columns {
+		if strings.EqualFold(b.Columns[i].Name, strings.TrimSpace(name)) {
+			return &b.Columns[i]
+		}
+	}
+	return nil
+}
+
+// HasStatus reports whether a status, by ID, is mapped to the column
+func (col *BoardColumn) HasStatus(statusID string) bool {
+	for _, s := range col.Statuses {
+		if s.ID == statusID {
+			return true
+		}
+	}
+	return false
+}
+
+// boardConfigurationResponse is the response of the board configuration
+// endpoint
+type boardConfigurationResponse struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Filter *struct {
+		ID string `json:"id"`
+	} `json:"filter"`
+	ColumnConfig struct {
+		Columns []struct {
+			Name     string   `json:"name"`
+			Statuses []Status `json:"statuses"`
+			Min      *int     `json:"min"`
+			Max      *int     `json:"max"`
+		} `json:"columns"`
+		ConstraintType string `json:"constraintType"`
+	} `json:"columnConfig"`
+	Estimation *struct {
+		Type  string `json:"type"`
+		Field *struct {
+			FieldID     string `json:"fieldId"`
+			DisplayName string `json:"displayName"`
+		} `json:"field"`
+	} `json:"estimation"`
+	Ranking *struct {
+		RankCustomFieldID int `json:"rankCustomFieldId"`
+	} `json:"ranking"`
+}
+
+// GetBoardConfiguration returns the configuration of a board, with the names
+// of the statuses in each column and the board's quick filters
+func (c *Client) GetBoardConfiguration(ctx context.Context, boardID int) (*BoardConfiguration, error) {
+	path := fmt.Sprintf("%s/board/%d/configuration", c.getAgileAPIPath(), boardID)
+
+	var resp boardConfigurationResponse
+	if err := c.doRequest(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get configuration of board %d: %w", boardID, err)
+	}
+
+	// The configuration only has status IDs
+	names := make(map[string]Status)
+	var statuses []Status
+	if err := c.doRequest(ctx, "GET", c.getAPIPath()+"/status", nil, &statuses); err != nil {
+		return nil, fmt.Errorf("failed to get statuses: %w", err)
+	}
+	for _, s := range statuses {
+		names[s.ID] = s
+	}
+
+	cfg := &BoardConfiguration{
+		ID:             resp.ID,
+		Name:           resp.Name,
+		Type:           resp.Type,
+		Columns:        make([]BoardColumn, 0, len(resp.ColumnConfig.Columns)),
+		ConstraintType: resp.ColumnConfig.ConstraintType,
+	}
+	if resp.Filter != nil {
+		cfg.FilterID = resp.Filter.ID
+	}
+	for _, col := range resp.ColumnConfig.Columns {
+		column := BoardColumn{Name: col.Name, Statuses: make([]Status, 0, len(col.Statuses)), Min: col.Min, Max: col.Max}
+		for _, s := range col.Statuses {
+			status := Status{ID: s.ID, Name: s.ID}
+			if named, ok := names[s.ID]; ok {
+				status = Status{ID: s.ID, Name: named.Name, StatusCategory: named.StatusCategory}
+			}
+			column.Statuses = append(column.Statuses, status)
+		}
+		cfg.Columns = append(cfg.Columns, column)
+	}
+	if resp.Estimation != nil {
+		cfg.Estimation = &BoardEstimation{Type: resp.Estimation.Type}
+		if resp.Estimation.Field != nil {
+			cfg.Estimation.FieldID = resp.Estimation.Field.FieldID
+			cfg.Estimation.FieldName = resp.Estimation.Field.DisplayName
+		}
+	}
+	if resp.Ranking != nil && resp.Ranking.RankCustomFieldID != 0 {
+		cfg.RankFieldID = "customfield_" + strconv.Itoa(resp.Ranking.RankCustomFieldID)
+	}
+
+	quickFilters, err := c.GetBoardQuickFilters(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	cfg.QuickFilters = quickFilters
+
+	return cfg, nil
+}
+
+// GetBoardQuickFilters returns the quick filters of a board
+func (c *Client) GetBoardQuickFilters(ctx context.Context, boardID int) ([]QuickFilter, error) {
+	filters := []QuickFilter{}
+	for {
+		var page struct {
+			Values []QuickFilter `json:"values"`
+			IsLast bool          `json:"isLast"`
+		}
+		path := buildURL(fmt.Sprintf("%s/board/%d/quickfilter", c.getAgileAPIPath(), boardID), map[string]string{"startAt": strconv.Itoa(len(filters))})
+		if err := c.doRequest(ctx, "GET", path, nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to get quick filters of board %d: %w", boardID, err)
+		}
+
+		filters = append(filters, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return filters, nil
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetBoardConfiguration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/agile/1.0/board/7/configuration":
+			w.Write([]byte(`{"id": 7, "name": "Team board", "type": "scrum", "filter": {"id": "10100"},
+				"columnConfig": {"columns": [
+					{"name": "To Do", "statuses": [{"id": "1"}]},
+					{"name": "Review", "statuses": [{"id": "3"}, {"id": "4"}], "max": 5}
+				], "constraintType": "issueCount"},
+				"estimation": {"type": "field", "field": {"fieldId": "customfield_10016", "displayName": "Story Points"}},
+				"ranking": {"rankCustomFieldId": 10019}}`))
+		case "/rest/api/2/status":
+			w.Write([]byte(`[{"id": "1", "name": "Open"}, {"id": "3", "name": "In Review"}, {"id": "4", "name": "Code Review"}]`))
+		case "/rest/agile/1.0/board/7/quickfilter":
+			if r.URL.Query().Get("startAt") == "0" {
+				w.Write([]byte(`{"values": [{"id": 1, "name": "Only my issues", "jql": "assignee = currentUser()", "position": 0}], "isLast": false}`))
+				return
+			}
+			w.Write([]byte(`{"values": [{"id": 2, "name": "Bugs", "jql": "type = Bug", "position": 1}], "isLast": true}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	cfg, err := client.GetBoardConfiguration(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GetBoardConfiguration() error = %v", err)
+	}
+
+	if cfg.FilterID != "10100" || cfg.ConstraintType != "issueCount" || cfg.RankFieldID != "customfield_10019" {
+		t.Errorf("configuration = %+v", cfg)
+	}
+	if cfg.Estimation == nil || cfg.Estimation.FieldID != "customfield_10016" || cfg.Estimation.FieldName != "Story Points" {
+		t.Errorf("Estimation = %+v", cfg.Estimation)
+	}
+
+	review := cfg.Column("review")
+	if review == nil || len(review.Statuses) != 2 || review.Statuses[1].Name != "Code Review" || review.Max == nil || *review.Max != 5 {
+		t.Fatalf("Column(review) = %+v", review)
+	}
+	if !review.HasStatus("3") || review.HasStatus("1") {
+		t.Error("HasStatus() doesn't match the column's statuses")
+	}
+	if cfg.Column("Done") != nil {
+		t.Error("Column() of a missing column should be nil")
+	}
+
+	if len(cfg.QuickFilters) != 2 || cfg.QuickFilters[1].JQL != "type = Bug" {
+		t.Errorf("QuickFilters = %+v", cfg.QuickFilters)
+	}
+}
@@ -1,16 +1,22 @@
 package jira
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/codeownersnet/atlas/internal/auth"
 	"github.com/codeownersnet/atlas/internal/client"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
 )
 
 const (
@@ -22,9 +28,27 @@ const (
 
 // Client is a Jira API client
 type Client struct {
-	httpClient     *client.Client
+	httpClient     atlassian.ClientDoer
 	baseURL        string
 	deploymentType DeploymentType
+
+	myselfMu sync.Mutex
+	myself   *User // Cached by Myself
+
+	assetsMu          sync.Mutex
+	assetsWorkspaceID string // Cloud Assets workspace, cached by assetsPath
+
+	automation map[string]*client.Client // Automation webhook clients by rule name
+
+	metadata *metadataCache // Field list and create metadata
+
+	templates map[string]IssueTemplate // Issue templates by lowercase name
+
+	legacySearch atomic.Bool // Cloud site without the enhanced search endpoint
+
+	timeTrackingOverride TimeTracking // Configured working time (zero values are read from the site)
+	timeTrackingMu       sync.Mutex
+	timeTracking         *TimeTracking // Cached by TimeTracking
 }
 
 // Config holds the configuration for creating a Jira client
@@ -37,6 +61,41 @@ type Config struct {
 	HTTPSProxy    string
 	SOCKSProxy    string
 	NoProxy       string
+
+	// TLS files (PEM): extra CA bundle, and client certificate and key for mTLS
+	CACertFile     string
+	ClientCertFile string
+	ClientKeyFile  string
+
+	MaxResponseSize int64 // Maximum response body size in bytes (0 = unlimited)
+
+	// Connection pooling (zero values use the HTTP client defaults)
+	MaxIdleConns        int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+	TLSHandshakeTimeout time.Duration
+
+	Capture  *client.Capture  // Records requests and responses for debugging (optional)
+	Cassette *client.Cassette // Records or replays API interactions (optional)
+
+	// HTTPClient sends the API requests instead of an HTTP client built from
+	// this configuration (optional, e.g. a fake in tests)
+	HTTPClient atlassian.ClientDoer
+
+	// Jira Automation incoming webhooks by rule name (optional)
+	AutomationWebhooks map[string]AutomationWebhook
+
+	// MetadataTTL is how long the field list and the create metadata of
+	// projects are cached (0 disables caching)
+	MetadataTTL time.Duration
+
+	// Named issue templates for new issues (optional)
+	IssueTemplates map[string]IssueTemplate
+
+	// Working hours per day and days per week of time tracking durations
+	// such as "1d" (0 reads them from the site's time tracking settings)
+	HoursPerDay float64
+	DaysPerWeek float64
 }
 
 // NewClient creates a new Jira client
@@ -45,15 +104,15 @@ func NewClient(cfg *Config) (*Client, error) {
 		return nil, fmt.Errorf("base URL is required")
 	}
 
-	if cfg.Auth == nil {
+	if cfg.Auth == nil && cfg.HTTPClient == nil {
 		return nil, fmt.Errorf("auth provider is required")
 	}
 
 	// Detect deployment type from URL
 	deploymentType := detectDeploymentType(cfg.BaseURL)
 
-	// Create HTTP client
-	httpClient, err := client.NewClient(&client.Config{
+	// Create HTTP client, unless one is given
+	httpCfg := &client.Config{
 		BaseURL:       cfg.BaseURL,
 		Auth:          cfg.Auth,
 		CustomHeaders: cfg.CustomHeaders,
@@ -62,15 +121,44 @@ func NewClient(cfg *Config) (*Client, error) {
 		HTTPSProxy:    cfg.HTTPSProxy,
 		SOCKSProxy:    cfg.SOCKSProxy,
 		NoProxy:       cfg.NoProxy,
-	})
+
+		CACertFile:     cfg.CACertFile,
+		ClientCertFile: cfg.ClientCertFile,
+		ClientKeyFile:  cfg.ClientKeyFile,
+
+		MaxResponseSize: cfg.MaxResponseSize,
+
+		MaxIdleConns:        cfg.MaxIdleConns,
+		MaxConnsPerHost:     cfg.MaxConnsPerHost,
+		IdleConnTimeout:     cfg.IdleConnTimeout,
+		TLSHandshakeTimeout: cfg.TLSHandshakeTimeout,
+
+		Capture:  cfg.Capture,
+		Cassette: cfg.Cassette,
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		defaultClient, err := client.NewClient(httpCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+		}
+		httpClient = defaultClient
+	}
+
+	automation, err := newAutomationClients(httpCfg, cfg.AutomationWebhooks)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+		return nil, err
 	}
 
 	return &Client{
 		httpClient:     httpClient,
 		baseURL:        strings.TrimRight(cfg.BaseURL, "/"),
 		deploymentType: deploymentType,
+		automation:     automation,
+		metadata:       newMetadataCache(cfg.MetadataTTL),
+		templates:      lowercaseTemplateNames(cfg.IssueTemplates),
+
+		timeTrackingOverride: TimeTracking{HoursPerDay: cfg.HoursPerDay, DaysPerWeek: cfg.DaysPerWeek},
 	}, nil
 }
 
@@ -92,6 +180,11 @@ func (c *Client) IsServer() bool {
 	return c.deploymentType == DeploymentServer
 }
 
+// BrowseURL returns the web URL of an issue
+func (c *Client) BrowseURL(issueKey string) string {
+	return fmt.Sprintf("%s/browse/%s", c.baseURL, issueKey)
+}
+
 // GetDeploymentType returns the deployment type
 func (c *Client) GetDeploymentType() DeploymentType {
 	return c.deploymentType
@@ -99,22 +192,17 @@ func (c *Client) GetDeploymentType() DeploymentType {
 
 // doRequest performs an HTTP request and decodes the response
 func (c *Client) doRequest(ctx context.Context, method, path string, body []byte, result interface{}) error {
-	var resp *http.Response
-	var err error
-
 	switch method {
-	case http.MethodGet:
-		resp, err = c.httpClient.Get(ctx, path)
-	case http.MethodPost:
-		resp, err = c.httpClient.Post(ctx, path, body)
-	case http.MethodPut:
-		resp, err = c.httpClient.Put(ctx, path, body)
-	case http.MethodDelete:
-		resp, err = c.httpClient.Delete(ctx, path)
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete:
 	default:
 		return fmt.Errorf("unsupported HTTP method: %s", method)
 	}
 
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	resp, err := c.httpClient.Do(ctx, method, path, reader)
 	if err != nil {
 		return err
 	}
@@ -141,26 +229,38 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body []byte
 	return nil
 }
 
+// Raw sends a request to any API path of the site, for endpoints without a
+// dedicated method. The path is relative to the base URL; error statuses are
+// returned as errors.
+func (c *Client) Raw(ctx context.Context, method, path string, body []byte) (*atlassian.RawResponse, error) {
+	return atlassian.DoRaw(ctx, c.httpClient, method, path, body, c.parseError)
+}
+
 // parseError parses an error response from Jira
 func (c *Client) parseError(statusCode int, body []byte) error {
 	var errResp ErrorResponse
 	if err := json.Unmarshal(body, &errResp); err != nil {
 		// If we can't parse the error, return the raw body
-		return fmt.Errorf("HTTP %d: %s", statusCode, string(body))
+		return atlassian.NewError(statusCode, string(body), nil)
 	}
 
 	// Build error message
 	var messages []string
 	messages = append(messages, errResp.ErrorMessages...)
-	for field, msg := range errResp.Errors {
-		messages = append(messages, fmt.Sprintf("%s: %s", field, msg))
+	fields := make([]string, 0, len(errResp.Errors))
+	for field := range errResp.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		messages = append(messages, fmt.Sprintf("%s: %s", field, errResp.Errors[field]))
 	}
 
 	if len(messages) == 0 {
-		return fmt.Errorf("HTTP %d: %s", statusCode, string(body))
+		return atlassian.NewError(statusCode, string(body), nil)
 	}
 
-	return fmt.Errorf("HTTP %d: %s", statusCode, strings.Join(messages, "; "))
+	return atlassian.NewError(statusCode, strings.Join(messages, "; "), errResp.Errors)
 }
 
 // buildURL builds a full URL with query parameters
@@ -169,9 +269,16 @@ func buildURL(base string, params map[string]string) string {
 		return base
 	}
 
+	// Parameters are sorted by name, so the same parameters give the same URL
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	values := make([]string, 0, len(params))
-	for k, v := range params {
-		if v != "" {
+	for _, k := range keys {
+		if v := params[k]; v != "" {
 			values = append(values, fmt.Sprintf("%s=%s", k, url.QueryEscape(v)))
 		}
 	}
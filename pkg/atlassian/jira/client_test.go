@@ -3,9 +3,15 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+	"github.com/codeownersnet/atlas/pkg/atlassian/atlassiantest"
 )
 
 // mockAuth is a mock authentication provider for testing
@@ -188,6 +194,45 @@ func TestGetIssue(t *testing.T) {
 	}
 }
 
+func TestGetIssueOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("expand") != "renderedFields" || query.Get("properties") != "*all" ||
+			query.Get("fieldsByKeys") != "true" || query.Get("updateHistory") != "true" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"key": "TEST-123",
+			"fields": {"description": "h2. Steps"},
+			"renderedFields": {"description": "<h2>Steps</h2>"},
+			"properties": {"triage": {"done": true}}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	issue, err := client.GetIssue(context.Background(), "TEST-123", &GetIssueOptions{
+		Expand:        []string{"renderedFields"},
+		Properties:    []string{"*all"},
+		FieldsByKeys:  true,
+		UpdateHistory: true,
+	})
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	if issue.RenderedFields["description"] != "<h2>Steps</h2>" {
+		t.Errorf("Expected the rendered description, got %v", issue.RenderedFields)
+	}
+	if triage, ok := issue.Properties["triage"].(map[string]interface{}); !ok || triage["done"] != true {
+		t.Errorf("Expected the triage property, got %v", issue.Properties)
+	}
+}
+
 func TestSearchIssues(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -404,4 +449,489 @@ func TestErrorHandling(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error, got nil")
 	}
+	if !errors.Is(err, atlassian.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errorMessages": [], "errors": {"customfield_10010": "Field 'customfield_10010' cannot be set."}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.CreateIssue(context.Background(), map[string]interface{}{"customfield_10010": "x"})
+	var apiErr *atlassian.Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *atlassian.Error, got %v", err)
+	}
+	if !errors.Is(err, atlassian.ErrValidation) || apiErr.ErrorCode() != atlassian.CodeValidation {
+		t.Errorf("Expected validation error, got %v (%s)", err, apiErr.ErrorCode())
+	}
+	if apiErr.Fields["customfield_10010"] == "" {
+		t.Errorf("Expected field error for customfield_10010, got %v", apiErr.Fields)
+	}
+}
+
+func TestValidationErrorOrder(t *testing.T) {
+	client := &Client{}
+	body := []byte(`{"errors": {"summary": "required", "priority": "invalid", "assignee": "unknown"}}`)
+
+	want := "assignee: unknown; priority: invalid; summary: required"
+	for i := 0; i < 10; i++ {
+		var apiErr *atlassian.Error
+		if err := client.parseError(http.StatusBadRequest, body); !errors.As(err, &apiErr) || apiErr.Message != want {
+			t.Fatalf("parseError() = %v, want fields in sorted order: %s", err, want)
+		}
+	}
+}
+
+func TestBuildURLIsStable(t *testing.T) {
+	params := map[string]string{"maxResults": "50", "jql": "project = PROJ", "fields": "summary", "expand": ""}
+	want := "/rest/api/2/search?fields=summary&jql=project+%3D+PROJ&maxResults=50"
+	for i := 0; i < 10; i++ {
+		if got := buildURL("/rest/api/2/search", params); got != want {
+			t.Fatalf("buildURL() = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestGetUserGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/user/groups" || r.URL.Query().Get("username") != "jdoe" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"name": "jira-users", "self": "x"}, {"name": "developers", "self": "y"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	groups, err := client.GetUserGroups(context.Background(), "jdoe")
+	if err != nil {
+		t.Fatalf("GetUserGroups() error = %v", err)
+	}
+	if len(groups) != 2 || groups[0] != "jira-users" || groups[1] != "developers" {
+		t.Errorf("Expected [jira-users developers], got %v", groups)
+	}
+}
+
+func TestFindGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/groups/picker" || r.URL.Query().Get("query") != "dev" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"header": "Showing 1 of 1 matching groups", "total": 1, "groups": [{"name": "developers", "html": "<b>dev</b>elopers"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	groups, err := client.FindGroups(context.Background(), "dev", 10)
+	if err != nil {
+		t.Fatalf("FindGroups() error = %v", err)
+	}
+	if len(groups) != 1 || groups[0].Name != "developers" {
+		t.Errorf("Expected [developers], got %v", groups)
+	}
+}
+
+func TestAddCommentServerWikiMarkup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if request["body"] != "h3. Status\n* *done*" {
+			t.Errorf("Expected wiki markup body, got %v", request["body"])
+		}
+		visibility, _ := request["visibility"].(map[string]interface{})
+		if visibility["type"] != "role" || visibility["value"] != "Developers" {
+			t.Errorf("Expected role visibility, got %v", request["visibility"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "10100", "body": "h3. Status"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	comment, err := client.AddComment(context.Background(), "TEST-1", "### Status\n- **done**", &Visibility{Type: "role", Value: "Developers"})
+	if err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+	if comment.ID != "10100" {
+		t.Errorf("Expected comment ID 10100, got %s", comment.ID)
+	}
+}
+
+func TestGetSprintReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/greenhopper/1.0/rapid/charts/sprintreport" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("rapidViewId") != "7" || r.URL.Query().Get("sprintId") != "42" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"contents": {
+			"completedIssues": [
+				{"key": "P-1", "summary": "Done", "statusName": "Done", "currentEstimateStatistic": {"statFieldValue": {"value": 5}}},
+				{"key": "P-2", "summary": "Late add", "statusName": "Done", "currentEstimateStatistic": {"statFieldValue": {"value": 2}}}
+			],
+			"issuesNotCompletedInCurrentSprint": [
+				{"key": "P-3", "summary": "Carried over", "statusName": "In Progress", "currentEstimateStatistic": {"statFieldValue": {"value": 3}}}
+			],
+			"puntedIssues": [
+				{"key": "P-4", "summary": "Removed", "statusName": "To Do", "currentEstimateStatistic": {"statFieldValue": {}}}
+			],
+			"issueKeysAddedDuringSprint": {"P-2": true}
+		}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	report, err := client.GetSprintReport(context.Background(), 7, 42)
+	if err != nil {
+		t.Fatalf("GetSprintReport() error = %v", err)
+	}
+
+	if len(report.Completed) != 2 || len(report.NotCompleted) != 1 || len(report.Removed) != 1 {
+		t.Fatalf("unexpected issue groups: %+v", report)
+	}
+	if report.CompletedPoints != 7 || report.NotCompletedPoints != 3 || report.TotalPoints != 10 {
+		t.Errorf("points = %v/%v/%v, want 7/3/10", report.CompletedPoints, report.NotCompletedPoints, report.TotalPoints)
+	}
+	if len(report.AddedKeys) != 1 || report.AddedKeys[0] != "P-2" || report.AddedPoints != 2 {
+		t.Errorf("added = %v (%v points), want [P-2] (2 points)", report.AddedKeys, report.AddedPoints)
+	}
+}
+
+func TestComputeSprintReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/agile/1.0/sprint/42":
+			w.Write([]byte(`{"id": 42, "name": "Sprint 42", "state": "active", "startDate": "2024-05-01T09:00:00.000Z"}`))
+		case "/rest/agile/1.0/sprint/42/issue":
+			if fields := r.URL.Query().Get("fields"); fields != "summary,status,issuetype,created,customfield_10016" {
+				t.Errorf("unexpected fields: %s", fields)
+			}
+			w.Write([]byte(`{"total": 2, "issues": [
+				{"key": "P-1", "fields": {"summary": "Done", "created": "2024-04-28T10:00:00.000Z",
+					"status": {"name": "Done", "statusCategory": {"key": "done"}}, "customfield_10016": 5}},
+				{"key": "P-2", "fields": {"summary": "Open", "created": "2024-05-03T10:00:00.000Z",
+					"status": {"name": "To Do", "statusCategory": {"key": "new"}}, "customfield_10016": null}}
+			]}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	report, err := client.ComputeSprintReport(context.Background(), 42, "customfield_10016")
+	if err != nil {
+		t.Fatalf("ComputeSprintReport() error = %v", err)
+	}
+
+	if report.Source != SprintReportSourceComputed || report.Sprint == nil || report.Sprint.Name != "Sprint 42" {
+		t.Errorf("unexpected report header: %+v", report)
+	}
+	if len(report.Completed) != 1 || report.Completed[0].Key != "P-1" || report.CompletedPoints != 5 {
+		t.Errorf("completed = %+v (%v points), want [P-1] (5 points)", report.Completed, report.CompletedPoints)
+	}
+	if len(report.NotCompleted) != 1 || report.NotCompleted[0].Key != "P-2" {
+		t.Errorf("not completed = %+v, want [P-2]", report.NotCompleted)
+	}
+	if len(report.AddedKeys) != 1 || report.AddedKeys[0] != "P-2" {
+		t.Errorf("added = %v, want [P-2]", report.AddedKeys)
+	}
+}
+
+func TestSearchAllIssues(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		var body struct {
+			StartAt    int `json:"startAt"`
+			MaxResults int `json:"maxResults"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		// Five issues in total; the server caps pages at two issues
+		result := SearchResult{StartAt: body.StartAt, MaxResults: 2, Total: 5}
+		for i := body.StartAt; i < 5 && i < body.StartAt+min(body.MaxResults, 2); i++ {
+			result.Issues = append(result.Issues, Issue{Key: fmt.Sprintf("TEST-%d", i+1)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &mockAuth{},
+		SSLVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	result, err := client.SearchAllIssues(context.Background(), "project = TEST", nil, 1000)
+	if err != nil {
+		t.Fatalf("SearchAllIssues() error = %v", err)
+	}
+	if len(result.Issues) != 5 || result.Issues[4].Key != "TEST-5" {
+		t.Errorf("Expected 5 issues ending with TEST-5, got %d", len(result.Issues))
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+	if result.HasMore() {
+		t.Errorf("Expected HasMore() = false after fetching every issue")
+	}
+
+	result, err = client.SearchAllIssues(context.Background(), "project = TEST", nil, 3)
+	if err != nil {
+		t.Fatalf("SearchAllIssues() error = %v", err)
+	}
+	if len(result.Issues) != 3 || !result.HasMore() {
+		t.Errorf("Expected 3 issues with more remaining, got %d (HasMore %v)", len(result.Issues), result.HasMore())
+	}
+}
+
+func TestResolveSecurityLevel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/project/PROJ/securitylevel" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"levels": [{"id": "10000", "name": "Internal"}, {"id": "10001", "name": "Security Team"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	for _, level := range []string{"10001", "security team"} {
+		id, err := client.ResolveSecurityLevel(context.Background(), "PROJ", level)
+		if err != nil || id != "10001" {
+			t.Errorf("ResolveSecurityLevel(%q) = %q, %v, want 10001", level, id, err)
+		}
+	}
+	if _, err := client.ResolveSecurityLevel(context.Background(), "PROJ", "Public"); err == nil {
+		t.Error("ResolveSecurityLevel() with an unknown level should return an error")
+	}
+}
+
+func TestGetProjectRoles(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/2/project/PROJ/role":
+			w.Write([]byte(`{"Developers": "` + server.URL + `/rest/api/2/project/PROJ/role/10001", "Administrators": "` + server.URL + `/rest/api/2/project/PROJ/role/10002"}`))
+		case "/rest/api/2/project/PROJ/role/10001":
+			w.Write([]byte(`{"id": 10001, "name": "Developers", "actors": [{"id": 1, "displayName": "Jane Doe", "type": "atlassian-user-role-actor", "name": "jdoe"}]}`))
+		case "/rest/api/2/project/PROJ/role/10002":
+			w.Write([]byte(`{"id": 10002, "name": "Administrators", "actors": [{"id": 2, "displayName": "jira-admins", "type": "atlassian-group-role-actor", "name": "jira-admins"}]}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	roles, err := client.GetProjectRoles(context.Background(), "PROJ", "")
+	if err != nil {
+		t.Fatalf("GetProjectRoles() error = %v", err)
+	}
+	if len(roles) != 2 || roles[0].Name != "Administrators" || roles[1].Name != "Developers" {
+		t.Fatalf("Expected Administrators and Developers, got %+v", roles)
+	}
+	if len(roles[1].Actors) != 1 || roles[1].Actors[0].Name != "jdoe" {
+		t.Errorf("Expected jdoe in Developers, got %+v", roles[1].Actors)
+	}
+
+	roles, err = client.GetProjectRoles(context.Background(), "PROJ", "developers")
+	if err != nil || len(roles) != 1 || roles[0].ID != 10001 {
+		t.Errorf("GetProjectRoles(developers) = %+v, %v, want the Developers role", roles, err)
+	}
+	if _, err := client.GetProjectRoles(context.Background(), "PROJ", "Testers"); err == nil {
+		t.Error("GetProjectRoles() with an unknown role should return an error")
+	}
+}
+
+func TestSearchIssuesLegacyFallback(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/search/jql":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errorMessages": ["Not found"]}`))
+		case "/rest/api/3/search":
+			// Pages of two of three issues
+			startAt := int(body["startAt"].(float64))
+			result := SearchResult{StartAt: startAt, Total: 3}
+			for i := startAt; i < min(startAt+2, 3); i++ {
+				result.Issues = append(result.Issues, Issue{Key: fmt.Sprintf("PROJ-%d", i+1)})
+			}
+			json.NewEncoder(w).Encode(result)
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: "https://mycompany.atlassian.net", Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	testClient, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create test client: %v", err)
+	}
+	client.httpClient = testClient.httpClient
+	client.baseURL = server.URL
+
+	result, err := client.SearchAllIssues(context.Background(), "project = PROJ", nil, 3)
+	if err != nil {
+		t.Fatalf("SearchAllIssues() error = %v", err)
+	}
+	if len(result.Issues) != 3 || result.HasMore() {
+		t.Errorf("Expected 3 issues and no more, got %d (HasMore %v)", len(result.Issues), result.HasMore())
+	}
+
+	// The enhanced endpoint is only tried once
+	want := []string{"/rest/api/3/search/jql", "/rest/api/3/search", "/rest/api/3/search"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Requests = %v, want %v", paths, want)
+	}
+}
+
+func TestSearchIssuesCloudPages(t *testing.T) {
+	fake := atlassiantest.NewServer()
+	defer fake.Close()
+	fake.Handle(http.MethodPost, "/rest/api/3/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["startAt"]; ok {
+			t.Errorf("the enhanced search endpoint was sent startAt: %v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if body["nextPageToken"] == "page-2" {
+			w.Write([]byte(`{"issues": [{"key": "PROJ-3"}]}`))
+			return
+		}
+		w.Write([]byte(`{"issues": [{"key": "PROJ-1"}, {"key": "PROJ-2"}], "nextPageToken": "page-2"}`))
+	})
+
+	client, err := NewClient(&Config{BaseURL: "https://mycompany.atlassian.net", HTTPClient: fake.Doer()})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if !client.TokenPagination() {
+		t.Fatal("TokenPagination() = false for a Cloud site")
+	}
+
+	first, err := client.SearchIssues(context.Background(), "project = PROJ", &SearchOptions{MaxResults: 2})
+	if err != nil || len(first.Issues) != 2 || first.NextPageToken != "page-2" {
+		t.Fatalf("SearchIssues() = %+v, %v, want the first page with a token", first, err)
+	}
+	second, err := client.SearchIssues(context.Background(), "project = PROJ", &SearchOptions{MaxResults: 2, NextPageToken: first.NextPageToken})
+	if err != nil || len(second.Issues) != 1 || second.Issues[0].Key != "PROJ-3" || second.HasMore() {
+		t.Fatalf("SearchIssues() page 2 = %+v, %v", second, err)
+	}
+
+	// An offset would silently return the first page again
+	if _, err := client.SearchIssues(context.Background(), "project = PROJ", &SearchOptions{StartAt: 2}); err == nil {
+		t.Error("SearchIssues() with StartAt on Cloud should return an error")
+	}
+}
+
+func TestGetAllProjectsIncludeArchived(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("includeArchived") != "true" {
+			t.Errorf("Expected includeArchived=true, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "1", "key": "OLD", "name": "Old", "archived": true}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	projects, err := client.GetAllProjects(context.Background(), &GetProjectsOptions{IncludeArchived: true})
+	if err != nil {
+		t.Fatalf("GetAllProjects() error = %v", err)
+	}
+	if len(projects) != 1 || !projects[0].Archived {
+		t.Errorf("Expected the archived project, got %+v", projects)
+	}
+}
+
+func TestIsArchived(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"gone", atlassian.NewError(http.StatusGone, "Gone", nil), true},
+		{"archived message", fmt.Errorf("failed to get issue: %w", atlassian.NewError(http.StatusNotFound, "Issue PROJ-1 is archived", nil)), true},
+		{"not found", atlassian.NewError(http.StatusNotFound, "Issue does not exist", nil), false},
+		{"other error", errors.New("archived"), false},
+	}
+
+	for _, tt := range tests {
+		if got := IsArchived(tt.err); got != tt.want {
+			t.Errorf("%s: IsArchived() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
 }
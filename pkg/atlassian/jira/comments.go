@@ -31,33 +31,25 @@ func (c *Client) GetComment(ctx context.Context, issueKey string, commentID stri
 }
 
 // AddComment adds a comment to an issue
-// For Cloud (API v3), the body is automatically converted to ADF format.
-// For Server/DC (API v2), the body is sent as plain text.
+// The markdown body is converted to ADF for Cloud (API v3) and to wiki
+// markup for Server/DC (API v2).
 func (c *Client) AddComment(ctx context.Context, issueKey string, body string, visibility *Visibility) (*Comment, error) {
-	path := fmt.Sprintf("%s/issue/%s/comment", c.getAPIPath(), issueKey)
+	return c.addComment(ctx, issueKey, c.CommentBody(body), visibility)
+}
 
-	var reqBody []byte
-	var err error
+// addComment adds a comment with a body already in the rich text format of
+// the deployment
+func (c *Client) addComment(ctx context.Context, issueKey string, body interface{}, visibility *Visibility) (*Comment, error) {
+	path := fmt.Sprintf("%s/issue/%s/comment", c.getAPIPath(), issueKey)
 
-	if c.IsCloud() {
-		// Cloud API v3 requires ADF format for comment body
-		adfBody := MarkdownToADF(body)
-		request := map[string]interface{}{
-			"body": adfBody.ToMap(),
-		}
-		if visibility != nil {
-			request["visibility"] = visibility
-		}
-		reqBody, err = json.Marshal(request)
-	} else {
-		// Server/DC uses plain text
-		request := CreateCommentRequest{
-			Body:       body,
-			Visibility: visibility,
-		}
-		reqBody, err = json.Marshal(request)
+	request := map[string]interface{}{
+		"body": body,
+	}
+	if visibility != nil {
+		request["visibility"] = visibility
 	}
 
+	reqBody, err := json.Marshal(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal comment request: %w", err)
 	}
@@ -71,33 +63,19 @@ func (c *Client) AddComment(ctx context.Context, issueKey string, body string, v
 }
 
 // UpdateComment updates an existing comment
-// For Cloud (API v3), the body is automatically converted to ADF format.
-// For Server/DC (API v2), the body is sent as plain text.
+// The markdown body is converted to ADF for Cloud (API v3) and to wiki
+// markup for Server/DC (API v2).
 func (c *Client) UpdateComment(ctx context.Context, issueKey string, commentID string, body string, visibility *Visibility) (*Comment, error) {
 	path := fmt.Sprintf("%s/issue/%s/comment/%s", c.getAPIPath(), issueKey, commentID)
 
-	var reqBody []byte
-	var err error
-
-	if c.IsCloud() {
-		// Cloud API v3 requires ADF format for comment body
-		adfBody := MarkdownToADF(body)
-		request := map[string]interface{}{
-			"body": adfBody.ToMap(),
-		}
-		if visibility != nil {
-			request["visibility"] = visibility
-		}
-		reqBody, err = json.Marshal(request)
-	} else {
-		// Server/DC uses plain text
-		request := CreateCommentRequest{
-			Body:       body,
-			Visibility: visibility,
-		}
-		reqBody, err = json.Marshal(request)
+	request := map[string]interface{}{
+		"body": c.CommentBody(body),
+	}
+	if visibility != nil {
+		request["visibility"] = visibility
 	}
 
+	reqBody, err := json.Marshal(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal comment request: %w", err)
 	}
@@ -120,3 +98,13 @@ func (c *Client) DeleteComment(ctx context.Context, issueKey string, commentID s
 
 	return nil
 }
+
+// CommentBody converts a markdown comment body to the rich text format of the
+// deployment: an ADF document for Cloud (API v3), wiki markup for Server/DC
+// (API v2)
+func (c *Client) CommentBody(body string) interface{} {
+	if c.IsCloud() {
+		return MarkdownToADF(body).ToMap()
+	}
+	return MarkdownToWiki(body)
+}
@@ -0,0 +1,179 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// wikiHeadingPattern matches a wiki markup heading line, e.g. "h2. Impact"
+var wikiHeadingPattern = regexp.MustCompile(`^h([1-6])\.\s+(.*)$`)
+
+// DescriptionPatch changes part of an issue description, leaving the rest and
+// its formatting as it is
+type DescriptionPatch struct {
+	Content string // Markdown to add
+	Section string // Heading of the section whose content is replaced; empty appends to the end
+}
+
+// PatchDescription appends markdown to the description of an issue, or
+// replaces the content of the section under a heading (up to the next heading
+// of the same or a higher level). A section that doesn't exist is added at
+// the end under a level 2 heading. It reports whether the section was added.
+//
+// On Cloud the current ADF document is merged node by node, so existing
+// formatting, mentions, and media are preserved; on Server/DC the wiki markup
+// is merged line by line.
+func (c *Client) PatchDescription(ctx context.Context, issueKey string, patch *DescriptionPatch) (bool, error) {
+	issue, err := c.GetIssue(ctx, issueKey, &GetIssueOptions{Fields: []string{"description"}})
+	if err != nil {
+		return false, err
+	}
+
+	current := issue.Fields.Description
+
+	var description interface{}
+	var added bool
+	if c.IsCloud() {
+		doc := &ADFDocument{Version: 1, Type: "doc"}
+		if current != nil && current.IsADF() {
+			if err := json.Unmarshal(current.Raw(), doc); err != nil {
+				return false, fmt.Errorf("failed to decode description of %s: %w", issueKey, err)
+			}
+		} else if text := current.String(); text != "" {
+			doc = MarkdownToADF(text)
+		}
+		added = patchADF(doc, patch)
+		description = doc.ToMap()
+	} else {
+		var wiki string
+		wiki, added = patchWiki(current.String(), patch)
+		description = wiki
+	}
+
+	path := fmt.Sprintf("%s/issue/%s", c.getAPIPath(), issueKey)
+	body, err := json.Marshal(UpdateIssueRequest{Fields: map[string]interface{}{"description": description}})
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	if err := c.doRequest(ctx, "PUT", path, body, nil); err != nil {
+		return false, fmt.Errorf("failed to update description of %s: %w", issueKey, err)
+	}
+	return added, nil
+}
+
+// patchADF applies a patch to an ADF document, reporting whether a section
+// was added
+func patchADF(doc *ADFDocument, patch *DescriptionPatch) bool {
+	content := MarkdownToADF(patch.Content).Content
+	if patch.Section == "" {
+		doc.Content = append(doc.Content, content...)
+		return false
+	}
+
+	start, level := -1, 0
+	for i, node := range doc.Content {
+		if node.Type == "heading" && sameHeading(adfNodeText(node), patch.Section) {
+			start, level = i, adfHeadingLevel(node)
+			break
+		}
+	}
+	if start < 0 {
+		heading := MarkdownToADF("## " + headingText(patch.Section)).Content
+		doc.Content = append(append(doc.Content, heading...), content...)
+		return true
+	}
+
+	end := len(doc.Content)
+	for i := start + 1; i < len(doc.Content); i++ {
+		if node := doc.Content[i]; node.Type == "heading" && adfHeadingLevel(node) <= level {
+			end = i
+			break
+		}
+	}
+
+	patched := append([]ADFNode{}, doc.Content[:start+1]...)
+	patched = append(patched, content...)
+	doc.Content = append(patched, doc.Content[end:]...)
+	return false
+}
+
+// adfNodeText returns the text of a node and its descendants
+func adfNodeText(node ADFNode) string {
+	text := node.Text
+	for _, child := range node.Content {
+		text += adfNodeText(child)
+	}
+	return text
+}
+
+// adfHeadingLevel returns the level of a heading node. Decoded JSON numbers
+// are float64; headings built by MarkdownToADF hold ints.
+func adfHeadingLevel(node ADFNode) int {
+	switch level := node.Attrs["level"].(type) {
+	case float64:
+		return int(level)
+	case int:
+		return level
+	}
+	return 1
+}
+
+// patchWiki applies a patch to wiki markup, reporting whether a section was
+// added
+func patchWiki(wiki string, patch *DescriptionPatch) (string, bool) {
+	content := MarkdownToWiki(patch.Content)
+	if patch.Section == "" {
+		return joinBlocks(wiki, content), false
+	}
+
+	lines := strings.Split(wiki, "\n")
+	start, level := -1, 0
+	for i, line := range lines {
+		if m := wikiHeadingPattern.FindStringSubmatch(strings.TrimSpace(line)); m != nil && sameHeading(m[2], patch.Section) {
+			start, level = i, int(m[1][0]-'0')
+			break
+		}
+	}
+	if start < 0 {
+		return joinBlocks(wiki, "h2. "+headingText(patch.Section)+"\n"+content), true
+	}
+
+	end := len(lines)
+	for i := start + 1; i < len(lines); i++ {
+		if m := wikiHeadingPattern.FindStringSubmatch(strings.TrimSpace(lines[i])); m != nil && int(m[1][0]-'0') <= level {
+			end = i
+			break
+		}
+	}
+
+	before := strings.Join(lines[:start+1], "\n")
+	after := strings.TrimSpace(strings.Join(lines[end:], "\n"))
+	return joinBlocks(before+"\n"+content, after), false
+}
+
+// joinBlocks joins two blocks of text with a blank line, skipping empty ones
+func joinBlocks(a, b string) string {
+	a, b = strings.TrimRight(a, "\n"), strings.TrimSpace(b)
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	}
+	return a + "\n\n" + b
+}
+
+// sameHeading reports whether a heading's text matches the requested one,
+// ignoring case and surrounding space
+func sameHeading(text, heading string) bool {
+	return strings.EqualFold(strings.TrimSpace(text), headingText(heading))
+}
+
+// headingText strips markdown heading markers (e.g. "## Impact") from a
+// requested section heading
+func headingText(heading string) string {
+	return strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(heading), "#"))
+}
@@ -0,0 +1,180 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPatchWiki(t *testing.T) {
+	wiki := "Intro\n\nh2. Impact\nOld impact\n\nh3. Details\nOld details\n\nh2. Plan\nThe plan"
+
+	tests := []struct {
+		name      string
+		patch     DescriptionPatch
+		want      string
+		wantAdded bool
+	}{
+		{
+			name:  "append",
+			patch: DescriptionPatch{Content: "More"},
+			want:  wiki + "\n\nMore",
+		},
+		{
+			name:  "replace section with subsections",
+			patch: DescriptionPatch{Content: "New impact", Section: "## impact"},
+			want:  "Intro\n\nh2. Impact\nNew impact\n\nh2. Plan\nThe plan",
+		},
+		{
+			name:  "replace last section",
+			patch: DescriptionPatch{Content: "New plan", Section: "Plan"},
+			want:  "Intro\n\nh2. Impact\nOld impact\n\nh3. Details\nOld details\n\nh2. Plan\nNew plan",
+		},
+		{
+			name:      "add missing section",
+			patch:     DescriptionPatch{Content: "Rolled back", Section: "Resolution"},
+			want:      wiki + "\n\nh2. Resolution\nRolled back",
+			wantAdded: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, added := patchWiki(wiki, &tt.patch)
+			if got != tt.want || added != tt.wantAdded {
+				t.Errorf("patchWiki() = %q, %v, want %q, %v", got, added, tt.want, tt.wantAdded)
+			}
+		})
+	}
+}
+
+func TestPatchADF(t *testing.T) {
+	newDoc := func() *ADFDocument {
+		var doc ADFDocument
+		// Decoded from JSON, as PatchDescription does, so heading levels are float64
+		raw := `{"version": 1, "type": "doc", "content": [
+			{"type": "paragraph", "content": [{"type": "text", "text": "Intro", "marks": [{"type": "strong"}]}]},
+			{"type": "heading", "attrs": {"level": 2}, "content": [{"type": "text", "text": "Impact"}]},
+			{"type": "paragraph", "content": [{"type": "text", "text": "Old impact"}]},
+			{"type": "heading", "attrs": {"level": 3}, "content": [{"type": "text", "text": "Details"}]},
+			{"type": "paragraph", "content": [{"type": "text", "text": "Old details"}]},
+			{"type": "heading", "attrs": {"level": 2}, "content": [{"type": "text", "text": "Plan"}]},
+			{"type": "paragraph", "content": [{"type": "text", "text": "The plan"}]}
+		]}`
+		if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+			t.Fatalf("Failed to decode document: %v", err)
+		}
+		return &doc
+	}
+	texts := func(doc *ADFDocument) []string {
+		var out []string
+		for _, node := range doc.Content {
+			out = append(out, node.Type+":"+adfNodeText(node))
+		}
+		return out
+	}
+
+	doc := newDoc()
+	if added := patchADF(doc, &DescriptionPatch{Content: "More"}); added {
+		t.Error("patchADF() append reported an added section")
+	}
+	if got := texts(doc); len(got) != 8 || got[7] != "paragraph:More" {
+		t.Errorf("append = %v", got)
+	}
+	if len(doc.Content[0].Content[0].Marks) != 1 {
+		t.Error("append lost the formatting of existing content")
+	}
+
+	doc = newDoc()
+	patchADF(doc, &DescriptionPatch{Content: "New impact", Section: "impact"})
+	want := "paragraph:Intro heading:Impact paragraph:New impact heading:Plan paragraph:The plan"
+	if got := strings.Join(texts(doc), " "); got != want {
+		t.Errorf("replace section = %q, want %q", got, want)
+	}
+
+	doc = newDoc()
+	if added := patchADF(doc, &DescriptionPatch{Content: "Rolled back", Section: "Resolution"}); !added {
+		t.Error("patchADF() with a missing section should report it as added")
+	}
+	got := texts(doc)
+	if len(got) != 9 || got[7] != "heading:Resolution" || got[8] != "paragraph:Rolled back" {
+		t.Errorf("add section = %v", got)
+	}
+}
+
+func TestPatchDescription(t *testing.T) {
+	t.Run("server", func(t *testing.T) {
+		var sent map[string]map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/rest/api/2/issue/PROJ-1":
+				w.Write([]byte(`{"key": "PROJ-1", "fields": {"description": "h2. Impact\nOld"}}`))
+			case r.Method == http.MethodPut && r.URL.Path == "/rest/api/2/issue/PROJ-1":
+				data, _ := io.ReadAll(r.Body)
+				json.Unmarshal(data, &sent)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+
+		added, err := client.PatchDescription(context.Background(), "PROJ-1", &DescriptionPatch{Content: "**New**", Section: "Impact"})
+		if err != nil || added {
+			t.Fatalf("PatchDescription() = %v, %v", added, err)
+		}
+		if got := sent["fields"]["description"]; got != "h2. Impact\n*New*" {
+			t.Errorf("description = %q", got)
+		}
+	})
+
+	t.Run("cloud", func(t *testing.T) {
+		var sent struct {
+			Fields struct {
+				Description ADFDocument `json:"description"`
+			} `json:"fields"`
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/PROJ-1":
+				w.Write([]byte(`{"key": "PROJ-1", "fields": {"description": {"version": 1, "type": "doc", "content": [
+					{"type": "paragraph", "content": [{"type": "mention", "attrs": {"id": "abc", "text": "@Jane"}}]}
+				]}}}`))
+			case r.Method == http.MethodPut && r.URL.Path == "/rest/api/3/issue/PROJ-1":
+				data, _ := io.ReadAll(r.Body)
+				json.Unmarshal(data, &sent)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		client.deploymentType = DeploymentCloud
+
+		if _, err := client.PatchDescription(context.Background(), "PROJ-1", &DescriptionPatch{Content: "Follow-up"}); err != nil {
+			t.Fatalf("PatchDescription() error = %v", err)
+		}
+		content := sent.Fields.Description.Content
+		if len(content) != 2 || content[0].Content[0].Type != "mention" || adfNodeText(content[1]) != "Follow-up" {
+			t.Errorf("description = %+v", sent.Fields.Description)
+		}
+	})
+}
@@ -6,8 +6,13 @@ import (
 	"strings"
 )
 
-// GetAllFields retrieves all fields (standard and custom)
+// GetAllFields retrieves all fields (standard and custom). The result is
+// cached; see InvalidateMetadata.
 func (c *Client) GetAllFields(ctx context.Context) ([]Field, error) {
+	if cached, ok := c.metadata.get(fieldsCacheKey); ok {
+		return cached.([]Field), nil
+	}
+
 	path := fmt.Sprintf("%s/field", c.getAPIPath())
 
 	var fields []Field
@@ -15,6 +20,7 @@ func (c *Client) GetAllFields(ctx context.Context) ([]Field, error) {
 		return nil, fmt.Errorf("failed to get fields: %w", err)
 	}
 
+	c.metadata.put(fieldsCacheKey, fields)
 	return fields, nil
 }
 
@@ -93,20 +99,9 @@ func (c *Client) GetCustomFields(ctx context.Context) ([]Field, error) {
 	return customFields, nil
 }
 
-// GetEssentialFields returns a list of essential field IDs
+// GetEssentialFields returns the field IDs of the essential field profile
 func (c *Client) GetEssentialFields() []string {
-	return []string{
-		"summary",
-		"status",
-		"issuetype",
-		"project",
-		"created",
-		"updated",
-		"priority",
-		"assignee",
-		"reporter",
-		"description",
-	}
+	return DefaultFieldProfiles().Resolve(FieldProfileEssential, "")
 }
 
 // GetEpicLinkField attempts to find the Epic Link field
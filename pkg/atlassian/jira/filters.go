@@ -0,0 +1,52 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Filter is a saved JQL filter
+type Filter struct {
+	ID          string `json:"id"`
+	Self        string `json:"self,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	JQL         string `json:"jql"`
+	ViewURL     string `json:"viewUrl,omitempty"`
+}
+
+// CreateFilterRequest represents a request to save a filter
+type CreateFilterRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	JQL         string `json:"jql"`
+}
+
+// CreateFilter saves a JQL query as a filter owned by the authenticated user
+func (c *Client) CreateFilter(ctx context.Context, req *CreateFilterRequest) (*Filter, error) {
+	path := fmt.Sprintf("%s/filter", c.getAPIPath())
+
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal filter request: %w", err)
+	}
+
+	var filter Filter
+	if err := c.doRequest(ctx, "POST", path, reqBody, &filter); err != nil {
+		return nil, fmt.Errorf("failed to create filter: %w", err)
+	}
+
+	return &filter, nil
+}
+
+// DeleteFilter deletes a saved filter
+func (c *Client) DeleteFilter(ctx context.Context, filterID string) error {
+	path := fmt.Sprintf("%s/filter/%s", c.getAPIPath(), filterID)
+
+	if err := c.doRequest(ctx, "DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete filter %s: %w", filterID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+)
+
+// RemediationHints maps a Jira API error to actionable hints for the agent
+// that made the call, naming the tools that help correct it
+func RemediationHints(apiErr *atlassian.Error) []string {
+	var hints []string
+
+	// Field errors, in field order for stable output
+	fields := make([]string, 0, len(apiErr.Fields))
+	for field := range apiErr.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		message := strings.ToLower(apiErr.Fields[field])
+		switch {
+		case strings.Contains(message, "cannot be set") || strings.Contains(message, "not on the appropriate screen"):
+			hints = append(hints, fmt.Sprintf("Field %s is not on the create/edit screen of this project and issue type: remove it from fields, or ask a Jira admin to add it to the screen configuration", field))
+		case strings.Contains(message, "required"):
+			hints = append(hints, fmt.Sprintf("Field %s is required: add it to fields", field))
+		case strings.Contains(message, "valid") || strings.Contains(message, "allowed") || strings.Contains(message, "does not exist"):
+			hints = append(hints, fmt.Sprintf("Field %s has an invalid value: check the allowed values and the expected format (e.g. {\"name\": \"High\"} for options)", field))
+		}
+		if strings.HasPrefix(field, "customfield_") {
+			hints = append(hints, fmt.Sprintf("Run jira_search_fields to check the ID, name, and type of %s", field))
+		}
+	}
+
+	message := strings.ToLower(apiErr.Message)
+	if strings.Contains(message, "transition") {
+		hints = append(hints, "Run jira_get_transitions to list the transitions available from the issue's current status")
+	}
+
+	if IsArchived(apiErr) {
+		hints = append(hints, "The issue or project is archived: restore it in Jira before changing it, or use jira_get_all_projects with include_archived to check")
+	}
+
+	switch apiErr.Unwrap() {
+	case atlassian.ErrNotFound:
+		hints = append(hints, "Check the key or ID, and that the configured user can browse the project (use jira_search or jira_get_all_projects to find it)")
+	case atlassian.ErrUnauthorized:
+		hints = append(hints, "Authentication failed: check the Jira credentials and run 'atlas-mcp doctor'")
+	case atlassian.ErrPermission:
+		hints = append(hints, "The configured user lacks the Jira permission for this operation: run jira_get_my_permissions to see which permissions it holds, and check the project's permission scheme")
+	case atlassian.ErrRateLimited:
+		hints = append(hints, "Jira rate limit reached: wait before retrying, and fetch fewer results per call")
+	}
+
+	return hints
+}
@@ -0,0 +1,91 @@
+package jira
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+)
+
+func TestRemediationHints(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *atlassian.Error
+		want []string // Substrings of the hints, in order
+	}{
+		{
+			name: "field not on screen",
+			err:  atlassian.NewError(400, "", map[string]string{"labels": "Field 'labels' cannot be set. It is not on the appropriate screen, or unknown."}),
+			want: []string{"Field labels is not on the create/edit screen"},
+		},
+		{
+			name: "required custom field",
+			err:  atlassian.NewError(400, "", map[string]string{"customfield_10010": "Team is required."}),
+			want: []string{"Field customfield_10010 is required", "Run jira_search_fields to check the ID, name, and type of customfield_10010"},
+		},
+		{
+			name: "invalid values in field order",
+			err: atlassian.NewError(400, "", map[string]string{
+				"priority":   "Specify a valid value for priority",
+				"components": "Component name 'Web' does not exist.",
+			}),
+			want: []string{"Field components has an invalid value", "Field priority has an invalid value"},
+		},
+		{
+			name: "transition",
+			err:  atlassian.NewError(400, "Transition id '31' is not valid for this issue.", nil),
+			want: []string{"Run jira_get_transitions"},
+		},
+		{
+			name: "archived",
+			err:  atlassian.NewError(410, "Issue is archived", nil),
+			want: []string{"The issue or project is archived"},
+		},
+		{
+			name: "not found",
+			err:  atlassian.NewError(404, "Issue does not exist or you do not have permission to see it.", nil),
+			want: []string{"Check the key or ID"},
+		},
+		{
+			name: "unauthorized",
+			err:  atlassian.NewError(401, "Unauthorized", nil),
+			want: []string{"Authentication failed"},
+		},
+		{
+			name: "permission",
+			err:  atlassian.NewError(403, "You do not have permission to edit issues", nil),
+			want: []string{"run jira_get_my_permissions"},
+		},
+		{
+			name: "rate limited",
+			err:  atlassian.NewError(429, "Rate limit exceeded", nil),
+			want: []string{"Jira rate limit reached"},
+		},
+		{
+			name: "no hints",
+			err:  atlassian.NewError(500, "Internal server error", nil),
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hints := RemediationHints(tt.err)
+			if len(hints) != len(tt.want) {
+				t.Fatalf("RemediationHints() = %q, want %d hints", hints, len(tt.want))
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(hints[i], want) {
+					t.Errorf("hint %d = %q, want it to contain %q", i, hints[i], want)
+				}
+			}
+		})
+	}
+
+	// Hints are stable across calls, whatever the map order of the fields
+	err := atlassian.NewError(400, "", map[string]string{"a": "a is required", "b": "b is required", "c": "c is required"})
+	if first, again := RemediationHints(err), RemediationHints(err); !reflect.DeepEqual(first, again) {
+		t.Errorf("RemediationHints() = %q, then %q", first, again)
+	}
+}
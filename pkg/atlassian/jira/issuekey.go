@@ -0,0 +1,43 @@
+package jira
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// issueKeyPattern matches a whole issue key, e.g. PROJ-123
+var issueKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-\d+$`)
+
+// ParseIssueKey returns the issue key in a Jira URL, so pasted links can be
+// used wherever a key is expected. Browse URLs (/browse/PROJ-123), board
+// URLs (?selectedIssue=PROJ-123), and URLs ending in a key (e.g. service desk
+// queues) are understood. Anything else, such as a key or an issue ID, is
+// returned trimmed but otherwise unchanged.
+func ParseIssueKey(value string) string {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "http://") && !strings.HasPrefix(value, "https://") {
+		return value
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return value
+	}
+
+	if key := u.Query().Get("selectedIssue"); issueKeyPattern.MatchString(key) {
+		return key
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "browse" && i+1 < len(segments) && issueKeyPattern.MatchString(segments[i+1]) {
+			return segments[i+1]
+		}
+	}
+	if last := segments[len(segments)-1]; issueKeyPattern.MatchString(last) {
+		return last
+	}
+
+	return value
+}
@@ -0,0 +1,26 @@
+package jira
+
+import "testing"
+
+func TestParseIssueKey(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"PROJ-123", "PROJ-123"},
+		{"  PROJ-123 ", "PROJ-123"},
+		{"10042", "10042"},
+		{"https://example.atlassian.net/browse/PROJ-123", "PROJ-123"},
+		{"https://example.atlassian.net/browse/PROJ-123?focusedCommentId=10500", "PROJ-123"},
+		{"https://jira.example.com/jira/browse/AB_C-7", "AB_C-7"},
+		{"https://example.atlassian.net/jira/software/projects/PROJ/boards/1?selectedIssue=PROJ-42", "PROJ-42"},
+		{"https://example.atlassian.net/jira/servicedesk/projects/SD/queues/custom/1/SD-9", "SD-9"},
+		{"https://example.atlassian.net/jira/software/projects/PROJ/boards/1", "https://example.atlassian.net/jira/software/projects/PROJ/boards/1"},
+	}
+
+	for _, tt := range tests {
+		if got := ParseIssueKey(tt.value); got != tt.want {
+			t.Errorf("ParseIssueKey(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
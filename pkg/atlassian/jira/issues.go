@@ -3,16 +3,22 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian"
 )
 
 // GetIssueOptions contains options for getting an issue
 type GetIssueOptions struct {
-	Fields     []string // Specific fields to retrieve, or "*all" for all fields
-	Expand     []string // Resources to expand (e.g., "changelog", "renderedFields")
-	Properties []string // Properties to retrieve
+	Fields        []string // Specific fields to retrieve, or "*all" for all fields
+	Expand        []string // Resources to expand (e.g., "changelog", "renderedFields", "versionedRepresentations")
+	Properties    []string // Issue properties to retrieve, or "*all" for all properties
+	FieldsByKeys  bool     // Fields are referenced by key rather than ID
+	UpdateHistory bool     // Add the issue to the user's recently viewed issues
 }
 
 // GetIssue retrieves an issue by key or ID
@@ -31,6 +37,12 @@ func (c *Client) GetIssue(ctx context.Context, issueKey string, opts *GetIssueOp
 		if len(opts.Properties) > 0 {
 			params["properties"] = strings.Join(opts.Properties, ",")
 		}
+		if opts.FieldsByKeys {
+			params["fieldsByKeys"] = "true"
+		}
+		if opts.UpdateHistory {
+			params["updateHistory"] = "true"
+		}
 	}
 
 	path = buildURL(path, params)
@@ -53,70 +65,76 @@ type SearchOptions struct {
 	ValidateQuery bool     // Whether to validate the JQL query
 }
 
-// SearchIssues searches for issues using JQL
+// SearchIssues searches for issues using JQL.
+//
+// Cloud uses the enhanced search endpoint (/rest/api/3/search/jql) with
+// token pagination: it has no offset, so StartAt is rejected and later pages
+// are read with NextPageToken. Sites where it is not available yet fall back
+// to the offset-based /rest/api/3/search, and the client remembers the
+// fallback. Server/DC uses /rest/api/2/search.
 func (c *Client) SearchIssues(ctx context.Context, jql string, opts *SearchOptions) (*SearchResult, error) {
-	// Use the deployment-specific search endpoint
-	// Cloud: /rest/api/3/search/jql (POST with JQL in body)
-	// Server/DC: /rest/api/2/search (POST with JQL in body)
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+
+	enhanced := c.TokenPagination()
+	if enhanced && opts.StartAt > 0 {
+		return nil, fmt.Errorf("Jira Cloud search pages with tokens, not offsets: pass the nextPageToken of the previous page instead of startAt %d", opts.StartAt)
+	}
+	result, err := c.search(ctx, jql, opts, enhanced)
+	if enhanced && isEndpointMissing(err) {
+		c.legacySearch.Store(true)
+		result, err = c.search(ctx, jql, opts, false)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	return result, nil
+}
+
+// TokenPagination reports whether searches page with NextPageToken instead
+// of StartAt, as the enhanced Cloud search endpoint does
+func (c *Client) TokenPagination() bool {
+	return c.IsCloud() && !c.legacySearch.Load()
+}
+
+// search runs a search against the enhanced (Cloud) or the offset-based
+// search endpoint
+func (c *Client) search(ctx context.Context, jql string, opts *SearchOptions, enhanced bool) (*SearchResult, error) {
 	path := c.getSearchAPIPath()
+	if c.IsCloud() && !enhanced {
+		path = apiVersion3 + "/search"
+	}
 
-	// Build request body
 	body := map[string]interface{}{
-		"jql": jql,
+		"jql":        jql,
+		"maxResults": 50,
+	}
+	if opts.MaxResults > 0 {
+		body["maxResults"] = opts.MaxResults
+	}
+	if len(opts.Fields) > 0 {
+		body["fields"] = opts.Fields
 	}
 
-	if opts != nil {
-		if len(opts.Fields) > 0 {
-			// Handle "*all" differently for Cloud vs Server
-			// Server v2: use "*all"
-			// Cloud v3: must explicitly list fields (doesn't support "*all" or "*navigable")
-			if len(opts.Fields) == 1 && opts.Fields[0] == "*all" {
-				if c.IsCloud() {
-					// For Cloud v3 API, explicitly list all standard fields
-					// Custom fields (customfield_*) will also be returned
-					body["fields"] = []string{
-						"*all", // Try *all since we fixed the startAt issue
-					}
-				} else{
-					// For Server, use "*all"
-					body["fields"] = opts.Fields
-				}
-			} else {
-				// For specific fields, add them normally
-				body["fields"] = opts.Fields
-			}
-		}
+	if enhanced {
+		// The enhanced endpoint takes expand as a comma-separated string,
+		// pages with tokens, and always validates the query
 		if len(opts.Expand) > 0 {
-			body["expand"] = opts.Expand
+			body["expand"] = strings.Join(opts.Expand, ",")
 		}
-
-		// Handle pagination differently for Cloud vs Server
-		// Cloud v3 uses token-based pagination (nextPageToken)
-		// Server/DC uses offset-based pagination (startAt)
-		if c.IsCloud() {
-			// Cloud: use nextPageToken if provided (omit for first page)
-			if opts.NextPageToken != "" {
-				body["nextPageToken"] = opts.NextPageToken
-			}
-		} else {
-			// Server: use startAt (always include it)
-			body["startAt"] = opts.StartAt
+		if opts.NextPageToken != "" {
+			body["nextPageToken"] = opts.NextPageToken
 		}
-
-		if opts.MaxResults > 0 {
-			body["maxResults"] = opts.MaxResults
-		} else {
-			body["maxResults"] = 50 // Default
+	} else {
+		if len(opts.Expand) > 0 {
+			body["expand"] = opts.Expand
 		}
+		body["startAt"] = opts.StartAt
 		if opts.ValidateQuery {
 			body["validateQuery"] = true
 		}
-	} else {
-		// No options provided - use defaults
-		if !c.IsCloud() {
-			body["startAt"] = 0 // Default for Server
-		}
-		body["maxResults"] = 50 // Default
 	}
 
 	reqBody, err := json.Marshal(body)
@@ -126,12 +144,76 @@ func (c *Client) SearchIssues(ctx context.Context, jql string, opts *SearchOptio
 
 	var result SearchResult
 	if err := c.doRequest(ctx, "POST", path, reqBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to search issues: %w", err)
+		return nil, err
 	}
 
 	return &result, nil
 }
 
+// isEndpointMissing reports whether a request failed because the endpoint
+// does not exist on the instance
+func isEndpointMissing(err error) bool {
+	var apiErr *atlassian.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusMethodNotAllowed
+}
+
+// maxSearchPageSize is the page size used when following search pagination
+const maxSearchPageSize = 100
+
+// SearchAllIssues searches for issues using JQL and follows pagination until
+// every matching issue, or limit issues, has been retrieved. The options'
+// MaxResults is ignored. Use HasMore on the result to tell whether the limit
+// cut the results short.
+func (c *Client) SearchAllIssues(ctx context.Context, jql string, opts *SearchOptions, limit int) (*SearchResult, error) {
+	pageOpts := SearchOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	combined := &SearchResult{StartAt: pageOpts.StartAt}
+	for len(combined.Issues) < limit {
+		pageOpts.MaxResults = min(maxSearchPageSize, limit-len(combined.Issues))
+
+		page, err := c.SearchIssues(ctx, jql, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		combined.Issues = append(combined.Issues, page.Issues...)
+		combined.Total = page.Total
+		combined.NextPageToken = page.NextPageToken
+
+		if len(page.Issues) == 0 {
+			break
+		}
+		if page.NextPageToken != "" {
+			pageOpts.NextPageToken = page.NextPageToken
+			continue
+		}
+		if c.TokenPagination() {
+			break
+		}
+		pageOpts.StartAt += len(page.Issues)
+		if pageOpts.StartAt >= page.Total {
+			break
+		}
+	}
+	combined.MaxResults = len(combined.Issues)
+
+	return combined, nil
+}
+
+// HasMore reports whether more issues match the search than the result holds
+func (r *SearchResult) HasMore() bool {
+	if r.NextPageToken != "" {
+		return true
+	}
+	return r.StartAt+len(r.Issues) < r.Total
+}
+
 // CreateIssue creates a new issue
 // For Cloud (API v3), string descriptions are automatically converted to ADF format.
 // For Server/DC (API v2), descriptions are sent as plain text.
@@ -306,8 +388,12 @@ func (c *Client) BatchGetChangelogs(ctx context.Context, issueKeys []string) (ma
 
 // GetProjectIssues retrieves all issues for a project
 func (c *Client) GetProjectIssues(ctx context.Context, projectKey string, opts *SearchOptions) (*SearchResult, error) {
-	jql := fmt.Sprintf("project = %s ORDER BY created DESC", projectKey)
-	return c.SearchIssues(ctx, jql, opts)
+	return c.SearchIssues(ctx, ProjectIssuesJQL(projectKey), opts)
+}
+
+// ProjectIssuesJQL returns the JQL query used to list a project's issues
+func ProjectIssuesJQL(projectKey string) string {
+	return fmt.Sprintf("project = %s ORDER BY created DESC", projectKey)
 }
 
 // LinkToEpic links an issue to an epic
@@ -353,7 +439,7 @@ func (c *Client) DownloadAttachment(ctx context.Context, attachmentURL string) (
 		return nil, fmt.Errorf("invalid attachment URL: %w", err)
 	}
 
-	resp, err := c.httpClient.Get(ctx, u.Path)
+	resp, err := c.httpClient.Do(ctx, http.MethodGet, u.Path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to download attachment: %w", err)
 	}
@@ -0,0 +1,155 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// jqlDatePattern matches the relative ("-7d", "-2w 3d") and absolute
+	// ("2025-01-15", "2025-01-15 10:00") dates JQL accepts
+	jqlDatePattern = regexp.MustCompile(`^(-?\d+[wdhm](\s+\d+[wdhm])*|\d{4}[-/]\d{2}[-/]\d{2}(\s+\d{2}:\d{2})?)$`)
+
+	// jqlOrderPattern matches one ORDER BY field with an optional direction
+	jqlOrderPattern = regexp.MustCompile(`(?i)^([a-z][\w.]*|cf\[\d+\]|"[^"]+")(\s+(asc|desc))?$`)
+
+	// jqlOrderByClause matches the ORDER BY clause ending a query
+	jqlOrderByClause = regexp.MustCompile(`(?is)(^|\s+)ORDER\s+BY\s.*$`)
+)
+
+// JQLFilter holds structured search criteria for building JQL. Empty
+// criteria are left out; list criteria match any of their values.
+type JQLFilter struct {
+	Projects     []string
+	IssueTypes   []string
+	Statuses     []string
+	Assignee     string // User, Me, or "unassigned"
+	Reporter     string // User or Me
+	Labels       []string
+	Text         string // Full-text search
+	UpdatedSince string // Relative ("-7d") or absolute ("2025-01-15") date
+	CreatedSince string // Relative ("-7d") or absolute ("2025-01-15") date
+	OrderBy      string // Comma-separated fields with optional ASC/DESC (e.g., "priority DESC, updated")
+}
+
+// JQL builds the JQL query of the filter, quoting and escaping every value.
+// It fails if a date or the ordering is invalid, or if the filter is empty.
+func (f *JQLFilter) JQL() (string, error) {
+	var clauses []string
+
+	if clause := jqlIn("project", f.Projects); clause != "" {
+		clauses = append(clauses, clause)
+	}
+	if clause := jqlIn("issuetype", f.IssueTypes); clause != "" {
+		clauses = append(clauses, clause)
+	}
+	if clause := jqlIn("status", f.Statuses); clause != "" {
+		clauses = append(clauses, clause)
+	}
+	if clause := jqlUser("assignee", f.Assignee); clause != "" {
+		clauses = append(clauses, clause)
+	}
+	if clause := jqlUser("reporter", f.Reporter); clause != "" {
+		clauses = append(clauses, clause)
+	}
+	if clause := jqlIn("labels", f.Labels); clause != "" {
+		clauses = append(clauses, clause)
+	}
+	if text := strings.TrimSpace(f.Text); text != "" {
+		clauses = append(clauses, "text ~ "+QuoteJQL(text))
+	}
+
+	for _, since := range []struct{ field, value string }{{"updated", f.UpdatedSince}, {"created", f.CreatedSince}} {
+		value := strings.TrimSpace(since.value)
+		if value == "" {
+			continue
+		}
+		if !jqlDatePattern.MatchString(value) {
+			return "", fmt.Errorf("invalid %s date %q: use a relative date like '-7d' or a date like '2025-01-15'", since.field, value)
+		}
+		clauses = append(clauses, fmt.Sprintf("%s >= %s", since.field, QuoteJQL(value)))
+	}
+
+	orderBy, err := jqlOrderBy(f.OrderBy)
+	if err != nil {
+		return "", err
+	}
+
+	if len(clauses) == 0 && orderBy == "" {
+		return "", fmt.Errorf("at least one filter is required")
+	}
+
+	jql := strings.Join(clauses, " AND ")
+	if orderBy != "" {
+		jql = strings.TrimSpace(jql + " ORDER BY " + orderBy)
+	}
+	return jql, nil
+}
+
+// QuoteJQL quotes a value for JQL, escaping backslashes and double quotes
+func QuoteJQL(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
+
+// StripOrderBy removes the ORDER BY clause of a query, so that it can be
+// combined with other clauses
+func StripOrderBy(jql string) string {
+	return strings.TrimSpace(jqlOrderByClause.ReplaceAllString(jql, ""))
+}
+
+// jqlIn builds a clause matching any of the values
+func jqlIn(field string, values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			quoted = append(quoted, QuoteJQL(value))
+		}
+	}
+
+	switch len(quoted) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("%s = %s", field, quoted[0])
+	default:
+		return fmt.Sprintf("%s in (%s)", field, strings.Join(quoted, ", "))
+	}
+}
+
+// jqlUser builds a clause matching a user field
+func jqlUser(field, user string) string {
+	switch user = strings.TrimSpace(user); strings.ToLower(user) {
+	case "":
+		return ""
+	case Me, "currentuser()":
+		return field + " = currentUser()"
+	case "unassigned", "empty", "none":
+		return field + " is EMPTY"
+	default:
+		return fmt.Sprintf("%s = %s", field, QuoteJQL(user))
+	}
+}
+
+// jqlOrderBy validates and normalizes an ORDER BY list
+func jqlOrderBy(orderBy string) (string, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return "", nil
+	}
+
+	fields := strings.Split(orderBy, ",")
+	for i, field := range fields {
+		field = strings.Join(strings.Fields(field), " ")
+		m := jqlOrderPattern.FindStringSubmatch(field)
+		if m == nil {
+			return "", fmt.Errorf("invalid order_by %q: use fields with optional ASC/DESC, e.g. 'priority DESC, updated'", strings.TrimSpace(orderBy))
+		}
+		fields[i] = m[1]
+		if m[3] != "" {
+			fields[i] += " " + strings.ToUpper(m[3])
+		}
+	}
+	return strings.Join(fields, ", "), nil
+}
@@ -0,0 +1,71 @@
+package jira
+
+import "testing"
+
+func TestJQLFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  JQLFilter
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "single values",
+			filter: JQLFilter{Projects: []string{"PROJ"}, Statuses: []string{"Open"}},
+			want:   `project = "PROJ" AND status = "Open"`,
+		},
+		{
+			name: "lists, users, dates and ordering",
+			filter: JQLFilter{
+				Projects:     []string{"PROJ", " OPS "},
+				Statuses:     []string{"To Do", "In Progress"},
+				Assignee:     "@me",
+				Reporter:     "jdoe",
+				Labels:       []string{"backend"},
+				UpdatedSince: "-7d",
+				OrderBy:      "priority desc,  updated",
+			},
+			want: `project in ("PROJ", "OPS") AND status in ("To Do", "In Progress") AND assignee = currentUser() AND reporter = "jdoe" AND labels = "backend" AND updated >= "-7d" ORDER BY priority DESC, updated`,
+		},
+		{
+			name:   "unassigned and escaped text",
+			filter: JQLFilter{Assignee: "unassigned", Text: `say "hi" \ bye`, CreatedSince: "2025-01-15"},
+			want:   `assignee is EMPTY AND text ~ "say \"hi\" \\ bye" AND created >= "2025-01-15"`,
+		},
+		{
+			name:   "order only",
+			filter: JQLFilter{OrderBy: "cf[10010] ASC"},
+			want:   `ORDER BY cf[10010] ASC`,
+		},
+		{name: "empty filter", filter: JQLFilter{}, wantErr: true},
+		{name: "invalid date", filter: JQLFilter{UpdatedSince: "last week"}, wantErr: true},
+		{name: "injected ordering", filter: JQLFilter{Projects: []string{"PROJ"}, OrderBy: "updated; DROP"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filter.JQL()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("JQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("JQL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripOrderBy(t *testing.T) {
+	tests := map[string]string{
+		"project = PROJ ORDER BY created DESC": "project = PROJ",
+		"project = PROJ order  by rank":        "project = PROJ",
+		"ORDER BY updated":                     "",
+		"summary ~ \"border\"":                 "summary ~ \"border\"",
+		"assignee = currentUser()":             "assignee = currentUser()",
+	}
+	for jql, want := range tests {
+		if got := StripOrderBy(jql); got != want {
+			t.Errorf("StripOrderBy(%q) = %q, want %q", jql, got, want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maxLabelLength is the longest label Jira accepts
+const maxLabelLength = 255
+
+// ValidateLabel checks that a label is one Jira accepts: not empty, without
+// spaces, and at most 255 characters
+func ValidateLabel(label string) error {
+	switch {
+	case label == "":
+		return fmt.Errorf("label must not be empty")
+	case strings.IndexFunc(label, unicode.IsSpace) >= 0:
+		return fmt.Errorf("label %q must not contain spaces (use '-' or '_' instead)", label)
+	case len(label) > maxLabelLength:
+		return fmt.Errorf("label %q is longer than %d characters", label, maxLabelLength)
+	}
+	return nil
+}
+
+// UpdateLabels adds and removes labels of an issue, leaving its other labels
+// unchanged
+func (c *Client) UpdateLabels(ctx context.Context, issueKey string, add, remove []string) error {
+	var ops []map[string]string
+	for _, label := range add {
+		if err := ValidateLabel(label); err != nil {
+			return err
+		}
+		ops = append(ops, map[string]string{"add": label})
+	}
+	for _, label := range remove {
+		ops = append(ops, map[string]string{"remove": label})
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+	return c.UpdateIssue(ctx, issueKey, nil, map[string]interface{}{"labels": ops})
+}
+
+// GetLabels returns the labels in use, up to limit (0 for all), optionally
+// only those containing query. Cloud lists every label; Server/DC has no
+// label list, so labels starting with query are taken from JQL autocomplete,
+// which returns a limited number of suggestions.
+func (c *Client) GetLabels(ctx context.Context, query string, limit int) ([]string, error) {
+	if !c.IsCloud() {
+		return c.suggestLabels(ctx, query, limit)
+	}
+
+	query = strings.ToLower(query)
+	var labels []string
+	startAt := 0
+	for {
+		var page struct {
+			Values []string `json:"values"`
+			IsLast bool     `json:"isLast"`
+		}
+		path := buildURL(c.getAPIPath()+"/label", map[string]string{"startAt": strconv.Itoa(startAt), "maxResults": "1000"})
+		if err := c.doRequest(ctx, "GET", path, nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to get labels: %w", err)
+		}
+
+		for _, label := range page.Values {
+			if strings.Contains(strings.ToLower(label), query) {
+				labels = append(labels, label)
+				if limit > 0 && len(labels) >= limit {
+					return labels, nil
+				}
+			}
+		}
+
+		startAt += len(page.Values)
+		if page.IsLast || len(page.Values) == 0 {
+			return labels, nil
+		}
+	}
+}
+
+// suggestLabels returns the labels JQL autocomplete suggests for a prefix
+func (c *Client) suggestLabels(ctx context.Context, prefix string, limit int) ([]string, error) {
+	path := buildURL(c.getAPIPath()+"/jql/autocompletedata/suggestions", map[string]string{"fieldName": "labels", "fieldValue": prefix})
+
+	var result struct {
+		Results []struct {
+			Value string `json:"value"`
+		} `json:"results"`
+	}
+	if err := c.doRequest(ctx, "GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	labels := make([]string, 0, len(result.Results))
+	for _, r := range result.Results {
+		labels = append(labels, r.Value)
+		if limit > 0 && len(labels) >= limit {
+			break
+		}
+	}
+	return labels, nil
+}
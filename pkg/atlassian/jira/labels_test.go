@@ -0,0 +1,109 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateLabel(t *testing.T) {
+	for _, label := range []string{"", "needs triage", strings.Repeat("x", 256)} {
+		if err := ValidateLabel(label); err == nil {
+			t.Errorf("ValidateLabel(%q) should return an error", label)
+		}
+	}
+	if err := ValidateLabel("needs-triage"); err != nil {
+		t.Errorf("ValidateLabel() error = %v", err)
+	}
+}
+
+func TestUpdateLabels(t *testing.T) {
+	var sent UpdateIssueRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/rest/api/2/issue/PROJ-1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &sent)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	ctx := context.Background()
+
+	if err := client.UpdateLabels(ctx, "PROJ-1", []string{"backend"}, []string{"old"}); err != nil {
+		t.Fatalf("UpdateLabels() error = %v", err)
+	}
+	want := []interface{}{map[string]interface{}{"add": "backend"}, map[string]interface{}{"remove": "old"}}
+	if !reflect.DeepEqual(sent.Update["labels"], want) {
+		t.Errorf("update = %v, want %v", sent.Update["labels"], want)
+	}
+
+	if err := client.UpdateLabels(ctx, "PROJ-1", []string{"two words"}, nil); err == nil {
+		t.Error("UpdateLabels() with an invalid label should return an error")
+	}
+}
+
+func TestGetLabels(t *testing.T) {
+	t.Run("cloud", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path != "/rest/api/3/label" {
+				t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			}
+			if r.URL.Query().Get("startAt") == "0" {
+				w.Write([]byte(`{"values": ["backend", "Frontend", "infra"], "isLast": false}`))
+				return
+			}
+			w.Write([]byte(`{"values": ["frontend-legacy"], "isLast": true}`))
+		}))
+		defer server.Close()
+
+		client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		client.deploymentType = DeploymentCloud
+		ctx := context.Background()
+
+		labels, err := client.GetLabels(ctx, "front", 0)
+		if err != nil || !reflect.DeepEqual(labels, []string{"Frontend", "frontend-legacy"}) {
+			t.Errorf("GetLabels() = %v, %v", labels, err)
+		}
+		labels, err = client.GetLabels(ctx, "", 2)
+		if err != nil || !reflect.DeepEqual(labels, []string{"backend", "Frontend"}) {
+			t.Errorf("GetLabels() with a limit = %v, %v", labels, err)
+		}
+	})
+
+	t.Run("server", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			q := r.URL.Query()
+			if r.URL.Path != "/rest/api/2/jql/autocompletedata/suggestions" || q.Get("fieldName") != "labels" || q.Get("fieldValue") != "front" {
+				t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			}
+			w.Write([]byte(`{"results": [{"value": "frontend", "displayName": "<b>front</b>end"}]}`))
+		}))
+		defer server.Close()
+
+		client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+
+		labels, err := client.GetLabels(context.Background(), "front", 0)
+		if err != nil || !reflect.DeepEqual(labels, []string{"frontend"}) {
+			t.Errorf("GetLabels() = %v, %v", labels, err)
+		}
+	})
+}
@@ -0,0 +1,200 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+)
+
+// markdownTimeFormat is the timestamp format of markdown output
+const markdownTimeFormat = "2006-01-02 15:04 MST"
+
+// ToMarkdown renders the issue as a compact markdown document: a heading with
+// the key and summary, a list of the populated fields, then the description,
+// subtasks, links, attachments, and comments. ADF content is converted to
+// markdown. Fields that were not retrieved are left out.
+func (i *Issue) ToMarkdown() string {
+	return i.ToMarkdownAt(time.Time{})
+}
+
+// ToMarkdownAt renders the issue like ToMarkdown, with times shown in the
+// location of now and followed by how long ago they were (e.g., "3 days
+// ago"). A zero now shows times as Jira returned them.
+func (i *Issue) ToMarkdownAt(now time.Time) string {
+	var b strings.Builder
+	i.writeMarkdown(&b, "#", now)
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+// ToMarkdown renders the search results as a markdown document with one
+// section per issue
+func (r *SearchResult) ToMarkdown() string {
+	return r.ToMarkdownAt(time.Time{})
+}
+
+// ToMarkdownAt renders the search results like ToMarkdown, with times shown
+// as by Issue.ToMarkdownAt
+func (r *SearchResult) ToMarkdownAt(now time.Time) string {
+	var b strings.Builder
+
+	switch {
+	case r.Total > 0:
+		fmt.Fprintf(&b, "# %d of %d issues\n\n", len(r.Issues), r.Total)
+	default:
+		fmt.Fprintf(&b, "# %d issues\n\n", len(r.Issues))
+	}
+	if r.NextPageToken != "" {
+		fmt.Fprintf(&b, "More results are available (next page token: %s).\n\n", r.NextPageToken)
+	} else if r.HasMore() {
+		b.WriteString("More results are available.\n\n")
+	}
+
+	for i := range r.Issues {
+		r.Issues[i].writeMarkdown(&b, "##", now)
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+// writeMarkdown writes the issue with its heading at the given level
+func (i *Issue) writeMarkdown(b *strings.Builder, heading string, now time.Time) {
+	f := &i.Fields
+
+	if f.Summary != "" {
+		fmt.Fprintf(b, "%s %s: %s\n\n", heading, i.Key, f.Summary)
+	} else {
+		fmt.Fprintf(b, "%s %s\n\n", heading, i.Key)
+	}
+
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(b, "- **%s:** %s\n", name, value)
+		}
+	}
+	if f.IssueType != nil {
+		field("Type", f.IssueType.Name)
+	}
+	if f.Status != nil {
+		field("Status", f.Status.Name)
+	}
+	if f.Resolution != nil {
+		field("Resolution", f.Resolution.Name)
+	}
+	if f.Priority != nil {
+		field("Priority", f.Priority.Name)
+	}
+	field("Assignee", userMarkdown(f.Assignee))
+	field("Reporter", userMarkdown(f.Reporter))
+	if f.Project != nil {
+		field("Project", f.Project.Key)
+	}
+	if f.Parent != nil {
+		field("Parent", linkedMarkdown(f.Parent.Key, &f.Parent.Fields))
+	}
+	field("Labels", strings.Join(f.Labels, ", "))
+	field("Components", componentNames(f.Components))
+	field("Fix Versions", versionNames(f.FixVersions))
+	field("Affects Versions", versionNames(f.Versions))
+	if f.DueDate != nil {
+		field("Due", *f.DueDate)
+	}
+	field("Created", timeMarkdown(f.Created, now))
+	field("Updated", timeMarkdown(f.Updated, now))
+	b.WriteString("\n")
+
+	sub := heading + "#"
+	if description := strings.TrimSpace(f.Description.ToMarkdown()); description != "" {
+		fmt.Fprintf(b, "%s Description\n\n%s\n\n", sub, description)
+	}
+
+	if len(f.Subtasks) > 0 {
+		fmt.Fprintf(b, "%s Subtasks\n\n", sub)
+		for _, subtask := range f.Subtasks {
+			fmt.Fprintf(b, "- %s\n", linkedMarkdown(subtask.Key, &subtask.Fields))
+		}
+		b.WriteString("\n")
+	}
+
+	if len(f.IssueLinks) > 0 {
+		fmt.Fprintf(b, "%s Links\n\n", sub)
+		for _, link := range f.IssueLinks {
+			if link.OutwardIssue != nil {
+				fmt.Fprintf(b, "- %s %s\n", link.Type.Outward, linkedMarkdown(link.OutwardIssue.Key, &link.OutwardIssue.Fields))
+			}
+			if link.InwardIssue != nil {
+				fmt.Fprintf(b, "- %s %s\n", link.Type.Inward, linkedMarkdown(link.InwardIssue.Key, &link.InwardIssue.Fields))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	if len(f.Attachment) > 0 {
+		fmt.Fprintf(b, "%s Attachments\n\n", sub)
+		for _, attachment := range f.Attachment {
+			fmt.Fprintf(b, "- %s (%s, %d bytes)\n", attachment.Filename, attachment.MimeType, attachment.Size)
+		}
+		b.WriteString("\n")
+	}
+
+	if f.Comment != nil && len(f.Comment.Comments) > 0 {
+		fmt.Fprintf(b, "%s Comments (%d)\n\n", sub, max(f.Comment.Total, len(f.Comment.Comments)))
+		for _, comment := range f.Comment.Comments {
+			fmt.Fprintf(b, "**%s** (%s):\n\n%s\n\n", userMarkdown(comment.Author), timeMarkdown(comment.Created, now), strings.TrimSpace(comment.Body.ToMarkdown()))
+		}
+	}
+}
+
+// userMarkdown returns the display name of a user
+func userMarkdown(user *User) string {
+	switch {
+	case user == nil:
+		return ""
+	case user.DisplayName != "":
+		return user.DisplayName
+	case user.Name != "":
+		return user.Name
+	default:
+		return user.AccountID
+	}
+}
+
+// linkedMarkdown describes a related issue: key, status, and summary
+func linkedMarkdown(key string, fields *IssueFields) string {
+	s := key
+	if fields.Status != nil {
+		s += " [" + fields.Status.Name + "]"
+	}
+	if fields.Summary != "" {
+		s += " " + fields.Summary
+	}
+	return s
+}
+
+func componentNames(components []Component) string {
+	names := make([]string, len(components))
+	for i, component := range components {
+		names[i] = component.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func versionNames(versions []Version) string {
+	names := make([]string, len(versions))
+	for i, version := range versions {
+		names[i] = version.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// timeMarkdown formats a time, in the location of now and relative to it
+// unless now is zero
+func timeMarkdown(t AtlassianTime, now time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	if now.IsZero() {
+		return t.Format(markdownTimeFormat)
+	}
+	return fmt.Sprintf("%s (%s)", t.In(now.Location()).Format(markdownTimeFormat), atlassian.RelativeTime(t.Time, now))
+}
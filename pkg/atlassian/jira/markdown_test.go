@@ -0,0 +1,96 @@
+package jira
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIssueToMarkdown(t *testing.T) {
+	data := `{
+		"key": "PROJ-1",
+		"fields": {
+			"summary": "Login fails",
+			"issuetype": {"name": "Bug"},
+			"status": {"name": "In Progress"},
+			"priority": {"name": "High"},
+			"assignee": {"displayName": "Jane Doe"},
+			"labels": ["auth", "web"],
+			"created": "2025-01-15T10:00:00.000+0000",
+			"description": {"type": "doc", "version": 1, "content": [
+				{"type": "paragraph", "content": [{"type": "text", "text": "Fails with "}, {"type": "text", "text": "500", "marks": [{"type": "strong"}]}]}
+			]},
+			"issuelinks": [{"type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "PROJ-2", "fields": {"summary": "Release", "status": {"name": "Open"}}}}],
+			"comment": {"total": 1, "comments": [{"id": "1", "author": {"displayName": "Bob"}, "created": "2025-01-16T09:30:00.000+0000", "body": "Reproduced"}]}
+		}
+	}`
+
+	var issue Issue
+	if err := json.Unmarshal([]byte(data), &issue); err != nil {
+		t.Fatalf("Failed to unmarshal issue: %v", err)
+	}
+
+	got := issue.ToMarkdown()
+	for _, want := range []string{
+		"# PROJ-1: Login fails\n",
+		"- **Type:** Bug\n- **Status:** In Progress\n- **Priority:** High\n- **Assignee:** Jane Doe\n",
+		"- **Labels:** auth, web\n",
+		"- **Created:** 2025-01-15 10:00 ",
+		"## Description\n\nFails with **500**\n",
+		"## Links\n\n- blocks PROJ-2 [Open] Release\n",
+		"## Comments (1)\n\n**Bob** (2025-01-16 09:30 ",
+		"Reproduced\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("ToMarkdown() missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Reporter") || strings.Contains(got, "Subtasks") {
+		t.Errorf("ToMarkdown() renders missing fields:\n%s", got)
+	}
+}
+
+func TestSearchResultToMarkdown(t *testing.T) {
+	result := &SearchResult{
+		Total: 3,
+		Issues: []Issue{
+			{Key: "PROJ-1", Fields: IssueFields{Summary: "First", Status: &Status{Name: "Done"}}},
+			{Key: "PROJ-2", Fields: IssueFields{Summary: "Second"}},
+		},
+	}
+
+	got := result.ToMarkdown()
+	for _, want := range []string{
+		"# 2 of 3 issues\n\nMore results are available.\n",
+		"## PROJ-1: First\n\n- **Status:** Done\n",
+		"## PROJ-2: Second\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("ToMarkdown() missing %q in:\n%s", want, got)
+		}
+	}
+
+	cloud := &SearchResult{Issues: result.Issues, NextPageToken: "page-2"}
+	if got, want := cloud.ToMarkdown(), "# 2 issues\n\nMore results are available (next page token: page-2).\n"; !strings.HasPrefix(got, want) {
+		t.Errorf("ToMarkdown() = %q, want the next page token", got)
+	}
+}
+
+func TestSearchResultToMarkdownAt(t *testing.T) {
+	created, _ := time.Parse(time.RFC3339, "2025-01-15T10:00:00Z")
+	result := &SearchResult{
+		Issues: []Issue{{Key: "PROJ-1", Fields: IssueFields{Summary: "First", Created: AtlassianTime{created}}}},
+	}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	now := created.Add(3 * 24 * time.Hour).In(berlin)
+
+	got := result.ToMarkdownAt(now)
+	if want := "- **Created:** 2025-01-15 11:00 CET (3 days ago)\n"; !strings.Contains(got, want) {
+		t.Errorf("ToMarkdownAt() missing %q in:\n%s", want, got)
+	}
+}
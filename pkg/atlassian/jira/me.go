@@ -0,0 +1,156 @@
+package jira
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// Me is the special user value standing for the authenticated user. It is
+// accepted wherever the tools take a user, and in JQL.
+const Me = "@me"
+
+// meJQLPattern matches unquoted JQL words containing @me. Only the words that
+// are exactly @me are replaced, leaving e.g. emails alone.
+var meJQLPattern = regexp.MustCompile(`[\w.@-]*@me[\w.@-]*`)
+
+// Myself returns the authenticated user. It is fetched once and cached for
+// the life of the client.
+func (c *Client) Myself(ctx context.Context) (*User, error) {
+	c.myselfMu.Lock()
+	defer c.myselfMu.Unlock()
+
+	if c.myself != nil {
+		return c.myself, nil
+	}
+
+	user, err := c.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.myself = user
+	return user, nil
+}
+
+// ResolveMe returns the account ID (Cloud) or username (Server/DC) of the
+// authenticated user if value is Me, and value otherwise
+func (c *Client) ResolveMe(ctx context.Context, value string) (string, error) {
+	if value != Me {
+		return value, nil
+	}
+
+	user, err := c.Myself(ctx)
+	if err != nil {
+		return "", err
+	}
+	if c.IsCloud() {
+		return user.AccountID, nil
+	}
+	return user.Name, nil
+}
+
+// ResolveUserFields replaces Me in issue field values with a reference to
+// the authenticated user. It accepts Me as a field value (e.g. "assignee":
+// "@me"), as the ID of a user object (e.g. {"accountId": "@me"}), and in
+// arrays of either (multi-user picker fields). The identity is only fetched
+// when Me is used.
+func (c *Client) ResolveUserFields(ctx context.Context, fields map[string]interface{}) error {
+	for name, value := range fields {
+		resolved, err := c.resolveUserValue(ctx, value)
+		if err != nil {
+			return err
+		}
+		fields[name] = resolved
+	}
+	return nil
+}
+
+func (c *Client) resolveUserValue(ctx context.Context, value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if v == Me {
+			return c.meRef(ctx)
+		}
+	case map[string]interface{}:
+		for _, key := range []string{"accountId", "name", "key", "id"} {
+			if v[key] == Me {
+				return c.meRef(ctx)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			resolved, err := c.resolveUserValue(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = resolved
+		}
+	}
+	return value, nil
+}
+
+// meRef returns the user reference of the authenticated user for issue fields
+func (c *Client) meRef(ctx context.Context) (map[string]interface{}, error) {
+	id, err := c.ResolveMe(ctx, Me)
+	if err != nil {
+		return nil, err
+	}
+	return c.UserRef(id), nil
+}
+
+// UserRef returns the reference to a user for issue fields: the account ID on
+// Cloud, the username on Server/DC
+func (c *Client) UserRef(accountIDOrUsername string) map[string]interface{} {
+	if c.IsCloud() {
+		return map[string]interface{}{"accountId": accountIDOrUsername}
+	}
+	return map[string]interface{}{"name": accountIDOrUsername}
+}
+
+// ResolveMeJQL replaces @me in a JQL query with currentUser(), so queries
+// like "assignee = @me" work without knowing the account ID. A quoted @me is
+// replaced too, but other quoted strings, such as the text of summary ~ "ask
+// @me", are left as they are.
+func ResolveMeJQL(jql string) string {
+	var b strings.Builder
+	for i := 0; i < len(jql); {
+		if jql[i] == '"' || jql[i] == '\'' {
+			end := quotedEnd(jql, i)
+			if span := jql[i:end]; span == jql[i:i+1]+Me+jql[i:i+1] {
+				b.WriteString("currentUser()")
+			} else {
+				b.WriteString(span)
+			}
+			i = end
+			continue
+		}
+
+		end := i
+		for end < len(jql) && jql[end] != '"' && jql[end] != '\'' {
+			end++
+		}
+		b.WriteString(meJQLPattern.ReplaceAllStringFunc(jql[i:end], func(word string) string {
+			if word == Me {
+				return "currentUser()"
+			}
+			return word
+		}))
+		i = end
+	}
+	return b.String()
+}
+
+// quotedEnd returns the index after the string quoted at start, skipping
+// escaped quotes. An unterminated string runs to the end of the query.
+func quotedEnd(jql string, start int) int {
+	quote := jql[start]
+	for i := start + 1; i < len(jql); i++ {
+		switch jql[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		}
+	}
+	return len(jql)
+}
@@ -0,0 +1,80 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestResolveMeJQL(t *testing.T) {
+	tests := []struct {
+		jql  string
+		want string
+	}{
+		{"assignee = @me", "assignee = currentUser()"},
+		{`reporter = "@me" AND status = Open`, "reporter = currentUser() AND status = Open"},
+		{"assignee in (@me,'@me')", "assignee in (currentUser(),currentUser())"},
+		{`reporter = "jdoe@me.com"`, `reporter = "jdoe@me.com"`},
+		{"summary ~ @meeting", "summary ~ @meeting"},
+		{"project = PROJ", "project = PROJ"},
+		{`summary ~ "waiting on @me" AND assignee = @me`, `summary ~ "waiting on @me" AND assignee = currentUser()`},
+		{`text ~ 'ping @me' OR reporter = '@me'`, `text ~ 'ping @me' OR reporter = currentUser()`},
+		{`summary ~ "say \"@me\"" AND watcher = @me`, `summary ~ "say \"@me\"" AND watcher = currentUser()`},
+		{`summary ~ "unterminated @me`, `summary ~ "unterminated @me`},
+	}
+
+	for _, tt := range tests {
+		if got := ResolveMeJQL(tt.jql); got != tt.want {
+			t.Errorf("ResolveMeJQL(%q) = %q, want %q", tt.jql, got, tt.want)
+		}
+	}
+}
+
+func TestResolveUserFields(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/myself" {
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "jdoe", "key": "JIRAUSER1", "displayName": "Jane Doe"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	fields := map[string]interface{}{
+		"summary":           "@me is not a user field value here",
+		"assignee":          "@me",
+		"reporter":          map[string]interface{}{"name": "@me"},
+		"customfield_10001": []interface{}{map[string]interface{}{"name": "asmith"}, "@me"},
+	}
+	if err := client.ResolveUserFields(context.Background(), fields); err != nil {
+		t.Fatalf("ResolveUserFields() error = %v", err)
+	}
+
+	me := map[string]interface{}{"name": "jdoe"}
+	want := map[string]interface{}{
+		"summary":           "@me is not a user field value here",
+		"assignee":          me,
+		"reporter":          me,
+		"customfield_10001": []interface{}{map[string]interface{}{"name": "asmith"}, me},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("ResolveUserFields() = %v, want %v", fields, want)
+	}
+
+	// The identity is cached
+	if id, err := client.ResolveMe(context.Background(), Me); err != nil || id != "jdoe" {
+		t.Errorf("ResolveMe() = %q, %v, want jdoe", id, err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected one /myself request, got %d", requests)
+	}
+}
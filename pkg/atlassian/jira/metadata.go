@@ -0,0 +1,281 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// metadataCache caches the field list and the create metadata of projects,
+// which rarely change but are slow to fetch on big instances. Entries expire
+// after the TTL; a zero TTL disables caching.
+type metadataCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]metadataEntry
+}
+
+// metadataEntry is a cached value and when it was fetched
+type metadataEntry struct {
+	value   interface{}
+	fetched time.Time
+}
+
+// newMetadataCache creates a metadata cache with the given TTL
+func newMetadataCache(ttl time.Duration) *metadataCache {
+	return &metadataCache{ttl: ttl, now: time.Now, entries: make(map[string]metadataEntry)}
+}
+
+// get returns the cached value of a key, if it hasn't expired
+func (m *metadataCache) get(key string) (interface{}, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok || m.now().Sub(entry.fetched) >= m.ttl {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// put caches the value of a key
+func (m *metadataCache) put(key string, value interface{}) {
+	if m.ttl <= 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = metadataEntry{value: value, fetched: m.now()}
+}
+
+// invalidate drops the field list and the create metadata of a project, or
+// every entry if projectKey is empty
+func (m *metadataCache) invalidate(projectKey string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if projectKey == "" {
+		m.entries = make(map[string]metadataEntry)
+		return
+	}
+	delete(m.entries, fieldsCacheKey)
+	prefix := createMetaCacheKey(projectKey, "")
+	for key := range m.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.entries, key)
+		}
+	}
+}
+
+// fieldsCacheKey is the cache key of the field list
+const fieldsCacheKey = "fields"
+
+// createMetaCacheKey returns the cache key of a project's issue types, or of
+// the fields of one of its issue types
+func createMetaCacheKey(projectKey, issueTypeID string) string {
+	return "createmeta/" + strings.ToUpper(projectKey) + "/" + issueTypeID
+}
+
+// InvalidateMetadata drops the cached field list and create metadata of a
+// project, or all cached metadata if projectKey is empty, so the next lookup
+// sees changes made in Jira (e.g., a new custom field or screen change)
+func (c *Client) InvalidateMetadata(projectKey string) {
+	c.metadata.invalidate(projectKey)
+}
+
+// CreateMetaIssueType is an issue type that can be created in a project
+type CreateMetaIssueType struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Subtask bool   `json:"subtask"`
+}
+
+// CreateMetaField is a field on the create screen of a project and issue type
+type CreateMetaField struct {
+	FieldID         string        `json:"fieldId"`
+	Name            string        `json:"name"`
+	Required        bool          `json:"required"`
+	HasDefaultValue bool          `json:"hasDefaultValue"`
+	Schema          *FieldSchema  `json:"schema,omitempty"`
+	AllowedValues   []interface{} `json:"allowedValues,omitempty"`
+}
+
+// AllowedValueNames returns the names (or values) of the field's allowed
+// values, such as the options of a select field
+func (f *CreateMetaField) AllowedValueNames() []string {
+	var names []string
+	for _, v := range f.AllowedValues {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range []string{"name", "value", "key"} {
+			if s, ok := obj[key].(string); ok && s != "" {
+				names = append(names, s)
+				break
+			}
+		}
+	}
+	return names
+}
+
+// createMetaPage is a page of the create metadata endpoints. Cloud names the
+// list after its contents, Server/DC calls it values.
+type createMetaPage struct {
+	IssueTypes json.RawMessage `json:"issueTypes"`
+	Fields     json.RawMessage `json:"fields"`
+	Values     json.RawMessage `json:"values"`
+	StartAt    int             `json:"startAt"`
+	Total      int             `json:"total"`
+	IsLast     bool            `json:"isLast"`
+}
+
+// getCreateMetaPages fetches every page of a create metadata endpoint,
+// decoding each page's list into items
+func getCreateMetaPages[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	var items []T
+	for {
+		var page createMetaPage
+		pagePath := buildURL(path, map[string]string{"startAt": strconv.Itoa(len(items)), "maxResults": "100"})
+		if err := c.doRequest(ctx, "GET", pagePath, nil, &page); err != nil {
+			return nil, err
+		}
+
+		list := page.Values
+		if len(page.IssueTypes) > 0 {
+			list = page.IssueTypes
+		} else if len(page.Fields) > 0 {
+			list = page.Fields
+		}
+		var pageItems []T
+		if len(list) > 0 {
+			if err := json.Unmarshal(list, &pageItems); err != nil {
+				return nil, fmt.Errorf("failed to decode create metadata: %w", err)
+			}
+		}
+		items = append(items, pageItems...)
+
+		if len(pageItems) == 0 || page.IsLast || (page.Total > 0 && len(items) >= page.Total) {
+			return items, nil
+		}
+	}
+}
+
+// GetCreateMetaIssueTypes returns the issue types that can be created in a
+// project. The result is cached.
+func (c *Client) GetCreateMetaIssueTypes(ctx context.Context, projectKey string) ([]CreateMetaIssueType, error) {
+	key := createMetaCacheKey(projectKey, "")
+	if cached, ok := c.metadata.get(key); ok {
+		return cached.([]CreateMetaIssueType), nil
+	}
+
+	path := fmt.Sprintf("%s/issue/createmeta/%s/issuetypes", c.getAPIPath(), projectKey)
+	issueTypes, err := getCreateMetaPages[CreateMetaIssueType](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue types of %s: %w", projectKey, err)
+	}
+
+	c.metadata.put(key, issueTypes)
+	return issueTypes, nil
+}
+
+// GetCreateMetaFields returns the fields on the create screen of a project
+// and issue type, given by name or ID. The result is cached.
+func (c *Client) GetCreateMetaFields(ctx context.Context, projectKey, issueType string) ([]CreateMetaField, error) {
+	issueTypes, err := c.GetCreateMetaIssueTypes(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var issueTypeID string
+	for _, it := range issueTypes {
+		if it.ID == issueType || strings.EqualFold(it.Name, issueType) {
+			issueTypeID = it.ID
+			break
+		}
+	}
+	if issueTypeID == "" {
+		names := make([]string, 0, len(issueTypes))
+		for _, it := range issueTypes {
+			names = append(names, it.Name)
+		}
+		return nil, fmt.Errorf("issue type %q is not available in %s (available: %s)", issueType, projectKey, strings.Join(names, ", "))
+	}
+
+	key := createMetaCacheKey(projectKey, issueTypeID)
+	if cached, ok := c.metadata.get(key); ok {
+		return cached.([]CreateMetaField), nil
+	}
+
+	path := fmt.Sprintf("%s/issue/createmeta/%s/issuetypes/%s", c.getAPIPath(), projectKey, issueTypeID)
+	fields, err := getCreateMetaPages[CreateMetaField](ctx, c, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get create fields of %s %s: %w", projectKey, issueType, err)
+	}
+
+	c.metadata.put(key, fields)
+	return fields, nil
+}
+
+// MissingRequiredFields returns the required fields without a default value
+// that are not set in the fields of a new issue
+func (c *Client) MissingRequiredFields(ctx context.Context, projectKey, issueType string, fields map[string]interface{}) ([]CreateMetaField, error) {
+	createFields, err := c.GetCreateMetaFields(ctx, projectKey, issueType)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []CreateMetaField
+	for _, f := range createFields {
+		if !f.Required || f.HasDefaultValue {
+			continue
+		}
+		if _, ok := fields[f.FieldID]; !ok {
+			missing = append(missing, f)
+		}
+	}
+	return missing, nil
+}
+
+// ResolveFieldNames replaces field names used as keys of issue fields (e.g.
+// "Story Points") with the field IDs Jira expects (e.g. "customfield_10016").
+// Keys that are field IDs, unknown, or ambiguous are left unchanged.
+func (c *Client) ResolveFieldNames(ctx context.Context, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	allFields, err := c.GetAllFields(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]bool, len(allFields))
+	byName := make(map[string][]string, len(allFields))
+	for _, f := range allFields {
+		ids[f.ID] = true
+		name := strings.ToLower(f.Name)
+		byName[name] = append(byName[name], f.ID)
+	}
+
+	for key, value := range fields {
+		if ids[key] {
+			continue
+		}
+		matches := byName[strings.ToLower(key)]
+		if len(matches) != 1 {
+			continue
+		}
+		if _, taken := fields[matches[0]]; taken {
+			continue
+		}
+		delete(fields, key)
+		fields[matches[0]] = value
+	}
+	return nil
+}
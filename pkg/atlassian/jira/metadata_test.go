@@ -0,0 +1,128 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMetadataCache(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/2/field":
+			w.Write([]byte(`[{"id": "summary", "name": "Summary"}, {"id": "customfield_10016", "name": "Story Points", "custom": true}]`))
+		case "/rest/api/2/issue/createmeta/PROJ/issuetypes":
+			w.Write([]byte(`{"values": [{"id": "10001", "name": "Bug"}], "total": 1, "isLast": true}`))
+		case "/rest/api/2/issue/createmeta/PROJ/issuetypes/10001":
+			if r.URL.Query().Get("startAt") == "0" {
+				w.Write([]byte(`{"values": [
+					{"fieldId": "summary", "name": "Summary", "required": true},
+					{"fieldId": "reporter", "name": "Reporter", "required": true, "hasDefaultValue": true}
+				], "total": 3}`))
+				return
+			}
+			w.Write([]byte(`{"values": [
+				{"fieldId": "customfield_10020", "name": "Severity", "required": true, "allowedValues": [{"value": "S1"}, {"value": "S2"}]}
+			], "total": 3}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true, MetadataTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	now := time.Now()
+	client.metadata.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		missing, err := client.MissingRequiredFields(ctx, "PROJ", "bug", map[string]interface{}{"summary": "Crash"})
+		if err != nil {
+			t.Fatalf("MissingRequiredFields() error = %v", err)
+		}
+		if len(missing) != 1 || missing[0].FieldID != "customfield_10020" {
+			t.Fatalf("MissingRequiredFields() = %+v, want Severity", missing)
+		}
+		if got := missing[0].AllowedValueNames(); !reflect.DeepEqual(got, []string{"S1", "S2"}) {
+			t.Errorf("AllowedValueNames() = %v", got)
+		}
+	}
+	if requests["/rest/api/2/issue/createmeta/PROJ/issuetypes"] != 1 || requests["/rest/api/2/issue/createmeta/PROJ/issuetypes/10001"] != 2 {
+		t.Errorf("create metadata fetched again instead of cached: %v", requests)
+	}
+
+	fields := map[string]interface{}{"story points": 5, "summary": "Crash"}
+	if err := client.ResolveFieldNames(ctx, fields); err != nil {
+		t.Fatalf("ResolveFieldNames() error = %v", err)
+	}
+	if !reflect.DeepEqual(fields, map[string]interface{}{"customfield_10016": 5, "summary": "Crash"}) {
+		t.Errorf("ResolveFieldNames() = %v", fields)
+	}
+	client.GetAllFields(ctx)
+	if requests["/rest/api/2/field"] != 1 {
+		t.Errorf("field list fetched %d times, want 1", requests["/rest/api/2/field"])
+	}
+
+	// Entries expire after the TTL
+	now = now.Add(2 * time.Minute)
+	client.GetAllFields(ctx)
+	if requests["/rest/api/2/field"] != 2 {
+		t.Errorf("field list fetched %d times after the TTL, want 2", requests["/rest/api/2/field"])
+	}
+
+	// Invalidating a project drops its create metadata and the field list
+	client.InvalidateMetadata("proj")
+	client.GetCreateMetaIssueTypes(ctx, "PROJ")
+	client.GetAllFields(ctx)
+	if requests["/rest/api/2/issue/createmeta/PROJ/issuetypes"] != 2 || requests["/rest/api/2/field"] != 3 {
+		t.Errorf("metadata not fetched again after InvalidateMetadata: %v", requests)
+	}
+}
+
+func TestMetadataCacheDisabled(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	client.GetAllFields(context.Background())
+	client.GetAllFields(context.Background())
+	if requests != 2 {
+		t.Errorf("field list fetched %d times without a TTL, want 2", requests)
+	}
+}
+
+func TestGetCreateMetaFieldsUnknownIssueType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issueTypes": [{"id": "10001", "name": "Bug"}, {"id": "10002", "name": "Task"}], "total": 2}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.GetCreateMetaFields(context.Background(), "PROJ", "Epic")
+	if err == nil || err.Error() != `issue type "Epic" is not available in PROJ (available: Bug, Task)` {
+		t.Errorf("GetCreateMetaFields() error = %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// MyPermissionsOptions holds options for checking the current user's permissions
+type MyPermissionsOptions struct {
+	Permissions []string // Permission keys to check (e.g. BROWSE_PROJECTS, CREATE_ISSUES)
+	ProjectKey  string   // Optional project context
+	IssueKey    string   // Optional issue context
+}
+
+// GetMyPermissions retrieves the permissions of the currently authenticated user.
+// Cloud requires at least one permission key; Server returns all permissions when none are given.
+func (c *Client) GetMyPermissions(ctx context.Context, opts *MyPermissionsOptions) (map[string]Permission, error) {
+	path := fmt.Sprintf("%s/mypermissions", c.getAPIPath())
+
+	params := make(map[string]string)
+	if opts != nil {
+		if len(opts.Permissions) > 0 {
+			params["permissions"] = strings.Join(opts.Permissions, ",")
+		}
+		if opts.ProjectKey != "" {
+			params["projectKey"] = opts.ProjectKey
+		}
+		if opts.IssueKey != "" {
+			params["issueKey"] = opts.IssueKey
+		}
+	}
+
+	path = buildURL(path, params)
+
+	var result MyPermissionsResponse
+	if err := c.doRequest(ctx, "GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get permissions: %w", err)
+	}
+
+	return result.Permissions, nil
+}
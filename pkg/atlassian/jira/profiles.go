@@ -0,0 +1,89 @@
+package jira
+
+import (
+	"sort"
+	"strings"
+)
+
+// Built-in field profile names
+const (
+	FieldProfileEssential    = "essential"     // Core fields of a single issue, including the description
+	FieldProfileIssueSummary = "issue-summary" // Compact fields for issue lists
+	FieldProfileIssueTriage  = "issue-triage"  // Fields needed to triage an issue
+)
+
+// FieldProfiles maps profile names to the field IDs they select
+type FieldProfiles map[string][]string
+
+// DefaultFieldProfiles returns the built-in field profiles
+func DefaultFieldProfiles() FieldProfiles {
+	return FieldProfiles{
+		FieldProfileEssential: {
+			"summary", "status", "issuetype", "project", "created", "updated",
+			"priority", "assignee", "reporter", "description",
+		},
+		FieldProfileIssueSummary: {
+			"summary", "status", "assignee", "reporter", "priority",
+			"issuetype", "project", "created", "updated",
+		},
+		FieldProfileIssueTriage: {
+			"summary", "status", "issuetype", "priority", "assignee", "reporter",
+			"labels", "components", "created", "updated", "duedate", "description",
+		},
+	}
+}
+
+// Merge returns a copy of the profiles with the custom profiles added.
+// Custom profiles replace built-in profiles of the same name.
+func (p FieldProfiles) Merge(custom map[string][]string) FieldProfiles {
+	merged := make(FieldProfiles, len(p)+len(custom))
+	for name, fields := range p {
+		merged[name] = fields
+	}
+	for name, fields := range custom {
+		merged[strings.ToLower(name)] = fields
+	}
+	return merged
+}
+
+// Has reports whether a profile is defined
+func (p FieldProfiles) Has(name string) bool {
+	_, ok := p[strings.ToLower(name)]
+	return ok
+}
+
+// Names returns the sorted profile names
+func (p FieldProfiles) Names() []string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Resolve converts a fields argument into field IDs. The argument is a
+// profile name, "*all", or a comma-separated list of field IDs; an empty
+// argument selects defaultProfile.
+func (p FieldProfiles) Resolve(spec, defaultProfile string) []string {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		spec = defaultProfile
+	}
+
+	if spec == "*all" {
+		return []string{"*all"}
+	}
+
+	if fields, ok := p[strings.ToLower(spec)]; ok {
+		return append([]string(nil), fields...)
+	}
+
+	var fields []string
+	for _, f := range strings.Split(spec, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
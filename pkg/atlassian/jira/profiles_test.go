@@ -0,0 +1,48 @@
+package jira
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldProfilesResolve(t *testing.T) {
+	profiles := DefaultFieldProfiles().Merge(map[string][]string{
+		"Release":                {"summary", "fixVersions"},
+		FieldProfileIssueSummary: {"summary", "status"},
+	})
+
+	tests := []struct {
+		name           string
+		spec           string
+		defaultProfile string
+		want           []string
+	}{
+		{"empty uses default profile", "", "release", []string{"summary", "fixVersions"}},
+		{"built-in profile", "essential", "", DefaultFieldProfiles()[FieldProfileEssential]},
+		{"custom profile overrides built-in", "issue-summary", "", []string{"summary", "status"}},
+		{"profile names are case-insensitive", "RELEASE", "", []string{"summary", "fixVersions"}},
+		{"all fields", "*all", "essential", []string{"*all"}},
+		{"field list", " summary, labels ,", "essential", []string{"summary", "labels"}},
+		{"single field", "labels", "essential", []string{"labels"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := profiles.Resolve(tt.spec, tt.defaultProfile); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Resolve(%q, %q) = %v, want %v", tt.spec, tt.defaultProfile, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldProfilesMergeKeepsDefaults(t *testing.T) {
+	profiles := DefaultFieldProfiles().Merge(map[string][]string{"release": {"fixVersions"}})
+
+	want := []string{FieldProfileEssential, FieldProfileIssueSummary, FieldProfileIssueTriage, "release"}
+	if got := profiles.Names(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+	if len(DefaultFieldProfiles()) != 3 {
+		t.Errorf("Merge() modified the built-in profiles")
+	}
+}
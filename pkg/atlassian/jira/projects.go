@@ -2,8 +2,13 @@ package jira
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"strings"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian"
 )
 
 // GetProjectsOptions contains options for listing projects
@@ -13,6 +18,8 @@ type GetProjectsOptions struct {
 	Properties []string // Project properties to return
 	StartAt    int      // Starting index for pagination (Cloud only)
 	MaxResults int      // Maximum results per page (Cloud only)
+
+	IncludeArchived bool // Also return archived projects
 }
 
 // GetAllProjects retrieves all accessible projects
@@ -38,10 +45,22 @@ func (c *Client) GetAllProjects(ctx context.Context, opts *GetProjectsOptions) (
 		if opts.MaxResults > 0 {
 			params["maxResults"] = fmt.Sprintf("%d", opts.MaxResults)
 		}
+		if opts.IncludeArchived && !c.IsCloud() {
+			params["includeArchived"] = "true"
+		}
 	}
 
 	path = buildURL(path, params)
 
+	// Cloud selects archived projects with the repeated status parameter
+	if opts != nil && opts.IncludeArchived && c.IsCloud() {
+		separator := "?"
+		if strings.Contains(path, "?") {
+			separator = "&"
+		}
+		path += separator + "status=live&status=archived"
+	}
+
 	// Handle different response formats
 	if c.IsCloud() {
 		// Cloud v3 returns paginated response
@@ -146,3 +165,89 @@ func (c *Client) SearchProjects(ctx context.Context, query string, maxResults in
 
 	return response.Values, nil
 }
+
+// GetProjectSecurityLevels retrieves the issue security levels of a project
+// that the current user can set on issues
+func (c *Client) GetProjectSecurityLevels(ctx context.Context, projectKey string) ([]SecurityLevel, error) {
+	path := fmt.Sprintf("%s/%s/securitylevel", c.getProjectAPIPath(), projectKey)
+
+	var response struct {
+		Levels []SecurityLevel `json:"levels"`
+	}
+	if err := c.doRequest(ctx, "GET", path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get security levels for project %s: %w", projectKey, err)
+	}
+
+	return response.Levels, nil
+}
+
+// ResolveSecurityLevel returns the ID of a project's security level given
+// its ID or name (case-insensitive)
+func (c *Client) ResolveSecurityLevel(ctx context.Context, projectKey, level string) (string, error) {
+	levels, err := c.GetProjectSecurityLevels(ctx, projectKey)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(levels))
+	for _, l := range levels {
+		if l.ID == level || strings.EqualFold(l.Name, level) {
+			return l.ID, nil
+		}
+		names = append(names, l.Name)
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("project %s has no security levels the current user can set", projectKey)
+	}
+	return "", fmt.Errorf("unknown security level %q for project %s (available: %s)", level, projectKey, strings.Join(names, ", "))
+}
+
+// GetProjectRoles retrieves the roles of a project with their actors. If
+// name is set, only the role with that name (case-insensitive) is returned.
+func (c *Client) GetProjectRoles(ctx context.Context, projectKey, name string) ([]ProjectRole, error) {
+	path := fmt.Sprintf("%s/%s/role", c.getProjectAPIPath(), projectKey)
+
+	// The list maps role names to the URLs of their details
+	var roleURLs map[string]string
+	if err := c.doRequest(ctx, "GET", path, nil, &roleURLs); err != nil {
+		return nil, fmt.Errorf("failed to get roles for project %s: %w", projectKey, err)
+	}
+
+	roleNames := make([]string, 0, len(roleURLs))
+	for roleName := range roleURLs {
+		if name == "" || strings.EqualFold(roleName, name) {
+			roleNames = append(roleNames, roleName)
+		}
+	}
+	if name != "" && len(roleNames) == 0 {
+		return nil, fmt.Errorf("project %s has no role %q", projectKey, name)
+	}
+	sort.Strings(roleNames)
+
+	roles := make([]ProjectRole, 0, len(roleNames))
+	for _, roleName := range roleNames {
+		roleURL := roleURLs[roleName]
+		id := roleURL[strings.LastIndex(roleURL, "/")+1:]
+
+		var role ProjectRole
+		if err := c.doRequest(ctx, "GET", fmt.Sprintf("%s/%s", path, id), nil, &role); err != nil {
+			return nil, fmt.Errorf("failed to get role %s for project %s: %w", roleName, projectKey, err)
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+// IsArchived reports whether a request failed because the issue or project
+// is archived: Jira answers 410 Gone, or an error naming the archive
+func IsArchived(err error) bool {
+	var apiErr *atlassian.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusGone {
+		return true
+	}
+	return apiErr.StatusCode < 500 && strings.Contains(strings.ToLower(apiErr.Message), "archived")
+}
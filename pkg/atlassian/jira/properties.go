@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// maxPropertySize is the largest issue property value Jira accepts, in bytes
+const maxPropertySize = 32768
+
+// IssueProperty is an entity property of an issue: a JSON value stored under
+// a key, invisible in the issue view but queryable with JQL once indexed
+type IssueProperty struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// GetIssuePropertyKeys returns the keys of the properties set on an issue
+func (c *Client) GetIssuePropertyKeys(ctx context.Context, issueKey string) ([]string, error) {
+	path := fmt.Sprintf("%s/issue/%s/properties", c.getAPIPath(), issueKey)
+
+	var result struct {
+		Keys []struct {
+			Key string `json:"key"`
+		} `json:"keys"`
+	}
+	if err := c.doRequest(ctx, "GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get issue property keys: %w", err)
+	}
+
+	keys := make([]string, 0, len(result.Keys))
+	for _, k := range result.Keys {
+		keys = append(keys, k.Key)
+	}
+	return keys, nil
+}
+
+// GetIssueProperty returns a property of an issue
+func (c *Client) GetIssueProperty(ctx context.Context, issueKey, propertyKey string) (*IssueProperty, error) {
+	path := fmt.Sprintf("%s/issue/%s/properties/%s", c.getAPIPath(), issueKey, url.PathEscape(propertyKey))
+
+	var property IssueProperty
+	if err := c.doRequest(ctx, "GET", path, nil, &property); err != nil {
+		return nil, fmt.Errorf("failed to get issue property: %w", err)
+	}
+	return &property, nil
+}
+
+// SetIssueProperty sets a property of an issue to a JSON value, replacing
+// any previous value
+func (c *Client) SetIssueProperty(ctx context.Context, issueKey, propertyKey string, value interface{}) error {
+	if propertyKey == "" {
+		return fmt.Errorf("property key is required")
+	}
+
+	body, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal property value: %w", err)
+	}
+	if len(body) > maxPropertySize {
+		return fmt.Errorf("property value is %d bytes, more than the %d Jira accepts", len(body), maxPropertySize)
+	}
+
+	path := fmt.Sprintf("%s/issue/%s/properties/%s", c.getAPIPath(), issueKey, url.PathEscape(propertyKey))
+	if err := c.doRequest(ctx, "PUT", path, body, nil); err != nil {
+		return fmt.Errorf("failed to set issue property: %w", err)
+	}
+	return nil
+}
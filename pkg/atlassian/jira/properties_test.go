@@ -0,0 +1,60 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIssueProperties(t *testing.T) {
+	var stored string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/2/issue/PROJ-1/properties":
+			w.Write([]byte(`{"keys": [{"key": "com.example.triage", "self": "https://jira.example.com/x"}]}`))
+		case r.Method == http.MethodPut && r.URL.EscapedPath() == "/rest/api/2/issue/PROJ-1/properties/com.example%2Ftriage":
+			data, _ := io.ReadAll(r.Body)
+			stored = string(data)
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/2/issue/PROJ-1/properties/com.example.triage":
+			w.Write([]byte(`{"key": "com.example.triage", "value": {"severity": "high"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	ctx := context.Background()
+
+	keys, err := client.GetIssuePropertyKeys(ctx, "PROJ-1")
+	if err != nil || !reflect.DeepEqual(keys, []string{"com.example.triage"}) {
+		t.Errorf("GetIssuePropertyKeys() = %v, %v", keys, err)
+	}
+
+	property, err := client.GetIssueProperty(ctx, "PROJ-1", "com.example.triage")
+	if err != nil || string(property.Value) != `{"severity": "high"}` {
+		t.Errorf("GetIssueProperty() = %+v, %v", property, err)
+	}
+
+	if err := client.SetIssueProperty(ctx, "PROJ-1", "com.example/triage", map[string]interface{}{"checked": true}); err != nil {
+		t.Fatalf("SetIssueProperty() error = %v", err)
+	}
+	if stored != `{"checked":true}` {
+		t.Errorf("stored value = %s", stored)
+	}
+
+	large := map[string]string{"notes": strings.Repeat("x", maxPropertySize)}
+	if err := client.SetIssueProperty(ctx, "PROJ-1", "com.example.triage", large); err == nil {
+		t.Error("SetIssueProperty() with a value over 32 KB should return an error")
+	}
+}
@@ -0,0 +1,119 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// wikiQuotePattern matches a {quote} block in wiki markup
+var wikiQuotePattern = regexp.MustCompile(`(?s)\{quote\}.*?\{quote\}`)
+
+// adfQuotableNodes are the node types ADF allows inside a blockquote
+var adfQuotableNodes = map[string]bool{
+	"paragraph":   true,
+	"bulletList":  true,
+	"orderedList": true,
+	"codeBlock":   true,
+	"mediaSingle": true,
+	"mediaGroup":  true,
+}
+
+// ReplyToComment adds a comment that quotes another comment of the issue and
+// mentions its author, followed by the markdown body. Jira has no comment
+// threads, so this is how replies are usually written by hand.
+//
+// Quotes in the replied-to comment are left out, so replies to replies don't
+// nest. Without a visibility the reply keeps the visibility of the quoted
+// comment, so restricted content isn't quoted to everyone.
+func (c *Client) ReplyToComment(ctx context.Context, issueKey, commentID, body string, visibility *Visibility) (*Comment, error) {
+	original, err := c.GetComment(ctx, issueKey, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if visibility == nil {
+		visibility = original.Visibility
+	}
+
+	var reply interface{}
+	if c.IsCloud() {
+		doc, err := replyADF(original, body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to quote comment %s: %w", commentID, err)
+		}
+		reply = doc.ToMap()
+	} else {
+		reply = replyWiki(original, body)
+	}
+
+	return c.addComment(ctx, issueKey, reply, visibility)
+}
+
+// replyADF builds the ADF document of a reply: a mention of the author of
+// the original comment, its content in a blockquote, and the reply
+func replyADF(original *Comment, body string) (*ADFDocument, error) {
+	quoted := &ADFDocument{}
+	if original.Body.IsADF() {
+		if err := json.Unmarshal(original.Body.Raw(), quoted); err != nil {
+			return nil, err
+		}
+	} else if text := original.Body.String(); text != "" {
+		quoted = MarkdownToADF(text)
+	}
+
+	var quote []ADFNode
+	for _, node := range quoted.Content {
+		switch {
+		case node.Type == "blockquote":
+			continue
+		case adfQuotableNodes[node.Type]:
+			quote = append(quote, node)
+		default:
+			// Headings, tables, panels, etc. aren't allowed in a blockquote
+			if text := strings.TrimSpace(adfNodeText(node)); text != "" {
+				quote = append(quote, ADFNode{Type: "paragraph", Content: []ADFNode{{Type: "text", Text: text}}})
+			}
+		}
+	}
+
+	var author ADFNode
+	switch {
+	case original.Author != nil && original.Author.AccountID != "":
+		author = ADFNode{Type: "mention", Attrs: map[string]interface{}{"id": original.Author.AccountID, "text": original.Author.DisplayName}}
+	case original.Author != nil:
+		author = ADFNode{Type: "text", Text: original.Author.DisplayName}
+	default:
+		author = ADFNode{Type: "text", Text: "Anonymous"}
+	}
+
+	doc := &ADFDocument{Version: 1, Type: "doc"}
+	doc.Content = append(doc.Content, ADFNode{
+		Type:    "paragraph",
+		Content: []ADFNode{author, {Type: "text", Text: " wrote:"}},
+	})
+	if len(quote) > 0 {
+		doc.Content = append(doc.Content, ADFNode{Type: "blockquote", Content: quote})
+	}
+	doc.Content = append(doc.Content, MarkdownToADF(body).Content...)
+	return doc, nil
+}
+
+// replyWiki builds the wiki markup of a reply: a mention of the author of the
+// original comment, its content in a {quote} block, and the reply
+func replyWiki(original *Comment, body string) string {
+	author := "Anonymous"
+	if original.Author != nil {
+		author = original.Author.DisplayName
+		if original.Author.Name != "" {
+			author = "[~" + original.Author.Name + "]"
+		}
+	}
+
+	reply := author + " wrote:"
+	if quote := strings.TrimSpace(wikiQuotePattern.ReplaceAllString(original.Body.String(), "")); quote != "" {
+		reply += "\n{quote}\n" + quote + "\n{quote}"
+	}
+	return joinBlocks(reply, MarkdownToWiki(body))
+}
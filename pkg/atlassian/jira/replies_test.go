@@ -0,0 +1,90 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReplyWiki(t *testing.T) {
+	original := &Comment{
+		Author: &User{Name: "jdoe", DisplayName: "Jane Doe"},
+		Body:   NewDescription("{quote}\nearlier\n{quote}\nIs this *fixed*?"),
+	}
+
+	want := "[~jdoe] wrote:\n{quote}\nIs this *fixed*?\n{quote}\n\nYes, in *1.2*"
+	if got := replyWiki(original, "Yes, in **1.2**"); got != want {
+		t.Errorf("replyWiki() = %q, want %q", got, want)
+	}
+
+	anonymous := &Comment{Body: NewDescription("")}
+	if got := replyWiki(anonymous, "Thanks"); got != "Anonymous wrote:\n\nThanks" {
+		t.Errorf("replyWiki() without author = %q", got)
+	}
+}
+
+func TestReplyToComment(t *testing.T) {
+	var sent struct {
+		Body       ADFDocument `json:"body"`
+		Visibility *Visibility `json:"visibility"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/PROJ-1/comment/10":
+			w.Write([]byte(`{"id": "10", "author": {"accountId": "abc", "displayName": "Jane Doe"},
+				"visibility": {"type": "role", "value": "Developers"},
+				"body": {"version": 1, "type": "doc", "content": [
+					{"type": "blockquote", "content": [{"type": "paragraph", "content": [{"type": "text", "text": "earlier"}]}]},
+					{"type": "heading", "attrs": {"level": 2}, "content": [{"type": "text", "text": "Question"}]},
+					{"type": "paragraph", "content": [{"type": "text", "text": "Is this fixed?", "marks": [{"type": "em"}]}]}
+				]}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/issue/PROJ-1/comment":
+			data, _ := io.ReadAll(r.Body)
+			json.Unmarshal(data, &sent)
+			w.Write([]byte(`{"id": "11"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.deploymentType = DeploymentCloud
+
+	comment, err := client.ReplyToComment(context.Background(), "PROJ-1", "10", "Yes", nil)
+	if err != nil || comment.ID != "11" {
+		t.Fatalf("ReplyToComment() = %+v, %v", comment, err)
+	}
+
+	content := sent.Body.Content
+	if len(content) != 3 {
+		t.Fatalf("reply = %+v", sent.Body)
+	}
+	if mention := content[0].Content[0]; mention.Type != "mention" || mention.Attrs["id"] != "abc" {
+		t.Errorf("reply should start with a mention of the author, got %+v", content[0])
+	}
+	quote := content[1]
+	if quote.Type != "blockquote" || len(quote.Content) != 2 {
+		t.Fatalf("quote = %+v", quote)
+	}
+	if quote.Content[0].Type != "paragraph" || adfNodeText(quote.Content[0]) != "Question" {
+		t.Errorf("heading should be quoted as a paragraph, got %+v", quote.Content[0])
+	}
+	if len(quote.Content[1].Content[0].Marks) != 1 {
+		t.Errorf("quote lost the formatting of the comment: %+v", quote.Content[1])
+	}
+	if adfNodeText(content[2]) != "Yes" {
+		t.Errorf("reply body = %+v", content[2])
+	}
+	if sent.Visibility == nil || sent.Visibility.Value != "Developers" {
+		t.Errorf("reply should keep the visibility of the quoted comment, got %+v", sent.Visibility)
+	}
+}
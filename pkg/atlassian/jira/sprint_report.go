@@ -0,0 +1,227 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// greenhopperVersion is the API path of the (undocumented) Jira Software
+// endpoints behind the sprint report
+const greenhopperVersion = "/rest/greenhopper/1.0"
+
+// Sprint report sources
+const (
+	SprintReportSourceJira     = "sprint_report"
+	SprintReportSourceComputed = "computed"
+)
+
+// SprintReport summarizes the outcome of a sprint
+type SprintReport struct {
+	Sprint             *Sprint             `json:"sprint,omitempty"`
+	Source             string              `json:"source"` // SprintReportSourceJira or SprintReportSourceComputed
+	Completed          []SprintReportIssue `json:"completed"`
+	NotCompleted       []SprintReportIssue `json:"not_completed"`
+	Removed            []SprintReportIssue `json:"removed,omitempty"`
+	AddedKeys          []string            `json:"added_keys,omitempty"`
+	CompletedPoints    float64             `json:"completed_points"`
+	NotCompletedPoints float64             `json:"not_completed_points"`
+	RemovedPoints      float64             `json:"removed_points"`
+	AddedPoints        float64             `json:"added_points"`
+	TotalPoints        float64             `json:"total_points"`
+}
+
+// SprintReportIssue represents an issue in a sprint report
+type SprintReportIssue struct {
+	Key       string  `json:"key"`
+	Summary   string  `json:"summary,omitempty"`
+	Status    string  `json:"status,omitempty"`
+	IssueType string  `json:"issue_type,omitempty"`
+	Points    float64 `json:"points,omitempty"`
+	Added     bool    `json:"added,omitempty"` // Added after the sprint started
+}
+
+// sprintReportResponse is the sprint report endpoint's response
+type sprintReportResponse struct {
+	Contents struct {
+		CompletedIssues                   []sprintReportEntry `json:"completedIssues"`
+		IssuesNotCompletedInCurrentSprint []sprintReportEntry `json:"issuesNotCompletedInCurrentSprint"`
+		PuntedIssues                      []sprintReportEntry `json:"puntedIssues"`
+		IssueKeysAddedDuringSprint        map[string]bool     `json:"issueKeysAddedDuringSprint"`
+	} `json:"contents"`
+}
+
+// sprintReportEntry is an issue in the sprint report endpoint's response
+type sprintReportEntry struct {
+	Key                      string `json:"key"`
+	Summary                  string `json:"summary"`
+	TypeName                 string `json:"typeName"`
+	StatusName               string `json:"statusName"`
+	CurrentEstimateStatistic *struct {
+		StatFieldValue struct {
+			Value *float64 `json:"value"`
+		} `json:"statFieldValue"`
+	} `json:"currentEstimateStatistic"`
+}
+
+// GetSprintReport retrieves the Jira Software sprint report of a sprint on a
+// board. Points are the board's estimation statistic (usually story points).
+func (c *Client) GetSprintReport(ctx context.Context, boardID, sprintID int) (*SprintReport, error) {
+	path := buildURL(greenhopperVersion+"/rapid/charts/sprintreport", map[string]string{
+		"rapidViewId": fmt.Sprintf("%d", boardID),
+		"sprintId":    fmt.Sprintf("%d", sprintID),
+	})
+
+	var response sprintReportResponse
+	if err := c.doRequest(ctx, "GET", path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get sprint report for sprint %d: %w", sprintID, err)
+	}
+
+	added := response.Contents.IssueKeysAddedDuringSprint
+	convert := func(entries []sprintReportEntry) []SprintReportIssue {
+		issues := make([]SprintReportIssue, 0, len(entries))
+		for _, e := range entries {
+			issue := SprintReportIssue{
+				Key:       e.Key,
+				Summary:   e.Summary,
+				Status:    e.StatusName,
+				IssueType: e.TypeName,
+				Added:     added[e.Key],
+			}
+			if e.CurrentEstimateStatistic != nil && e.CurrentEstimateStatistic.StatFieldValue.Value != nil {
+				issue.Points = *e.CurrentEstimateStatistic.StatFieldValue.Value
+			}
+			issues = append(issues, issue)
+		}
+		return issues
+	}
+
+	report := &SprintReport{
+		Source:       SprintReportSourceJira,
+		Completed:    convert(response.Contents.CompletedIssues),
+		NotCompleted: convert(response.Contents.IssuesNotCompletedInCurrentSprint),
+		Removed:      convert(response.Contents.PuntedIssues),
+	}
+	report.summarize()
+
+	return report, nil
+}
+
+// ComputeSprintReport builds a sprint report from the sprint's current
+// issues, for when the sprint report endpoint is unavailable. Issues in a
+// done status category count as completed. Removed issues cannot be
+// determined, and issues created after the sprint started are counted as
+// added. Points are read from storyPointsField when it is set.
+func (c *Client) ComputeSprintReport(ctx context.Context, sprintID int, storyPointsField string) (*SprintReport, error) {
+	sprint, err := c.GetSprint(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []string{"summary", "status", "issuetype", "created"}
+	if storyPointsField != "" {
+		fields = append(fields, storyPointsField)
+	}
+
+	report := &SprintReport{
+		Sprint: sprint,
+		Source: SprintReportSourceComputed,
+	}
+
+	for startAt := 0; ; {
+		path := buildURL(fmt.Sprintf("%s/sprint/%d/issue", c.getAgileAPIPath(), sprintID), map[string]string{
+			"fields":     strings.Join(fields, ","),
+			"startAt":    fmt.Sprintf("%d", startAt),
+			"maxResults": "100",
+		})
+
+		var page struct {
+			Total  int `json:"total"`
+			Issues []struct {
+				Key    string          `json:"key"`
+				Fields json.RawMessage `json:"fields"`
+			} `json:"issues"`
+		}
+		if err := c.doRequest(ctx, "GET", path, nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to get issues for sprint %d: %w", sprintID, err)
+		}
+
+		for _, raw := range page.Issues {
+			var f struct {
+				Summary   string        `json:"summary"`
+				Status    *Status       `json:"status"`
+				IssueType *IssueType    `json:"issuetype"`
+				Created   AtlassianTime `json:"created"`
+			}
+			var custom map[string]json.RawMessage
+			if err := json.Unmarshal(raw.Fields, &f); err != nil {
+				return nil, fmt.Errorf("failed to decode issue %s: %w", raw.Key, err)
+			}
+			if err := json.Unmarshal(raw.Fields, &custom); err != nil {
+				return nil, fmt.Errorf("failed to decode issue %s: %w", raw.Key, err)
+			}
+
+			issue := SprintReportIssue{Key: raw.Key, Summary: f.Summary}
+			if f.IssueType != nil {
+				issue.IssueType = f.IssueType.Name
+			}
+			if storyPointsField != "" {
+				var points *float64
+				if err := json.Unmarshal(custom[storyPointsField], &points); err == nil && points != nil {
+					issue.Points = *points
+				}
+			}
+			if sprint.StartDate != nil && !f.Created.IsZero() && f.Created.After(sprint.StartDate.Time) {
+				issue.Added = true
+			}
+
+			done := false
+			if f.Status != nil {
+				issue.Status = f.Status.Name
+				done = f.Status.StatusCategory != nil && f.Status.StatusCategory.Key == "done"
+			}
+			if done {
+				report.Completed = append(report.Completed, issue)
+			} else {
+				report.NotCompleted = append(report.NotCompleted, issue)
+			}
+		}
+
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+
+	report.summarize()
+
+	return report, nil
+}
+
+// summarize totals the report's points and collects the added issue keys
+func (r *SprintReport) summarize() {
+	r.CompletedPoints, r.NotCompletedPoints, r.RemovedPoints, r.AddedPoints = 0, 0, 0, 0
+	r.AddedKeys = nil
+
+	for _, group := range []struct {
+		issues []SprintReportIssue
+		total  *float64
+	}{
+		{r.Completed, &r.CompletedPoints},
+		{r.NotCompleted, &r.NotCompletedPoints},
+		{r.Removed, &r.RemovedPoints},
+	} {
+		for _, issue := range group.issues {
+			*group.total += issue.Points
+			if issue.Added {
+				r.AddedKeys = append(r.AddedKeys, issue.Key)
+				r.AddedPoints += issue.Points
+			}
+		}
+	}
+	sort.Strings(r.AddedKeys)
+
+	r.TotalPoints = r.CompletedPoints + r.NotCompletedPoints
+}
@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"fmt"
+	"time"
+)
+
+// Sprint states
+const (
+	SprintStateFuture = "future"
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
+)
+
+// sprintTransitions are the state changes Jira allows: a sprint is started
+// once and closed once
+var sprintTransitions = map[string]string{
+	SprintStateFuture: SprintStateActive,
+	SprintStateActive: SprintStateClosed,
+}
+
+// CheckUpdate reports an update Jira would reject for the sprint in its
+// current state, so it can be explained before the API call fails. Sprints
+// go from future to active to closed; starting a sprint requires start and
+// end dates, given in the update or already set; a complete date is only
+// accepted when closing; and a closed sprint only takes a new name or goal.
+func (s *Sprint) CheckUpdate(req *UpdateSprintRequest) error {
+	switch req.State {
+	case "", SprintStateFuture, SprintStateActive, SprintStateClosed:
+	default:
+		return fmt.Errorf("unknown sprint state %q: use %s, %s, or %s", req.State, SprintStateFuture, SprintStateActive, SprintStateClosed)
+	}
+
+	closing := req.State == SprintStateClosed && s.State != SprintStateClosed
+	if req.CompleteDate != "" && !closing {
+		return fmt.Errorf("a complete date can only be set when closing a sprint; sprint %d is %s", s.ID, s.State)
+	}
+
+	if s.State == SprintStateClosed {
+		if req.State != "" && req.State != SprintStateClosed {
+			return fmt.Errorf("sprint %d is closed, and closed sprints can't be reopened", s.ID)
+		}
+		if req.StartDate != "" || req.EndDate != "" {
+			return fmt.Errorf("sprint %d is closed: only its name and goal can be changed", s.ID)
+		}
+		return nil
+	}
+
+	if req.State != "" && req.State != s.State && sprintTransitions[s.State] != req.State {
+		return fmt.Errorf("sprint %d can't go from %s to %s: sprints go from future to active to closed", s.ID, s.State, req.State)
+	}
+
+	start, err := sprintDate("start date", req.StartDate, s.StartDate)
+	if err != nil {
+		return err
+	}
+	end, err := sprintDate("end date", req.EndDate, s.EndDate)
+	if err != nil {
+		return err
+	}
+	complete, err := sprintDate("complete date", req.CompleteDate, nil)
+	if err != nil {
+		return err
+	}
+
+	if req.State == SprintStateActive && s.State == SprintStateFuture && (start.IsZero() || end.IsZero()) {
+		return fmt.Errorf("starting sprint %d requires a start date and an end date", s.ID)
+	}
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		return fmt.Errorf("the end date of sprint %d must be after its start date", s.ID)
+	}
+	if !start.IsZero() && !complete.IsZero() && complete.Before(start) {
+		return fmt.Errorf("the complete date of sprint %d must not be before its start date", s.ID)
+	}
+	return nil
+}
+
+// sprintDate returns the date an update sets, or the sprint's current date
+// if the update leaves it unchanged
+func sprintDate(name, value string, current *AtlassianTime) (time.Time, error) {
+	if value == "" {
+		if current == nil {
+			return time.Time{}, nil
+		}
+		return current.Time, nil
+	}
+
+	for _, format := range atlassianTimeFormats {
+		if t, err := time.Parse(format, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid %s %q: use ISO 8601", name, value)
+}
@@ -0,0 +1,61 @@
+package jira
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSprintCheckUpdate(t *testing.T) {
+	date := func(value string) *AtlassianTime {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatalf("invalid time %q: %v", value, err)
+		}
+		return &AtlassianTime{parsed}
+	}
+	future := &Sprint{ID: 1, State: SprintStateFuture}
+	planned := &Sprint{ID: 2, State: SprintStateFuture, StartDate: date("2025-01-06T09:00:00Z"), EndDate: date("2025-01-20T09:00:00Z")}
+	active := &Sprint{ID: 3, State: SprintStateActive, StartDate: date("2025-01-06T09:00:00Z"), EndDate: date("2025-01-20T09:00:00Z")}
+	closed := &Sprint{ID: 4, State: SprintStateClosed, StartDate: date("2025-01-06T09:00:00Z"), EndDate: date("2025-01-20T09:00:00Z")}
+
+	tests := []struct {
+		name    string
+		sprint  *Sprint
+		req     UpdateSprintRequest
+		wantErr string
+	}{
+		{"rename future", future, UpdateSprintRequest{Name: "Sprint 1"}, ""},
+		{"start with dates", future, UpdateSprintRequest{State: "active", StartDate: "2025-01-06T09:00:00.000Z", EndDate: "2025-01-20T09:00:00.000+0100"}, ""},
+		{"start planned", planned, UpdateSprintRequest{State: "active"}, ""},
+		{"start without dates", future, UpdateSprintRequest{State: "active"}, "requires a start date and an end date"},
+		{"start with one date", future, UpdateSprintRequest{State: "active", StartDate: "2025-01-06T09:00:00Z"}, "requires a start date and an end date"},
+		{"close future", future, UpdateSprintRequest{State: "closed"}, "can't go from future to closed"},
+		{"close active", active, UpdateSprintRequest{State: "closed"}, ""},
+		{"close with complete date", active, UpdateSprintRequest{State: "closed", CompleteDate: "2025-01-19T17:00:00Z"}, ""},
+		{"complete date without closing", active, UpdateSprintRequest{CompleteDate: "2025-01-19T17:00:00Z"}, "only be set when closing"},
+		{"complete date before start", active, UpdateSprintRequest{State: "closed", CompleteDate: "2025-01-01T00:00:00Z"}, "must not be before its start date"},
+		{"active back to future", active, UpdateSprintRequest{State: "future"}, "can't go from active to future"},
+		{"same state", active, UpdateSprintRequest{State: "active", Goal: "Ship it"}, ""},
+		{"end before start", active, UpdateSprintRequest{EndDate: "2025-01-05T09:00:00Z"}, "must be after its start date"},
+		{"reopen", closed, UpdateSprintRequest{State: "active"}, "can't be reopened"},
+		{"closed dates", closed, UpdateSprintRequest{EndDate: "2025-01-21T09:00:00Z"}, "only its name and goal"},
+		{"closed goal", closed, UpdateSprintRequest{Goal: "Shipped"}, ""},
+		{"unknown state", active, UpdateSprintRequest{State: "done"}, "unknown sprint state"},
+		{"invalid date", active, UpdateSprintRequest{EndDate: "next friday"}, "invalid end date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sprint.CheckUpdate(&tt.req)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("CheckUpdate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("CheckUpdate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
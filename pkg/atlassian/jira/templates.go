@@ -0,0 +1,139 @@
+package jira
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// IssueTemplate standardizes new issues of a kind, such as bug reports. Its
+// values fill in what the fields of a new issue leave out; labels and
+// components are added to those of the issue.
+type IssueTemplate struct {
+	Description string                 // Markdown skeleton used when the issue has no description
+	Fields      map[string]interface{} // Field values by ID or name (e.g., {"priority": {"name": "High"}})
+	Labels      []string
+	Components  []string // Component names
+}
+
+// lowercaseTemplateNames returns the templates keyed by lowercase name
+func lowercaseTemplateNames(templates map[string]IssueTemplate) map[string]IssueTemplate {
+	lowered := make(map[string]IssueTemplate, len(templates))
+	for name, t := range templates {
+		lowered[strings.ToLower(name)] = t
+	}
+	return lowered
+}
+
+// IssueTemplateNames returns the sorted names of the configured templates
+func (c *Client) IssueTemplateNames() []string {
+	names := make([]string, 0, len(c.templates))
+	for name := range c.templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IssueTemplate returns a template by name. Names are case-insensitive.
+func (c *Client) IssueTemplate(name string) (*IssueTemplate, error) {
+	t, ok := c.templates[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		if len(c.templates) == 0 {
+			return nil, fmt.Errorf("no issue templates are configured")
+		}
+		return nil, fmt.Errorf("unknown issue template %q (configured: %s)", name, strings.Join(c.IssueTemplateNames(), ", "))
+	}
+	return &t, nil
+}
+
+// Apply fills in the fields of a new issue from the template. Fields already
+// set keep their values, except labels and components, which are merged.
+// Values are copied, so changing the issue's fields leaves the template as
+// it was.
+func (t *IssueTemplate) Apply(fields map[string]interface{}) {
+	for key, value := range t.Fields {
+		if _, ok := fields[key]; !ok {
+			fields[key] = copyValue(value)
+		}
+	}
+	if _, ok := fields["description"]; !ok && t.Description != "" {
+		fields["description"] = t.Description
+	}
+
+	if len(t.Labels) > 0 {
+		labels := stringList(fields["labels"])
+		for _, label := range t.Labels {
+			if !containsFold(labels, label) {
+				labels = append(labels, label)
+			}
+		}
+		fields["labels"] = labels
+	}
+
+	if len(t.Components) > 0 {
+		components, _ := fields["components"].([]interface{})
+		var names []string
+		for _, c := range components {
+			if obj, ok := c.(map[string]interface{}); ok {
+				if name, ok := obj["name"].(string); ok {
+					names = append(names, name)
+				}
+			}
+		}
+		for _, name := range t.Components {
+			if !containsFold(names, name) {
+				components = append(components, map[string]interface{}{"name": name})
+			}
+		}
+		fields["components"] = components
+	}
+}
+
+// copyValue returns a deep copy of a decoded field value
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			copied[key] = copyValue(item)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = copyValue(item)
+		}
+		return copied
+	case []string:
+		return append([]string(nil), v...)
+	}
+	return value
+}
+
+// stringList returns the strings of a decoded JSON list
+func stringList(value interface{}) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return nil
+}
+
+// containsFold reports whether list contains s, ignoring case
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
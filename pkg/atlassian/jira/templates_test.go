@@ -0,0 +1,73 @@
+package jira
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIssueTemplateApply(t *testing.T) {
+	template := &IssueTemplate{
+		Description: "## Steps to reproduce",
+		Fields: map[string]interface{}{
+			"priority":    map[string]interface{}{"name": "High"},
+			"environment": "production",
+		},
+		Labels:     []string{"bug", "triage"},
+		Components: []string{"Backend", "API"},
+	}
+
+	fields := map[string]interface{}{
+		"summary":     "Login fails",
+		"environment": "staging",
+		"labels":      []interface{}{"Bug", "login"},
+		"components":  []interface{}{map[string]interface{}{"name": "api"}},
+	}
+	template.Apply(fields)
+
+	if fields["environment"] != "staging" {
+		t.Errorf("environment = %v, fields of the issue should win", fields["environment"])
+	}
+	if priority, ok := fields["priority"].(map[string]interface{}); !ok || priority["name"] != "High" {
+		t.Errorf("priority = %v", fields["priority"])
+	}
+	if fields["description"] != "## Steps to reproduce" {
+		t.Errorf("description = %v", fields["description"])
+	}
+	if want := []string{"Bug", "login", "triage"}; !reflect.DeepEqual(fields["labels"], want) {
+		t.Errorf("labels = %v, want %v", fields["labels"], want)
+	}
+	want := []interface{}{map[string]interface{}{"name": "api"}, map[string]interface{}{"name": "Backend"}}
+	if !reflect.DeepEqual(fields["components"], want) {
+		t.Errorf("components = %v, want %v", fields["components"], want)
+	}
+
+	fields["priority"].(map[string]interface{})["name"] = "Low"
+	if priority := template.Fields["priority"].(map[string]interface{}); priority["name"] != "High" {
+		t.Errorf("template priority = %v, changing the issue's fields should not change the template", priority)
+	}
+
+	described := map[string]interface{}{"description": "Already described"}
+	template.Apply(described)
+	if described["description"] != "Already described" {
+		t.Errorf("description = %v, the issue's description should be kept", described["description"])
+	}
+}
+
+func TestClientIssueTemplate(t *testing.T) {
+	client, err := NewClient(&Config{
+		BaseURL:        "https://jira.example.com",
+		Auth:           &mockAuth{},
+		IssueTemplates: map[string]IssueTemplate{"Bug-Report": {Labels: []string{"bug"}}, "incident": {}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if template, err := client.IssueTemplate("bug-report"); err != nil || template.Labels[0] != "bug" {
+		t.Errorf("IssueTemplate() = %+v, %v", template, err)
+	}
+	if _, err := client.IssueTemplate("story"); err == nil || !strings.Contains(err.Error(), "bug-report, incident") {
+		t.Errorf("IssueTemplate() with an unknown name error = %v", err)
+	}
+}
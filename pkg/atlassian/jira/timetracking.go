@@ -0,0 +1,121 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TimeTracking is the working time a Jira site counts as a day and a week in
+// durations such as "1d" or "2w"
+type TimeTracking struct {
+	HoursPerDay float64 `json:"workingHoursPerDay"`
+	DaysPerWeek float64 `json:"workingDaysPerWeek"`
+}
+
+// DefaultTimeTracking is Jira's default of 8 hour days and 5 day weeks
+var DefaultTimeTracking = TimeTracking{HoursPerDay: 8, DaysPerWeek: 5}
+
+// durationPattern matches one unit of a Jira duration, e.g. "2h" or "1.5d"
+var durationPattern = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*([wdhm])`)
+
+// daySeconds returns the seconds of a working day and week
+func (t TimeTracking) daySeconds() (day, week int) {
+	day = int(math.Round(t.HoursPerDay * 3600))
+	week = int(math.Round(t.DaysPerWeek * float64(day)))
+	return day, week
+}
+
+// ParseDuration converts a Jira duration (e.g. "2h 30m", "1d", "3w") to
+// seconds, counting days and weeks as working time
+func (t TimeTracking) ParseDuration(value string) (int, error) {
+	matches := durationPattern.FindAllStringSubmatch(strings.ToLower(strings.TrimSpace(value)), -1)
+	if len(matches) == 0 {
+		return 0, fmt.Errorf("invalid time format: %s (expected format like '2h 30m', '1d', '3w')", value)
+	}
+
+	day, week := t.daySeconds()
+	unitSeconds := map[string]int{"w": week, "d": day, "h": 3600, "m": 60}
+
+	total := 0.0
+	for _, match := range matches {
+		n, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number in time format: %s", match[1])
+		}
+		total += n * float64(unitSeconds[match[2]])
+	}
+	return int(math.Round(total)), nil
+}
+
+// FormatDuration formats seconds as a Jira duration (e.g. "1d 2h 30m"),
+// counting days and weeks as working time. Seconds below a minute are
+// dropped.
+func (t TimeTracking) FormatDuration(seconds int) string {
+	day, week := t.daySeconds()
+	units := []struct {
+		suffix  string
+		seconds int
+	}{{"w", week}, {"d", day}, {"h", 3600}, {"m", 60}}
+
+	var parts []string
+	for _, unit := range units {
+		if unit.seconds <= 0 || seconds < unit.seconds {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%d%s", seconds/unit.seconds, unit.suffix))
+		seconds %= unit.seconds
+	}
+	if len(parts) == 0 {
+		return "0m"
+	}
+	return strings.Join(parts, " ")
+}
+
+// TimeTracking returns the working time of the site. Values set in the
+// client's Config take precedence; the others are read from the site's time
+// tracking settings once and cached, falling back to DefaultTimeTracking when
+// the settings can't be read or time tracking is disabled.
+func (c *Client) TimeTracking(ctx context.Context) TimeTracking {
+	c.timeTrackingMu.Lock()
+	defer c.timeTrackingMu.Unlock()
+
+	if c.timeTracking != nil {
+		return *c.timeTracking
+	}
+
+	tt := c.timeTrackingOverride
+	if tt.HoursPerDay > 0 && tt.DaysPerWeek > 0 {
+		c.timeTracking = &tt
+		return tt
+	}
+
+	site := DefaultTimeTracking
+	var config struct {
+		TimeTrackingConfiguration *TimeTracking `json:"timeTrackingConfiguration"`
+	}
+	err := c.doRequest(ctx, "GET", c.getAPIPath()+"/configuration", nil, &config)
+	if err == nil && config.TimeTrackingConfiguration != nil {
+		if config.TimeTrackingConfiguration.HoursPerDay > 0 {
+			site.HoursPerDay = config.TimeTrackingConfiguration.HoursPerDay
+		}
+		if config.TimeTrackingConfiguration.DaysPerWeek > 0 {
+			site.DaysPerWeek = config.TimeTrackingConfiguration.DaysPerWeek
+		}
+	}
+
+	if tt.HoursPerDay <= 0 {
+		tt.HoursPerDay = site.HoursPerDay
+	}
+	if tt.DaysPerWeek <= 0 {
+		tt.DaysPerWeek = site.DaysPerWeek
+	}
+	// A failed read is retried by the next call
+	if err == nil {
+		c.timeTracking = &tt
+	}
+	return tt
+}
@@ -0,0 +1,125 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTimeTrackingParseDuration(t *testing.T) {
+	short := TimeTracking{HoursPerDay: 7.5, DaysPerWeek: 4}
+
+	tests := []struct {
+		name  string
+		tt    TimeTracking
+		value string
+		want  int
+	}{
+		{"hours and minutes", DefaultTimeTracking, "2h 30m", 9000},
+		{"day", DefaultTimeTracking, "1d", 8 * 3600},
+		{"week", DefaultTimeTracking, "1w", 40 * 3600},
+		{"fraction", DefaultTimeTracking, "1.5h", 5400},
+		{"short day", short, "1d", 27000},
+		{"short week", short, "1w 1d", 5 * 27000},
+		{"spaces and case", DefaultTimeTracking, " 1D 2H ", 10 * 3600},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.tt.ParseDuration(tt.value)
+			if err != nil {
+				t.Fatalf("ParseDuration(%q) error = %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDuration(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := DefaultTimeTracking.ParseDuration("soon"); err == nil {
+		t.Error("ParseDuration() should reject values without units")
+	}
+}
+
+func TestTimeTrackingFormatDuration(t *testing.T) {
+	short := TimeTracking{HoursPerDay: 7.5, DaysPerWeek: 4}
+
+	tests := []struct {
+		name    string
+		tt      TimeTracking
+		seconds int
+		want    string
+	}{
+		{"zero", DefaultTimeTracking, 0, "0m"},
+		{"seconds", DefaultTimeTracking, 59, "0m"},
+		{"hours and minutes", DefaultTimeTracking, 9000, "2h 30m"},
+		{"day", DefaultTimeTracking, 8 * 3600, "1d"},
+		{"all units", DefaultTimeTracking, 40*3600 + 8*3600 + 3600 + 60, "1w 1d 1h 1m"},
+		{"short day", short, 27000 + 3600, "1d 1h"},
+		{"short week", short, 4 * 27000, "1w"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tt.FormatDuration(tt.seconds); got != tt.want {
+				t.Errorf("FormatDuration(%d) = %q, want %q", tt.seconds, got, tt.want)
+			}
+		})
+	}
+
+	// Durations survive a roundtrip
+	for _, value := range []string{"1w 2d 3h 4m", "45m", "6h"} {
+		seconds, err := short.ParseDuration(value)
+		if err != nil {
+			t.Fatalf("ParseDuration(%q) error = %v", value, err)
+		}
+		if got := short.FormatDuration(seconds); got != value {
+			t.Errorf("FormatDuration(ParseDuration(%q)) = %q", value, got)
+		}
+	}
+}
+
+func TestClientTimeTracking(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"timeTrackingEnabled": true, "timeTrackingConfiguration": {"workingHoursPerDay": 7.5, "workingDaysPerWeek": 4.0, "timeFormat": "pretty", "defaultUnit": "minute"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	want := TimeTracking{HoursPerDay: 7.5, DaysPerWeek: 4}
+	for i := 0; i < 2; i++ {
+		if got := client.TimeTracking(context.Background()); got != want {
+			t.Errorf("TimeTracking() = %+v, want %+v", got, want)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected one /configuration request, got %d", requests)
+	}
+
+	// Configured values take precedence over the site's
+	client, err = NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true, HoursPerDay: 6})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if got := client.TimeTracking(context.Background()); got != (TimeTracking{HoursPerDay: 6, DaysPerWeek: 4}) {
+		t.Errorf("TimeTracking() = %+v, want 6 hour days from the config", got)
+	}
+
+	// Without site settings, the defaults apply
+	client, err = NewClient(&Config{BaseURL: server.URL + "/missing", Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if got := client.TimeTracking(context.Background()); got != DefaultTimeTracking {
+		t.Errorf("TimeTracking() = %+v, want the defaults", got)
+	}
+}
@@ -26,6 +26,13 @@ func (c *Client) GetTransitions(ctx context.Context, issueKey string) ([]Transit
 
 // TransitionIssue transitions an issue to a new status
 func (c *Client) TransitionIssue(ctx context.Context, issueKey string, transitionID string, fields map[string]interface{}) error {
+	return c.TransitionIssueWithComment(ctx, issueKey, transitionID, fields, "")
+}
+
+// TransitionIssueWithComment transitions an issue and adds a comment with the
+// transition. The markdown comment is converted like in AddComment. An empty
+// comment adds none.
+func (c *Client) TransitionIssueWithComment(ctx context.Context, issueKey string, transitionID string, fields map[string]interface{}, comment string) error {
 	path := fmt.Sprintf("%s/issue/%s/transitions", c.getAPIPath(), issueKey)
 
 	request := TransitionRequest{
@@ -34,6 +41,13 @@ func (c *Client) TransitionIssue(ctx context.Context, issueKey string, transitio
 		},
 		Fields: fields,
 	}
+	if comment != "" {
+		request.Update = map[string]interface{}{
+			"comment": []map[string]interface{}{
+				{"add": map[string]interface{}{"body": c.CommentBody(comment)}},
+			},
+		}
+	}
 
 	reqBody, err := json.Marshal(request)
 	if err != nil {
@@ -254,6 +254,12 @@ type Issue struct {
 	Self   string      `json:"self"`
 	Fields IssueFields `json:"fields"`
 	Expand string      `json:"expand,omitempty"`
+
+	// Returned only when requested: renderedFields and versionedRepresentations
+	// with expand, properties with GetIssueOptions.Properties
+	RenderedFields           map[string]interface{}            `json:"renderedFields,omitempty"`           // Field values rendered as HTML
+	VersionedRepresentations map[string]map[string]interface{} `json:"versionedRepresentations,omitempty"` // Field values by representation version
+	Properties               map[string]interface{}            `json:"properties,omitempty"`               // Issue property values by key
 }
 
 // IssueFields represents all possible fields in a Jira issue
@@ -310,6 +316,7 @@ type Project struct {
 	ProjectCategory *ProjectCategory `json:"projectCategory,omitempty"`
 	Versions        []Version        `json:"versions,omitempty"`
 	Components      []Component      `json:"components,omitempty"`
+	Archived        bool             `json:"archived,omitempty"`
 }
 
 // ProjectCategory represents a project category
@@ -320,6 +327,38 @@ type ProjectCategory struct {
 	Self        string `json:"self,omitempty"`
 }
 
+// SecurityLevel represents an issue security level
+type SecurityLevel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Self        string `json:"self,omitempty"`
+}
+
+// ProjectRole represents a project role and the users and groups in it
+type ProjectRole struct {
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Self        string      `json:"self,omitempty"`
+	Actors      []RoleActor `json:"actors,omitempty"`
+}
+
+// RoleActor represents a user or group in a project role
+type RoleActor struct {
+	ID          int    `json:"id"`
+	DisplayName string `json:"displayName"`
+	Type        string `json:"type"`           // "atlassian-user-role-actor" or "atlassian-group-role-actor"
+	Name        string `json:"name,omitempty"` // Username or group name
+	ActorUser   *struct {
+		AccountID string `json:"accountId"`
+	} `json:"actorUser,omitempty"` // Cloud
+	ActorGroup *struct {
+		Name    string `json:"name"`
+		GroupID string `json:"groupId,omitempty"`
+	} `json:"actorGroup,omitempty"`
+}
+
 // User represents a Jira user
 type User struct {
 	Self         string      `json:"self,omitempty"`
@@ -341,6 +380,12 @@ type AvatarUrls struct {
 	Size32 string `json:"32x32,omitempty"`
 }
 
+// Group represents a user group
+type Group struct {
+	Name    string `json:"name"`
+	GroupID string `json:"groupId,omitempty"` // Cloud
+}
+
 // Priority represents issue priority
 type Priority struct {
 	ID      string `json:"id"`
@@ -579,9 +624,28 @@ type Sprint struct {
 	Goal          string         `json:"goal,omitempty"`
 }
 
+// RemoteLinkID is the ID of a remote issue link. Jira returns it as a
+// number.
+type RemoteLinkID string
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (id *RemoteLinkID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = RemoteLinkID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("invalid remote link ID %s", data)
+	}
+	*id = RemoteLinkID(n.String())
+	return nil
+}
+
 // RemoteLink represents a remote issue link
 type RemoteLink struct {
-	ID           string           `json:"id,omitempty"`
+	ID           RemoteLinkID     `json:"id,omitempty"`
 	Self         string           `json:"self,omitempty"`
 	GlobalID     string           `json:"globalId,omitempty"`
 	Application  *LinkApplication `json:"application,omitempty"`
@@ -649,6 +713,7 @@ type UpdateIssueRequest struct {
 type TransitionRequest struct {
 	Transition Transition             `json:"transition"`
 	Fields     map[string]interface{} `json:"fields,omitempty"`
+	Update     map[string]interface{} `json:"update,omitempty"`
 }
 
 // CreateCommentRequest represents a request to add a comment
@@ -714,3 +779,18 @@ type ErrorResponse struct {
 	ErrorMessages []string          `json:"errorMessages,omitempty"`
 	Errors        map[string]string `json:"errors,omitempty"`
 }
+
+// Permission represents a Jira permission and whether the current user holds it
+type Permission struct {
+	ID             string `json:"id,omitempty"`
+	Key            string `json:"key"`
+	Name           string `json:"name,omitempty"`
+	Type           string `json:"type,omitempty"`
+	Description    string `json:"description,omitempty"`
+	HavePermission bool   `json:"havePermission"`
+}
+
+// MyPermissionsResponse represents the response from the mypermissions endpoint
+type MyPermissionsResponse struct {
+	Permissions map[string]Permission `json:"permissions"`
+}
@@ -469,3 +469,21 @@ func TestComment_UnmarshalJSON_Body(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoteLink_UnmarshalJSON_ID(t *testing.T) {
+	for _, data := range []string{`{"id": 10000}`, `{"id": "10000"}`} {
+		var link RemoteLink
+		if err := json.Unmarshal([]byte(data), &link); err != nil {
+			t.Errorf("json.Unmarshal(%s) error = %v", data, err)
+			continue
+		}
+		if link.ID != "10000" {
+			t.Errorf("json.Unmarshal(%s) ID = %q, want 10000", data, link.ID)
+		}
+	}
+
+	var link RemoteLink
+	if err := json.Unmarshal([]byte(`{"id": true}`), &link); err == nil {
+		t.Error("json.Unmarshal() should reject a boolean ID")
+	}
+}
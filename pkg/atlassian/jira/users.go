@@ -109,10 +109,39 @@ func (c *Client) GetUserGroups(ctx context.Context, accountIDOrUsername string)
 
 	path = buildURL(path, params)
 
-	var groups []string
-	if err := c.doRequest(ctx, "GET", path, nil, &groups); err != nil {
+	var response []struct {
+		Name string `json:"name"`
+	}
+	if err := c.doRequest(ctx, "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get user groups: %w", err)
 	}
 
+	groups := make([]string, 0, len(response))
+	for _, group := range response {
+		groups = append(groups, group.Name)
+	}
+
 	return groups, nil
 }
+
+// FindGroups searches for groups whose name contains the query. An empty
+// query lists groups.
+func (c *Client) FindGroups(ctx context.Context, query string, maxResults int) ([]Group, error) {
+	path := fmt.Sprintf("%s/groups/picker", c.getAPIPath())
+
+	params := map[string]string{"query": query}
+	if maxResults > 0 {
+		params["maxResults"] = fmt.Sprintf("%d", maxResults)
+	}
+
+	path = buildURL(path, params)
+
+	var response struct {
+		Groups []Group `json:"groups"`
+	}
+	if err := c.doRequest(ctx, "GET", path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to find groups: %w", err)
+	}
+
+	return response.Groups, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // CreateVersion creates a new version
@@ -105,3 +106,30 @@ func (c *Client) ArchiveVersion(ctx context.Context, versionID string) (*Version
 	}
 	return c.UpdateVersion(ctx, versionID, req)
 }
+
+// ResolveVersion finds a version of a project by name (case-insensitive) or ID
+func (c *Client) ResolveVersion(ctx context.Context, projectKey, nameOrID string) (*Version, error) {
+	versions, err := c.GetProjectVersions(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range versions {
+		if versions[i].ID == nameOrID || strings.EqualFold(versions[i].Name, nameOrID) {
+			return &versions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("version %q not found in project %s", nameOrID, projectKey)
+}
+
+// MoveIssueFixVersion replaces a fix version of an issue with another,
+// leaving its other fix versions unchanged
+func (c *Client) MoveIssueFixVersion(ctx context.Context, issueKey, fromVersionID, toVersionID string) error {
+	update := map[string]interface{}{
+		"fixVersions": []map[string]interface{}{
+			{"remove": map[string]string{"id": fromVersionID}},
+			{"add": map[string]string{"id": toVersionID}},
+		},
+	}
+	return c.UpdateIssue(ctx, issueKey, nil, update)
+}
@@ -0,0 +1,67 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestResolveVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/rest/api/2/project/PROJ/versions" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Write([]byte(`[{"id": "100", "name": "1.0"}, {"id": "101", "name": "Release 1.1"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	ctx := context.Background()
+
+	if v, err := client.ResolveVersion(ctx, "PROJ", "release 1.1"); err != nil || v.ID != "101" {
+		t.Errorf("ResolveVersion() by name = %+v, %v", v, err)
+	}
+	if v, err := client.ResolveVersion(ctx, "PROJ", "100"); err != nil || v.Name != "1.0" {
+		t.Errorf("ResolveVersion() by ID = %+v, %v", v, err)
+	}
+	if _, err := client.ResolveVersion(ctx, "PROJ", "2.0"); err == nil {
+		t.Error("ResolveVersion() with an unknown version should return an error")
+	}
+}
+
+func TestMoveIssueFixVersion(t *testing.T) {
+	var sent UpdateIssueRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/rest/api/2/issue/PROJ-1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		data, _ := io.ReadAll(r.Body)
+		json.Unmarshal(data, &sent)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &mockAuth{}, SSLVerify: true})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if err := client.MoveIssueFixVersion(context.Background(), "PROJ-1", "100", "101"); err != nil {
+		t.Fatalf("MoveIssueFixVersion() error = %v", err)
+	}
+	want := []interface{}{
+		map[string]interface{}{"remove": map[string]interface{}{"id": "100"}},
+		map[string]interface{}{"add": map[string]interface{}{"id": "101"}},
+	}
+	if !reflect.DeepEqual(sent.Update["fixVersions"], want) {
+		t.Errorf("update = %v, want %v", sent.Update["fixVersions"], want)
+	}
+}
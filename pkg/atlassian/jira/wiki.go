@@ -0,0 +1,138 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	wikiMarkupPattern = regexp.MustCompile(`(?m)^h[1-6]\.\s|^bq\.\s|\{code|\{noformat\}|\{\{|\[[^\]|]+\|[^\]]+\]|^\|\|`)
+	markdownPattern   = regexp.MustCompile("(?m)^#{1,6}\\s|^```|\\]\\(|\\*\\*|^>\\s")
+
+	mdHeadingPattern = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	mdRulePattern    = regexp.MustCompile(`^\s*([-*_])(\s*[-*_]){2,}\s*$`)
+	mdListPattern    = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	mdTableSeparator = regexp.MustCompile(`^\|[\s\-:|]+\|$`)
+
+	mdCodeSpanPattern  = regexp.MustCompile("`([^`]+)`")
+	mdImagePattern     = regexp.MustCompile(`!\[[^\]]*\]\(([^)\s]+)[^)]*\)`)
+	mdLinkPattern      = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)[^)]*\)`)
+	mdBoldPattern      = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	mdItalicPattern    = regexp.MustCompile(`\*([^*\s](?:[^*]*[^*\s])?)\*`)
+	mdStrikePattern    = regexp.MustCompile(`~~([^~]+)~~`)
+	mdUnderlinePattern = regexp.MustCompile(`\+\+([^+]+)\+\+`)
+)
+
+// MarkdownToWiki converts a markdown string to Jira wiki markup, the rich text
+// format of Server/DC. Text that already uses wiki markup (e.g. h2., {code},
+// [text|url]) and no markdown is returned unchanged.
+func MarkdownToWiki(markdown string) string {
+	if wikiMarkupPattern.MatchString(markdown) && !markdownPattern.MatchString(markdown) {
+		return markdown
+	}
+
+	lines := strings.Split(markdown, "\n")
+	result := make([]string, 0, len(lines))
+
+	inCodeBlock := false
+	var listMarkers []string
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		// Code blocks are copied verbatim
+		if strings.HasPrefix(trimmed, "```") {
+			if inCodeBlock {
+				result = append(result, "{code}")
+			} else if lang := strings.TrimPrefix(trimmed, "```"); lang != "" {
+				result = append(result, "{code:"+lang+"}")
+			} else {
+				result = append(result, "{code}")
+			}
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if inCodeBlock {
+			result = append(result, line)
+			continue
+		}
+
+		if m := mdListPattern.FindStringSubmatch(line); m != nil && !mdRulePattern.MatchString(line) {
+			level := len(strings.ReplaceAll(m[1], "\t", "  "))/2 + 1
+			if level > len(listMarkers)+1 {
+				level = len(listMarkers) + 1
+			}
+			marker := "*"
+			if m[2][0] >= '0' && m[2][0] <= '9' {
+				marker = "#"
+			}
+			listMarkers = append(listMarkers[:level-1], marker)
+			result = append(result, strings.Join(listMarkers, "")+" "+convertInlineToWiki(m[3]))
+			continue
+		}
+		listMarkers = nil
+
+		switch {
+		case mdHeadingPattern.MatchString(line):
+			m := mdHeadingPattern.FindStringSubmatch(line)
+			result = append(result, fmt.Sprintf("h%d. %s", len(m[1]), convertInlineToWiki(m[2])))
+		case mdRulePattern.MatchString(line):
+			result = append(result, "----")
+		case strings.HasPrefix(trimmed, ">"):
+			result = append(result, "bq. "+convertInlineToWiki(strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))))
+		case strings.HasPrefix(trimmed, "|") && strings.HasSuffix(trimmed, "|"):
+			if mdTableSeparator.MatchString(trimmed) {
+				continue
+			}
+			header := i+1 < len(lines) && mdTableSeparator.MatchString(strings.TrimSpace(lines[i+1]))
+			result = append(result, convertTableRowToWiki(trimmed, header))
+		default:
+			result = append(result, convertInlineToWiki(line))
+		}
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// convertTableRowToWiki converts a markdown table row, using || cell
+// separators for header rows
+func convertTableRowToWiki(row string, header bool) string {
+	separator := "|"
+	if header {
+		separator = "||"
+	}
+
+	cells := strings.Split(strings.Trim(row, "|"), "|")
+	for i, cell := range cells {
+		cells[i] = " " + convertInlineToWiki(strings.TrimSpace(cell)) + " "
+	}
+	return separator + strings.Join(cells, separator) + separator
+}
+
+// convertInlineToWiki converts markdown inline formatting to wiki markup
+func convertInlineToWiki(text string) string {
+	// Code spans are set aside so their content is not formatted
+	var spans []string
+	text = mdCodeSpanPattern.ReplaceAllStringFunc(text, func(match string) string {
+		spans = append(spans, "{{"+mdCodeSpanPattern.FindStringSubmatch(match)[1]+"}}")
+		return fmt.Sprintf("\x00%d\x00", len(spans)-1)
+	})
+
+	text = mdImagePattern.ReplaceAllString(text, "!$1!")
+	text = mdLinkPattern.ReplaceAllString(text, "[$1|$2]")
+
+	// Bold is marked with a placeholder so the italic pattern skips it
+	text = mdBoldPattern.ReplaceAllString(text, "\x01$1$2\x01")
+	text = mdItalicPattern.ReplaceAllString(text, "_${1}_")
+	text = strings.ReplaceAll(text, "\x01", "*")
+
+	text = mdStrikePattern.ReplaceAllString(text, "-$1-")
+	text = mdUnderlinePattern.ReplaceAllString(text, "+$1+")
+
+	for i, span := range spans {
+		text = strings.Replace(text, fmt.Sprintf("\x00%d\x00", i), span, 1)
+	}
+	return text
+}
@@ -0,0 +1,29 @@
+package jira
+
+import "testing"
+
+func TestMarkdownToWiki(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{"plain text", "Looks good to me", "Looks good to me"},
+		{"heading", "## Root cause", "h2. Root cause"},
+		{"inline formatting", "**bold**, *italic*, ~~gone~~, ++under++ and `a*b*c`", "*bold*, _italic_, -gone-, +under+ and {{a*b*c}}"},
+		{"link and image", "See [the docs](https://example.com) ![chart](https://example.com/c.png)", "See [the docs|https://example.com] !https://example.com/c.png!"},
+		{"nested lists", "- one\n  1. first\n  2. second\n- two", "* one\n*# first\n*# second\n* two"},
+		{"code block", "```go\nx := *p\n```", "{code:go}\nx := *p\n{code}"},
+		{"blockquote and rule", "> quoted\n\n---", "bq. quoted\n\n----"},
+		{"table", "| Name | Status |\n|------|--------|\n| API | **Done** |", "|| Name || Status ||\n| API | *Done* |"},
+		{"wiki markup unchanged", "h2. Summary\n*bold* and {{code}}", "h2. Summary\n*bold* and {{code}}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MarkdownToWiki(tt.markdown); got != tt.want {
+				t.Errorf("MarkdownToWiki(%q) = %q, want %q", tt.markdown, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package jira
+
+import (
+	"math"
+	"sort"
+)
+
+// worklogDateFormat is the layout of worklog report dates
+const worklogDateFormat = "2006-01-02"
+
+// WorklogReport aggregates the time logged on a set of issues
+type WorklogReport struct {
+	From       string             `json:"from"` // First day included (YYYY-MM-DD)
+	To         string             `json:"to"`   // Last day included (YYYY-MM-DD)
+	TotalHours float64            `json:"total_hours"`
+	TotalTime  string             `json:"total_time"` // Jira duration, e.g. "1d 2h 30m"
+	Worklogs   int                `json:"worklogs"`   // Number of worklogs counted
+	Users      []WorklogUserTotal `json:"users"`      // Most hours first
+	Issues     []WorklogIssueTime `json:"issues"`     // Most hours first
+}
+
+// WorklogUserTotal is the time a user logged, broken down by issue
+type WorklogUserTotal struct {
+	User   string             `json:"user"`         // Display name
+	ID     string             `json:"id,omitempty"` // Account ID (Cloud) or username (Server/DC)
+	Hours  float64            `json:"hours"`
+	Time   string             `json:"time"` // Jira duration, e.g. "1d 2h 30m"
+	Issues []WorklogIssueTime `json:"issues"`
+}
+
+// WorklogIssueTime is the time logged on an issue
+type WorklogIssueTime struct {
+	Key     string  `json:"key"`
+	Summary string  `json:"summary,omitempty"`
+	Hours   float64 `json:"hours"`
+	Time    string  `json:"time"` // Jira duration, e.g. "2h 30m"
+}
+
+// BuildWorklogReport aggregates the worklogs of issues, keyed by issue key,
+// that were started between from and to (inclusive, YYYY-MM-DD). Days are
+// compared in the time zone each worklog was logged in, so a worklog counts
+// on the day its author saw. Times are formatted with the working days and
+// weeks of tt.
+func BuildWorklogReport(issues []Issue, worklogs map[string][]Worklog, from, to string, tt TimeTracking) *WorklogReport {
+	report := &WorklogReport{
+		From:   from,
+		To:     to,
+		Users:  []WorklogUserTotal{},
+		Issues: []WorklogIssueTime{},
+	}
+
+	type userSeconds struct {
+		total   WorklogUserTotal
+		seconds int
+		byIssue map[string]int
+	}
+	users := make(map[string]*userSeconds)
+	var userOrder []string
+	totalSeconds := 0
+
+	for _, issue := range issues {
+		issueSeconds := 0
+		for _, worklog := range worklogs[issue.Key] {
+			day := worklog.Started.Format(worklogDateFormat)
+			if worklog.Started.IsZero() || day < from || day > to {
+				continue
+			}
+
+			id, name := worklogAuthor(worklog.Author)
+			user, ok := users[id]
+			if !ok {
+				user = &userSeconds{
+					total:   WorklogUserTotal{User: name, ID: id},
+					byIssue: make(map[string]int),
+				}
+				users[id] = user
+				userOrder = append(userOrder, id)
+			}
+			user.seconds += worklog.TimeSpentSeconds
+			user.byIssue[issue.Key] += worklog.TimeSpentSeconds
+
+			issueSeconds += worklog.TimeSpentSeconds
+			report.Worklogs++
+		}
+		if issueSeconds == 0 {
+			continue
+		}
+		totalSeconds += issueSeconds
+		report.Issues = append(report.Issues, WorklogIssueTime{
+			Key:     issue.Key,
+			Summary: issue.Fields.Summary,
+			Hours:   secondsToHours(issueSeconds),
+			Time:    tt.FormatDuration(issueSeconds),
+		})
+	}
+
+	summaries := make(map[string]string, len(report.Issues))
+	for _, issue := range report.Issues {
+		summaries[issue.Key] = issue.Summary
+	}
+
+	for _, id := range userOrder {
+		user := users[id]
+		user.total.Hours = secondsToHours(user.seconds)
+		user.total.Time = tt.FormatDuration(user.seconds)
+		user.total.Issues = make([]WorklogIssueTime, 0, len(user.byIssue))
+		for key, seconds := range user.byIssue {
+			user.total.Issues = append(user.total.Issues, WorklogIssueTime{
+				Key:     key,
+				Summary: summaries[key],
+				Hours:   secondsToHours(seconds),
+				Time:    tt.FormatDuration(seconds),
+			})
+		}
+		sortIssueTimes(user.total.Issues)
+		report.Users = append(report.Users, user.total)
+	}
+
+	sort.SliceStable(report.Users, func(i, j int) bool {
+		if report.Users[i].Hours != report.Users[j].Hours {
+			return report.Users[i].Hours > report.Users[j].Hours
+		}
+		return report.Users[i].User < report.Users[j].User
+	})
+	sortIssueTimes(report.Issues)
+	report.TotalHours = secondsToHours(totalSeconds)
+	report.TotalTime = tt.FormatDuration(totalSeconds)
+
+	return report
+}
+
+// worklogAuthor returns the ID and display name of a worklog's author
+func worklogAuthor(author *User) (string, string) {
+	if author == nil {
+		return "", "Unknown"
+	}
+
+	id := author.AccountID
+	if id == "" {
+		id = author.Name
+	}
+	name := author.DisplayName
+	if name == "" {
+		name = id
+	}
+	return id, name
+}
+
+// sortIssueTimes sorts issues by hours, most first, then by key
+func sortIssueTimes(issues []WorklogIssueTime) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Hours != issues[j].Hours {
+			return issues[i].Hours > issues[j].Hours
+		}
+		return issues[i].Key < issues[j].Key
+	})
+}
+
+// secondsToHours converts seconds to hours, rounded to two decimals
+func secondsToHours(seconds int) float64 {
+	return math.Round(float64(seconds)/36) / 100
+}
@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildWorklogReport(t *testing.T) {
+	at := func(value string) AtlassianTime {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatalf("invalid time %q: %v", value, err)
+		}
+		return AtlassianTime{parsed}
+	}
+	alice := &User{AccountID: "a1", DisplayName: "Alice"}
+	bob := &User{Name: "bob", DisplayName: "Bob"}
+
+	issues := []Issue{
+		{Key: "PROJ-1", Fields: IssueFields{Summary: "Login page"}},
+		{Key: "PROJ-2", Fields: IssueFields{Summary: "Logout"}},
+		{Key: "PROJ-3", Fields: IssueFields{Summary: "Nothing in range"}},
+	}
+	worklogs := map[string][]Worklog{
+		"PROJ-1": {
+			{Author: alice, Started: at("2025-03-03T09:00:00Z"), TimeSpentSeconds: 7200},
+			{Author: bob, Started: at("2025-03-04T09:00:00Z"), TimeSpentSeconds: 1800},
+			// Logged late on the last day in the author's time zone
+			{Author: alice, Started: at("2025-03-07T23:30:00-05:00"), TimeSpentSeconds: 3600},
+		},
+		"PROJ-2": {
+			{Author: bob, Started: at("2025-03-05T14:00:00+01:00"), TimeSpentSeconds: 5400},
+			{Author: nil, Started: at("2025-03-05T15:00:00Z"), TimeSpentSeconds: 600},
+		},
+		"PROJ-3": {
+			{Author: alice, Started: at("2025-03-02T23:59:00Z"), TimeSpentSeconds: 3600},
+			{Author: alice, Started: at("2025-03-08T00:00:00Z"), TimeSpentSeconds: 3600},
+		},
+	}
+
+	report := BuildWorklogReport(issues, worklogs, "2025-03-03", "2025-03-07", DefaultTimeTracking)
+
+	if report.TotalHours != 5.17 {
+		t.Errorf("TotalHours = %v, want 5.17", report.TotalHours)
+	}
+	if report.TotalTime != "5h 10m" {
+		t.Errorf("TotalTime = %q, want 5h 10m", report.TotalTime)
+	}
+	if report.Worklogs != 5 {
+		t.Errorf("Worklogs = %d, want 5", report.Worklogs)
+	}
+
+	if len(report.Issues) != 2 {
+		t.Fatalf("Issues = %+v, want PROJ-1 and PROJ-2", report.Issues)
+	}
+	if report.Issues[0].Key != "PROJ-1" || report.Issues[0].Hours != 3.5 || report.Issues[0].Summary != "Login page" {
+		t.Errorf("Issues[0] = %+v, want PROJ-1 with 3.5 hours", report.Issues[0])
+	}
+	if report.Issues[1].Key != "PROJ-2" || report.Issues[1].Hours != 1.67 || report.Issues[1].Time != "1h 40m" {
+		t.Errorf("Issues[1] = %+v, want PROJ-2 with 1.67 hours", report.Issues[1])
+	}
+
+	if len(report.Users) != 3 {
+		t.Fatalf("Users = %+v, want three users", report.Users)
+	}
+	if report.Users[0].User != "Alice" || report.Users[0].ID != "a1" || report.Users[0].Hours != 3 {
+		t.Errorf("Users[0] = %+v, want Alice with 3 hours", report.Users[0])
+	}
+	if report.Users[1].User != "Bob" || report.Users[1].ID != "bob" || report.Users[1].Hours != 2 {
+		t.Errorf("Users[1] = %+v, want Bob with 2 hours", report.Users[1])
+	}
+	if issues := report.Users[1].Issues; len(issues) != 2 || issues[0].Key != "PROJ-2" || issues[0].Hours != 1.5 || issues[1].Key != "PROJ-1" {
+		t.Errorf("Bob's issues = %+v, want PROJ-2 then PROJ-1", issues)
+	}
+	if report.Users[2].User != "Unknown" || report.Users[2].Hours != 0.17 {
+		t.Errorf("Users[2] = %+v, want Unknown with 0.17 hours", report.Users[2])
+	}
+}
+
+func TestBuildWorklogReport_Empty(t *testing.T) {
+	report := BuildWorklogReport(nil, nil, "2025-03-03", "2025-03-07", DefaultTimeTracking)
+	if report.TotalHours != 0 || report.Users == nil || report.Issues == nil {
+		t.Errorf("report = %+v, want zero hours and empty lists", report)
+	}
+}
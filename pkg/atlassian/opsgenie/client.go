@@ -1,27 +1,37 @@
 package opsgenie
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/codeownersnet/atlas/internal/auth"
 	"github.com/codeownersnet/atlas/internal/client"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
 )
 
 const (
 	// API path
 	apiVersion = "/v2"
+
+	// DefaultWaitTimeout is how long WaitForRequest polls when no timeout is given
+	DefaultWaitTimeout = 30 * time.Second
+
+	// apiVersionV1 is the API path for resources only available in v1 (e.g., services)
+	apiVersionV1 = "/v1"
 )
 
 // Client is an Opsgenie API client
 type Client struct {
-	httpClient *client.Client
+	httpClient atlassian.ClientDoer
 	baseURL    string
 }
 
@@ -35,6 +45,26 @@ type Config struct {
 	HTTPSProxy    string
 	SOCKSProxy    string
 	NoProxy       string
+
+	// TLS files (PEM): extra CA bundle, and client certificate and key for mTLS
+	CACertFile     string
+	ClientCertFile string
+	ClientKeyFile  string
+
+	MaxResponseSize int64 // Maximum response body size in bytes (0 = unlimited)
+
+	// Connection pooling (zero values use the HTTP client defaults)
+	MaxIdleConns        int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+	TLSHandshakeTimeout time.Duration
+
+	Capture  *client.Capture  // Records requests and responses for debugging (optional)
+	Cassette *client.Cassette // Records or replays API interactions (optional)
+
+	// HTTPClient sends the API requests instead of an HTTP client built from
+	// this configuration (optional, e.g. a fake in tests)
+	HTTPClient atlassian.ClientDoer
 }
 
 // NewClient creates a new Opsgenie client
@@ -43,23 +73,41 @@ func NewClient(cfg *Config) (*Client, error) {
 		return nil, fmt.Errorf("base URL is required")
 	}
 
-	if cfg.Auth == nil {
+	if cfg.Auth == nil && cfg.HTTPClient == nil {
 		return nil, fmt.Errorf("auth provider is required")
 	}
 
-	// Create HTTP client
-	httpClient, err := client.NewClient(&client.Config{
-		BaseURL:       cfg.BaseURL,
-		Auth:          cfg.Auth,
-		CustomHeaders: cfg.CustomHeaders,
-		SSLVerify:     cfg.SSLVerify,
-		HTTPProxy:     cfg.HTTPProxy,
-		HTTPSProxy:    cfg.HTTPSProxy,
-		SOCKSProxy:    cfg.SOCKSProxy,
-		NoProxy:       cfg.NoProxy,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+	// Create HTTP client, unless one is given
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		defaultClient, err := client.NewClient(&client.Config{
+			BaseURL:       cfg.BaseURL,
+			Auth:          cfg.Auth,
+			CustomHeaders: cfg.CustomHeaders,
+			SSLVerify:     cfg.SSLVerify,
+			HTTPProxy:     cfg.HTTPProxy,
+			HTTPSProxy:    cfg.HTTPSProxy,
+			SOCKSProxy:    cfg.SOCKSProxy,
+			NoProxy:       cfg.NoProxy,
+
+			CACertFile:     cfg.CACertFile,
+			ClientCertFile: cfg.ClientCertFile,
+			ClientKeyFile:  cfg.ClientKeyFile,
+
+			MaxResponseSize: cfg.MaxResponseSize,
+
+			MaxIdleConns:        cfg.MaxIdleConns,
+			MaxConnsPerHost:     cfg.MaxConnsPerHost,
+			IdleConnTimeout:     cfg.IdleConnTimeout,
+			TLSHandshakeTimeout: cfg.TLSHandshakeTimeout,
+
+			Capture:  cfg.Capture,
+			Cassette: cfg.Cassette,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create HTTP client: %w", err)
+		}
+		httpClient = defaultClient
 	}
 
 	return &Client{
@@ -68,6 +116,19 @@ func NewClient(cfg *Config) (*Client, error) {
 	}, nil
 }
 
+// AlertURL returns the web UI URL of an alert. The UI host is derived from
+// the API host (api.opsgenie.com → app.opsgenie.com, api.eu.opsgenie.com →
+// app.eu.opsgenie.com); other hosts are used as-is.
+func (c *Client) AlertURL(alertID string) string {
+	base := c.baseURL
+	if u, err := url.Parse(c.baseURL); err == nil && strings.HasPrefix(u.Host, "api.") {
+		u.Host = "app." + strings.TrimPrefix(u.Host, "api.")
+		u.Path = ""
+		base = u.String()
+	}
+	return fmt.Sprintf("%s/alert/detail/%s/details", base, url.PathEscape(alertID))
+}
+
 // buildURL constructs a full URL from a path
 func (c *Client) buildURL(endpoint string) string {
 	// If endpoint already has API version, use as-is
@@ -84,9 +145,16 @@ func buildURLWithParams(base string, params map[string]string) string {
 		return base
 	}
 
+	// Parameters are sorted by name, so the same parameters give the same URL
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	values := make([]string, 0, len(params))
-	for k, v := range params {
-		if v != "" {
+	for _, k := range keys {
+		if v := params[k]; v != "" {
 			values = append(values, fmt.Sprintf("%s=%s", k, url.QueryEscape(v)))
 		}
 	}
@@ -100,22 +168,17 @@ func buildURLWithParams(base string, params map[string]string) string {
 
 // doRequest performs an HTTP request and decodes the response
 func (c *Client) doRequest(ctx context.Context, method, path string, body []byte, result interface{}) error {
-	var resp *http.Response
-	var err error
-
 	switch method {
-	case http.MethodGet:
-		resp, err = c.httpClient.Get(ctx, path)
-	case http.MethodPost:
-		resp, err = c.httpClient.Post(ctx, path, body)
-	case http.MethodPut:
-		resp, err = c.httpClient.Put(ctx, path, body)
-	case http.MethodDelete:
-		resp, err = c.httpClient.Delete(ctx, path)
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete:
 	default:
 		return fmt.Errorf("unsupported HTTP method: %s", method)
 	}
 
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	resp, err := c.httpClient.Do(ctx, method, path, reader)
 	if err != nil {
 		return err
 	}
@@ -142,19 +205,26 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body []byte
 	return nil
 }
 
+// Raw sends a request to any API path of the site, for endpoints without a
+// dedicated method. The path is relative to the base URL; error statuses are
+// returned as errors.
+func (c *Client) Raw(ctx context.Context, method, path string, body []byte) (*atlassian.RawResponse, error) {
+	return atlassian.DoRaw(ctx, c.httpClient, method, path, body, c.parseError)
+}
+
 // parseError parses an error response from Opsgenie
 func (c *Client) parseError(statusCode int, body []byte) error {
 	var errResp ErrorResponse
 	if err := json.Unmarshal(body, &errResp); err != nil {
 		// If we can't parse the error, return the raw body
-		return fmt.Errorf("HTTP %d: %s", statusCode, string(body))
+		return atlassian.NewError(statusCode, string(body), nil)
 	}
 
 	if errResp.Message != "" {
-		return fmt.Errorf("HTTP %d: %s", statusCode, errResp.Message)
+		return atlassian.NewError(statusCode, errResp.Message, nil)
 	}
 
-	return fmt.Errorf("HTTP %d: %s", statusCode, string(body))
+	return atlassian.NewError(statusCode, string(body), nil)
 }
 
 // getAPIPath returns the API path
@@ -162,6 +232,23 @@ func (c *Client) getAPIPath() string {
 	return apiVersion
 }
 
+// GetAccount retrieves information about the account the API key belongs to
+func (c *Client) GetAccount(ctx context.Context) (*Account, error) {
+	path := fmt.Sprintf("%s/account", apiVersion)
+
+	var response struct {
+		Data      *Account `json:"data"`
+		Took      float64  `json:"took,omitempty"`
+		RequestID string   `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	return response.Data, nil
+}
+
 // GetAlert retrieves an alert by ID or alias
 func (c *Client) GetAlert(ctx context.Context, id string) (*Alert, error) {
 	path := fmt.Sprintf("%s/alerts/%s", apiVersion, id)
@@ -179,12 +266,46 @@ func (c *Client) GetAlert(ctx context.Context, id string) (*Alert, error) {
 	return response.Data, nil
 }
 
+// GetAlertByAlias retrieves the alert with an alias. Opsgenie deduplicates
+// new alerts against the open alert with the same alias.
+func (c *Client) GetAlertByAlias(ctx context.Context, alias string) (*Alert, error) {
+	path := buildURLWithParams(fmt.Sprintf("%s/alerts/%s", apiVersion, url.PathEscape(alias)), map[string]string{
+		"identifierType": "alias",
+	})
+
+	var response struct {
+		Data      *Alert  `json:"data"`
+		Took      float64 `json:"took,omitempty"`
+		RequestID string  `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get alert with alias %s: %w", alias, err)
+	}
+
+	return response.Data, nil
+}
+
 // ListAlerts retrieves a list of alerts based on query parameters
 func (c *Client) ListAlerts(ctx context.Context, query string, limit, offset int) (*ListAlertsResponse, error) {
+	return c.listAlerts(ctx, query, limit, offset, nil)
+}
+
+// ListAlertsSorted retrieves alerts matching the query, sorted by an alert
+// field (e.g. "createdAt" or "updatedAt") in "asc" or "desc" order
+func (c *Client) ListAlertsSorted(ctx context.Context, query, sort, order string, limit int) (*ListAlertsResponse, error) {
+	return c.listAlerts(ctx, query, limit, 0, map[string]string{"sort": sort, "order": order})
+}
+
+// listAlerts retrieves a page of alerts with extra query parameters
+func (c *Client) listAlerts(ctx context.Context, query string, limit, offset int, extra map[string]string) (*ListAlertsResponse, error) {
 	path := fmt.Sprintf("%s/alerts", apiVersion)
 
 	// Build query parameters
 	params := make(map[string]string)
+	for key, value := range extra {
+		params[key] = value
+	}
 	if query != "" {
 		params["query"] = query
 	}
@@ -205,6 +326,33 @@ func (c *Client) ListAlerts(ctx context.Context, query string, limit, offset int
 	return &response, nil
 }
 
+// maxAlertPageSize is the largest page size accepted by the list alerts API
+const maxAlertPageSize = 100
+
+// ListAllAlerts retrieves alerts matching the query, following pagination
+// until every alert, or limit alerts, has been retrieved. The response's
+// paging links point past the last alert retrieved.
+func (c *Client) ListAllAlerts(ctx context.Context, query string, limit int) (*ListAlertsResponse, error) {
+	combined := &ListAlertsResponse{}
+	for len(combined.Data) < limit {
+		page, err := c.ListAlerts(ctx, query, min(maxAlertPageSize, limit-len(combined.Data)), len(combined.Data))
+		if err != nil {
+			return nil, err
+		}
+
+		combined.Data = append(combined.Data, page.Data...)
+		combined.Paging = page.Paging
+		combined.Took += page.Took
+		combined.RequestID = page.RequestID
+
+		if len(page.Data) == 0 || page.Paging == nil || page.Paging.Next == "" {
+			break
+		}
+	}
+
+	return combined, nil
+}
+
 // CountAlerts returns the count of alerts matching the query
 func (c *Client) CountAlerts(ctx context.Context, query string) (int, error) {
 	path := fmt.Sprintf("%s/alerts/count", apiVersion)
@@ -250,7 +398,7 @@ func (c *Client) CreateAlert(ctx context.Context, req *AlertRequest) (*CreateAle
 }
 
 // CloseAlert closes an alert by ID or alias
-func (c *Client) CloseAlert(ctx context.Context, id, note string) error {
+func (c *Client) CloseAlert(ctx context.Context, id, note string) (string, error) {
 	path := fmt.Sprintf("%s/alerts/%s/close", apiVersion, id)
 
 	request := make(map[string]interface{})
@@ -260,7 +408,7 @@ func (c *Client) CloseAlert(ctx context.Context, id, note string) error {
 
 	reqBody, err := json.Marshal(request)
 	if err != nil {
-		return fmt.Errorf("failed to marshal close alert request: %w", err)
+		return "", fmt.Errorf("failed to marshal close alert request: %w", err)
 	}
 
 	var response struct {
@@ -270,14 +418,14 @@ func (c *Client) CloseAlert(ctx context.Context, id, note string) error {
 	}
 
 	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
-		return fmt.Errorf("failed to close alert %s: %w", id, err)
+		return "", fmt.Errorf("failed to close alert %s: %w", id, err)
 	}
 
-	return nil
+	return response.RequestID, nil
 }
 
 // AcknowledgeAlert acknowledges an alert by ID or alias
-func (c *Client) AcknowledgeAlert(ctx context.Context, id, note string) error {
+func (c *Client) AcknowledgeAlert(ctx context.Context, id, note string) (string, error) {
 	path := fmt.Sprintf("%s/alerts/%s/acknowledge", apiVersion, id)
 
 	request := make(map[string]interface{})
@@ -287,7 +435,7 @@ func (c *Client) AcknowledgeAlert(ctx context.Context, id, note string) error {
 
 	reqBody, err := json.Marshal(request)
 	if err != nil {
-		return fmt.Errorf("failed to marshal acknowledge alert request: %w", err)
+		return "", fmt.Errorf("failed to marshal acknowledge alert request: %w", err)
 	}
 
 	var response struct {
@@ -297,14 +445,41 @@ func (c *Client) AcknowledgeAlert(ctx context.Context, id, note string) error {
 	}
 
 	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
-		return fmt.Errorf("failed to acknowledge alert %s: %w", id, err)
+		return "", fmt.Errorf("failed to acknowledge alert %s: %w", id, err)
 	}
 
-	return nil
+	return response.RequestID, nil
+}
+
+// UnacknowledgeAlert reverts the acknowledgement of an alert by ID or alias
+func (c *Client) UnacknowledgeAlert(ctx context.Context, id, note string) (string, error) {
+	path := fmt.Sprintf("%s/alerts/%s/unacknowledge", apiVersion, id)
+
+	request := make(map[string]interface{})
+	if note != "" {
+		request["note"] = note
+	}
+
+	reqBody, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal unacknowledge alert request: %w", err)
+	}
+
+	var response struct {
+		Result    string  `json:"result"`
+		Took      float64 `json:"took"`
+		RequestID string  `json:"requestId"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
+		return "", fmt.Errorf("failed to unacknowledge alert %s: %w", id, err)
+	}
+
+	return response.RequestID, nil
 }
 
 // SnoozeAlert snoozes an alert by ID or alias until the specified end time
-func (c *Client) SnoozeAlert(ctx context.Context, id, endTime, note string) error {
+func (c *Client) SnoozeAlert(ctx context.Context, id, endTime, note string) (string, error) {
 	path := fmt.Sprintf("%s/alerts/%s/snooze", apiVersion, id)
 
 	request := map[string]interface{}{
@@ -316,7 +491,7 @@ func (c *Client) SnoozeAlert(ctx context.Context, id, endTime, note string) erro
 
 	reqBody, err := json.Marshal(request)
 	if err != nil {
-		return fmt.Errorf("failed to marshal snooze alert request: %w", err)
+		return "", fmt.Errorf("failed to marshal snooze alert request: %w", err)
 	}
 
 	var response struct {
@@ -326,10 +501,10 @@ func (c *Client) SnoozeAlert(ctx context.Context, id, endTime, note string) erro
 	}
 
 	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
-		return fmt.Errorf("failed to snooze alert %s: %w", id, err)
+		return "", fmt.Errorf("failed to snooze alert %s: %w", id, err)
 	}
 
-	return nil
+	return response.RequestID, nil
 }
 
 // GetSchedule retrieves a schedule by ID
@@ -399,9 +574,20 @@ func (c *Client) GetScheduleTimeline(ctx context.Context, id string, from, to ti
 // If schedule is provided, returns on-calls for that schedule only.
 // If schedule is empty, returns on-calls for all schedules.
 func (c *Client) GetOnCalls(ctx context.Context, schedule string) ([]OnCall, error) {
+	return c.GetOnCallsWithOptions(ctx, schedule, nil)
+}
+
+// GetOnCallsWithOptions retrieves the on-call participants at a point in time,
+// optionally flattened to individual users. Like GetOnCalls, an empty schedule
+// returns on-calls for all schedules.
+func (c *Client) GetOnCallsWithOptions(ctx context.Context, schedule string, opts *OnCallOptions) ([]OnCall, error) {
+	if opts == nil {
+		opts = &OnCallOptions{}
+	}
+
 	if schedule != "" {
 		// Get on-calls for specific schedule
-		return c.getScheduleOnCalls(ctx, schedule)
+		return c.getScheduleOnCalls(ctx, schedule, opts)
 	}
 
 	// Get on-calls for all schedules
@@ -412,7 +598,7 @@ func (c *Client) GetOnCalls(ctx context.Context, schedule string) ([]OnCall, err
 
 	var allOnCalls []OnCall
 	for _, sched := range schedules {
-		onCalls, err := c.getScheduleOnCalls(ctx, sched.ID)
+		onCalls, err := c.getScheduleOnCalls(ctx, sched.ID, opts)
 		if err != nil {
 			// Log error but continue with other schedules
 			continue
@@ -424,9 +610,20 @@ func (c *Client) GetOnCalls(ctx context.Context, schedule string) ([]OnCall, err
 }
 
 // getScheduleOnCalls retrieves on-calls for a specific schedule
-func (c *Client) getScheduleOnCalls(ctx context.Context, scheduleID string) ([]OnCall, error) {
+func (c *Client) getScheduleOnCalls(ctx context.Context, scheduleID string, opts *OnCallOptions) ([]OnCall, error) {
 	path := fmt.Sprintf("%s/schedules/%s/on-calls", apiVersion, scheduleID)
 
+	// Build query parameters
+	params := make(map[string]string)
+	if !opts.Date.IsZero() {
+		params["date"] = opts.Date.Format(time.RFC3339)
+	}
+	if opts.Flat {
+		params["flat"] = "true"
+	}
+
+	path = buildURLWithParams(path, params)
+
 	var response struct {
 		Data      *ScheduleOnCallResponse `json:"data"`
 		Took      float64                 `json:"took,omitempty"`
@@ -441,16 +638,23 @@ func (c *Client) getScheduleOnCalls(ctx context.Context, scheduleID string) ([]O
 		return []OnCall{}, nil
 	}
 
+	recipients := response.Data.OnCallParticipants
+	if opts.Flat {
+		recipients = response.Data.OnCallRecipients
+	}
+
 	// Convert to OnCall format with schedule info
-	result := []OnCall{
-		{
-			ScheduleID:       scheduleID,
-			ScheduleName:     response.Data.Parent.Name,
-			OnCallRecipients: response.Data.OnCallParticipants,
-		},
+	onCall := OnCall{
+		ScheduleID:       scheduleID,
+		ScheduleName:     response.Data.Parent.Name,
+		OnCallRecipients: recipients,
+	}
+	if !opts.Date.IsZero() {
+		date := opts.Date
+		onCall.Date = &date
 	}
 
-	return result, nil
+	return []OnCall{onCall}, nil
 }
 
 // GetTeam retrieves a team by ID or name
@@ -487,6 +691,171 @@ func (c *Client) ListTeams(ctx context.Context) ([]Team, error) {
 	return response.Data, nil
 }
 
+// ListTeamMembers retrieves the members of a team, with their roles, by team ID or name
+func (c *Client) ListTeamMembers(ctx context.Context, teamID string) ([]TeamMember, error) {
+	team, err := c.GetTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return []TeamMember{}, nil
+	}
+
+	return team.Members, nil
+}
+
+// AddTeamMember adds a user to a team by team ID or name. The user is
+// identified by ID or username (email), and role defaults to "user" when empty.
+func (c *Client) AddTeamMember(ctx context.Context, teamID, user string, role TeamMemberRole) error {
+	path := fmt.Sprintf("%s/teams/%s/members", apiVersion, teamID)
+
+	userRef := map[string]string{"id": user}
+	if strings.Contains(user, "@") {
+		userRef = map[string]string{"username": user}
+	}
+
+	request := map[string]interface{}{
+		"user": userRef,
+	}
+	if role != "" {
+		request["role"] = role
+	}
+
+	reqBody, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal add team member request: %w", err)
+	}
+
+	var response struct {
+		Result    string  `json:"result"`
+		Took      float64 `json:"took"`
+		RequestID string  `json:"requestId"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
+		return fmt.Errorf("failed to add member %s to team %s: %w", user, teamID, err)
+	}
+
+	return nil
+}
+
+// RemoveTeamMember removes a user, by ID or username (email), from a team by team ID or name
+func (c *Client) RemoveTeamMember(ctx context.Context, teamID, user string) error {
+	path := fmt.Sprintf("%s/teams/%s/members/%s", apiVersion, teamID, url.PathEscape(user))
+
+	var response struct {
+		Result    string  `json:"result"`
+		Took      float64 `json:"took"`
+		RequestID string  `json:"requestId"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodDelete, path, nil, &response); err != nil {
+		return fmt.Errorf("failed to remove member %s from team %s: %w", user, teamID, err)
+	}
+
+	return nil
+}
+
+// ListTeamRoutingRules retrieves the routing rules of a team by team ID, in evaluation order
+func (c *Client) ListTeamRoutingRules(ctx context.Context, teamID string) ([]RoutingRule, error) {
+	path := fmt.Sprintf("%s/teams/%s/routing-rules", apiVersion, teamID)
+
+	var response struct {
+		Data      []RoutingRule `json:"data"`
+		Took      float64       `json:"took,omitempty"`
+		RequestID string        `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list routing rules for team %s: %w", teamID, err)
+	}
+
+	return response.Data, nil
+}
+
+// ListIntegrations retrieves the integrations, optionally only those of a
+// type (e.g., "API", "Prometheus") or owned by a team
+func (c *Client) ListIntegrations(ctx context.Context, integrationType, teamID string) ([]IntegrationSummary, error) {
+	path := buildURLWithParams(fmt.Sprintf("%s/integrations", apiVersion), map[string]string{
+		"type":   integrationType,
+		"teamId": teamID,
+	})
+
+	var response struct {
+		Data      []IntegrationSummary `json:"data"`
+		Took      float64              `json:"took,omitempty"`
+		RequestID string               `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list integrations: %w", err)
+	}
+
+	return response.Data, nil
+}
+
+// GetIntegration retrieves an integration by ID. Its fields depend on the
+// integration type, so it is returned as a map.
+func (c *Client) GetIntegration(ctx context.Context, id string) (map[string]interface{}, error) {
+	path := fmt.Sprintf("%s/integrations/%s", apiVersion, id)
+
+	var response struct {
+		Data      map[string]interface{} `json:"data"`
+		Took      float64                `json:"took,omitempty"`
+		RequestID string                 `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get integration %s: %w", id, err)
+	}
+
+	return response.Data, nil
+}
+
+// ListPolicies retrieves the alert or notification policies in evaluation
+// order. Alert policies are global without a team ID; notification policies
+// always belong to a team.
+func (c *Client) ListPolicies(ctx context.Context, policyType PolicyType, teamID string) ([]PolicySummary, error) {
+	if policyType == PolicyTypeNotification && teamID == "" {
+		return nil, fmt.Errorf("notification policies require a team ID")
+	}
+
+	path := buildURLWithParams(fmt.Sprintf("%s/policies/%s", apiVersion, policyType), map[string]string{
+		"teamId": teamID,
+	})
+
+	var response struct {
+		Data      []PolicySummary `json:"data"`
+		Took      float64         `json:"took,omitempty"`
+		RequestID string          `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list %s policies: %w", policyType, err)
+	}
+
+	return response.Data, nil
+}
+
+// GetPolicy retrieves a policy by ID. Team policies need the team ID.
+func (c *Client) GetPolicy(ctx context.Context, id, teamID string) (*Policy, error) {
+	path := buildURLWithParams(fmt.Sprintf("%s/policies/%s", apiVersion, id), map[string]string{
+		"teamId": teamID,
+	})
+
+	var response struct {
+		Data      *Policy `json:"data"`
+		Took      float64 `json:"took,omitempty"`
+		RequestID string  `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get policy %s: %w", id, err)
+	}
+
+	return response.Data, nil
+}
+
 // GetUser retrieves a user by identifier (ID, username, or email)
 func (c *Client) GetUser(ctx context.Context, identifier string) (*User, error) {
 	path := fmt.Sprintf("%s/users/%s", apiVersion, identifier)
@@ -504,6 +873,46 @@ func (c *Client) GetUser(ctx context.Context, identifier string) (*User, error)
 	return response.Data, nil
 }
 
+// ListServices retrieves a page of services
+func (c *Client) ListServices(ctx context.Context, limit, offset int) (*ListServicesResponse, error) {
+	path := fmt.Sprintf("%s/services", apiVersionV1)
+
+	// Build query parameters
+	params := make(map[string]string)
+	if limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", limit)
+	}
+	if offset > 0 {
+		params["offset"] = fmt.Sprintf("%d", offset)
+	}
+
+	path = buildURLWithParams(path, params)
+
+	var response ListServicesResponse
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetService retrieves a service by ID
+func (c *Client) GetService(ctx context.Context, id string) (*Service, error) {
+	path := fmt.Sprintf("%s/services/%s", apiVersionV1, id)
+
+	var response struct {
+		Data      *Service `json:"data"`
+		Took      float64  `json:"took,omitempty"`
+		RequestID string   `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get service %s: %w", id, err)
+	}
+
+	return response.Data, nil
+}
+
 // GetIncident retrieves an incident by ID
 func (c *Client) GetIncident(ctx context.Context, id string) (*Incident, error) {
 	path := fmt.Sprintf("%s/incidents/%s", apiVersion, id)
@@ -521,8 +930,26 @@ func (c *Client) GetIncident(ctx context.Context, id string) (*Incident, error)
 	return response.Data, nil
 }
 
+// GetIncidentAlertIDs retrieves the IDs of the alerts associated with an
+// incident. This endpoint is only available in the v1 API.
+func (c *Client) GetIncidentAlertIDs(ctx context.Context, id string) ([]string, error) {
+	path := fmt.Sprintf("%s/incidents/%s/associated-alert-ids", apiVersionV1, id)
+
+	var response struct {
+		Data      []string `json:"data"`
+		Took      float64  `json:"took,omitempty"`
+		RequestID string   `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get alerts of incident %s: %w", id, err)
+	}
+
+	return response.Data, nil
+}
+
 // ListIncidents retrieves a list of incidents based on query parameters
-func (c *Client) ListIncidents(ctx context.Context, query string, limit, offset int) (*IncidentResponse, error) {
+func (c *Client) ListIncidents(ctx context.Context, query string, limit, offset int) (*ListIncidentsResponse, error) {
 	path := fmt.Sprintf("%s/incidents", apiVersion)
 
 	// Build query parameters
@@ -539,7 +966,7 @@ func (c *Client) ListIncidents(ctx context.Context, query string, limit, offset
 
 	path = buildURLWithParams(path, params)
 
-	var response IncidentResponse
+	var response ListIncidentsResponse
 	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to list incidents: %w", err)
 	}
@@ -547,6 +974,35 @@ func (c *Client) ListIncidents(ctx context.Context, query string, limit, offset
 	return &response, nil
 }
 
+// maxIncidentPageSize is the largest page size accepted by the list
+// incidents API
+const maxIncidentPageSize = 100
+
+// ListAllIncidents retrieves incidents matching the query, following
+// pagination until every incident, or limit incidents, has been retrieved.
+// The response's paging links point past the last incident retrieved.
+func (c *Client) ListAllIncidents(ctx context.Context, query string, limit int) (*ListIncidentsResponse, error) {
+	combined := &ListIncidentsResponse{}
+	for len(combined.Data) < limit {
+		page, err := c.ListIncidents(ctx, query, min(maxIncidentPageSize, limit-len(combined.Data)), len(combined.Data))
+		if err != nil {
+			return nil, err
+		}
+
+		combined.Data = append(combined.Data, page.Data...)
+		combined.TotalCount = page.TotalCount
+		combined.Paging = page.Paging
+		combined.Took += page.Took
+		combined.RequestID = page.RequestID
+
+		if len(page.Data) == 0 || page.Paging == nil || page.Paging.Next == "" {
+			break
+		}
+	}
+
+	return combined, nil
+}
+
 // CreateIncident creates a new incident
 func (c *Client) CreateIncident(ctx context.Context, req *IncidentRequest) (*Incident, error) {
 	path := fmt.Sprintf("%s/incidents", apiVersion)
@@ -650,7 +1106,7 @@ func (c *Client) AddResponderToIncident(ctx context.Context, id string, responde
 }
 
 // EscalateAlert escalates an alert to a specified responder (escalation policy)
-func (c *Client) EscalateAlert(ctx context.Context, id string, escalation *Responder, note string) error {
+func (c *Client) EscalateAlert(ctx context.Context, id string, escalation *Responder, note string) (string, error) {
 	path := fmt.Sprintf("%s/alerts/%s/escalate", apiVersion, id)
 
 	request := map[string]interface{}{
@@ -662,7 +1118,7 @@ func (c *Client) EscalateAlert(ctx context.Context, id string, escalation *Respo
 
 	reqBody, err := json.Marshal(request)
 	if err != nil {
-		return fmt.Errorf("failed to marshal escalate alert request: %w", err)
+		return "", fmt.Errorf("failed to marshal escalate alert request: %w", err)
 	}
 
 	var response struct {
@@ -672,14 +1128,14 @@ func (c *Client) EscalateAlert(ctx context.Context, id string, escalation *Respo
 	}
 
 	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
-		return fmt.Errorf("failed to escalate alert %s: %w", id, err)
+		return "", fmt.Errorf("failed to escalate alert %s: %w", id, err)
 	}
 
-	return nil
+	return response.RequestID, nil
 }
 
 // AssignAlert assigns an alert to a specified owner
-func (c *Client) AssignAlert(ctx context.Context, id string, owner *Responder, note string) error {
+func (c *Client) AssignAlert(ctx context.Context, id string, owner *Responder, note string) (string, error) {
 	path := fmt.Sprintf("%s/alerts/%s/assign", apiVersion, id)
 
 	request := map[string]interface{}{
@@ -691,7 +1147,7 @@ func (c *Client) AssignAlert(ctx context.Context, id string, owner *Responder, n
 
 	reqBody, err := json.Marshal(request)
 	if err != nil {
-		return fmt.Errorf("failed to marshal assign alert request: %w", err)
+		return "", fmt.Errorf("failed to marshal assign alert request: %w", err)
 	}
 
 	var response struct {
@@ -701,14 +1157,42 @@ func (c *Client) AssignAlert(ctx context.Context, id string, owner *Responder, n
 	}
 
 	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
-		return fmt.Errorf("failed to assign alert %s: %w", id, err)
+		return "", fmt.Errorf("failed to assign alert %s: %w", id, err)
 	}
 
-	return nil
+	return response.RequestID, nil
+}
+
+// ExecuteCustomAction runs a custom action defined for the alert's
+// integration (e.g., a runbook step). Action names are case-sensitive.
+func (c *Client) ExecuteCustomAction(ctx context.Context, id, action, note string) (string, error) {
+	path := fmt.Sprintf("%s/alerts/%s/actions/%s", apiVersion, id, url.PathEscape(action))
+
+	request := map[string]interface{}{}
+	if note != "" {
+		request["note"] = note
+	}
+
+	reqBody, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal custom action request: %w", err)
+	}
+
+	var response struct {
+		Result    string  `json:"result"`
+		Took      float64 `json:"took"`
+		RequestID string  `json:"requestId"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
+		return "", fmt.Errorf("failed to execute custom action %q on alert %s: %w", action, id, err)
+	}
+
+	return response.RequestID, nil
 }
 
 // AddNoteToAlert adds a note to an alert by ID or alias
-func (c *Client) AddNoteToAlert(ctx context.Context, id, note string) error {
+func (c *Client) AddNoteToAlert(ctx context.Context, id, note string) (string, error) {
 	path := fmt.Sprintf("%s/alerts/%s/notes", apiVersion, id)
 
 	request := map[string]interface{}{
@@ -717,7 +1201,7 @@ func (c *Client) AddNoteToAlert(ctx context.Context, id, note string) error {
 
 	reqBody, err := json.Marshal(request)
 	if err != nil {
-		return fmt.Errorf("failed to marshal add note request: %w", err)
+		return "", fmt.Errorf("failed to marshal add note request: %w", err)
 	}
 
 	var response struct {
@@ -727,14 +1211,14 @@ func (c *Client) AddNoteToAlert(ctx context.Context, id, note string) error {
 	}
 
 	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
-		return fmt.Errorf("failed to add note to alert %s: %w", id, err)
+		return "", fmt.Errorf("failed to add note to alert %s: %w", id, err)
 	}
 
-	return nil
+	return response.RequestID, nil
 }
 
 // AddTagsToAlert adds tags to an alert by ID or alias
-func (c *Client) AddTagsToAlert(ctx context.Context, id string, tags []string, note string) error {
+func (c *Client) AddTagsToAlert(ctx context.Context, id string, tags []string, note string) (string, error) {
 	path := fmt.Sprintf("%s/alerts/%s/tags", apiVersion, id)
 
 	request := map[string]interface{}{
@@ -746,7 +1230,100 @@ func (c *Client) AddTagsToAlert(ctx context.Context, id string, tags []string, n
 
 	reqBody, err := json.Marshal(request)
 	if err != nil {
-		return fmt.Errorf("failed to marshal add tags request: %w", err)
+		return "", fmt.Errorf("failed to marshal add tags request: %w", err)
+	}
+
+	var response struct {
+		Result    string  `json:"result"`
+		Took      float64 `json:"took"`
+		RequestID string  `json:"requestId"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
+		return "", fmt.Errorf("failed to add tags to alert %s: %w", id, err)
+	}
+
+	return response.RequestID, nil
+}
+
+// RemoveTagsFromAlert removes tags from an alert by ID or alias
+func (c *Client) RemoveTagsFromAlert(ctx context.Context, id string, tags []string, note string) (string, error) {
+	path := fmt.Sprintf("%s/alerts/%s/tags", apiVersion, id)
+
+	// The delete endpoint takes its arguments as query parameters
+	path = buildURLWithParams(path, map[string]string{
+		"tags": strings.Join(tags, ","),
+		"note": note,
+	})
+
+	var response struct {
+		Result    string  `json:"result"`
+		Took      float64 `json:"took"`
+		RequestID string  `json:"requestId"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodDelete, path, nil, &response); err != nil {
+		return "", fmt.Errorf("failed to remove tags from alert %s: %w", id, err)
+	}
+
+	return response.RequestID, nil
+}
+
+// UpdateAlertPriority changes the priority of an alert by ID or alias
+func (c *Client) UpdateAlertPriority(ctx context.Context, id string, priority Priority) (string, error) {
+	return c.updateAlertField(ctx, id, "priority", string(priority))
+}
+
+// UpdateAlertMessage changes the message of an alert by ID or alias
+func (c *Client) UpdateAlertMessage(ctx context.Context, id, message string) (string, error) {
+	return c.updateAlertField(ctx, id, "message", message)
+}
+
+// UpdateAlertDescription changes the description of an alert by ID or alias
+func (c *Client) UpdateAlertDescription(ctx context.Context, id, description string) (string, error) {
+	return c.updateAlertField(ctx, id, "description", description)
+}
+
+// updateAlertField sets a single alert field using its PUT /alerts/{id}/{field} endpoint
+func (c *Client) updateAlertField(ctx context.Context, id, field, value string) (string, error) {
+	path := fmt.Sprintf("%s/alerts/%s/%s", apiVersion, id, field)
+
+	request := map[string]interface{}{
+		field: value,
+	}
+
+	reqBody, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal update alert %s request: %w", field, err)
+	}
+
+	var response struct {
+		Result    string  `json:"result"`
+		Took      float64 `json:"took"`
+		RequestID string  `json:"requestId"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodPut, path, reqBody, &response); err != nil {
+		return "", fmt.Errorf("failed to update %s of alert %s: %w", field, id, err)
+	}
+
+	return response.RequestID, nil
+}
+
+// AddDetailsToAlert adds custom key/value properties to an alert by ID or alias
+func (c *Client) AddDetailsToAlert(ctx context.Context, id string, details map[string]string, note string) (string, error) {
+	path := fmt.Sprintf("%s/alerts/%s/details", apiVersion, id)
+
+	request := map[string]interface{}{
+		"details": details,
+	}
+	if note != "" {
+		request["note"] = note
+	}
+
+	reqBody, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal add details request: %w", err)
 	}
 
 	var response struct {
@@ -756,12 +1333,166 @@ func (c *Client) AddTagsToAlert(ctx context.Context, id string, tags []string, n
 	}
 
 	if err := c.doRequest(ctx, http.MethodPost, path, reqBody, &response); err != nil {
-		return fmt.Errorf("failed to add tags to alert %s: %w", id, err)
+		return "", fmt.Errorf("failed to add details to alert %s: %w", id, err)
+	}
+
+	return response.RequestID, nil
+}
+
+// ListAlertNotes retrieves a page of notes for an alert by ID
+func (c *Client) ListAlertNotes(ctx context.Context, id string, opts *AlertActivityOptions) (*ListAlertNotesResponse, error) {
+	path := buildURLWithParams(fmt.Sprintf("%s/alerts/%s/notes", apiVersion, id), alertActivityParams(opts))
+
+	var response ListAlertNotesResponse
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list notes of alert %s: %w", id, err)
+	}
+
+	response.NextOffset = nextOffset(response.Paging)
+
+	return &response, nil
+}
+
+// ListAlertLogs retrieves a page of activity log entries for an alert by ID
+func (c *Client) ListAlertLogs(ctx context.Context, id string, opts *AlertActivityOptions) (*ListAlertLogsResponse, error) {
+	path := buildURLWithParams(fmt.Sprintf("%s/alerts/%s/logs", apiVersion, id), alertActivityParams(opts))
+
+	var response ListAlertLogsResponse
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list logs of alert %s: %w", id, err)
+	}
+
+	response.NextOffset = nextOffset(response.Paging)
+
+	return &response, nil
+}
+
+// ListAlertRecipients retrieves the users notified about an alert and their notification states
+func (c *Client) ListAlertRecipients(ctx context.Context, id string) ([]AlertRecipient, error) {
+	path := fmt.Sprintf("%s/alerts/%s/recipients", apiVersion, id)
+
+	var response struct {
+		Data      []AlertRecipient `json:"data"`
+		Took      float64          `json:"took,omitempty"`
+		RequestID string           `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list recipients of alert %s: %w", id, err)
+	}
+
+	return response.Data, nil
+}
+
+// alertActivityParams builds the query parameters for alert notes and logs
+func alertActivityParams(opts *AlertActivityOptions) map[string]string {
+	params := make(map[string]string)
+	if opts == nil {
+		return params
+	}
+
+	params["offset"] = opts.Offset
+	params["direction"] = opts.Direction
+	params["order"] = opts.Order
+	if opts.Limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", opts.Limit)
+	}
+
+	return params
+}
+
+// nextOffset extracts the offset parameter from a paging.next URL
+func nextOffset(paging *Pagination) string {
+	if paging == nil || paging.Next == "" {
+		return ""
+	}
+	next, err := url.Parse(paging.Next)
+	if err != nil {
+		return ""
+	}
+	return next.Query().Get("offset")
+}
+
+// ListHeartbeats retrieves all heartbeats
+func (c *Client) ListHeartbeats(ctx context.Context) ([]Heartbeat, error) {
+	path := fmt.Sprintf("%s/heartbeats", apiVersion)
+
+	var response struct {
+		Data struct {
+			Heartbeats []Heartbeat `json:"heartbeats"`
+		} `json:"data"`
+		Took      float64 `json:"took,omitempty"`
+		RequestID string  `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list heartbeats: %w", err)
+	}
+
+	return response.Data.Heartbeats, nil
+}
+
+// GetHeartbeat retrieves a heartbeat by name
+func (c *Client) GetHeartbeat(ctx context.Context, name string) (*Heartbeat, error) {
+	path := fmt.Sprintf("%s/heartbeats/%s", apiVersion, url.PathEscape(name))
+
+	var response struct {
+		Data      *Heartbeat `json:"data"`
+		Took      float64    `json:"took,omitempty"`
+		RequestID string     `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get heartbeat %s: %w", name, err)
+	}
+
+	return response.Data, nil
+}
+
+// PingHeartbeat pings a heartbeat by name, resetting its interval
+func (c *Client) PingHeartbeat(ctx context.Context, name string) error {
+	path := fmt.Sprintf("%s/heartbeats/%s/ping", apiVersion, url.PathEscape(name))
+
+	var response struct {
+		Result    string  `json:"result"`
+		Took      float64 `json:"took"`
+		RequestID string  `json:"requestId"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodPost, path, nil, &response); err != nil {
+		return fmt.Errorf("failed to ping heartbeat %s: %w", name, err)
 	}
 
 	return nil
 }
 
+// EnableHeartbeat enables a heartbeat by name
+func (c *Client) EnableHeartbeat(ctx context.Context, name string) (*Heartbeat, error) {
+	return c.setHeartbeatEnabled(ctx, name, "enable")
+}
+
+// DisableHeartbeat disables a heartbeat by name, so no alert is created when it expires
+func (c *Client) DisableHeartbeat(ctx context.Context, name string) (*Heartbeat, error) {
+	return c.setHeartbeatEnabled(ctx, name, "disable")
+}
+
+// setHeartbeatEnabled calls the enable or disable action of a heartbeat
+func (c *Client) setHeartbeatEnabled(ctx context.Context, name, action string) (*Heartbeat, error) {
+	path := fmt.Sprintf("%s/heartbeats/%s/%s", apiVersion, url.PathEscape(name), action)
+
+	var response struct {
+		Data      *Heartbeat `json:"data"`
+		Took      float64    `json:"took,omitempty"`
+		RequestID string     `json:"requestId,omitempty"`
+	}
+
+	if err := c.doRequest(ctx, http.MethodPost, path, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to %s heartbeat %s: %w", action, name, err)
+	}
+
+	return response.Data, nil
+}
+
 // GetRequestStatus retrieves the status of an asynchronous request
 func (c *Client) GetRequestStatus(ctx context.Context, requestID string) (*AsyncResponse, error) {
 	path := fmt.Sprintf("%s/alerts/requests/%s", apiVersion, requestID)
@@ -778,3 +1509,55 @@ func (c *Client) GetRequestStatus(ctx context.Context, requestID string) (*Async
 
 	return response.Data, nil
 }
+
+// Request polling intervals, doubled after each attempt up to the maximum
+var (
+	requestPollInitialInterval = 250 * time.Millisecond
+	requestPollMaxInterval     = 2 * time.Second
+)
+
+// WaitForRequest polls the status of an asynchronous request with backoff until
+// it has been processed or the timeout elapses. Alert mutations are processed
+// asynchronously; they return the request ID to pass here. An error is
+// returned if the request was processed unsuccessfully.
+func (c *Client) WaitForRequest(ctx context.Context, requestID string, timeout time.Duration) (*AsyncResponse, error) {
+	if timeout <= 0 {
+		timeout = DefaultWaitTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	path := fmt.Sprintf("%s/alerts/requests/%s", apiVersion, requestID)
+	interval := requestPollInitialInterval
+
+	for {
+		var response struct {
+			Data      *AsyncResponse `json:"data"`
+			Took      float64        `json:"took,omitempty"`
+			RequestID string         `json:"requestId,omitempty"`
+		}
+
+		// The status endpoint returns 404 until the request has been processed
+		err := c.doRequest(ctx, http.MethodGet, path, nil, &response)
+		if err != nil && !errors.Is(err, atlassian.ErrNotFound) {
+			return nil, fmt.Errorf("failed to get request status %s: %w", requestID, err)
+		}
+		if err == nil && response.Data != nil {
+			if !response.Data.IsSuccess {
+				return response.Data, fmt.Errorf("request %s failed: %s", requestID, response.Data.Status)
+			}
+			return response.Data, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for request %s to be processed: %w", requestID, ctx.Err())
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > requestPollMaxInterval {
+			interval = requestPollMaxInterval
+		}
+	}
+}
@@ -3,11 +3,17 @@ package opsgenie
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/codeownersnet/atlas/internal/auth"
+	"github.com/codeownersnet/atlas/pkg/atlassian"
 )
 
 func TestGetOnCalls_WithSchedule(t *testing.T) {
@@ -265,3 +271,636 @@ func TestGetOnCalls_EmptyResponse(t *testing.T) {
 		t.Errorf("expected 0 recipients, got %d", len(onCalls[0].OnCallRecipients))
 	}
 }
+
+// newTestClient creates a client backed by a test server running handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	authProvider, err := auth.NewAPIKeyAuth("test-api-key")
+	if err != nil {
+		t.Fatalf("failed to create auth provider: %v", err)
+	}
+
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      authProvider,
+		SSLVerify: false,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	return client
+}
+
+func TestUpdateAlertFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		update   func(c *Client) (string, error)
+		wantPath string
+		wantBody map[string]interface{}
+	}{
+		{
+			name:     "priority",
+			update:   func(c *Client) (string, error) { return c.UpdateAlertPriority(context.Background(), "a1", PriorityP1) },
+			wantPath: "/v2/alerts/a1/priority",
+			wantBody: map[string]interface{}{"priority": "P1"},
+		},
+		{
+			name:     "message",
+			update:   func(c *Client) (string, error) { return c.UpdateAlertMessage(context.Background(), "a1", "Disk full") },
+			wantPath: "/v2/alerts/a1/message",
+			wantBody: map[string]interface{}{"message": "Disk full"},
+		},
+		{
+			name: "description",
+			update: func(c *Client) (string, error) {
+				return c.UpdateAlertDescription(context.Background(), "a1", "See runbook")
+			},
+			wantPath: "/v2/alerts/a1/description",
+			wantBody: map[string]interface{}{"description": "See runbook"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut || r.URL.Path != tt.wantPath {
+					t.Errorf("expected PUT %s, got %s %s", tt.wantPath, r.Method, r.URL.Path)
+				}
+
+				var body map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("failed to decode request: %v", err)
+				}
+				for k, v := range tt.wantBody {
+					if body[k] != v {
+						t.Errorf("expected %s=%v, got %v", k, v, body[k])
+					}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte(`{"result": "Request will be processed", "requestId": "r1"}`))
+			})
+
+			if _, err := tt.update(client); err != nil {
+				t.Fatalf("update failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestListAlertLogs(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/alerts/a1/logs" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("offset") != "1492" || query.Get("order") != "asc" || query.Get("limit") != "2" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"data": [
+				{"log": "Alert created", "type": "system", "owner": "System", "createdAt": "2024-05-01T10:00:00Z", "offset": "1500"},
+				{"log": "Acknowledged", "type": "alertAction", "owner": "jane@example.com", "createdAt": "2024-05-01T10:05:00Z", "offset": "1510"}
+			],
+			"paging": {"next": "https://api.opsgenie.com/v2/alerts/a1/logs?identifierType=id&offset=1510&order=asc&direction=next&limit=2"}
+		}`))
+	})
+
+	result, err := client.ListAlertLogs(context.Background(), "a1", &AlertActivityOptions{
+		Offset: "1492",
+		Order:  "asc",
+		Limit:  2,
+	})
+	if err != nil {
+		t.Fatalf("ListAlertLogs failed: %v", err)
+	}
+
+	if len(result.Data) != 2 || result.Data[1].Owner != "jane@example.com" {
+		t.Errorf("unexpected logs: %+v", result.Data)
+	}
+	if result.NextOffset != "1510" {
+		t.Errorf("expected next offset 1510, got %q", result.NextOffset)
+	}
+}
+
+func TestRemoveTagsFromAlert(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/v2/alerts/a1/tags" {
+			t.Errorf("expected DELETE /v2/alerts/a1/tags, got %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("tags"); got != "stale,flaky" {
+			t.Errorf("expected tags=stale,flaky, got %q", got)
+		}
+		if got := r.URL.Query().Get("note"); got != "cleanup" {
+			t.Errorf("expected note=cleanup, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"result": "Request will be processed", "requestId": "r1"}`))
+	})
+
+	if _, err := client.RemoveTagsFromAlert(context.Background(), "a1", []string{"stale", "flaky"}, "cleanup"); err != nil {
+		t.Fatalf("RemoveTagsFromAlert failed: %v", err)
+	}
+}
+
+func TestGetAlertByAlias(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/v2/alerts/disk%2Ffull" || r.URL.Query().Get("identifierType") != "alias" {
+			t.Errorf("expected GET /v2/alerts/disk%%2Ffull?identifierType=alias, got %s", r.URL.String())
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"id": "a1", "alias": "disk/full", "status": "open", "count": 3}}`))
+	})
+
+	alert, err := client.GetAlertByAlias(context.Background(), "disk/full")
+	if err != nil {
+		t.Fatalf("GetAlertByAlias failed: %v", err)
+	}
+	if alert.ID != "a1" || alert.Count != 3 {
+		t.Errorf("expected alert a1 with count 3, got %+v", alert)
+	}
+}
+
+func TestListAlertsSorted(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("sort") != "updatedAt" || query.Get("order") != "asc" || query.Get("limit") != "25" || query.Get("query") != "updatedAt >= 1" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{"id": "a1"}, {"id": "a2"}]}`))
+	})
+
+	result, err := client.ListAlertsSorted(context.Background(), "updatedAt >= 1", "updatedAt", "asc", 25)
+	if err != nil {
+		t.Fatalf("ListAlertsSorted failed: %v", err)
+	}
+	if len(result.Data) != 2 || result.Data[0].ID != "a1" {
+		t.Errorf("expected alerts a1 and a2, got %+v", result.Data)
+	}
+}
+
+func TestExecuteCustomAction(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.EscapedPath() != "/v2/alerts/a1/actions/Restart%20Service" {
+			t.Errorf("expected POST /v2/alerts/a1/actions/Restart%%20Service, got %s %s", r.Method, r.URL.EscapedPath())
+		}
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["note"] != "runbook step 2" {
+			t.Errorf("expected note, got %v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"result": "Request will be processed", "requestId": "r1"}`))
+	})
+
+	requestID, err := client.ExecuteCustomAction(context.Background(), "a1", "Restart Service", "runbook step 2")
+	if err != nil {
+		t.Fatalf("ExecuteCustomAction failed: %v", err)
+	}
+	if requestID != "r1" {
+		t.Errorf("expected request ID r1, got %q", requestID)
+	}
+}
+
+func TestGetOnCallsWithOptions_DateAndFlat(t *testing.T) {
+	date := time.Date(2024, 5, 7, 2, 0, 0, 0, time.UTC)
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/schedules/test-schedule-123/on-calls" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("date") != "2024-05-07T02:00:00Z" {
+			t.Errorf("expected date 2024-05-07T02:00:00Z, got %q", query.Get("date"))
+		}
+		if query.Get("flat") != "true" {
+			t.Errorf("expected flat=true, got %q", query.Get("flat"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {
+			"_parent": {"id": "test-schedule-123", "name": "Test Schedule", "enabled": true},
+			"onCallRecipients": ["night-owl@example.com"]
+		}}`))
+	})
+
+	onCalls, err := client.GetOnCallsWithOptions(context.Background(), "test-schedule-123", &OnCallOptions{
+		Date: date,
+		Flat: true,
+	})
+	if err != nil {
+		t.Fatalf("GetOnCallsWithOptions failed: %v", err)
+	}
+
+	if len(onCalls) != 1 {
+		t.Fatalf("expected 1 on-call entry, got %d", len(onCalls))
+	}
+	if len(onCalls[0].OnCallRecipients) != 1 || onCalls[0].OnCallRecipients[0] != "night-owl@example.com" {
+		t.Errorf("unexpected recipients: %v", onCalls[0].OnCallRecipients)
+	}
+	if onCalls[0].Date == nil || !onCalls[0].Date.Equal(date) {
+		t.Errorf("expected date %v, got %v", date, onCalls[0].Date)
+	}
+}
+
+func TestAddTeamMember(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     string
+		role     TeamMemberRole
+		wantUser map[string]string
+		wantRole string
+	}{
+		{"by username", "jane@example.com", TeamMemberRoleAdmin, map[string]string{"username": "jane@example.com"}, "admin"},
+		{"by id with default role", "u-123", "", map[string]string{"id": "u-123"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v2/teams/t1/members" {
+					t.Errorf("expected POST /v2/teams/t1/members, got %s %s", r.Method, r.URL.Path)
+				}
+
+				var body struct {
+					User map[string]string `json:"user"`
+					Role string            `json:"role"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("failed to decode request: %v", err)
+				}
+				if len(body.User) != 1 {
+					t.Errorf("expected a single user reference, got %v", body.User)
+				}
+				for k, v := range tt.wantUser {
+					if body.User[k] != v {
+						t.Errorf("expected user %s=%s, got %v", k, v, body.User)
+					}
+				}
+				if body.Role != tt.wantRole {
+					t.Errorf("expected role %q, got %q", tt.wantRole, body.Role)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"result": "Added", "requestId": "r1"}`))
+			})
+
+			if err := client.AddTeamMember(context.Background(), "t1", tt.user, tt.role); err != nil {
+				t.Fatalf("AddTeamMember failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestHeartbeats(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/heartbeats":
+			w.Write([]byte(`{"data": {"heartbeats": [
+				{"name": "nightly backup", "interval": 1, "intervalUnit": "days", "enabled": true, "expired": true}
+			]}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/heartbeats/nightly backup/ping":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"result": "PONG - Heartbeat received"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/heartbeats/nightly backup/disable":
+			w.Write([]byte(`{"data": {"name": "nightly backup", "enabled": false}}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	})
+
+	heartbeats, err := client.ListHeartbeats(context.Background())
+	if err != nil {
+		t.Fatalf("ListHeartbeats failed: %v", err)
+	}
+	if len(heartbeats) != 1 || !heartbeats[0].Expired || heartbeats[0].IntervalUnit != "days" {
+		t.Errorf("unexpected heartbeats: %+v", heartbeats)
+	}
+
+	if err := client.PingHeartbeat(context.Background(), "nightly backup"); err != nil {
+		t.Fatalf("PingHeartbeat failed: %v", err)
+	}
+
+	heartbeat, err := client.DisableHeartbeat(context.Background(), "nightly backup")
+	if err != nil {
+		t.Fatalf("DisableHeartbeat failed: %v", err)
+	}
+	if heartbeat.Enabled {
+		t.Error("expected heartbeat to be disabled")
+	}
+}
+
+func TestListServices(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/services" {
+			t.Errorf("expected /v1/services, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("limit"); got != "10" {
+			t.Errorf("expected limit=10, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [
+			{"id": "svc-1", "name": "Checkout", "teamId": "t1", "tags": ["payments"]}
+		]}`))
+	})
+
+	result, err := client.ListServices(context.Background(), 10, 0)
+	if err != nil {
+		t.Fatalf("ListServices failed: %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0].Name != "Checkout" || result.Data[0].TeamID != "t1" {
+		t.Errorf("unexpected services: %+v", result.Data)
+	}
+}
+
+func TestWaitForRequest(t *testing.T) {
+	initialInterval := requestPollInitialInterval
+	requestPollInitialInterval = time.Millisecond
+	defer func() { requestPollInitialInterval = initialInterval }()
+
+	tests := []struct {
+		name       string
+		pending    int
+		success    bool
+		wantErr    bool
+		wantPolled int
+	}{
+		{"processed after pending polls", 2, true, false, 3},
+		{"processed unsuccessfully", 0, false, true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polled := 0
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v2/alerts/requests/r1" {
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+				polled++
+
+				w.Header().Set("Content-Type", "application/json")
+				if polled <= tt.pending {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write([]byte(`{"message": "Request not found. It might not be processed, yet."}`))
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": map[string]interface{}{
+						"isSuccess": tt.success,
+						"status":    "Closed alert",
+						"alertId":   "a1",
+					},
+				})
+			})
+
+			status, err := client.WaitForRequest(context.Background(), "r1", time.Second)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WaitForRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if polled != tt.wantPolled {
+				t.Errorf("expected %d polls, got %d", tt.wantPolled, polled)
+			}
+			if status == nil || status.AlertID != "a1" {
+				t.Errorf("expected alert ID a1, got %+v", status)
+			}
+		})
+	}
+}
+
+func TestAlertURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{"https://api.opsgenie.com", "https://app.opsgenie.com/alert/detail/abc-123/details"},
+		{"https://api.eu.opsgenie.com/", "https://app.eu.opsgenie.com/alert/detail/abc-123/details"},
+		{"http://localhost:8080", "http://localhost:8080/alert/detail/abc-123/details"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.baseURL, func(t *testing.T) {
+			client, err := NewClient(&Config{BaseURL: tt.baseURL, Auth: &auth.APIKeyAuth{}})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			if got := client.AlertURL("abc-123"); got != tt.want {
+				t.Errorf("AlertURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetIncidentAlertIDs(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/incidents/inc-1/associated-alert-ids" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": ["alert-1", "alert-2"], "took": 0.01, "requestId": "req-1"}`))
+	})
+
+	ids, err := client.GetIncidentAlertIDs(context.Background(), "inc-1")
+	if err != nil {
+		t.Fatalf("GetIncidentAlertIDs() error = %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "alert-1" || ids[1] != "alert-2" {
+		t.Errorf("GetIncidentAlertIDs() = %v, want [alert-1 alert-2]", ids)
+	}
+}
+
+func TestListAllAlerts(t *testing.T) {
+	var offsets []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+
+		// Three alerts in total, served in pages of the requested size
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		response := ListAlertsResponse{Paging: &Pagination{}}
+		for i := offset; i < 3 && i < offset+limit; i++ {
+			response.Data = append(response.Data, Alert{ID: fmt.Sprintf("alert-%d", i)})
+		}
+		if offset+limit < 3 {
+			response.Paging.Next = "https://api.opsgenie.com/v2/alerts?offset=next"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	})
+
+	result, err := client.ListAllAlerts(context.Background(), "status: open", 2)
+	if err != nil {
+		t.Fatalf("ListAllAlerts() error = %v", err)
+	}
+	if len(result.Data) != 2 || result.Paging == nil || result.Paging.Next == "" {
+		t.Errorf("ListAllAlerts(limit 2) = %d alerts, paging %+v; want 2 alerts with a next page", len(result.Data), result.Paging)
+	}
+
+	offsets = nil
+	result, err = client.ListAllAlerts(context.Background(), "status: open", 1000)
+	if err != nil {
+		t.Fatalf("ListAllAlerts() error = %v", err)
+	}
+	if len(result.Data) != 3 || result.Data[2].ID != "alert-2" {
+		t.Errorf("ListAllAlerts() returned %d alerts, want 3", len(result.Data))
+	}
+	if len(offsets) != 1 {
+		t.Errorf("ListAllAlerts() made %d requests (offsets %v), want 1", len(offsets), offsets)
+	}
+}
+
+func TestPaginationOffsets(t *testing.T) {
+	paging := &Pagination{
+		Next: "https://api.opsgenie.com/v2/alerts?query=status%3Aopen&offset=40&limit=20",
+		Prev: "https://api.opsgenie.com/v2/alerts?query=status%3Aopen&limit=20",
+	}
+	if offset, ok := paging.NextOffset(); !ok || offset != 40 {
+		t.Errorf("NextOffset() = %d, %v, want 40", offset, ok)
+	}
+	if offset, ok := paging.PrevOffset(); !ok || offset != 0 {
+		t.Errorf("PrevOffset() = %d, %v, want 0", offset, ok)
+	}
+
+	var none *Pagination
+	if _, ok := none.NextOffset(); ok {
+		t.Error("NextOffset() of nil paging should report no page")
+	}
+	if _, ok := (&Pagination{}).PrevOffset(); ok {
+		t.Error("PrevOffset() without a link should report no page")
+	}
+}
+
+func TestListAllIncidents(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/incidents" {
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+
+		// Three incidents in total, served in pages of the requested size
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		response := ListIncidentsResponse{TotalCount: 3, Paging: &Pagination{}}
+		for i := offset; i < 3 && i < offset+limit; i++ {
+			response.Data = append(response.Data, Incident{ID: fmt.Sprintf("incident-%d", i)})
+		}
+		if offset+limit < 3 {
+			response.Paging.Next = fmt.Sprintf("https://api.opsgenie.com/v2/incidents?offset=%d&limit=%d", offset+limit, limit)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	})
+
+	result, err := client.ListAllIncidents(context.Background(), "status:open", 2)
+	if err != nil {
+		t.Fatalf("ListAllIncidents() error = %v", err)
+	}
+	if offset, ok := result.Paging.NextOffset(); len(result.Data) != 2 || !ok || offset != 2 {
+		t.Errorf("ListAllIncidents(limit 2) = %d incidents, next offset %d; want 2 incidents with a next page at 2", len(result.Data), offset)
+	}
+
+	result, err = client.ListAllIncidents(context.Background(), "status:open", 1000)
+	if err != nil {
+		t.Fatalf("ListAllIncidents() error = %v", err)
+	}
+	if len(result.Data) != 3 || result.TotalCount != 3 {
+		t.Errorf("ListAllIncidents() returned %d of %d incidents, want 3", len(result.Data), result.TotalCount)
+	}
+}
+
+func TestListPolicies(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/policies/notification" || r.URL.Query().Get("teamId") != "team-1" {
+			t.Errorf("Unexpected request %s", r.URL.String())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"policy-1","name":"Quiet P3s","type":"notification","enabled":true,"order":1}]}`))
+	})
+
+	if _, err := client.ListPolicies(context.Background(), PolicyTypeNotification, ""); err == nil {
+		t.Error("ListPolicies(notification) without a team ID should fail")
+	}
+
+	policies, err := client.ListPolicies(context.Background(), PolicyTypeNotification, "team-1")
+	if err != nil {
+		t.Fatalf("ListPolicies() error = %v", err)
+	}
+	if len(policies) != 1 || policies[0].Name != "Quiet P3s" || !policies[0].Enabled {
+		t.Errorf("ListPolicies() = %+v, want the enabled Quiet P3s policy", policies)
+	}
+}
+
+func TestGetPolicy(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/policies/policy-1" || r.URL.Query().Get("teamId") != "team-1" {
+			t.Errorf("Unexpected request %s", r.URL.String())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"id":"policy-1","name":"Quiet P3s","type":"notification","enabled":true,"suppress":true,
+			"filter":{"type":"match-all-conditions","conditions":[{"field":"priority","operation":"equals","expectedValue":"P3"}]},
+			"timeRestrictions":{"type":"time-of-day","restrictions":[{"startHour":22,"startMin":0,"endHour":6,"endMin":0}]}}}`))
+	})
+
+	policy, err := client.GetPolicy(context.Background(), "policy-1", "team-1")
+	if err != nil {
+		t.Fatalf("GetPolicy() error = %v", err)
+	}
+	if !policy.Suppress || policy.Filter == nil || len(policy.Filter.Conditions) != 1 {
+		t.Errorf("GetPolicy() = %+v, want a suppressing policy with one condition", policy)
+	}
+	if policy.TimeRestrictions == nil || policy.TimeRestrictions.Type != "time-of-day" {
+		t.Errorf("GetPolicy() time restrictions = %+v, want time-of-day", policy.TimeRestrictions)
+	}
+}
+
+func TestRaw(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/v2/maintenance/m1":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"description":"Extended"}` {
+				t.Errorf("unexpected body %s", body)
+			}
+			w.Write([]byte(`{"result": "Updated"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Maintenance not found"}`))
+		}
+	})
+	ctx := context.Background()
+
+	resp, err := client.Raw(ctx, http.MethodPatch, "/v2/maintenance/m1", []byte(`{"description":"Extended"}`))
+	if err != nil {
+		t.Fatalf("Raw() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || string(resp.Body) != `{"result": "Updated"}` {
+		t.Errorf("Raw() = %d %s", resp.StatusCode, resp.Body)
+	}
+
+	if _, err := client.Raw(ctx, http.MethodGet, "/v2/maintenance/m2", nil); !errors.Is(err, atlassian.ErrNotFound) {
+		t.Errorf("Raw() error = %v, want not found", err)
+	}
+	if _, err := client.Raw(ctx, http.MethodGet, "https://example.com/v2/alerts", nil); err == nil {
+		t.Error("Raw() should refuse absolute URLs")
+	}
+}
@@ -0,0 +1,122 @@
+package opsgenie
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Alert states accepted by AlertQuery.Status
+const (
+	AlertQueryStatusOpen           = "open"
+	AlertQueryStatusClosed         = "closed"
+	AlertQueryStatusAcknowledged   = "acknowledged"
+	AlertQueryStatusUnacknowledged = "unacknowledged"
+)
+
+// AlertQuery represents structured alert search criteria that compile to the
+// Opsgenie alert query syntax. All criteria are combined with AND.
+type AlertQuery struct {
+	Query         string    // Raw query, parenthesized when combined with the other criteria
+	Status        string    // One of the AlertQueryStatus values
+	Priorities    []string  // Matches any of the priorities
+	Tags          []string  // Matches alerts having all of the tags
+	Teams         []string  // Matches alerts of any of the teams
+	CreatedAfter  time.Time // Matches alerts created at or after this time
+	CreatedBefore time.Time // Matches alerts created before this time
+}
+
+// String compiles the query to the Opsgenie alert query syntax
+func (q *AlertQuery) String() string {
+	var clauses []string
+
+	switch q.Status {
+	case AlertQueryStatusOpen, AlertQueryStatusClosed:
+		clauses = append(clauses, "status: "+q.Status)
+	case AlertQueryStatusAcknowledged:
+		clauses = append(clauses, "acknowledged: true")
+	case AlertQueryStatusUnacknowledged:
+		clauses = append(clauses, "status: open", "acknowledged: false")
+	}
+
+	if clause := anyOf("priority", q.Priorities); clause != "" {
+		clauses = append(clauses, clause)
+	}
+	for _, tag := range q.Tags {
+		clauses = append(clauses, "tag: "+quoteQueryValue(tag))
+	}
+	if clause := anyOf("teams", q.Teams); clause != "" {
+		clauses = append(clauses, clause)
+	}
+
+	if !q.CreatedAfter.IsZero() {
+		clauses = append(clauses, fmt.Sprintf("createdAt >= %d", q.CreatedAfter.UnixMilli()))
+	}
+	if !q.CreatedBefore.IsZero() {
+		clauses = append(clauses, fmt.Sprintf("createdAt < %d", q.CreatedBefore.UnixMilli()))
+	}
+
+	// The raw query may contain OR, which binds looser than the AND joining it
+	// to the other criteria
+	if raw := strings.TrimSpace(q.Query); raw != "" {
+		if len(clauses) == 0 {
+			return raw
+		}
+		clauses = append([]string{"(" + raw + ")"}, clauses...)
+	}
+
+	return strings.Join(clauses, " AND ")
+}
+
+// Validate checks the query for unsupported values
+func (q *AlertQuery) Validate() error {
+	switch q.Status {
+	case "", AlertQueryStatusOpen, AlertQueryStatusClosed, AlertQueryStatusAcknowledged, AlertQueryStatusUnacknowledged:
+	default:
+		return fmt.Errorf("invalid status %q: use open, closed, acknowledged, or unacknowledged", q.Status)
+	}
+
+	for _, p := range q.Priorities {
+		switch Priority(strings.ToUpper(p)) {
+		case PriorityP1, PriorityP2, PriorityP3, PriorityP4, PriorityP5:
+		default:
+			return fmt.Errorf("invalid priority %q: use P1 to P5", p)
+		}
+	}
+
+	if !q.CreatedAfter.IsZero() && !q.CreatedBefore.IsZero() && !q.CreatedAfter.Before(q.CreatedBefore) {
+		return fmt.Errorf("created after must be earlier than created before")
+	}
+
+	return nil
+}
+
+// anyOf builds a clause matching any of the values for a field
+func anyOf(field string, values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			if field == "priority" {
+				v = strings.ToUpper(v)
+			}
+			quoted = append(quoted, quoteQueryValue(v))
+		}
+	}
+
+	switch len(quoted) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("%s: %s", field, quoted[0])
+	default:
+		return fmt.Sprintf("%s: (%s)", field, strings.Join(quoted, " OR "))
+	}
+}
+
+// quoteQueryValue quotes a value that contains whitespace or query syntax characters
+func quoteQueryValue(value string) string {
+	if strings.ContainsAny(value, " \t:()\"") {
+		return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+	}
+	return value
+}
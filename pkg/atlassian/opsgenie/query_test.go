@@ -0,0 +1,84 @@
+package opsgenie
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlertQueryString(t *testing.T) {
+	after := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query AlertQuery
+		want  string
+	}{
+		{
+			name:  "empty",
+			query: AlertQuery{},
+			want:  "",
+		},
+		{
+			name:  "raw query only",
+			query: AlertQuery{Query: "message: disk"},
+			want:  "message: disk",
+		},
+		{
+			name:  "status and single priority",
+			query: AlertQuery{Status: "open", Priorities: []string{"p1"}},
+			want:  "status: open AND priority: P1",
+		},
+		{
+			name:  "unacknowledged with priorities",
+			query: AlertQuery{Status: "unacknowledged", Priorities: []string{"P1", "P2"}},
+			want:  "status: open AND acknowledged: false AND priority: (P1 OR P2)",
+		},
+		{
+			name:  "tags and quoted teams",
+			query: AlertQuery{Tags: []string{"db", "prod"}, Teams: []string{"Platform Team", "sre"}},
+			want:  `tag: db AND tag: prod AND teams: ("Platform Team" OR sre)`,
+		},
+		{
+			name:  "created range combined with raw query",
+			query: AlertQuery{Query: "source: grafana", CreatedAfter: after, CreatedBefore: before},
+			want:  "(source: grafana) AND createdAt >= 1714521600000 AND createdAt < 1714608000000",
+		},
+		{
+			name:  "raw OR query combined with status",
+			query: AlertQuery{Query: "tag: db OR tag: cache", Status: "open"},
+			want:  "(tag: db OR tag: cache) AND status: open",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlertQueryValidate(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		query   AlertQuery
+		wantErr bool
+	}{
+		{"valid", AlertQuery{Status: "acknowledged", Priorities: []string{"P3"}}, false},
+		{"invalid status", AlertQuery{Status: "resolved"}, true},
+		{"invalid priority", AlertQuery{Priorities: []string{"high"}}, true},
+		{"inverted range", AlertQuery{CreatedAfter: now, CreatedBefore: now.Add(-time.Hour)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.query.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package opsgenie
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Time is a timestamp from the Opsgenie API. It accepts RFC 3339 strings
+// (with or without a colon in the offset), epoch milliseconds as returned by
+// webhooks and some v1 endpoints, and empty values, which leave it zero.
+type Time struct {
+	time.Time
+}
+
+// Opsgenie timestamp formats
+var opsgenieTimeFormats = []string{
+	time.RFC3339Nano,                // "2006-01-02T15:04:05.999999999Z07:00"
+	"2006-01-02T15:04:05.999-0700",  // Offset without a colon
+	"2006-01-02T15:04:05.999999999", // No offset (UTC)
+}
+
+// NewTime wraps t as a Time
+func NewTime(t time.Time) Time {
+	return Time{Time: t}
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	// Epoch milliseconds
+	if len(data) > 0 && data[0] != '"' {
+		millis, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse time %s: %w", data, err)
+		}
+		t.Time = time.UnixMilli(millis).UTC()
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var lastErr error
+	for _, format := range opsgenieTimeFormats {
+		parsed, err := time.Parse(format, s)
+		if err == nil {
+			t.Time = parsed
+			return nil
+		}
+		lastErr = err
+	}
+
+	return fmt.Errorf("unable to parse time %q: %w", s, lastErr)
+}
+
+// MarshalJSON implements json.Marshaler interface
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.Time.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Time.Format(time.RFC3339Nano))
+}
+
+// String returns the string representation of the time
+func (t Time) String() string {
+	if t.Time.IsZero() {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339)
+}
+
+// Since returns the time elapsed between t and now, or zero if t is unset
+func (t Time) Since(now time.Time) time.Duration {
+	if t.Time.IsZero() {
+		return 0
+	}
+	return now.Sub(t.Time)
+}
+
+// FormatAge formats a duration compactly for humans, using at most two
+// units (e.g., "45s", "12m", "3h5m", "2d4h")
+func FormatAge(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d/time.Second))
+	}
+	if d < time.Hour {
+		return fmt.Sprintf("%dm", int(d/time.Minute))
+	}
+
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+	switch {
+	case days > 0 && hours > 0:
+		return fmt.Sprintf("%dd%dh", days, hours)
+	case days > 0:
+		return fmt.Sprintf("%dd", days)
+	case minutes > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dh", hours)
+	}
+}
@@ -0,0 +1,147 @@
+package opsgenie
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeUnmarshalJSON(t *testing.T) {
+	want := time.Date(2024, 5, 7, 2, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"RFC 3339", `"2024-05-07T02:00:00Z"`, want},
+		{"milliseconds", `"2024-05-07T02:00:00.000Z"`, want},
+		{"offset without colon", `"2024-05-07T04:00:00.000+0200"`, want},
+		{"no offset", `"2024-05-07T02:00:00"`, want},
+		{"epoch milliseconds", `1715047200000`, want},
+		{"empty", `""`, time.Time{}},
+		{"null", `null`, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Time
+			if err := json.Unmarshal([]byte(tt.input), &got); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Unmarshal(%s) = %v, want %v", tt.input, got.Time, tt.want)
+			}
+		})
+	}
+
+	var invalid Time
+	if err := json.Unmarshal([]byte(`"yesterday"`), &invalid); err == nil {
+		t.Error("Unmarshal(\"yesterday\") should fail")
+	}
+}
+
+func TestTimeMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Created Time  `json:"created"`
+		Updated *Time `json:"updated,omitempty"`
+		Zero    Time  `json:"zero"`
+	}{Created: NewTime(time.Date(2024, 5, 7, 2, 0, 0, 0, time.UTC))})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"created":"2024-05-07T02:00:00Z","zero":null}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+}
+
+func TestAlertAge(t *testing.T) {
+	now := time.Date(2024, 5, 7, 3, 30, 0, 0, time.UTC)
+
+	var alert Alert
+	if err := json.Unmarshal([]byte(`{"id":"a1","createdAt":"2024-05-07T02:00:00.000Z","report":{"ackTime":90000}}`), &alert); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := alert.Age(now); got != 90*time.Minute {
+		t.Errorf("Age() = %v, want 1h30m", got)
+	}
+	if got := alert.Report.TimeToAck(); got != 90*time.Second {
+		t.Errorf("TimeToAck() = %v, want 1m30s", got)
+	}
+
+	if got := (&Alert{}).Age(now); got != 0 {
+		t.Errorf("Age() without a creation time = %v, want 0", got)
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{45 * time.Second, "45s"},
+		{12*time.Minute + 30*time.Second, "12m"},
+		{3*time.Hour + 5*time.Minute, "3h5m"},
+		{3 * time.Hour, "3h"},
+		{52*time.Hour + 10*time.Minute, "2d4h"},
+		{48 * time.Hour, "2d"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatAge(tt.d); got != tt.want {
+			t.Errorf("FormatAge(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestSummarizeAlerts(t *testing.T) {
+	now := time.Date(2024, 5, 7, 3, 30, 0, 0, time.UTC)
+	alerts := []Alert{
+		{ID: "a1", Message: "Disk full", Priority: PriorityP2, Status: AlertStatusOpen, Acknowledged: true, CreatedAt: NewTime(now.Add(-90 * time.Minute)), Tags: []string{"disk"}},
+		{ID: "a2", Message: "CPU high", Status: AlertStatusClosed},
+	}
+
+	summaries := SummarizeAlerts(alerts, now)
+	if len(summaries) != 2 {
+		t.Fatalf("SummarizeAlerts() returned %d rows, want 2", len(summaries))
+	}
+	if got := summaries[0]; got.Age != "1h30m" || got.Status != "acknowledged" || len(got.Tags) != 1 {
+		t.Errorf("SummarizeAlerts()[0] = %+v, want an acknowledged alert aged 1h30m", got)
+	}
+	if got := summaries[1]; got.Age != "" || got.Status != AlertStatusClosed {
+		t.Errorf("SummarizeAlerts()[1] = %+v, want a closed alert without an age", got)
+	}
+}
+
+func TestSummaryInLocation(t *testing.T) {
+	now := time.Date(2024, 5, 7, 3, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+	alert := Alert{ID: "a1", CreatedAt: NewTime(time.Date(2024, 5, 4, 1, 0, 0, 0, time.UTC))}
+
+	got := alert.Summary(now)
+	if got.CreatedAt != "2024-05-04T03:00:00+02:00" || got.CreatedRelative != "3 days ago" {
+		t.Errorf("Summary() = %+v, want the creation time in UTC+2, 3 days ago", got)
+	}
+}
+
+func TestScheduleTimelineLocalize(t *testing.T) {
+	now := time.Date(2024, 5, 7, 12, 0, 0, 0, time.UTC)
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	timeline := &ScheduleTimeline{
+		StartDate: now,
+		EndDate:   now.AddDate(0, 0, 7),
+		FinalTimeline: &FinalTimeline{Rotations: []TimelineRotation{{
+			Name:    "Primary",
+			Periods: []TimelinePeriod{{StartDate: now.Add(-2 * time.Hour), EndDate: now.AddDate(0, 0, 2)}},
+		}}},
+		Overrides: []Override{{StartDate: now, EndDate: now.Add(time.Hour)}},
+	}
+
+	timeline.Localize(now.In(loc))
+	period := timeline.FinalTimeline.Rotations[0].Periods[0]
+	if period.Starts != "2 hours ago" || period.Ends != "in 2 days" {
+		t.Errorf("period = %+v, want relative start and end", period)
+	}
+	if period.StartDate.Location() != loc || timeline.EndDate.Location() != loc || timeline.Overrides[0].EndDate.Location() != loc {
+		t.Error("Localize() should show every time in the location of now")
+	}
+}
@@ -1,6 +1,12 @@
 package opsgenie
 
-import "time"
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/codeownersnet/atlas/pkg/atlassian"
+)
 
 // DeploymentType represents the Opsgenie deployment type
 type DeploymentType string
@@ -55,6 +61,43 @@ type Pagination struct {
 	Prev  string `json:"prev,omitempty"`
 }
 
+// NextOffset returns the offset of the next page, if there is one
+func (p *Pagination) NextOffset() (int, bool) {
+	if p == nil {
+		return 0, false
+	}
+	return linkOffset(p.Next)
+}
+
+// PrevOffset returns the offset of the previous page, if there is one
+func (p *Pagination) PrevOffset() (int, bool) {
+	if p == nil {
+		return 0, false
+	}
+	return linkOffset(p.Prev)
+}
+
+// linkOffset reads the offset parameter of a paging link. A link without
+// one points to the first page.
+func linkOffset(link string) (int, bool) {
+	if link == "" {
+		return 0, false
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return 0, false
+	}
+	value := u.Query().Get("offset")
+	if value == "" {
+		return 0, true
+	}
+	offset, err := strconv.Atoi(value)
+	if err != nil || offset < 0 {
+		return 0, false
+	}
+	return offset, true
+}
+
 // Responder represents a responder (user, team, escalation, or schedule)
 type Responder struct {
 	Type ResponderType `json:"type"`
@@ -73,11 +116,11 @@ type Alert struct {
 	IsSeen         bool              `json:"isSeen,omitempty"`
 	Tags           []string          `json:"tags,omitempty"`
 	Snoozed        bool              `json:"snoozed,omitempty"`
-	SnoozedUntil   *time.Time        `json:"snoozedUntil,omitempty"`
+	SnoozedUntil   *Time             `json:"snoozedUntil,omitempty"`
 	Count          int               `json:"count,omitempty"`
-	LastOccurredAt *time.Time        `json:"lastOccurredAt,omitempty"`
-	CreatedAt      time.Time         `json:"createdAt"`
-	UpdatedAt      *time.Time        `json:"updatedAt,omitempty"`
+	LastOccurredAt *Time             `json:"lastOccurredAt,omitempty"`
+	CreatedAt      Time              `json:"createdAt"`
+	UpdatedAt      *Time             `json:"updatedAt,omitempty"`
 	Source         string            `json:"source,omitempty"`
 	Owner          string            `json:"owner,omitempty"`
 	Priority       Priority          `json:"priority,omitempty"`
@@ -90,6 +133,58 @@ type Alert struct {
 	Details        map[string]string `json:"details,omitempty"`
 }
 
+// Age returns how long ago the alert was created
+func (a *Alert) Age(now time.Time) time.Duration {
+	return a.CreatedAt.Since(now)
+}
+
+// AlertSummary is a compact view of an alert for triaging many alerts at once
+type AlertSummary struct {
+	ID       string      `json:"id"`
+	TinyID   string      `json:"tinyId,omitempty"`
+	Message  string      `json:"message"`
+	Priority Priority    `json:"priority,omitempty"`
+	Status   AlertStatus `json:"status,omitempty"`
+	Age      string      `json:"age,omitempty"` // Since creation (e.g., "3h5m")
+	Owner    string      `json:"owner,omitempty"`
+	Tags     []string    `json:"tags,omitempty"`
+
+	CreatedAt       string `json:"createdAt,omitempty"`       // ISO 8601, in the location of now
+	CreatedRelative string `json:"createdRelative,omitempty"` // e.g., "3 hours ago"
+}
+
+// Summary returns the compact view of the alert, with its age at now and its
+// creation time in the location of now
+func (a *Alert) Summary(now time.Time) AlertSummary {
+	summary := AlertSummary{
+		ID:       a.ID,
+		TinyID:   a.TinyID,
+		Message:  a.Message,
+		Priority: a.Priority,
+		Status:   a.Status,
+		Owner:    a.Owner,
+		Tags:     a.Tags,
+	}
+	if !a.CreatedAt.IsZero() {
+		summary.Age = FormatAge(a.Age(now))
+		summary.CreatedAt = a.CreatedAt.In(now.Location()).Format(time.RFC3339)
+		summary.CreatedRelative = atlassian.RelativeTime(a.CreatedAt.Time, now)
+	}
+	if a.Acknowledged && a.Status == AlertStatusOpen {
+		summary.Status = "acknowledged"
+	}
+	return summary
+}
+
+// SummarizeAlerts returns the compact views of alerts, with their ages at now
+func SummarizeAlerts(alerts []Alert, now time.Time) []AlertSummary {
+	summaries := make([]AlertSummary, len(alerts))
+	for i := range alerts {
+		summaries[i] = alerts[i].Summary(now)
+	}
+	return summaries
+}
+
 // Integration represents integration information
 type Integration struct {
 	ID   string `json:"id,omitempty"`
@@ -97,14 +192,33 @@ type Integration struct {
 	Type string `json:"type,omitempty"`
 }
 
+// IntegrationSummary represents an integration in a list of integrations
+type IntegrationSummary struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+	TeamID  string `json:"teamId,omitempty"`
+}
+
 // Report represents alert report information
 type Report struct {
-	AckTime        int64  `json:"ackTime,omitempty"`
-	CloseTime      int64  `json:"closeTime,omitempty"`
+	AckTime        int64  `json:"ackTime,omitempty"`   // Milliseconds from creation to acknowledgement
+	CloseTime      int64  `json:"closeTime,omitempty"` // Milliseconds from creation to close
 	AcknowledgedBy string `json:"acknowledgedBy,omitempty"`
 	ClosedBy       string `json:"closedBy,omitempty"`
 }
 
+// TimeToAck returns how long the alert took to be acknowledged
+func (r *Report) TimeToAck() time.Duration {
+	return time.Duration(r.AckTime) * time.Millisecond
+}
+
+// TimeToClose returns how long the alert took to be closed
+func (r *Report) TimeToClose() time.Duration {
+	return time.Duration(r.CloseTime) * time.Millisecond
+}
+
 // AlertRequest represents a request to create or update an alert
 type AlertRequest struct {
 	Message     string            `json:"message"`
@@ -137,6 +251,58 @@ type ListAlertsResponse struct {
 	RequestID string      `json:"requestId,omitempty"`
 }
 
+// AlertNote represents a note on an alert
+type AlertNote struct {
+	Note      string `json:"note"`
+	Owner     string `json:"owner,omitempty"`
+	CreatedAt Time   `json:"createdAt"`
+	Offset    string `json:"offset,omitempty"`
+}
+
+// AlertLog represents an entry in an alert's activity log
+type AlertLog struct {
+	Log       string `json:"log"`
+	Type      string `json:"type,omitempty"`
+	Owner     string `json:"owner,omitempty"`
+	CreatedAt Time   `json:"createdAt"`
+	Offset    string `json:"offset,omitempty"`
+}
+
+// AlertRecipient represents a user notified about an alert and their notification state
+type AlertRecipient struct {
+	User      *User  `json:"user,omitempty"`
+	State     string `json:"state,omitempty"`
+	Method    string `json:"method,omitempty"`
+	CreatedAt Time   `json:"createdAt"`
+	UpdatedAt *Time  `json:"updatedAt,omitempty"`
+}
+
+// AlertActivityOptions represents pagination options for alert notes and logs
+type AlertActivityOptions struct {
+	Offset    string // Offset of the entry to start from, as returned in a previous page
+	Direction string // "next" or "prev" (default next)
+	Order     string // "asc" or "desc" (default desc)
+	Limit     int    // Maximum entries per page (max 100)
+}
+
+// ListAlertNotesResponse represents the response when listing alert notes
+type ListAlertNotesResponse struct {
+	Data       []AlertNote `json:"data"`
+	Paging     *Pagination `json:"paging,omitempty"`
+	NextOffset string      `json:"nextOffset,omitempty"`
+	Took       float64     `json:"took,omitempty"`
+	RequestID  string      `json:"requestId,omitempty"`
+}
+
+// ListAlertLogsResponse represents the response when listing alert logs
+type ListAlertLogsResponse struct {
+	Data       []AlertLog  `json:"data"`
+	Paging     *Pagination `json:"paging,omitempty"`
+	NextOffset string      `json:"nextOffset,omitempty"`
+	Took       float64     `json:"took,omitempty"`
+	RequestID  string      `json:"requestId,omitempty"`
+}
+
 // Incident represents an Opsgenie incident
 type Incident struct {
 	ID               string                 `json:"id"`
@@ -144,8 +310,8 @@ type Incident struct {
 	Message          string                 `json:"message"`
 	Status           IncidentStatus         `json:"status"`
 	Tags             []string               `json:"tags,omitempty"`
-	CreatedAt        time.Time              `json:"createdAt"`
-	UpdatedAt        *time.Time             `json:"updatedAt,omitempty"`
+	CreatedAt        Time                   `json:"createdAt"`
+	UpdatedAt        *Time                  `json:"updatedAt,omitempty"`
 	Priority         Priority               `json:"priority"`
 	OwnerTeam        string                 `json:"ownerTeam,omitempty"`
 	Responders       []Responder            `json:"responders,omitempty"`
@@ -156,6 +322,11 @@ type Incident struct {
 	ImpactedServices []string               `json:"impactedServices,omitempty"`
 }
 
+// Age returns how long ago the incident was opened
+func (i *Incident) Age(now time.Time) time.Duration {
+	return i.CreatedAt.Since(now)
+}
+
 // IncidentRequest represents a request to create or update an incident
 type IncidentRequest struct {
 	Message            string            `json:"message"`
@@ -177,6 +348,15 @@ type StatusPageEntity struct {
 	Description string `json:"description,omitempty"`
 }
 
+// ListIncidentsResponse represents the response when listing incidents
+type ListIncidentsResponse struct {
+	Data       []Incident  `json:"data"`
+	TotalCount int         `json:"totalCount,omitempty"`
+	Paging     *Pagination `json:"paging,omitempty"`
+	Took       float64     `json:"took,omitempty"`
+	RequestID  string      `json:"requestId,omitempty"`
+}
+
 // IncidentResponse represents a generic incident response
 type IncidentResponse struct {
 	Data      *Incident `json:"data,omitempty"`
@@ -185,6 +365,31 @@ type IncidentResponse struct {
 	RequestID string    `json:"requestId,omitempty"`
 }
 
+// Service represents a business service that incidents can impact
+type Service struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	TeamID      string        `json:"teamId,omitempty"`
+	Tags        []string      `json:"tags,omitempty"`
+	IsExternal  bool          `json:"isExternal,omitempty"`
+	Links       *ServiceLinks `json:"links,omitempty"`
+}
+
+// ServiceLinks represents links to a service
+type ServiceLinks struct {
+	Web string `json:"web,omitempty"`
+	API string `json:"api,omitempty"`
+}
+
+// ListServicesResponse represents the response when listing services
+type ListServicesResponse struct {
+	Data      []Service   `json:"data"`
+	Paging    *Pagination `json:"paging,omitempty"`
+	Took      float64     `json:"took,omitempty"`
+	RequestID string      `json:"requestId,omitempty"`
+}
+
 // Schedule represents an Opsgenie schedule
 type Schedule struct {
 	ID          string     `json:"id"`
@@ -226,9 +431,16 @@ type Restriction struct {
 
 // OnCall represents on-call information
 type OnCall struct {
-	ScheduleID       string   `json:"scheduleId,omitempty"`
-	ScheduleName     string   `json:"scheduleName,omitempty"`
-	OnCallRecipients []string `json:"onCallRecipients,omitempty"`
+	ScheduleID       string     `json:"scheduleId,omitempty"`
+	ScheduleName     string     `json:"scheduleName,omitempty"`
+	OnCallRecipients []string   `json:"onCallRecipients,omitempty"`
+	Date             *time.Time `json:"date,omitempty"`
+}
+
+// OnCallOptions represents options for retrieving on-call participants
+type OnCallOptions struct {
+	Date time.Time // Point in time to resolve on-calls for (zero means now)
+	Flat bool      // Resolve escalations and teams down to the individual users
 }
 
 // ScheduleOnCallResponse represents the response from per-schedule on-call endpoint
@@ -239,6 +451,7 @@ type ScheduleOnCallResponse struct {
 		Enabled bool   `json:"enabled"`
 	} `json:"_parent"`
 	OnCallParticipants []string `json:"onCallParticipants"`
+	OnCallRecipients   []string `json:"onCallRecipients"` // Set instead of participants when flat=true
 }
 
 // ScheduleTimeline represents schedule timeline information
@@ -251,6 +464,39 @@ type ScheduleTimeline struct {
 	Forwardings   []Forwarding   `json:"forwardings,omitempty"`
 }
 
+// Localize shows the times of the timeline in the location of now and sets
+// when each period starts and ends relative to now
+func (t *ScheduleTimeline) Localize(now time.Time) {
+	loc := now.Location()
+	t.StartDate = t.StartDate.In(loc)
+	t.EndDate = t.EndDate.In(loc)
+
+	var rotations []TimelineRotation
+	if t.FinalTimeline != nil {
+		rotations = append(rotations, t.FinalTimeline.Rotations...)
+	}
+	if t.BaseTimeline != nil {
+		rotations = append(rotations, t.BaseTimeline.Rotations...)
+	}
+	for _, rotation := range rotations {
+		for i := range rotation.Periods {
+			period := &rotation.Periods[i]
+			period.StartDate = period.StartDate.In(loc)
+			period.EndDate = period.EndDate.In(loc)
+			period.Starts = atlassian.RelativeTime(period.StartDate, now)
+			period.Ends = atlassian.RelativeTime(period.EndDate, now)
+		}
+	}
+	for i := range t.Overrides {
+		t.Overrides[i].StartDate = t.Overrides[i].StartDate.In(loc)
+		t.Overrides[i].EndDate = t.Overrides[i].EndDate.In(loc)
+	}
+	for i := range t.Forwardings {
+		t.Forwardings[i].StartDate = t.Forwardings[i].StartDate.In(loc)
+		t.Forwardings[i].EndDate = t.Forwardings[i].EndDate.In(loc)
+	}
+}
+
 // FinalTimeline represents the final computed timeline
 type FinalTimeline struct {
 	Rotations []TimelineRotation `json:"rotations,omitempty"`
@@ -273,6 +519,11 @@ type TimelinePeriod struct {
 	StartDate time.Time  `json:"startDate"`
 	EndDate   time.Time  `json:"endDate"`
 	Recipient *Responder `json:"recipient,omitempty"`
+
+	// When the period starts and ends relative to now (e.g., "in 2 days"),
+	// set by ScheduleTimeline.Localize
+	Starts string `json:"starts,omitempty"`
+	Ends   string `json:"ends,omitempty"`
 }
 
 // Override represents a schedule override
@@ -305,6 +556,85 @@ type TeamMember struct {
 	Role string `json:"role,omitempty"`
 }
 
+// TeamMemberRole represents the role of a team member
+type TeamMemberRole string
+
+const (
+	TeamMemberRoleAdmin TeamMemberRole = "admin"
+	TeamMemberRoleUser  TeamMemberRole = "user"
+)
+
+// RoutingRule represents a team routing rule, which decides who is notified
+// about an alert that matches its criteria
+type RoutingRule struct {
+	ID              string           `json:"id"`
+	Name            string           `json:"name,omitempty"`
+	IsDefault       bool             `json:"isDefault,omitempty"`
+	Order           int              `json:"order"`
+	Timezone        string           `json:"timezone,omitempty"`
+	Criteria        *Criteria        `json:"criteria,omitempty"`
+	TimeRestriction *TimeRestriction `json:"timeRestriction,omitempty"`
+	Notify          *Responder       `json:"notify,omitempty"`
+}
+
+// PolicyType is the kind of a policy
+type PolicyType string
+
+const (
+	PolicyTypeAlert        PolicyType = "alert"        // Modifies alerts as they are created
+	PolicyTypeNotification PolicyType = "notification" // Suppresses, delays, or deduplicates notifications of a team
+)
+
+// PolicySummary represents a policy in a list of policies
+type PolicySummary struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+	Order   int    `json:"order"`
+}
+
+// Policy represents an alert or notification policy. Fields that only apply
+// to one type are empty for the other.
+type Policy struct {
+	ID               string           `json:"id"`
+	Name             string           `json:"name"`
+	Type             string           `json:"type"`
+	Description      string           `json:"policyDescription,omitempty"`
+	TeamID           string           `json:"teamId,omitempty"`
+	Enabled          bool             `json:"enabled"`
+	Filter           *Criteria        `json:"filter,omitempty"`
+	TimeRestrictions *TimeRestriction `json:"timeRestrictions,omitempty"`
+
+	// Alert policies
+	Message  string   `json:"message,omitempty"`
+	Priority Priority `json:"priority,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Continue bool     `json:"continue,omitempty"` // Later policies are also applied
+
+	// Notification policies
+	Suppress            bool                   `json:"suppress,omitempty"` // Notifications are not sent
+	DelayAction         map[string]interface{} `json:"delayAction,omitempty"`
+	DeduplicationAction map[string]interface{} `json:"deduplicationAction,omitempty"`
+	AutoCloseAction     map[string]interface{} `json:"autoCloseAction,omitempty"`
+}
+
+// Criteria represents the conditions an alert must match
+type Criteria struct {
+	Type       string      `json:"type"`
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// Condition represents a single criteria condition
+type Condition struct {
+	Field         string `json:"field"`
+	Key           string `json:"key,omitempty"`
+	Not           bool   `json:"not,omitempty"`
+	Operation     string `json:"operation"`
+	ExpectedValue string `json:"expectedValue,omitempty"`
+	Order         int    `json:"order,omitempty"`
+}
+
 // User represents an Opsgenie user
 type User struct {
 	ID       string   `json:"id,omitempty"`
@@ -325,17 +655,46 @@ type Role struct {
 	Name string `json:"name,omitempty"`
 }
 
+// Heartbeat represents a heartbeat monitor. Opsgenie creates an alert when a
+// heartbeat is not pinged within its interval.
+type Heartbeat struct {
+	Name          string   `json:"name"`
+	Description   string   `json:"description,omitempty"`
+	Interval      int      `json:"interval"`
+	IntervalUnit  string   `json:"intervalUnit"`
+	Enabled       bool     `json:"enabled"`
+	Expired       bool     `json:"expired"`
+	OwnerTeam     *Team    `json:"ownerTeam,omitempty"`
+	AlertMessage  string   `json:"alertMessage,omitempty"`
+	AlertTags     []string `json:"alertTags,omitempty"`
+	AlertPriority Priority `json:"alertPriority,omitempty"`
+}
+
 // AsyncResponse represents an asynchronous operation response
 type AsyncResponse struct {
 	IsSuccess     bool   `json:"isSuccess"`
 	Status        string `json:"status"`
 	Action        string `json:"action,omitempty"`
-	ProcessedAt   string `json:"processedAt,omitempty"`
+	ProcessedAt   *Time  `json:"processedAt,omitempty"`
 	IntegrationID string `json:"integrationId,omitempty"`
 	AlertID       string `json:"alertId,omitempty"`
 	Alias         string `json:"alias,omitempty"`
 }
 
+// Account represents Opsgenie account information
+type Account struct {
+	Name      string       `json:"name"`
+	UserCount int          `json:"userCount,omitempty"`
+	Plan      *AccountPlan `json:"plan,omitempty"`
+}
+
+// AccountPlan represents the subscription plan of an account
+type AccountPlan struct {
+	MaxUserCount int    `json:"maxUserCount,omitempty"`
+	Name         string `json:"name,omitempty"`
+	IsYearly     bool   `json:"isYearly,omitempty"`
+}
+
 // ErrorResponse represents an Opsgenie error response
 type ErrorResponse struct {
 	Message   string  `json:"message,omitempty"`
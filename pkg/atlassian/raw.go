@@ -0,0 +1,84 @@
+package atlassian
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RawMethods are the HTTP methods raw requests can use
+var RawMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// RawResponse is the response to a raw API request
+type RawResponse struct {
+	StatusCode int
+	Body       []byte
+}
+
+// ValidateRawRequest checks the method and path of a raw API request. The
+// path must be relative to the product's base URL (e.g. "/rest/api/2/field"),
+// so a request can't leave the configured site.
+func ValidateRawRequest(method, path string) error {
+	if !isRawMethod(method) {
+		return fmt.Errorf("unsupported HTTP method %q: use one of %s", method, strings.Join(RawMethods, ", "))
+	}
+
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return fmt.Errorf("path %q must start with a single / and be relative to the base URL", path)
+	}
+	u, err := url.Parse(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	if u.Scheme != "" || u.Host != "" {
+		return fmt.Errorf("path %q must be relative to the base URL", path)
+	}
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment == ".." {
+			return fmt.Errorf("path %q must not contain '..' segments", path)
+		}
+	}
+	return nil
+}
+
+// DoRaw sends a raw API request through doer after validating it. Error
+// statuses are turned into errors by parseError, the product client's own
+// error response parser.
+func DoRaw(ctx context.Context, doer ClientDoer, method, path string, body []byte, parseError func(statusCode int, body []byte) error) (*RawResponse, error) {
+	if err := ValidateRawRequest(method, path); err != nil {
+		return nil, err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	resp, err := doer.Do(ctx, method, path, reader)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, parseError(resp.StatusCode, respBody)
+	}
+
+	return &RawResponse{StatusCode: resp.StatusCode, Body: respBody}, nil
+}
+
+func isRawMethod(method string) bool {
+	for _, m := range RawMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestValidateRawRequest(t *testing.T) {
+	tests := []struct {
+		method  string
+		path    string
+		wantErr bool
+	}{
+		{"GET", "/rest/api/2/field", false},
+		{"POST", "/rest/api/2/search?jql=project%3DPROJ", false},
+		{"PATCH", "/v2/alerts/1", false},
+		{"get", "/rest/api/2/field", true},
+		{"TRACE", "/rest/api/2/field", true},
+		{"GET", "rest/api/2/field", true},
+		{"GET", "//evil.example.com/rest", true},
+		{"GET", "https://evil.example.com/rest", true},
+		{"GET", "/rest/api/../../admin", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			err := ValidateRawRequest(tt.method, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRawRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type rawDoer struct {
+	status int
+	body   string
+	sent   string
+}
+
+func (d *rawDoer) Do(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	if body != nil {
+		data, _ := io.ReadAll(body)
+		d.sent = string(data)
+	}
+	return &http.Response{StatusCode: d.status, Body: io.NopCloser(strings.NewReader(d.body))}, nil
+}
+
+func TestDoRaw(t *testing.T) {
+	parseError := func(statusCode int, body []byte) error {
+		return fmt.Errorf("parsed %d: %s", statusCode, body)
+	}
+
+	doer := &rawDoer{status: http.StatusCreated, body: `{"id": "1"}`}
+	resp, err := DoRaw(context.Background(), doer, "POST", "/v2/alerts", []byte(`{"message": "down"}`), parseError)
+	if err != nil || resp.StatusCode != http.StatusCreated || string(resp.Body) != `{"id": "1"}` {
+		t.Fatalf("DoRaw() = %+v, %v", resp, err)
+	}
+	if doer.sent != `{"message": "down"}` {
+		t.Errorf("sent body = %q", doer.sent)
+	}
+
+	doer = &rawDoer{status: http.StatusNotFound, body: "missing"}
+	if _, err := DoRaw(context.Background(), doer, "GET", "/v2/alerts/1", nil, parseError); err == nil || err.Error() != "parsed 404: missing" {
+		t.Errorf("DoRaw() error = %v, want the product's parsed error", err)
+	}
+
+	if _, err := DoRaw(context.Background(), doer, "GET", "//evil.example.com", nil, parseError); err == nil {
+		t.Error("DoRaw() should validate the request")
+	}
+}
@@ -0,0 +1,137 @@
+package atlassian
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayouts are the absolute time formats ParseTime accepts. Layouts
+// without a zone are read in the location of now.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000-0700", // Jira
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+var (
+	// timeExprPattern matches an expression without its time of day: an
+	// optional anchor and offsets
+	timeExprPattern = regexp.MustCompile(`^(now|today|yesterday|tomorrow|(?:start|end)-of-(?:day|week|month))?((?:[+-]\d+[mhdw])*)$`)
+
+	// timeOffsetPattern matches one offset, e.g. "-2d"
+	timeOffsetPattern = regexp.MustCompile(`([+-])(\d+)([mhdw])`)
+
+	// timeOfDayPattern matches a time of day, e.g. "09:00"
+	timeOfDayPattern = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+)
+
+// ParseTime parses an absolute time or a time expression relative to now.
+// Absolute times are ISO 8601 (e.g. "2024-01-15T09:00:00Z" or
+// "2024-01-15"). An expression is an anchor, optional offsets, and an
+// optional time of day:
+//
+//   - anchors: now, today, yesterday, tomorrow, start-of-day, end-of-day,
+//     start-of-week, end-of-week, start-of-month, end-of-month (weeks start on
+//     Monday; an end is the start of the next day, week, or month)
+//   - offsets: +N or -N minutes (m), hours (h), days (d), or weeks (w), e.g.
+//     "now-2d" or "start-of-week+1d-2h"; offsets alone are relative to now
+//   - time of day: HH:MM after the anchor and offsets, e.g. "tomorrow 09:00";
+//     alone it is today at that time
+//
+// Days and times of day are those of now's location.
+func ParseTime(expr string, now time.Time) (time.Time, error) {
+	value := strings.TrimSpace(expr)
+	loc := now.Location()
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	t, err := parseTimeExpression(strings.ToLower(value), now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: %w; use ISO 8601 (e.g. 2024-01-15T09:00:00Z) or an expression such as now-2d, start-of-week, or tomorrow 09:00", expr, err)
+	}
+	return t, nil
+}
+
+func parseTimeExpression(value string, now time.Time) (time.Time, error) {
+	// A trailing time of day
+	clock := ""
+	if i := strings.LastIndex(value, " "); i >= 0 {
+		value, clock = strings.TrimSpace(value[:i]), value[i+1:]
+	} else if timeOfDayPattern.MatchString(value) {
+		value, clock = "today", value
+	}
+
+	m := timeExprPattern.FindStringSubmatch(value)
+	if m == nil || (m[1] == "" && m[2] == "" && clock == "") {
+		return time.Time{}, fmt.Errorf("not a time or time expression")
+	}
+
+	t := timeAnchor(m[1], now)
+	for _, offset := range timeOffsetPattern.FindAllStringSubmatch(m[2], -1) {
+		n, _ := strconv.Atoi(offset[2])
+		if offset[1] == "-" {
+			n = -n
+		}
+		switch offset[3] {
+		case "m":
+			t = t.Add(time.Duration(n) * time.Minute)
+		case "h":
+			t = t.Add(time.Duration(n) * time.Hour)
+		case "d":
+			t = t.AddDate(0, 0, n)
+		case "w":
+			t = t.AddDate(0, 0, 7*n)
+		}
+	}
+
+	if clock != "" {
+		c := timeOfDayPattern.FindStringSubmatch(clock)
+		if c == nil {
+			return time.Time{}, fmt.Errorf("invalid time of day %q", clock)
+		}
+		hour, _ := strconv.Atoi(c[1])
+		minute, _ := strconv.Atoi(c[2])
+		if hour > 23 || minute > 59 {
+			return time.Time{}, fmt.Errorf("invalid time of day %q", clock)
+		}
+		t = time.Date(t.Year(), t.Month(), t.Day(), hour, minute, 0, 0, t.Location())
+	}
+	return t, nil
+}
+
+// timeAnchor resolves the anchor of an expression; an empty anchor is now
+func timeAnchor(anchor string, now time.Time) time.Time {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	switch anchor {
+	case "today", "start-of-day":
+		return today
+	case "yesterday":
+		return today.AddDate(0, 0, -1)
+	case "tomorrow", "end-of-day":
+		return today.AddDate(0, 0, 1)
+	case "start-of-week":
+		return monday
+	case "end-of-week":
+		return monday.AddDate(0, 0, 7)
+	case "start-of-month":
+		return month
+	case "end-of-month":
+		return month.AddDate(0, 1, 0)
+	default:
+		return now
+	}
+}
@@ -0,0 +1,68 @@
+package atlassian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	loc := time.FixedZone("CEST", 2*60*60)
+	// A Wednesday
+	now := time.Date(2024, 5, 15, 14, 30, 0, 0, loc)
+
+	tests := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{"RFC 3339", "2024-01-15T09:00:00Z", time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)},
+		{"Jira format", "2024-01-15T09:00:00.000+0000", time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)},
+		{"date", "2024-01-15", time.Date(2024, 1, 15, 0, 0, 0, 0, loc)},
+		{"local time", "2024-01-15 09:00", time.Date(2024, 1, 15, 9, 0, 0, 0, loc)},
+		{"now", "now", now},
+		{"now minus days", "now-2d", now.AddDate(0, 0, -2)},
+		{"offset alone", "-7d", now.AddDate(0, 0, -7)},
+		{"offsets", "now+1h-30m", now.Add(30 * time.Minute)},
+		{"today", "today", time.Date(2024, 5, 15, 0, 0, 0, 0, loc)},
+		{"yesterday", "yesterday", time.Date(2024, 5, 14, 0, 0, 0, 0, loc)},
+		{"start of week", "start-of-week", time.Date(2024, 5, 13, 0, 0, 0, 0, loc)},
+		{"start of week plus a day", "start-of-week+1d", time.Date(2024, 5, 14, 0, 0, 0, 0, loc)},
+		{"end of week", "end-of-week", time.Date(2024, 5, 20, 0, 0, 0, 0, loc)},
+		{"end of month", "end-of-month", time.Date(2024, 6, 1, 0, 0, 0, 0, loc)},
+		{"last week", "start-of-week-1w", time.Date(2024, 5, 6, 0, 0, 0, 0, loc)},
+		{"tomorrow at", "tomorrow 09:00", time.Date(2024, 5, 16, 9, 0, 0, 0, loc)},
+		{"time of day", "09:00", time.Date(2024, 5, 15, 9, 0, 0, 0, loc)},
+		{"case and spaces", "  Tomorrow 9:15 ", time.Date(2024, 5, 16, 9, 15, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTime(tt.expr, now)
+			if err != nil {
+				t.Fatalf("ParseTime(%q) error = %v", tt.expr, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseTime(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTimeSundayStartOfWeek(t *testing.T) {
+	sunday := time.Date(2024, 5, 19, 23, 0, 0, 0, time.UTC)
+	got, err := ParseTime("start-of-week", sunday)
+	if err != nil {
+		t.Fatalf("ParseTime() error = %v", err)
+	}
+	if want := time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("ParseTime() = %v, want %v", got, want)
+	}
+}
+
+func TestParseTimeInvalid(t *testing.T) {
+	now := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+	for _, expr := range []string{"", "soon", "now-2x", "next week", "tomorrow 25:00", "2024-13-01"} {
+		if _, err := ParseTime(expr, now); err == nil {
+			t.Errorf("ParseTime(%q) should fail", expr)
+		}
+	}
+}